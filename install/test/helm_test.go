@@ -5321,6 +5321,26 @@ metadata:
 					testManifest.ExpectConfigMapWithYamlData(envoyBootstrapCm)
 				})
 
+				It("can set the local cluster of a gateway proxy", func() {
+					prepareMakefileFromValuesFile("values/val_local_cluster.yaml")
+
+					byt, err := os.ReadFile("fixtures/envoy_config/local_cluster.yaml")
+					Expect(err).ToNot(HaveOccurred())
+					envoyBootstrapYaml := string(byt)
+
+					envoyBootstrapSpec := make(map[string]string)
+					envoyBootstrapSpec["envoy.yaml"] = envoyBootstrapYaml
+
+					cmRb := ResourceBuilder{
+						Namespace: namespace,
+						Name:      gatewayProxyConfigMapName,
+						Labels:    labels,
+						Data:      envoyBootstrapSpec,
+					}
+					envoyBootstrapCm := cmRb.GetConfigMap()
+					testManifest.ExpectConfigMapWithYamlData(envoyBootstrapCm)
+				})
+
 				It("can create a gateway proxy with added overload manager config", func() {
 					prepareMakefile(namespace, helmValues{
 						valuesArgs: []string{
@@ -118,7 +118,12 @@ var _ = Describe("RBAC Test", func() {
 						Rules: []rbacv1.PolicyRule{
 							{
 								APIGroups: []string{""},
-								Resources: []string{"pods", "services", "secrets", "endpoints", "configmaps", "namespaces"},
+								Resources: []string{"pods", "services", "secrets", "endpoints", "configmaps", "namespaces", "nodes"},
+								Verbs:     []string{"get", "list", "watch"},
+							},
+							{
+								APIGroups: []string{"discovery.k8s.io"},
+								Resources: []string{"endpointslices"},
 								Verbs:     []string{"get", "list", "watch"},
 							},
 						},
@@ -807,6 +812,21 @@ var _ = Describe("RBAC Test", func() {
 								Resources: []string{"proxies"},
 								Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
 							},
+							{
+								APIGroups: []string{"gloo.solo.io"},
+								Resources: []string{"upstreamgroups"},
+								Verbs:     []string{"update"},
+							},
+							{
+								APIGroups: []string{"gateway.solo.io"},
+								Resources: []string{"canaries"},
+								Verbs:     []string{"get", "list", "watch", "update", "patch"},
+							},
+							{
+								APIGroups: []string{""},
+								Resources: []string{"events"},
+								Verbs:     []string{"create"},
+							},
 						},
 						RoleRef: rbacv1.RoleRef{
 							APIGroup: "rbac.authorization.k8s.io",
@@ -10,7 +10,13 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		"gloo-system.gloo",
 		namespace,
 		[]string{""},
-		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints"},
+		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints", "nodes"},
+		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gloo",
+		namespace,
+		[]string{"discovery.k8s.io"},
+		[]string{"endpointslices"},
 		[]string{"get", "list", "watch"})
 	permissions.AddExpectedPermission(
 		"gloo-system.gloo",
@@ -44,6 +50,24 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{"gloo.solo.io"},
 		[]string{"proxies"},
 		[]string{"get", "list", "watch", "update", "patch", "create", "delete"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gloo",
+		namespace,
+		[]string{"gloo.solo.io"},
+		[]string{"upstreamgroups"},
+		[]string{"update"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gloo",
+		namespace,
+		[]string{"gateway.solo.io"},
+		[]string{"canaries"},
+		[]string{"get", "list", "watch", "update", "patch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gloo",
+		namespace,
+		[]string{""},
+		[]string{"events"},
+		[]string{"create"})
 	permissions.AddExpectedPermission(
 		"gloo-system.gloo",
 		namespace,
@@ -74,7 +98,13 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		"gloo-system.discovery",
 		namespace,
 		[]string{""},
-		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints"},
+		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints", "nodes"},
+		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.discovery",
+		namespace,
+		[]string{"discovery.k8s.io"},
+		[]string{"endpointslices"},
 		[]string{"get", "list", "watch"})
 	permissions.AddExpectedPermission(
 		"gloo-system.discovery",
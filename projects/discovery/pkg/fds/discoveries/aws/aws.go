@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"os"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -25,6 +26,9 @@ const (
 	AWS_WEB_IDENTITY_TOKEN_FILE = "AWS_WEB_IDENTITY_TOKEN_FILE"
 	AWS_ROLE_ARN                = "AWS_ROLE_ARN"
 	AWS_REGION                  = "AWS_REGION"
+
+	latestQualifier     = "$LATEST"
+	unqualifiedArnParts = 7
 )
 
 func NewFunctionDiscoveryFactory() fds.FunctionDiscoveryFactory {
@@ -36,11 +40,14 @@ func NewFunctionDiscoveryFactory() fds.FunctionDiscoveryFactory {
 // AWSLambdaFunctionDiscoveryFactory represents a factory for AWS Lambda function discovery.
 type AWSLambdaFunctionDiscoveryFactory struct {
 	PollingTime time.Duration
+	// Endpoint overrides the AWS Lambda API endpoint, e.g. to discover functions from a local stand-in of the API.
+	Endpoint string
 }
 
 func (f *AWSLambdaFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, _ fds.AdditionalClients) fds.UpstreamFunctionDiscovery {
 	return &AWSLambdaFunctionDiscovery{
 		timeToWait: f.PollingTime,
+		endpoint:   f.Endpoint,
 		upstream:   u,
 	}
 }
@@ -48,6 +55,7 @@ func (f *AWSLambdaFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream,
 // AWSLambdaFunctionDiscovery is a discovery that polls AWS Lambda for function discovery.
 type AWSLambdaFunctionDiscovery struct {
 	timeToWait time.Duration
+	endpoint   string
 	upstream   *v1.Upstream
 }
 
@@ -134,7 +142,11 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, se
 	if awsRegion == "" {
 		awsRegion = os.Getenv(AWS_REGION)
 	}
-	sess, err := awsutils.GetAwsSession(lambdaSpec.GetSecretRef(), secrets, &aws.Config{Region: aws.String(lambdaSpec.GetRegion())})
+	config := &aws.Config{Region: aws.String(lambdaSpec.GetRegion())}
+	if f.endpoint != "" {
+		config.Endpoint = aws.String(f.endpoint)
+	}
+	sess, err := awsutils.GetAwsSession(lambdaSpec.GetSecretRef(), secrets, config)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create AWS session")
 	}
@@ -156,32 +168,116 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, se
 		svc = lambda.New(sess)
 	}
 
-	var newfunctions []*glooaws.LambdaFunctionSpec
+	return discoverFunctions(ctx, svc, lambdaSpec.GetLambdaDiscovery())
+}
 
-	options := &lambda.ListFunctionsInput{FunctionVersion: aws.String("ALL")}
-	err = svc.ListFunctionsPagesWithContext(ctx, options, func(results *lambda.ListFunctionsOutput, _ bool) bool {
+// discoverFunctions lists every published version of the functions matching the tag selector, along with their
+// aliases and function urls if enabled. Functions which were deleted in AWS are simply absent from the result, which
+// then replaces the functions of the upstream.
+func discoverFunctions(ctx context.Context, svc *lambda.Lambda, options *glooaws.LambdaDiscovery) ([]*glooaws.LambdaFunctionSpec, error) {
+	// tags, aliases and function urls belong to the function rather than to its versions,
+	// so group the versions by function
+	var functionNames []string
+	functionArns := make(map[string]string)
+	functionVersions := make(map[string][]string)
 
+	listOptions := &lambda.ListFunctionsInput{FunctionVersion: aws.String("ALL")}
+	err := svc.ListFunctionsPagesWithContext(ctx, listOptions, func(results *lambda.ListFunctionsOutput, _ bool) bool {
 		for _, f := range results.Functions {
-			version := aws.StringValue(f.Version)
 			name := aws.StringValue(f.FunctionName)
-
-			logicalName := fmt.Sprintf("%s:%s", name, version)
-			if version == "$LATEST" {
-				logicalName = name
+			if _, ok := functionVersions[name]; !ok {
+				functionNames = append(functionNames, name)
+				functionArns[name] = unqualifiedFunctionArn(aws.StringValue(f.FunctionArn))
 			}
-
-			newfunctions = append(newfunctions, &glooaws.LambdaFunctionSpec{
-				LambdaFunctionName: name,
-				Qualifier:          version,
-				LogicalName:        logicalName,
-			})
+			functionVersions[name] = append(functionVersions[name], aws.StringValue(f.Version))
 		}
-
 		return true
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get list of functions from AWS")
 	}
 
+	var newfunctions []*glooaws.LambdaFunctionSpec
+	for _, name := range functionNames {
+		if len(options.GetTagSelector()) > 0 {
+			tags, err := svc.ListTagsWithContext(ctx, &lambda.ListTagsInput{Resource: aws.String(functionArns[name])})
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to get tags of function %s from AWS", name)
+			}
+			if !matchesTags(tags.Tags, options.GetTagSelector()) {
+				continue
+			}
+		}
+
+		var functionUrls map[string]string
+		if options.GetDiscoverFunctionUrls() {
+			functionUrls, err = listFunctionUrls(ctx, svc, name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to get function urls of function %s from AWS", name)
+			}
+		}
+
+		for _, version := range functionVersions[name] {
+			newfunctions = append(newfunctions, functionSpec(name, version, functionUrls[version]))
+		}
+
+		if options.GetDiscoverAliases() {
+			aliasOptions := &lambda.ListAliasesInput{FunctionName: aws.String(name)}
+			err = svc.ListAliasesPagesWithContext(ctx, aliasOptions, func(results *lambda.ListAliasesOutput, _ bool) bool {
+				for _, alias := range results.Aliases {
+					aliasName := aws.StringValue(alias.Name)
+					newfunctions = append(newfunctions, functionSpec(name, aliasName, functionUrls[aliasName]))
+				}
+				return true
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to get aliases of function %s from AWS", name)
+			}
+		}
+	}
+
 	return newfunctions, nil
 }
+
+func functionSpec(name, qualifier, functionUrl string) *glooaws.LambdaFunctionSpec {
+	logicalName := fmt.Sprintf("%s:%s", name, qualifier)
+	if qualifier == latestQualifier {
+		logicalName = name
+	}
+
+	return &glooaws.LambdaFunctionSpec{
+		LambdaFunctionName: name,
+		Qualifier:          qualifier,
+		LogicalName:        logicalName,
+		FunctionUrl:        functionUrl,
+	}
+}
+
+func matchesTags(tags map[string]*string, selector map[string]string) bool {
+	for key, value := range selector {
+		tag, ok := tags[key]
+		if !ok || aws.StringValue(tag) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// unqualifiedFunctionArn strips the version or alias from a function arn,
+// i.e. arn:aws:lambda:us-east-1:123456789012:function:name:1 becomes arn:aws:lambda:us-east-1:123456789012:function:name
+func unqualifiedFunctionArn(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) > unqualifiedArnParts {
+		return strings.Join(parts[:unqualifiedArnParts], ":")
+	}
+	return arn
+}
+
+// qualifierFromFunctionArn returns the version or alias of a function arn, which is $LATEST if unqualified
+func qualifierFromFunctionArn(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) > unqualifiedArnParts {
+		return parts[unqualifiedArnParts]
+	}
+	return latestQualifier
+}
@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooaws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
+)
+
+const functionArnPrefix = "arn:aws:lambda:us-east-1:123456789012:function:"
+
+// lambdaStandIn serves the parts of the AWS Lambda API used by discovery
+type lambdaStandIn struct {
+	// versions by function name
+	functions map[string][]string
+	// tags by function name
+	tags map[string]map[string]string
+	// version of each alias by function name
+	aliases map[string]map[string]string
+	// function urls by qualified function arn
+	functionUrls map[string]string
+}
+
+func (s *lambdaStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var response interface{}
+	switch path := r.URL.Path; {
+	case path == "/2015-03-31/functions/":
+		var functions []map[string]string
+		for name, versions := range s.functions {
+			for _, version := range versions {
+				arn := functionArnPrefix + name
+				if version != latestQualifier {
+					arn += ":" + version
+				}
+				functions = append(functions, map[string]string{"FunctionName": name, "FunctionArn": arn, "Version": version})
+			}
+		}
+		response = map[string]interface{}{"Functions": functions}
+	case strings.HasPrefix(path, "/2017-03-31/tags/"):
+		name := strings.TrimPrefix(strings.TrimPrefix(path, "/2017-03-31/tags/"), functionArnPrefix)
+		response = map[string]interface{}{"Tags": s.tags[name]}
+	case strings.HasPrefix(path, "/2015-03-31/functions/") && strings.HasSuffix(path, "/aliases"):
+		name := strings.TrimSuffix(strings.TrimPrefix(path, "/2015-03-31/functions/"), "/aliases")
+		var aliases []map[string]string
+		for alias, version := range s.aliases[name] {
+			aliases = append(aliases, map[string]string{"Name": alias, "FunctionVersion": version})
+		}
+		response = map[string]interface{}{"Aliases": aliases}
+	case strings.HasPrefix(path, "/2021-10-31/functions/") && strings.HasSuffix(path, "/urls"):
+		name := strings.TrimSuffix(strings.TrimPrefix(path, "/2021-10-31/functions/"), "/urls")
+		var configs []map[string]string
+		for arn, url := range s.functionUrls {
+			if unqualifiedFunctionArn(arn) == functionArnPrefix+name {
+				configs = append(configs, map[string]string{"FunctionArn": arn, "FunctionUrl": url})
+			}
+		}
+		response = map[string]interface{}{"FunctionUrlConfigs": configs}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+var _ = Describe("AWS Lambda function discovery", func() {
+
+	var (
+		ctx       context.Context
+		cancel    context.CancelFunc
+		standIn   *lambdaStandIn
+		server    *httptest.Server
+		upstream  *v1.Upstream
+		secrets   v1.SecretList
+		discovery *AWSLambdaFunctionDiscovery
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		standIn = &lambdaStandIn{
+			functions: map[string][]string{
+				"orders":  {latestQualifier, "1", "2"},
+				"reports": {latestQualifier},
+			},
+			tags: map[string]map[string]string{
+				"orders":  {"team": "shop", "expose": "true"},
+				"reports": {"team": "finance"},
+			},
+			aliases: map[string]map[string]string{
+				"orders": {"live": "2"},
+			},
+			functionUrls: map[string]string{
+				functionArnPrefix + "orders":      "https://latest.lambda-url.us-east-1.on.aws/",
+				functionArnPrefix + "orders:live": "https://live.lambda-url.us-east-1.on.aws/",
+			},
+		}
+		server = httptest.NewServer(standIn)
+
+		secrets = v1.SecretList{{
+			Metadata: &core.Metadata{Name: "aws", Namespace: "gloo-system"},
+			Kind:     &v1.Secret_Aws{Aws: &v1.AwsSecret{AccessKey: "access", SecretKey: "secret"}},
+		}}
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "lambda", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Aws{
+				Aws: &glooaws.UpstreamSpec{
+					Region:    "us-east-1",
+					SecretRef: secrets[0].GetMetadata().Ref(),
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+		cancel()
+	})
+
+	detectFunctions := func() []*glooaws.LambdaFunctionSpec {
+		factory := &AWSLambdaFunctionDiscoveryFactory{Endpoint: server.URL}
+		discovery = factory.NewFunctionDiscovery(upstream, fds.AdditionalClients{}).(*AWSLambdaFunctionDiscovery)
+		functions, err := discovery.DetectFunctionsOnce(ctx, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		return functions
+	}
+
+	It("discovers every published version", func() {
+		Expect(detectFunctions()).To(ConsistOf(
+			&glooaws.LambdaFunctionSpec{LogicalName: "orders", LambdaFunctionName: "orders", Qualifier: latestQualifier},
+			&glooaws.LambdaFunctionSpec{LogicalName: "orders:1", LambdaFunctionName: "orders", Qualifier: "1"},
+			&glooaws.LambdaFunctionSpec{LogicalName: "orders:2", LambdaFunctionName: "orders", Qualifier: "2"},
+			&glooaws.LambdaFunctionSpec{LogicalName: "reports", LambdaFunctionName: "reports", Qualifier: latestQualifier},
+		))
+	})
+
+	It("discovers aliases and function urls of the functions matching the tag selector", func() {
+		upstream.GetAws().LambdaDiscovery = &glooaws.LambdaDiscovery{
+			TagSelector:          map[string]string{"team": "shop"},
+			DiscoverAliases:      true,
+			DiscoverFunctionUrls: true,
+		}
+
+		Expect(detectFunctions()).To(ConsistOf(
+			&glooaws.LambdaFunctionSpec{LogicalName: "orders", LambdaFunctionName: "orders", Qualifier: latestQualifier, FunctionUrl: "https://latest.lambda-url.us-east-1.on.aws/"},
+			&glooaws.LambdaFunctionSpec{LogicalName: "orders:1", LambdaFunctionName: "orders", Qualifier: "1"},
+			&glooaws.LambdaFunctionSpec{LogicalName: "orders:2", LambdaFunctionName: "orders", Qualifier: "2"},
+			&glooaws.LambdaFunctionSpec{LogicalName: "orders:live", LambdaFunctionName: "orders", Qualifier: "live", FunctionUrl: "https://live.lambda-url.us-east-1.on.aws/"},
+		))
+	})
+
+	It("drops functions which were deleted in AWS", func() {
+		Expect(detectFunctions()).To(HaveLen(4))
+
+		delete(standIn.functions, "orders")
+
+		Expect(detectFunctions()).To(ConsistOf(
+			&glooaws.LambdaFunctionSpec{LogicalName: "reports", LambdaFunctionName: "reports", Qualifier: latestQualifier},
+		))
+	})
+
+	It("extracts the qualifier of function arns", func() {
+		Expect(unqualifiedFunctionArn(functionArnPrefix + "orders:live")).To(Equal(functionArnPrefix + "orders"))
+		Expect(qualifierFromFunctionArn(functionArnPrefix + "orders:live")).To(Equal("live"))
+		Expect(qualifierFromFunctionArn(functionArnPrefix + "orders")).To(Equal(latestQualifier))
+	})
+})
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+// The vendored aws sdk predates Lambda Function URLs, so the ListFunctionUrlConfigs operation is declared here.
+// See https://docs.aws.amazon.com/lambda/latest/dg/API_ListFunctionUrlConfigs.html
+const opListFunctionUrlConfigs = "ListFunctionUrlConfigs"
+
+type listFunctionUrlConfigsInput struct {
+	_ struct{} `type:"structure"`
+
+	FunctionName *string `location:"uri" locationName:"FunctionName" min:"1" type:"string" required:"true"`
+
+	Marker *string `location:"querystring" locationName:"Marker" type:"string"`
+}
+
+type listFunctionUrlConfigsOutput struct {
+	_ struct{} `type:"structure"`
+
+	FunctionUrlConfigs []*functionUrlConfig `type:"list"`
+
+	NextMarker *string `type:"string"`
+}
+
+type functionUrlConfig struct {
+	_ struct{} `type:"structure"`
+
+	FunctionArn *string `type:"string"`
+
+	FunctionUrl *string `type:"string"`
+}
+
+// listFunctionUrls returns the function urls of a function by qualifier
+func listFunctionUrls(ctx context.Context, svc *lambda.Lambda, functionName string) (map[string]string, error) {
+	functionUrls := make(map[string]string)
+
+	input := &listFunctionUrlConfigsInput{FunctionName: aws.String(functionName)}
+	for {
+		output := &listFunctionUrlConfigsOutput{}
+		req := svc.NewRequest(&request.Operation{
+			Name:       opListFunctionUrlConfigs,
+			HTTPMethod: "GET",
+			HTTPPath:   "/2021-10-31/functions/{FunctionName}/urls",
+		}, input, output)
+		req.SetContext(ctx)
+		if err := req.Send(); err != nil {
+			return nil, err
+		}
+
+		for _, config := range output.FunctionUrlConfigs {
+			functionUrls[qualifierFromFunctionArn(aws.StringValue(config.FunctionArn))] = aws.StringValue(config.FunctionUrl)
+		}
+
+		if aws.StringValue(output.NextMarker) == "" {
+			return functionUrls, nil
+		}
+		input.Marker = output.NextMarker
+	}
+}
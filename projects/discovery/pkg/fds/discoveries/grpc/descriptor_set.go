@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+const (
+	// DescriptorSetAnnotation references the Artifact (a ConfigMap on Kubernetes) holding the descriptor set of the
+	// services of the upstream, as "name" or "namespace/name". Functions are discovered from the descriptor set
+	// instead of by server reflection.
+	DescriptorSetAnnotation = "discovery.solo.io/grpc-descriptor-set"
+	// DescriptorSetKeyAnnotation selects the key of the Artifact data holding the descriptor set,
+	// required if the Artifact has more than one key.
+	DescriptorSetKeyAnnotation = "discovery.solo.io/grpc-descriptor-set-key"
+)
+
+var (
+	NoArtifactClientError = errors.Errorf("the %v annotation is set, but no artifact client is configured", DescriptorSetAnnotation)
+
+	DescriptorSetKeyRequiredError = func(ref *core.ResourceRef) error {
+		return errors.Errorf("artifact %v has more than one key, select the descriptor set with the %v annotation", ref.Key(), DescriptorSetKeyAnnotation)
+	}
+
+	DescriptorSetKeyNotFoundError = func(ref *core.ResourceRef, key string) error {
+		return errors.Errorf("key %v not found in artifact %v", key, ref.Key())
+	}
+)
+
+// returns the ref to the artifact holding the descriptor set of the upstream, if it is annotated with one
+func descriptorSetRef(u *v1.Upstream) (*core.ResourceRef, string, bool) {
+	annotations := u.GetMetadata().GetAnnotations()
+	value := annotations[DescriptorSetAnnotation]
+	if value == "" {
+		return nil, "", false
+	}
+	ref := &core.ResourceRef{
+		Name:      value,
+		Namespace: u.GetMetadata().GetNamespace(),
+	}
+	if namespace, name, ok := strings.Cut(value, "/"); ok {
+		ref.Namespace, ref.Name = namespace, name
+	}
+	return ref, annotations[DescriptorSetKeyAnnotation], true
+}
+
+// reads the descriptor set, which is stored base64 encoded like protoDescriptorBin, from the artifact and returns it
+// together with the services it defines
+func loadDescriptorSet(ctx context.Context, artifacts v1.ArtifactClient, ref *core.ResourceRef, key string) (*descriptor.FileDescriptorSet, []string, error) {
+	if artifacts == nil {
+		return nil, nil, NoArtifactClientError
+	}
+	artifact, err := artifacts.Read(ref.GetNamespace(), ref.GetName(), clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "reading grpc descriptor set artifact %v", ref.Key())
+	}
+
+	data := artifact.GetData()
+	if key == "" {
+		if len(data) != 1 {
+			return nil, nil, DescriptorSetKeyRequiredError(ref)
+		}
+		for k := range data {
+			key = k
+		}
+	}
+	encoded, ok := data[key]
+	if !ok {
+		return nil, nil, DescriptorSetKeyNotFoundError(ref, key)
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "decoding grpc descriptor set in artifact %v", ref.Key())
+	}
+
+	descriptors := &descriptor.FileDescriptorSet{}
+	if err := proto.Unmarshal(raw, descriptors); err != nil {
+		return nil, nil, errors.Wrapf(err, "unmarshalling grpc descriptor set in artifact %v", ref.Key())
+	}
+	// the transcoder needs every dependency of the services in the descriptor set
+	files, err := desc.CreateFileDescriptorsFromSet(descriptors)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid grpc descriptor set in artifact %v, was it built with --include_imports?", ref.Key())
+	}
+
+	var services []string
+	for _, file := range files {
+		for _, svc := range file.GetServices() {
+			if isReflectionService(svc.GetFullyQualifiedName()) {
+				continue
+			}
+			services = append(services, svc.GetFullyQualifiedName())
+		}
+	}
+	sort.Strings(services)
+	return descriptors, services, nil
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
 	"net/url"
 	"time"
 
@@ -13,13 +14,16 @@ import (
 	"github.com/jhump/protoreflect/grpcreflect"
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	reflectpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	grpc_json_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 )
 
 func getGrpcspec(u *v1.Upstream) *grpc_json_plugins.GrpcJsonTranscoder {
@@ -62,6 +66,33 @@ func NewFunctionDiscoveryFactory() fds.FunctionDiscoveryFactory {
 	}
 }
 
+// NewFunctionDiscoveryFactoryFromOpts returns a FunctionDiscoveryFactory that can also discover functions from
+// descriptor sets stored in artifacts, and read the ssl secrets of upstreams to use reflection over TLS
+func NewFunctionDiscoveryFactoryFromOpts(opts bootstrap.Opts) fds.FunctionDiscoveryFactory {
+	f := NewFunctionDiscoveryFactory().(*FunctionDiscoveryFactory)
+	ctx := opts.WatchOpts.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts.Artifacts != nil {
+		artifacts, err := v1.NewArtifactClient(ctx, opts.Artifacts)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Warnf("grpc function discovery from descriptor sets disabled: %v", err)
+		} else {
+			f.Artifacts = artifacts
+		}
+	}
+	if opts.Secrets != nil {
+		secrets, err := v1.NewSecretClient(ctx, opts.Secrets)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Warnf("grpc reflection over tls disabled for upstreams with secrets: %v", err)
+		} else {
+			f.Secrets = secrets
+		}
+	}
+	return f
+}
+
 // FunctionDiscoveryFactory returns a FunctionDiscovery that can be used to discover functions
 // ilackarms: this is the root object
 type FunctionDiscoveryFactory struct {
@@ -69,14 +100,19 @@ type FunctionDiscoveryFactory struct {
 	DetectionTimeout   time.Duration
 	DetectionRetryBase time.Duration
 	FunctionPollTime   time.Duration
-	// TODO: move over to ArtifactClient
+	// used to read descriptor sets referenced by the DescriptorSetAnnotation
 	Artifacts v1.ArtifactClient
+	// used to read the ssl secret of the upstream when detecting its type,
+	// function discovery uses the secrets of the discovery snapshot
+	Secrets v1.SecretClient
 }
 
 // NewFunctionDiscovery returns a FunctionDiscovery that can be used to discover functions
 func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, _ fds.AdditionalClients) fds.UpstreamFunctionDiscovery {
 	return &UpstreamFunctionDiscovery{
 		upstream:     u,
+		artifacts:    f.Artifacts,
+		secrets:      f.Secrets,
 		clientGetter: getClient,
 	}
 }
@@ -84,7 +120,9 @@ func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, _ fds.Ad
 // UpstreamFunctionDiscovery represents a function discovery for upstream
 type UpstreamFunctionDiscovery struct {
 	upstream     *v1.Upstream
-	clientGetter func(ctx context.Context, url *url.URL) (*grpcreflect.Client, func() error, error)
+	artifacts    v1.ArtifactClient
+	secrets      v1.SecretClient
+	clientGetter func(ctx context.Context, url *url.URL, tlsConfig *tls.Config) (*grpcreflect.Client, func() error, error)
 }
 
 // IsFunctional returns true if the upstream is functional
@@ -96,7 +134,31 @@ func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL
 	log := contextutils.LoggerFrom(ctx)
 	log.Debugf("attempting to detect GRPC for %s", f.upstream.GetMetadata().GetName())
 
-	refClient, closeConn, err := f.clientGetter(ctx, url)
+	svcInfo := &plugins.ServiceSpec{
+		PluginType: &plugins.ServiceSpec_GrpcJsonTranscoder{
+			GrpcJsonTranscoder: &grpc_json_plugins.GrpcJsonTranscoder{
+				AutoMapping: false,
+			},
+		},
+	}
+
+	// upstreams annotated with a descriptor set are gRPC services, whether or not they implement reflection
+	if ref, key, ok := descriptorSetRef(f.upstream); ok {
+		if _, _, err := loadDescriptorSet(ctx, f.artifacts, ref, key); err != nil {
+			return nil, err
+		}
+		return svcInfo, nil
+	}
+
+	secrets, err := f.readSslSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := tlsConfigForUpstream(f.upstream.GetSslConfig(), secrets)
+	if err != nil {
+		return nil, err
+	}
+	refClient, closeConn, err := f.clientGetter(ctx, url, tlsConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -108,20 +170,30 @@ func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL
 		return nil, errors.Wrapf(err, "listing services. are you sure %v implements reflection?", url)
 	}
 
-	svcInfo := &plugins.ServiceSpec{
-		PluginType: &plugins.ServiceSpec_GrpcJsonTranscoder{
-			GrpcJsonTranscoder: &grpc_json_plugins.GrpcJsonTranscoder{
-				AutoMapping: false,
-			},
-		},
-	}
 	return svcInfo, nil
 }
 
-func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+// type detection runs before the upstream has a service spec, so it reads the ssl secret of the upstream directly
+func (f *UpstreamFunctionDiscovery) readSslSecret(ctx context.Context) (v1.SecretList, error) {
+	ref := f.upstream.GetSslConfig().GetSecretRef()
+	if ref == nil || f.secrets == nil {
+		return nil, nil
+	}
+	secret, err := f.secrets.Read(ref.GetNamespace(), ref.GetName(), clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading ssl secret for grpc reflection")
+	}
+	return v1.SecretList{secret}, nil
+}
+
+func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
 	// TODO: get backoff values from config?
 	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
-		return f.DetectFunctionsOnce(ctx, url, updatecb)
+		var secrets v1.SecretList
+		if dependencies != nil {
+			secrets = dependencies().Secrets
+		}
+		return f.DetectFunctionsOnce(ctx, url, secrets, updatecb)
 	})
 	if err != nil {
 		if ctx.Err() != nil {
@@ -143,20 +215,59 @@ func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *ur
 	return nil
 }
 
-func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url *url.URL, updatecb func(fds.UpstreamMutator) error) error {
+func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url *url.URL, secrets v1.SecretList, updatecb func(fds.UpstreamMutator) error) error {
+	var (
+		descriptors        *descriptor.FileDescriptorSet
+		servicesDiscovered []string
+		err                error
+	)
+	if ref, key, ok := descriptorSetRef(f.upstream); ok {
+		descriptors, servicesDiscovered, err = loadDescriptorSet(ctx, f.artifacts, ref, key)
+	} else {
+		descriptors, servicesDiscovered, err = f.reflectDescriptors(ctx, url, secrets)
+	}
+	if err != nil {
+		return err
+	}
+
+	rawDescriptors, err := proto.Marshal(descriptors)
+	if err != nil {
+		return errors.Wrap(err, "marshalling proto descriptors")
+	}
+	return updatecb(func(out *v1.Upstream) error {
+		svcSpec := getGrpcspec(out)
+		if svcSpec == nil {
+			if isDeprecatedGrpcspec(out) {
+				//TODO: description of how to find migration guide
+				return errors.New("Existing upstream with deprecated API found")
+			}
+			return errors.New("not a GRPC upstream")
+		}
+		svcSpec.DescriptorSet = &grpc_json_plugins.GrpcJsonTranscoder_ProtoDescriptorBin{ProtoDescriptorBin: rawDescriptors}
+		svcSpec.Services = servicesDiscovered
+		svcSpec.MatchIncomingRequestRoute = true
+		return nil
+	})
+}
+
+func (f *UpstreamFunctionDiscovery) reflectDescriptors(ctx context.Context, url *url.URL, secrets v1.SecretList) (*descriptor.FileDescriptorSet, []string, error) {
 	log := contextutils.LoggerFrom(ctx)
 
 	log.Infof("%v discovered as a gRPC service", url)
 
-	refClient, closeConn, err := getClient(ctx, url)
+	tlsConfig, err := tlsConfigForUpstream(f.upstream.GetSslConfig(), secrets)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+	refClient, closeConn, err := f.clientGetter(ctx, url, tlsConfig)
+	if err != nil {
+		return nil, nil, err
 	}
 	defer closeConn()
 
 	services, err := refClient.ListServices()
 	if err != nil {
-		return errors.Wrapf(err, "listing services. are you sure %v implements reflection?", url)
+		return nil, nil, errors.Wrapf(err, "listing services. are you sure %v implements reflection?", url)
 	}
 
 	descriptors := &descriptor.FileDescriptorSet{}
@@ -164,13 +275,13 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 	var servicesDiscovered []string
 	for _, s := range services {
 		// ignore the reflection descriptor
-		if s == "grpc.reflection.v1alpha.ServerReflection" {
+		if isReflectionService(s) {
 			continue
 		}
 		// TODO(yuval-k): do not add the same file twice
 		root, err := refClient.FileContainingSymbol(s)
 		if err != nil {
-			return errors.Wrapf(err, "getting file for svc symbol %s", s)
+			return nil, nil, errors.Wrapf(err, "getting file for svc symbol %s", s)
 		}
 		files := getDepTree(root)
 
@@ -178,29 +289,21 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 
 		servicesDiscovered = append(servicesDiscovered, s)
 	}
+	return descriptors, servicesDiscovered, nil
+}
 
-	rawDescriptors, err := proto.Marshal(descriptors)
-	if err != nil {
-		return errors.Wrap(err, "marshalling proto descriptors")
-	}
-	return updatecb(func(out *v1.Upstream) error {
-		svcSpec := getGrpcspec(out)
-		if svcSpec == nil {
-			if isDeprecatedGrpcspec(out) {
-				//TODO: description of how to find migration guide
-				return errors.New("Existing upstream with deprecated API found")
-			}
-			return errors.New("not a GRPC upstream")
-		}
-		svcSpec.DescriptorSet = &grpc_json_plugins.GrpcJsonTranscoder_ProtoDescriptorBin{ProtoDescriptorBin: rawDescriptors}
-		svcSpec.Services = servicesDiscovered
-		svcSpec.MatchIncomingRequestRoute = true
-		return nil
-	})
+func isReflectionService(service string) bool {
+	return service == "grpc.reflection.v1alpha.ServerReflection" || service == "grpc.reflection.v1.ServerReflection"
 }
-func getClient(ctx context.Context, url *url.URL) (*grpcreflect.Client, func() error, error) {
+
+func getClient(ctx context.Context, url *url.URL, tlsConfig *tls.Config) (*grpcreflect.Client, func() error, error) {
 	var dialOpts []grpc.DialOption
-	if url.Scheme != "https" {
+	switch {
+	case tlsConfig != nil:
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	case url.Scheme == "https":
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})))
+	default:
 		dialOpts = append(dialOpts, grpc.WithInsecure())
 	}
 
@@ -0,0 +1,205 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"net"
+	"net/url"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/reflect/protodesc"
+
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	grpc_json_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/gloo/test/helpers"
+)
+
+var _ = Describe("gRPC function discovery", func() {
+
+	var (
+		ctx       context.Context
+		cancel    context.CancelFunc
+		upstream  *v1.Upstream
+		artifacts v1.ArtifactClient
+		secrets   v1.SecretClient
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		resourceClientFactory := &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()}
+		var err error
+		artifacts, err = v1.NewArtifactClient(ctx, resourceClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+		secrets, err = v1.NewSecretClient(ctx, resourceClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+
+		upstream = &v1.Upstream{
+			Metadata:     &core.Metadata{Name: "grpc", Namespace: "default"},
+			UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{}},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	newDiscovery := func() *UpstreamFunctionDiscovery {
+		f := &FunctionDiscoveryFactory{Artifacts: artifacts, Secrets: secrets}
+		return f.NewFunctionDiscovery(upstream, fds.AdditionalClients{}).(*UpstreamFunctionDiscovery)
+	}
+
+	// detects the type of the upstream, then discovers its functions
+	discoverFunctions := func(url *url.URL, secretList v1.SecretList) (*grpc_json_plugins.GrpcJsonTranscoder, error) {
+		discovery := newDiscovery()
+		spec, err := discovery.DetectType(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+		upstream.GetStatic().ServiceSpec = spec
+		err = discovery.DetectFunctionsOnce(ctx, url, secretList, func(mutator fds.UpstreamMutator) error {
+			return mutator(upstream)
+		})
+		return getGrpcspec(upstream), err
+	}
+
+	healthDescriptorSet := func() *descriptor.FileDescriptorSet {
+		return &descriptor.FileDescriptorSet{
+			File: []*descriptor.FileDescriptorProto{protodesc.ToFileDescriptorProto(healthpb.File_grpc_health_v1_health_proto)},
+		}
+	}
+
+	Context("reflection over tls", func() {
+
+		var (
+			serverUrl *url.URL
+			rootCa    string
+			secret    *v1.Secret
+		)
+
+		BeforeEach(func() {
+			cert, key := helpers.GetCerts(helpers.Params{Hosts: "grpc-server", IsCA: true})
+			rootCa = cert
+			keyPair, err := tls.X509KeyPair([]byte(cert), []byte(key))
+			Expect(err).NotTo(HaveOccurred())
+
+			server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{keyPair}})))
+			healthpb.RegisterHealthServer(server, health.NewServer())
+			reflection.Register(server)
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go server.Serve(lis)
+			DeferCleanup(server.Stop)
+
+			serverUrl = &url.URL{Scheme: "tcp", Host: lis.Addr().String()}
+
+			secret = &v1.Secret{
+				Metadata: &core.Metadata{Name: "grpc-tls", Namespace: "default"},
+				Kind:     &v1.Secret_Tls{Tls: &v1.TlsSecret{RootCa: rootCa}},
+			}
+			secret, err = secrets.Write(secret, clients.WriteOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			upstream.SslConfig = &ssl.UpstreamSslConfig{
+				SslSecrets: &ssl.UpstreamSslConfig_SecretRef{SecretRef: secret.GetMetadata().Ref()},
+			}
+		})
+
+		It("discovers the services using the ssl config of the upstream", func() {
+			spec, err := discoverFunctions(serverUrl, v1.SecretList{secret})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.GetServices()).To(ConsistOf("grpc.health.v1.Health"))
+			Expect(spec.GetProtoDescriptorBin()).NotTo(BeEmpty())
+		})
+
+		It("verifies the subject alt names of the server", func() {
+			upstream.GetSslConfig().VerifySubjectAltName = []string{"grpc-server"}
+			_, err := discoverFunctions(serverUrl, v1.SecretList{secret})
+			Expect(err).NotTo(HaveOccurred())
+
+			upstream.GetSslConfig().VerifySubjectAltName = []string{"other-server"}
+			_, err = newDiscovery().DetectType(ctx, serverUrl)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("does not trust servers signed by another root", func() {
+			otherCa, _ := helpers.GetCerts(helpers.Params{Hosts: "grpc-server", IsCA: true})
+			secret.GetTls().RootCa = otherCa
+			_, err := secrets.Write(secret, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = newDiscovery().DetectType(ctx, serverUrl)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("descriptor sets", func() {
+
+		writeArtifact := func(data map[string]string) {
+			_, err := artifacts.Write(&v1.Artifact{
+				Metadata: &core.Metadata{Name: "descriptors", Namespace: "grpc-apis"},
+				Data:     data,
+			}, clients.WriteOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		encode := func(set *descriptor.FileDescriptorSet) string {
+			raw, err := proto.Marshal(set)
+			Expect(err).NotTo(HaveOccurred())
+			return base64.StdEncoding.EncodeToString(raw)
+		}
+
+		BeforeEach(func() {
+			upstream.Metadata.Annotations = map[string]string{DescriptorSetAnnotation: "grpc-apis/descriptors"}
+		})
+
+		It("discovers the services in the descriptor set without connecting to the upstream", func() {
+			writeArtifact(map[string]string{"health.pb": encode(healthDescriptorSet())})
+
+			spec, err := discoverFunctions(&url.URL{Scheme: "tcp", Host: "127.0.0.1:1"}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.GetServices()).To(Equal([]string{"grpc.health.v1.Health"}))
+
+			var discovered descriptor.FileDescriptorSet
+			Expect(proto.Unmarshal(spec.GetProtoDescriptorBin(), &discovered)).To(Succeed())
+			Expect(proto.Equal(&discovered, healthDescriptorSet())).To(BeTrue())
+			Expect(spec.GetMatchIncomingRequestRoute()).To(BeTrue())
+		})
+
+		It("requires the key when the artifact has more than one", func() {
+			writeArtifact(map[string]string{"health.pb": encode(healthDescriptorSet()), "README": "descriptors"})
+
+			_, err := newDiscovery().DetectType(ctx, &url.URL{})
+			Expect(err).To(MatchError(ContainSubstring("has more than one key")))
+
+			upstream.Metadata.Annotations[DescriptorSetKeyAnnotation] = "health.pb"
+			spec, err := newDiscovery().DetectType(ctx, &url.URL{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.GetPluginType()).To(BeAssignableToTypeOf(&plugins.ServiceSpec_GrpcJsonTranscoder{}))
+		})
+
+		It("rejects descriptor sets missing the imports of their files", func() {
+			writeArtifact(map[string]string{"grpc_json.pb": encode(&descriptor.FileDescriptorSet{
+				File: []*descriptor.FileDescriptorProto{protodesc.ToFileDescriptorProto(grpc_json_plugins.File_github_com_solo_io_gloo_projects_gloo_api_v1_options_grpc_json_grpc_json_proto)},
+			})})
+
+			_, err := newDiscovery().DetectType(ctx, &url.URL{})
+			Expect(err).To(MatchError(ContainSubstring("was it built with --include_imports?")))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+
+	errors "github.com/rotisserie/eris"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+)
+
+var (
+	SdsNotSupportedError = errors.New("grpc reflection does not support upstream ssl config from SDS")
+
+	NotTlsSecretError = func(namespace, name string) error {
+		return errors.Errorf("secret %v.%v is not a tls secret", namespace, name)
+	}
+
+	NoTrustedRootError = func(rootCa string) error {
+		return errors.Errorf("no certificates found in root ca %v", rootCa)
+	}
+
+	SubjectAltNameMismatchError = func(subjectAltNames []string) error {
+		return errors.Errorf("server certificate does not match any of the subject alt names %v", subjectAltNames)
+	}
+)
+
+// builds the tls config used for reflection requests from the ssl config of the upstream,
+// verifying the server certificate the same way Envoy does:
+// the chain is only verified when a root ca is given, and the server name is not verified unless listed in the subject alt names.
+func tlsConfigForUpstream(sslConfig *ssl.UpstreamSslConfig, secrets v1.SecretList) (*tls.Config, error) {
+	if sslConfig == nil {
+		return nil, nil
+	}
+
+	var certChain, privateKey, rootCa []byte
+	switch sslSecrets := sslConfig.GetSslSecrets().(type) {
+	case *ssl.UpstreamSslConfig_SecretRef:
+		ref := sslSecrets.SecretRef
+		secret, err := secrets.Find(ref.GetNamespace(), ref.GetName())
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding ssl secret for grpc reflection")
+		}
+		tlsSecret := secret.GetTls()
+		if tlsSecret == nil {
+			return nil, NotTlsSecretError(ref.GetNamespace(), ref.GetName())
+		}
+		certChain, privateKey, rootCa = []byte(tlsSecret.GetCertChain()), []byte(tlsSecret.GetPrivateKey()), []byte(tlsSecret.GetRootCa())
+	case *ssl.UpstreamSslConfig_SslFiles:
+		var err error
+		if certChain, err = readOptionalFile(sslSecrets.SslFiles.GetTlsCert()); err != nil {
+			return nil, err
+		}
+		if privateKey, err = readOptionalFile(sslSecrets.SslFiles.GetTlsKey()); err != nil {
+			return nil, err
+		}
+		if rootCa, err = readOptionalFile(sslSecrets.SslFiles.GetRootCa()); err != nil {
+			return nil, err
+		}
+	case *ssl.UpstreamSslConfig_Sds:
+		return nil, SdsNotSupportedError
+	}
+
+	cfg := &tls.Config{
+		ServerName: sslConfig.GetSni(),
+		// the server certificate is verified in VerifyConnection
+		InsecureSkipVerify: true,
+	}
+	if len(certChain) > 0 && len(privateKey) > 0 {
+		cert, err := tls.X509KeyPair(certChain, privateKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid client certificate for grpc reflection")
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if len(rootCa) == 0 {
+		return cfg, nil
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(rootCa) {
+		return nil, NoTrustedRootError(string(rootCa))
+	}
+	subjectAltNames := sslConfig.GetVerifySubjectAltName()
+	cfg.VerifyConnection = func(state tls.ConnectionState) error {
+		if len(state.PeerCertificates) == 0 {
+			return errors.New("server did not present a certificate")
+		}
+		intermediates := x509.NewCertPool()
+		for _, cert := range state.PeerCertificates[1:] {
+			intermediates.AddCert(cert)
+		}
+		leaf := state.PeerCertificates[0]
+		if _, err := leaf.Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates}); err != nil {
+			return err
+		}
+		if len(subjectAltNames) > 0 && !matchesSubjectAltName(leaf, subjectAltNames) {
+			return SubjectAltNameMismatchError(subjectAltNames)
+		}
+		return nil
+	}
+	return cfg, nil
+}
+
+func readOptionalFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading ssl file %v for grpc reflection", path)
+	}
+	return b, nil
+}
+
+func matchesSubjectAltName(cert *x509.Certificate, subjectAltNames []string) bool {
+	var certNames []string
+	certNames = append(certNames, cert.DNSNames...)
+	certNames = append(certNames, cert.EmailAddresses...)
+	for _, ip := range cert.IPAddresses {
+		certNames = append(certNames, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		certNames = append(certNames, uri.String())
+	}
+	for _, want := range subjectAltNames {
+		for _, name := range certNames {
+			if name == want {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -21,7 +21,7 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...func() plugin
 	// plugins should be added here
 	reg.plugins = append(reg.plugins,
 		aws.NewFunctionDiscoveryFactory(),
-		grpc.NewFunctionDiscoveryFactory(),
+		grpc.NewFunctionDiscoveryFactoryFromOpts(opts),
 		swagger.NewFunctionDiscoveryFactory(),
 	)
 
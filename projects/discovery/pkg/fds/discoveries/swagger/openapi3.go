@@ -0,0 +1,242 @@
+package swagger
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	errors "github.com/rotisserie/eris"
+)
+
+// OpenAPI 3 documents are converted to the equivalent Swagger 2.0 document, so that both produce the same functions.
+// Only the parts of the document that function discovery uses are converted.
+
+const (
+	openApiV3SchemaRefPrefix        = "#/components/schemas/"
+	openApiV3ParameterRefPrefix     = "#/components/parameters/"
+	openApiV3RequestBodiesRefPrefix = "#/components/requestBodies/"
+	swaggerDefinitionRefPrefix      = "#/definitions/"
+
+	jsonContentType = "application/json"
+)
+
+type openApiV3Document struct {
+	OpenAPI    string                       `json:"openapi"`
+	Servers    []openApiV3Server            `json:"servers"`
+	Paths      map[string]openApiV3PathItem `json:"paths"`
+	Components openApiV3Components          `json:"components"`
+}
+
+type openApiV3Server struct {
+	URL       string                             `json:"url"`
+	Variables map[string]openApiV3ServerVariable `json:"variables"`
+}
+
+type openApiV3ServerVariable struct {
+	Default string `json:"default"`
+}
+
+type openApiV3Components struct {
+	Schemas       spec.Definitions                `json:"schemas"`
+	Parameters    map[string]spec.Parameter       `json:"parameters"`
+	RequestBodies map[string]openApiV3RequestBody `json:"requestBodies"`
+}
+
+type openApiV3PathItem struct {
+	Parameters []spec.Parameter    `json:"parameters"`
+	Get        *openApiV3Operation `json:"get"`
+	Put        *openApiV3Operation `json:"put"`
+	Post       *openApiV3Operation `json:"post"`
+	Delete     *openApiV3Operation `json:"delete"`
+	Options    *openApiV3Operation `json:"options"`
+	Head       *openApiV3Operation `json:"head"`
+	Patch      *openApiV3Operation `json:"patch"`
+}
+
+type openApiV3Operation struct {
+	OperationID string                `json:"operationId"`
+	Parameters  []spec.Parameter      `json:"parameters"`
+	RequestBody *openApiV3RequestBody `json:"requestBody"`
+}
+
+type openApiV3RequestBody struct {
+	Ref     string                        `json:"$ref"`
+	Content map[string]openApiV3MediaType `json:"content"`
+}
+
+type openApiV3MediaType struct {
+	Schema *spec.Schema `json:"schema"`
+}
+
+// returns true if the (json) document declares an OpenAPI 3.x version
+func isOpenApiV3Doc(jsonBytes []byte) bool {
+	var version struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(jsonBytes, &version); err != nil {
+		return false
+	}
+	return strings.HasPrefix(version.OpenAPI, "3.")
+}
+
+func parseOpenApiV3Doc(jsonBytes []byte) (*spec.Swagger, error) {
+	// schemas are referenced from the swagger definitions instead of the components
+	jsonBytes = []byte(strings.ReplaceAll(string(jsonBytes), openApiV3SchemaRefPrefix, swaggerDefinitionRefPrefix))
+
+	var doc openApiV3Document
+	if err := json.Unmarshal(jsonBytes, &doc); err != nil {
+		return nil, errors.Wrap(err, "invalid openapi v3 doc")
+	}
+	return doc.toSwagger()
+}
+
+func (doc *openApiV3Document) toSwagger() (*spec.Swagger, error) {
+	definitions := spec.Definitions{}
+	for name, schema := range doc.Components.Schemas {
+		definitions[name] = schema
+	}
+
+	paths := map[string]spec.PathItem{}
+	for path, pathItem := range doc.Paths {
+		var err error
+		convert := func(method string, operation *openApiV3Operation) *spec.Operation {
+			if operation == nil || err != nil {
+				return nil
+			}
+			var converted *spec.Operation
+			converted, err = doc.convertOperation(method, path, pathItem.Parameters, operation, definitions)
+			return converted
+		}
+		swaggerPathItem := spec.PathItem{
+			PathItemProps: spec.PathItemProps{
+				Get:     convert("get", pathItem.Get),
+				Put:     convert("put", pathItem.Put),
+				Post:    convert("post", pathItem.Post),
+				Delete:  convert("delete", pathItem.Delete),
+				Options: convert("options", pathItem.Options),
+				Head:    convert("head", pathItem.Head),
+				Patch:   convert("patch", pathItem.Patch),
+			},
+		}
+		if err != nil {
+			return nil, err
+		}
+		paths[path] = swaggerPathItem
+	}
+
+	basePath, err := doc.basePath()
+	if err != nil {
+		return nil, err
+	}
+
+	return &spec.Swagger{
+		SwaggerProps: spec.SwaggerProps{
+			Swagger:     "2.0",
+			BasePath:    basePath,
+			Paths:       &spec.Paths{Paths: paths},
+			Definitions: definitions,
+		},
+	}, nil
+}
+
+func (doc *openApiV3Document) convertOperation(
+	method, path string,
+	pathParameters []spec.Parameter,
+	operation *openApiV3Operation,
+	definitions spec.Definitions,
+) (*spec.Operation, error) {
+	// parameters of the operation override the parameters of the path with the same name and location
+	var parameters []spec.Parameter
+	seen := map[string]bool{}
+	for _, params := range [][]spec.Parameter{operation.Parameters, pathParameters} {
+		for _, param := range params {
+			resolved, err := doc.resolveParameter(param)
+			if err != nil {
+				return nil, err
+			}
+			key := resolved.In + "/" + resolved.Name
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			parameters = append(parameters, resolved)
+		}
+	}
+	bodyParam, err := doc.requestBodyParameter(method, path, operation, definitions)
+	if err != nil {
+		return nil, err
+	}
+	if bodyParam != nil {
+		parameters = append(parameters, *bodyParam)
+	}
+
+	return &spec.Operation{
+		OperationProps: spec.OperationProps{
+			ID:         operation.OperationID,
+			Parameters: parameters,
+		},
+	}, nil
+}
+
+func (doc *openApiV3Document) resolveParameter(param spec.Parameter) (spec.Parameter, error) {
+	ref := param.Ref.String()
+	if ref == "" {
+		return param, nil
+	}
+	resolved, ok := doc.Components.Parameters[strings.TrimPrefix(ref, openApiV3ParameterRefPrefix)]
+	if !ok {
+		return spec.Parameter{}, errors.Errorf("openapi v3 parameter %v not found", ref)
+	}
+	return resolved, nil
+}
+
+// JSON request bodies become swagger body parameters named after the definition of their schema.
+// Inline schemas are added to the definitions under a name unique to the operation.
+func (doc *openApiV3Document) requestBodyParameter(method, path string, operation *openApiV3Operation, definitions spec.Definitions) (*spec.Parameter, error) {
+	requestBody := operation.RequestBody
+	if requestBody == nil {
+		return nil, nil
+	}
+	if requestBody.Ref != "" {
+		resolved, ok := doc.Components.RequestBodies[strings.TrimPrefix(requestBody.Ref, openApiV3RequestBodiesRefPrefix)]
+		if !ok {
+			return nil, errors.Errorf("openapi v3 request body %v not found", requestBody.Ref)
+		}
+		requestBody = &resolved
+	}
+	mediaType, ok := requestBody.Content[jsonContentType]
+	if !ok || mediaType.Schema == nil {
+		return nil, nil
+	}
+
+	name := strings.TrimPrefix(mediaType.Schema.Ref.String(), swaggerDefinitionRefPrefix)
+	if name == "" {
+		name = method + path + ".body"
+		definitions[name] = *mediaType.Schema
+	}
+	return &spec.Parameter{
+		ParamProps: spec.ParamProps{
+			Name:   name,
+			In:     "body",
+			Schema: mediaType.Schema,
+		},
+	}, nil
+}
+
+// the base path is the path of the first server, which may be relative to the url of the document
+func (doc *openApiV3Document) basePath() (string, error) {
+	if len(doc.Servers) == 0 {
+		return "", nil
+	}
+	server := doc.Servers[0]
+	serverUrl := server.URL
+	for name, variable := range server.Variables {
+		serverUrl = strings.ReplaceAll(serverUrl, "{"+name+"}", variable.Default)
+	}
+	parsed, err := url.Parse(serverUrl)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid openapi v3 server url %v", server.URL)
+	}
+	return strings.TrimSuffix(parsed.Path, "/"), nil
+}
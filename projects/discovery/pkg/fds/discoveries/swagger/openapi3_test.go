@@ -0,0 +1,192 @@
+package swagger
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	rest_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	. "github.com/solo-io/solo-kit/test/matchers"
+)
+
+const swaggerV2Doc = `{
+  "swagger": "2.0",
+  "basePath": "/api",
+  "paths": {
+    "/pets": {
+      "get": {
+        "operationId": "listPets",
+        "parameters": [
+          {"name": "limit", "in": "query", "type": "integer"},
+          {"name": "x-request-id", "in": "header", "type": "string"}
+        ]
+      },
+      "post": {
+        "operationId": "addPet",
+        "parameters": [
+          {"name": "Pet", "in": "body", "schema": {"$ref": "#/definitions/Pet"}}
+        ]
+      }
+    },
+    "/pets/{id}": {
+      "delete": {
+        "parameters": [
+          {"name": "id", "in": "path", "type": "integer"}
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "Pet": {
+      "type": "object",
+      "properties": {
+        "id": {"type": "integer"},
+        "name": {"type": "string"},
+        "tag": {"type": "string", "default": "none"}
+      }
+    }
+  }
+}`
+
+const openApiV3Doc = `
+openapi: 3.0.3
+servers:
+  - url: https://{host}/{base}
+    variables:
+      host:
+        default: pets.example.com
+      base:
+        default: api
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+        - $ref: '#/components/parameters/RequestId'
+    post:
+      operationId: addPet
+      requestBody:
+        $ref: '#/components/requestBodies/Pet'
+  /pets/{id}:
+    parameters:
+      - name: id
+        in: path
+        schema:
+          type: integer
+    delete: {}
+components:
+  parameters:
+    RequestId:
+      name: x-request-id
+      in: header
+      schema:
+        type: string
+  requestBodies:
+    Pet:
+      content:
+        application/json:
+          schema:
+            $ref: '#/components/schemas/Pet'
+  schemas:
+    Pet:
+      type: object
+      properties:
+        id:
+          type: integer
+        name:
+          type: string
+        tag:
+          type: string
+          default: none
+`
+
+// OpenAPI 3.1 allows a list of types, and schemas inline in the request body
+const openApiV31Doc = `{
+  "openapi": "3.1.0",
+  "servers": [{"url": "/v1/"}],
+  "paths": {
+    "/orders": {
+      "put": {
+        "operationId": "putOrder",
+        "requestBody": {
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "properties": {
+                  "note": {"type": ["string", "null"]},
+                  "quantity": {"type": "integer"}
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+var _ = Describe("OpenAPI v3", func() {
+
+	discoverFunctions := func(document string) map[string]*transformation_plugins.TransformationTemplate {
+		upstream := &v1.Upstream{
+			Metadata: &core.Metadata{Name: "pets", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static.UpstreamSpec{
+					ServiceSpec: &plugins.ServiceSpec{
+						PluginType: &plugins.ServiceSpec_Rest{
+							Rest: &rest_plugins.ServiceSpec{
+								SwaggerInfo: &rest_plugins.ServiceSpec_SwaggerInfo{
+									SwaggerSpec: &rest_plugins.ServiceSpec_SwaggerInfo_Inline{Inline: document},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		discovery := NewFunctionDiscoveryFactory().NewFunctionDiscovery(upstream, fds.AdditionalClients{})
+		err := discovery.DetectFunctions(context.Background(), nil, nil, func(mutator fds.UpstreamMutator) error {
+			return mutator(upstream)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return upstream.GetStatic().GetServiceSpec().GetRest().GetTransformations()
+	}
+
+	It("generates the same functions as the equivalent swagger document", func() {
+		swaggerFunctions := discoverFunctions(swaggerV2Doc)
+		Expect(swaggerFunctions).To(HaveKey("listPets"))
+		Expect(swaggerFunctions).To(HaveKey("addPet"))
+		Expect(swaggerFunctions).To(HaveKey("delete.pets.{id}"))
+
+		openApiFunctions := discoverFunctions(openApiV3Doc)
+		Expect(openApiFunctions).To(HaveLen(len(swaggerFunctions)))
+		for name, template := range swaggerFunctions {
+			Expect(openApiFunctions).To(HaveKey(name))
+			Expect(openApiFunctions[name]).To(MatchProto(template))
+		}
+
+		Expect(openApiFunctions["listPets"].GetHeaders()[":path"].GetText()).To(Equal(`/api/pets?limit={{default(limit, "")}}`))
+		Expect(openApiFunctions["listPets"].GetHeaders()["x-request-id"].GetText()).To(Equal(`{{default(x-request-id, "")}}`))
+		Expect(openApiFunctions["addPet"].GetBody().GetText()).To(Equal(
+			`{"id": {{ default(id, "") }},"name": "{{ default(name, "")}}","tag": "{{ default(tag, "none")}}"}`))
+	})
+
+	It("supports OpenAPI 3.1 documents with inline request bodies", func() {
+		functions := discoverFunctions(openApiV31Doc)
+		Expect(functions).To(HaveKey("putOrder"))
+		Expect(functions["putOrder"].GetHeaders()[":path"].GetText()).To(Equal("/v1/orders"))
+		Expect(functions["putOrder"].GetBody().GetText()).To(Equal(
+			`{"note": "{{ default(note, "")}}","quantity": {{ default(quantity, "") }}}`))
+	})
+})
@@ -2,6 +2,7 @@ package swagger
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -26,11 +27,14 @@ import (
 )
 
 var commonSwaggerURIs = []string{
+	"/openapi.json",
 	"/swagger.json",
 	"/swagger/docs/v1",
 	"/swagger/docs/v2",
 	"/v1/swagger",
 	"/v2/swagger",
+	"/openapi.yaml",
+	"/v3/api-docs",
 }
 
 // TODO(yuval-k): run this in a back off for a limited amount of time, with high initial retry.
@@ -308,18 +312,26 @@ func loadHTTPBytes(ctx context.Context) func(path string) ([]byte, error) {
 	}
 }
 
+// parses Swagger 2.0 and OpenAPI 3 documents, in json or yaml
 func parseSwaggerDoc(docBytes []byte) (*openapi.Swagger, error) {
-	doc, err := loads.Analyzed(docBytes, "")
-	if err != nil {
+	jsn := json.RawMessage(docBytes)
+	if !json.Valid(docBytes) {
 		log.Debugf("parsing doc as json failed, falling back to yaml")
-		jsn, err := swag.YAMLToJSON(docBytes)
+		yml, err := swag.BytesToYAMLDoc(docBytes)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert yaml to json (after falling back to yaml parsing)")
+			return nil, errors.Wrap(err, "failed to parse doc as yaml")
 		}
-		doc, err = loads.Analyzed(jsn, "")
+		jsn, err = swag.YAMLToJSON(yml)
 		if err != nil {
-			return nil, errors.Wrap(err, "invalid swagger doc")
+			return nil, errors.Wrap(err, "failed to convert yaml to json (after falling back to yaml parsing)")
 		}
 	}
+	if isOpenApiV3Doc(jsn) {
+		return parseOpenApiV3Doc(jsn)
+	}
+	doc, err := loads.Analyzed(jsn, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid swagger doc")
+	}
 	return doc.Spec(), nil
 }
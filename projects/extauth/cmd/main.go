@@ -0,0 +1,11 @@
+package main
+
+import (
+	"github.com/solo-io/gloo/projects/extauth/pkg/runner"
+	"github.com/solo-io/go-utils/stats"
+)
+
+func main() {
+	stats.ConditionallyStartStatsServer()
+	runner.Run()
+}
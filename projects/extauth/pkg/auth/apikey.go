@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+)
+
+// DefaultApiKeyHeader is the header API keys are read from when the config does not name one.
+const DefaultApiKeyHeader = "api-key"
+
+type apiKeyAuth struct {
+	headerName          string
+	validApiKeys        map[string]*extauthv1.ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata
+	headersFromMetadata map[string]string
+}
+
+// NewApiKeyAuth accepts requests carrying one of the API keys resolved by Gloo from ApiKey secrets.
+func NewApiKeyAuth(cfg *extauthv1.ExtAuthConfig_ApiKeyAuthConfig) Config {
+	headerName := cfg.GetHeaderName()
+	if headerName == "" {
+		headerName = DefaultApiKeyHeader
+	}
+	return &apiKeyAuth{
+		headerName:          headerName,
+		validApiKeys:        cfg.GetValidApiKeys(),
+		headersFromMetadata: cfg.GetHeadersFromKeyMetadata(),
+	}
+}
+
+func (a *apiKeyAuth) Authorize(_ context.Context, req *Request) *Response {
+	apiKey := req.Header(a.headerName)
+	if apiKey == "" {
+		return Deny(http.StatusUnauthorized)
+	}
+	keyMetadata, ok := a.validApiKeys[apiKey]
+	if !ok {
+		return Deny(http.StatusUnauthorized)
+	}
+
+	headers := []Header{{Key: UserIdHeader, Value: keyMetadata.GetUsername()}}
+	for header, metadataKey := range a.headersFromMetadata {
+		if value, ok := keyMetadata.GetMetadata()[metadataKey]; ok {
+			headers = append(headers, Header{Key: header, Value: value})
+		}
+	}
+	sort.Slice(headers[1:], func(i, j int) bool { return headers[i+1].Key < headers[j+1].Key })
+	return Allow(headers...)
+}
@@ -0,0 +1,60 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/extauth/pkg/auth"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+)
+
+var _ = Describe("ApiKeyAuth", func() {
+
+	var cfg *extauthv1.ExtAuthConfig_ApiKeyAuthConfig
+
+	BeforeEach(func() {
+		cfg = &extauthv1.ExtAuthConfig_ApiKeyAuthConfig{
+			ValidApiKeys: map[string]*extauthv1.ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata{
+				"key-1": {Username: "alice", Metadata: map[string]string{"email": "alice@example.com", "team": "infra"}},
+			},
+			HeadersFromKeyMetadata: map[string]string{
+				"x-user-team":  "team",
+				"x-user-email": "email",
+				"x-user-org":   "org",
+			},
+		}
+	})
+
+	It("allows known keys and forwards their metadata", func() {
+		resp := NewApiKeyAuth(cfg).Authorize(context.Background(), &Request{Headers: map[string]string{DefaultApiKeyHeader: "key-1"}})
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.UpstreamHeaders).To(Equal([]Header{
+			{Key: UserIdHeader, Value: "alice"},
+			{Key: "x-user-email", Value: "alice@example.com"},
+			{Key: "x-user-team", Value: "infra"},
+		}))
+	})
+
+	It("reads the key from the configured header", func() {
+		cfg.HeaderName = "X-Api-Key"
+		config := NewApiKeyAuth(cfg)
+
+		resp := config.Authorize(context.Background(), &Request{Headers: map[string]string{"x-api-key": "key-1"}})
+		Expect(resp.Allowed).To(BeTrue())
+
+		resp = config.Authorize(context.Background(), &Request{Headers: map[string]string{DefaultApiKeyHeader: "key-1"}})
+		Expect(resp.Allowed).To(BeFalse())
+	})
+
+	DescribeTable("rejects requests without a valid key",
+		func(headers map[string]string) {
+			resp := NewApiKeyAuth(cfg).Authorize(context.Background(), &Request{Headers: headers})
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Status).To(Equal(http.StatusUnauthorized))
+		},
+		Entry("missing key", map[string]string{}),
+		Entry("unknown key", map[string]string{DefaultApiKeyHeader: "key-2"}),
+	)
+})
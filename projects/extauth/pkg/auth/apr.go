@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"crypto/md5"
+)
+
+const (
+	aprMagic = "$apr1$"
+	// alphabet used by crypt(3) style hashes
+	cryptAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// AprHash computes Apache's APR1 variant of the MD5 crypt algorithm, as produced by `htpasswd -m`.
+// The result is the hash alone, without the `$apr1$<salt>$` prefix.
+func AprHash(password, salt string) string {
+	pw := []byte(password)
+	if len(salt) > 8 {
+		salt = salt[:8]
+	}
+	s := []byte(salt)
+
+	alternate := md5.New()
+	alternate.Write(pw)
+	alternate.Write(s)
+	alternate.Write(pw)
+	alternateSum := alternate.Sum(nil)
+
+	h := md5.New()
+	h.Write(pw)
+	h.Write([]byte(aprMagic))
+	h.Write(s)
+	for i := len(pw); i > 0; i -= md5.Size {
+		h.Write(alternateSum[:min(i, md5.Size)])
+	}
+	for i := len(pw); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			h.Write([]byte{0})
+		} else {
+			h.Write(pw[:1])
+		}
+	}
+	sum := h.Sum(nil)
+
+	// stretch the hash to slow down brute force attacks
+	for i := 0; i < 1000; i++ {
+		round := md5.New()
+		if i&1 != 0 {
+			round.Write(pw)
+		} else {
+			round.Write(sum)
+		}
+		if i%3 != 0 {
+			round.Write(s)
+		}
+		if i%7 != 0 {
+			round.Write(pw)
+		}
+		if i&1 != 0 {
+			round.Write(sum)
+		} else {
+			round.Write(pw)
+		}
+		sum = round.Sum(nil)
+	}
+
+	var out []byte
+	encode := func(a, b, c byte, n int) {
+		v := uint(a)<<16 | uint(b)<<8 | uint(c)
+		for ; n > 0; n-- {
+			out = append(out, cryptAlphabet[v&0x3f])
+			v >>= 6
+		}
+	}
+	encode(sum[0], sum[6], sum[12], 4)
+	encode(sum[1], sum[7], sum[13], 4)
+	encode(sum[2], sum[8], sum[14], 4)
+	encode(sum[3], sum[9], sum[15], 4)
+	encode(sum[4], sum[10], sum[5], 4)
+	encode(0, 0, sum[11], 2)
+	return string(out)
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// Request holds the parts of a request checked by Envoy's ext_authz filter that auth configs inspect.
+type Request struct {
+	Method string
+	Scheme string
+	Host   string
+	// Path includes the query string
+	Path string
+	// Headers are keyed by lower-case header name
+	Headers map[string]string
+}
+
+// Header returns the value of the named header.
+func (r *Request) Header(name string) string {
+	return r.Headers[strings.ToLower(name)]
+}
+
+// Cookie returns the value of the named cookie.
+func (r *Request) Cookie(name string) (string, bool) {
+	cookie, err := (&http.Request{Header: http.Header{"Cookie": {r.Header("cookie")}}}).Cookie(name)
+	if err != nil {
+		return "", false
+	}
+	return cookie.Value, true
+}
+
+// Header is a header added to an upstream request or to a response sent to the client.
+type Header struct {
+	Key   string
+	Value string
+}
+
+// Response is the decision of an auth config.
+type Response struct {
+	Allowed bool
+	// UpstreamHeaders are added to the request forwarded upstream when it is allowed
+	UpstreamHeaders []Header
+	// Status is the HTTP status returned to the client when the request is denied
+	Status int
+	// ResponseHeaders are returned to the client when the request is denied
+	ResponseHeaders []Header
+}
+
+// Allow authorizes a request, forwarding the given headers upstream.
+func Allow(upstreamHeaders ...Header) *Response {
+	return &Response{Allowed: true, UpstreamHeaders: upstreamHeaders}
+}
+
+// Deny rejects a request with the given HTTP status.
+func Deny(status int, responseHeaders ...Header) *Response {
+	return &Response{Status: status, ResponseHeaders: responseHeaders}
+}
+
+// Redirect rejects a request by redirecting the client, e.g. to an identity provider.
+func Redirect(location string, responseHeaders ...Header) *Response {
+	return Deny(http.StatusFound, append([]Header{{Key: "location", Value: location}}, responseHeaders...)...)
+}
+
+// Config is a single auth config of an auth config chain.
+type Config interface {
+	Authorize(ctx context.Context, req *Request) *Response
+}
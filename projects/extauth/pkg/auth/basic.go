@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+)
+
+// UserIdHeader carries the name of the authenticated user to the upstream.
+const UserIdHeader = "x-user-id"
+
+type basicAuth struct {
+	realm string
+	users map[string]*extauthv1.ExtAuthConfig_BasicAuthInternal_User
+}
+
+// NewBasicAuth verifies the credentials of the `Authorization: Basic` header against users with apr hashed passwords.
+func NewBasicAuth(cfg *extauthv1.ExtAuthConfig_BasicAuthInternal) Config {
+	return &basicAuth{
+		realm: cfg.GetRealm(),
+		users: cfg.GetUserList().GetUsers(),
+	}
+}
+
+func (b *basicAuth) Authorize(_ context.Context, req *Request) *Response {
+	username, password, ok := parseBasicAuth(req.Header("authorization"))
+	if !ok {
+		return b.challenge()
+	}
+	user, ok := b.users[username]
+	if !ok {
+		return b.challenge()
+	}
+	hash := AprHash(password, user.GetSalt())
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(user.GetHashedPassword())) != 1 {
+		return b.challenge()
+	}
+	return Allow(Header{Key: UserIdHeader, Value: username})
+}
+
+func (b *basicAuth) challenge() *Response {
+	challenge := "Basic"
+	if b.realm != "" {
+		challenge = fmt.Sprintf("Basic realm=%q", b.realm)
+	}
+	return Deny(http.StatusUnauthorized, Header{Key: "www-authenticate", Value: challenge})
+}
+
+func parseBasicAuth(authorization string) (string, string, bool) {
+	scheme, credentials, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "basic") {
+		return "", "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(credentials))
+	if err != nil {
+		return "", "", false
+	}
+	return strings.Cut(string(decoded), ":")
+}
@@ -0,0 +1,70 @@
+package auth_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/extauth/pkg/auth"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+)
+
+var _ = Describe("AprHash", func() {
+
+	// expected hashes generated with `openssl passwd -apr1 -salt <salt> <password>`
+	DescribeTable("matches the apache md5 crypt hashes",
+		func(password, salt, hash string) {
+			Expect(AprHash(password, salt)).To(Equal(hash))
+		},
+		Entry("password", "password", "TYiryv0/", "8BvzLUO9IfGPGGsPnAgSu1"),
+		Entry("empty password", "", "abc", "BfqKdn9xFDWJPa3kcp/PH0"),
+	)
+})
+
+var _ = Describe("BasicAuth", func() {
+
+	var config Config
+
+	request := func(authorization string) *Request {
+		return &Request{Headers: map[string]string{"authorization": authorization}}
+	}
+
+	credentials := func(username, password string) string {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	}
+
+	BeforeEach(func() {
+		config = NewBasicAuth(&extauthv1.ExtAuthConfig_BasicAuthInternal{
+			Realm: "gloo",
+			UserSource: &extauthv1.ExtAuthConfig_BasicAuthInternal_UserList_{
+				UserList: &extauthv1.ExtAuthConfig_BasicAuthInternal_UserList{
+					Users: map[string]*extauthv1.ExtAuthConfig_BasicAuthInternal_User{
+						"user": {Salt: "TYiryv0/", HashedPassword: "8BvzLUO9IfGPGGsPnAgSu1"},
+					},
+				},
+			},
+		})
+	})
+
+	It("allows valid credentials", func() {
+		resp := config.Authorize(context.Background(), request(credentials("user", "password")))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.UpstreamHeaders).To(ConsistOf(Header{Key: UserIdHeader, Value: "user"}))
+	})
+
+	DescribeTable("challenges invalid credentials",
+		func(authorization string) {
+			resp := config.Authorize(context.Background(), request(authorization))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Status).To(Equal(http.StatusUnauthorized))
+			Expect(resp.ResponseHeaders).To(ConsistOf(Header{Key: "www-authenticate", Value: `Basic realm="gloo"`}))
+		},
+		Entry("missing header", ""),
+		Entry("wrong password", credentials("user", "wrong")),
+		Entry("unknown user", credentials("other", "password")),
+		Entry("other scheme", "Bearer token"),
+		Entry("malformed credentials", "Basic !!!"),
+	)
+})
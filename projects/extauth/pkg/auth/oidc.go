@@ -0,0 +1,438 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/rotisserie/eris"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+)
+
+const (
+	idTokenCookie     = "id_token"
+	accessTokenCookie = "access_token"
+	stateCookie       = "oidc_state"
+
+	// default lifetime of the session cookies, matching the enterprise extauth server
+	defaultSessionMaxAge = 30 * 24 * time.Hour
+	// lifetime of the cookie tracking an authorization request in progress
+	stateMaxAge = 10 * time.Minute
+	// minimum time between two fetches of the issuer's signing keys
+	jwksRefreshInterval = 10 * time.Second
+)
+
+var (
+	DiscoveryError = func(issuer string, err error) error {
+		return eris.Wrapf(err, "discovering OpenID configuration of issuer %s", issuer)
+	}
+
+	TokenExchangeError = func(status int) error {
+		return eris.Errorf("token endpoint responded with status %d", status)
+	}
+
+	MissingIdTokenError = eris.New("token endpoint response does not contain an id_token")
+
+	UnknownSigningKeyError = func(kid string) error {
+		return eris.Errorf("id token is signed with unknown key %q", kid)
+	}
+
+	InvalidIdTokenClaimError = func(claim string) error {
+		return eris.Errorf("id token has an invalid %s claim", claim)
+	}
+)
+
+type oidcAuth struct {
+	cfg         *extauthv1.ExtAuthConfig_OidcAuthorizationCodeConfig
+	httpClient  *http.Client
+	redirectUri string
+	now         func() time.Time
+
+	lock        sync.Mutex
+	discovery   *discoveryDocument
+	keys        map[string]*rsa.PublicKey
+	keysFetched time.Time
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+}
+
+type tokenResponse struct {
+	IdToken     string `json:"id_token"`
+	AccessToken string `json:"access_token"`
+}
+
+// NewOidcAuth authenticates users with the OpenID Connect authorization code flow. Once the issuer redirects
+// back to the callback path, the tokens are stored in cookies and validated on every request.
+func NewOidcAuth(cfg *extauthv1.ExtAuthConfig_OidcAuthorizationCodeConfig, httpClient *http.Client) Config {
+	return &oidcAuth{
+		cfg:         cfg,
+		httpClient:  httpClient,
+		redirectUri: strings.TrimSuffix(cfg.GetAppUrl(), "/") + cfg.GetCallbackPath(),
+		now:         time.Now,
+	}
+}
+
+func (o *oidcAuth) Authorize(ctx context.Context, req *Request) *Response {
+	logger := contextutils.LoggerFrom(ctx)
+
+	discovery, err := o.getDiscovery(ctx)
+	if err != nil {
+		logger.Errorw("failed to discover OpenID configuration", zap.Error(err))
+		return Deny(http.StatusServiceUnavailable)
+	}
+
+	path, _, _ := strings.Cut(req.Path, "?")
+	switch {
+	case path == o.cfg.GetCallbackPath():
+		return o.handleCallback(ctx, discovery, req)
+	case o.cfg.GetLogoutPath() != "" && path == o.cfg.GetLogoutPath():
+		return o.handleLogout()
+	}
+
+	idToken, ok := req.Cookie(o.cookieName(idTokenCookie))
+	if ok {
+		claims, err := o.verifyIdToken(ctx, discovery, idToken)
+		if err == nil {
+			accessToken, _ := req.Cookie(o.cookieName(accessTokenCookie))
+			return Allow(o.upstreamHeaders(claims, idToken, accessToken)...)
+		}
+		logger.Debugw("session id token is not valid, restarting the authorization flow", zap.Error(err))
+	}
+	return o.startAuthorization(discovery, req)
+}
+
+// startAuthorization redirects the client to the issuer, remembering where to send it back to
+func (o *oidcAuth) startAuthorization(discovery *discoveryDocument, req *Request) *Response {
+	state := randomString()
+	originalUrl := o.cfg.GetAppUrl()
+	if appOrigin := o.appOrigin(); appOrigin != "" && strings.HasPrefix(req.Path, "/") {
+		// the host of the request is set by the client, so the client is only sent back to a path of the app
+		originalUrl = appOrigin + req.Path
+	}
+
+	scopes := []string{"openid"}
+	for _, scope := range o.cfg.GetScopes() {
+		if scope != "openid" {
+			scopes = append(scopes, scope)
+		}
+	}
+	query := url.Values{}
+	for key, value := range o.cfg.GetAuthEndpointQueryParams() {
+		query.Set(key, value)
+	}
+	query.Set("response_type", "code")
+	query.Set("client_id", o.cfg.GetClientId())
+	query.Set("redirect_uri", o.redirectUri)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", state)
+
+	location := discovery.AuthorizationEndpoint
+	if strings.Contains(location, "?") {
+		location += "&" + query.Encode()
+	} else {
+		location += "?" + query.Encode()
+	}
+	stateValue := state + "." + base64.RawURLEncoding.EncodeToString([]byte(originalUrl))
+	return Redirect(location, o.setCookie(stateCookie, stateValue, stateMaxAge))
+}
+
+func (o *oidcAuth) handleCallback(ctx context.Context, discovery *discoveryDocument, req *Request) *Response {
+	logger := contextutils.LoggerFrom(ctx)
+
+	_, rawQuery, _ := strings.Cut(req.Path, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil || query.Get("code") == "" {
+		return Deny(http.StatusBadRequest)
+	}
+	stateValue, ok := req.Cookie(o.cookieName(stateCookie))
+	if !ok {
+		return Deny(http.StatusBadRequest)
+	}
+	state, encodedUrl, _ := strings.Cut(stateValue, ".")
+	if state == "" || state != query.Get("state") {
+		logger.Debugw("authorization callback state does not match the state cookie")
+		return Deny(http.StatusBadRequest)
+	}
+	// the state cookie is set by the client too, so its url must be in the app
+	originalUrl, err := base64.RawURLEncoding.DecodeString(encodedUrl)
+	if err != nil || !o.isAppUrl(string(originalUrl)) {
+		originalUrl = []byte(o.cfg.GetAppUrl())
+	}
+
+	tokens, err := o.exchangeCode(ctx, discovery, query.Get("code"))
+	if err != nil {
+		logger.Errorw("failed to exchange authorization code", zap.Error(err))
+		return Deny(http.StatusUnauthorized)
+	}
+	if _, err := o.verifyIdToken(ctx, discovery, tokens.IdToken); err != nil {
+		logger.Errorw("issuer returned an invalid id token", zap.Error(err))
+		return Deny(http.StatusUnauthorized)
+	}
+
+	maxAge := defaultSessionMaxAge
+	if cookieMaxAge := o.cfg.GetSession().GetCookieOptions().GetMaxAge(); cookieMaxAge != nil {
+		maxAge = time.Duration(cookieMaxAge.GetValue()) * time.Second
+	}
+	headers := []Header{
+		o.setCookie(idTokenCookie, tokens.IdToken, maxAge),
+		o.setCookie(stateCookie, "", -1),
+	}
+	if tokens.AccessToken != "" {
+		headers = append(headers, o.setCookie(accessTokenCookie, tokens.AccessToken, maxAge))
+	}
+	return Redirect(string(originalUrl), headers...)
+}
+
+// appOrigin returns the scheme and host of the app url
+func (o *oidcAuth) appOrigin() string {
+	appUrl, err := url.Parse(o.cfg.GetAppUrl())
+	if err != nil || appUrl.Scheme == "" || appUrl.Host == "" {
+		return ""
+	}
+	return appUrl.Scheme + "://" + appUrl.Host
+}
+
+// isAppUrl returns true if the url has the scheme and host of the app url
+func (o *oidcAuth) isAppUrl(rawUrl string) bool {
+	appOrigin := o.appOrigin()
+	return appOrigin != "" && (rawUrl == appOrigin || strings.HasPrefix(rawUrl, appOrigin+"/"))
+}
+
+func (o *oidcAuth) handleLogout() *Response {
+	location := o.cfg.GetAfterLogoutUrl()
+	if location == "" {
+		location = o.cfg.GetAppUrl()
+	}
+	return Redirect(location,
+		o.setCookie(idTokenCookie, "", -1),
+		o.setCookie(accessTokenCookie, "", -1),
+	)
+}
+
+func (o *oidcAuth) exchangeCode(ctx context.Context, discovery *discoveryDocument, code string) (*tokenResponse, error) {
+	form := url.Values{}
+	for key, value := range o.cfg.GetTokenEndpointQueryParams() {
+		form.Set(key, value)
+	}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", o.redirectUri)
+	form.Set("client_id", o.cfg.GetClientId())
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if o.cfg.GetClientSecret() != "" {
+		httpReq.SetBasicAuth(url.QueryEscape(o.cfg.GetClientId()), url.QueryEscape(o.cfg.GetClientSecret()))
+	}
+
+	resp, err := o.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, TokenExchangeError(resp.StatusCode)
+	}
+	var tokens tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		return nil, err
+	}
+	if tokens.IdToken == "" {
+		return nil, MissingIdTokenError
+	}
+	return &tokens, nil
+}
+
+func (o *oidcAuth) verifyIdToken(ctx context.Context, discovery *discoveryDocument, idToken string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, eris.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return o.getKey(ctx, discovery, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !claims.VerifyIssuer(discovery.Issuer, true) {
+		return nil, InvalidIdTokenClaimError("iss")
+	}
+	if !claims.VerifyAudience(o.cfg.GetClientId(), true) {
+		return nil, InvalidIdTokenClaimError("aud")
+	}
+	if !claims.VerifyExpiresAt(o.now().Unix(), true) {
+		return nil, InvalidIdTokenClaimError("exp")
+	}
+	return claims, nil
+}
+
+func (o *oidcAuth) upstreamHeaders(claims jwt.MapClaims, idToken, accessToken string) []Header {
+	var headers []Header
+	if sub, ok := claims["sub"].(string); ok {
+		headers = append(headers, Header{Key: UserIdHeader, Value: sub})
+	}
+	headerCfg := o.cfg.GetHeaders()
+	if headerCfg.GetIdTokenHeader() != "" {
+		headers = append(headers, Header{Key: headerCfg.GetIdTokenHeader(), Value: idToken})
+	}
+	if headerCfg.GetAccessTokenHeader() != "" && accessToken != "" {
+		if headerCfg.GetUseBearerSchemaForAuthorization().GetValue() {
+			accessToken = "Bearer " + accessToken
+		}
+		headers = append(headers, Header{Key: headerCfg.GetAccessTokenHeader(), Value: accessToken})
+	}
+	return headers
+}
+
+func (o *oidcAuth) cookieName(name string) string {
+	if prefix := o.cfg.GetSession().GetCookie().GetKeyPrefix(); prefix != "" {
+		return prefix + "_" + name
+	}
+	return name
+}
+
+// setCookie builds a Set-Cookie header honoring the session cookie options; a negative maxAge deletes the cookie
+func (o *oidcAuth) setCookie(name, value string, maxAge time.Duration) Header {
+	options := o.cfg.GetSession().GetCookieOptions()
+	cookie := &http.Cookie{
+		Name:     o.cookieName(name),
+		Value:    value,
+		Path:     "/",
+		Domain:   options.GetDomain(),
+		Secure:   !options.GetNotSecure(),
+		HttpOnly: options.GetHttpOnly() == nil || options.GetHttpOnly().GetValue(),
+		MaxAge:   int(maxAge.Seconds()),
+	}
+	if maxAge < 0 {
+		cookie.MaxAge = -1
+	}
+	if options.GetPath() != nil {
+		cookie.Path = options.GetPath().GetValue()
+	}
+	switch options.GetSameSite() {
+	case extauthv1.UserSession_CookieOptions_LaxMode:
+		cookie.SameSite = http.SameSiteLaxMode
+	case extauthv1.UserSession_CookieOptions_StrictMode:
+		cookie.SameSite = http.SameSiteStrictMode
+	case extauthv1.UserSession_CookieOptions_NoneMode:
+		cookie.SameSite = http.SameSiteNoneMode
+	}
+	return Header{Key: "set-cookie", Value: cookie.String()}
+}
+
+func (o *oidcAuth) getDiscovery(ctx context.Context) (*discoveryDocument, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if o.discovery != nil {
+		return o.discovery, nil
+	}
+
+	discoveryUrl := strings.TrimSuffix(o.cfg.GetIssuerUrl(), "/") + "/.well-known/openid-configuration"
+	var discovery discoveryDocument
+	if err := o.getJson(ctx, discoveryUrl, &discovery); err != nil {
+		return nil, DiscoveryError(o.cfg.GetIssuerUrl(), err)
+	}
+	o.discovery = &discovery
+	return o.discovery, nil
+}
+
+// getKey returns the issuer's signing key with the given id, refreshing the key set when the key is unknown
+func (o *oidcAuth) getKey(ctx context.Context, discovery *discoveryDocument, kid string) (*rsa.PublicKey, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if key, ok := o.keys[kid]; ok {
+		return key, nil
+	}
+	if o.keys != nil && o.now().Sub(o.keysFetched) < jwksRefreshInterval {
+		return nil, UnknownSigningKeyError(kid)
+	}
+
+	var keySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := o.getJson(ctx, discovery.JwksUri, &keySet); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*rsa.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if key, err := jwk.rsaPublicKey(); err == nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	o.keys = keys
+	o.keysFetched = o.now()
+
+	if key, ok := o.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, UnknownSigningKeyError(kid)
+}
+
+func (o *oidcAuth) getJson(ctx context.Context, target string, out interface{}) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return eris.Errorf("GET %s responded with status %d", target, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (k jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	if k.Kty != "RSA" {
+		return nil, eris.Errorf("unsupported key type %s", k.Kty)
+	}
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
+
+func randomString() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
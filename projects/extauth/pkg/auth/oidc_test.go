@@ -0,0 +1,259 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/form3tech-oss/jwt-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/extauth/pkg/auth"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+)
+
+const (
+	testClientId     = "gloo"
+	testClientSecret = "secret"
+	testCode         = "auth-code"
+	testKeyId        = "test-key"
+)
+
+// testIdp is a minimal OpenID Connect issuer serving discovery, its signing keys and a token endpoint
+type testIdp struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	// claims of the id token issued for testCode
+	claims jwt.MapClaims
+}
+
+func newTestIdp() *testIdp {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+	idp := &testIdp{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.server.URL,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"jwks_uri":               idp.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": testKeyId,
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientId, clientSecret, _ := r.BasicAuth()
+		if clientId != testClientId || clientSecret != testClientSecret || r.FormValue("code") != testCode {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"id_token":     idp.sign(idp.claims),
+			"access_token": "access-token",
+		})
+	})
+	idp.server = httptest.NewServer(mux)
+	idp.claims = idp.validClaims()
+	return idp
+}
+
+func (i *testIdp) validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss": i.server.URL,
+		"aud": testClientId,
+		"sub": "user-1",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func (i *testIdp) sign(claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testKeyId
+	signed, err := token.SignedString(i.key)
+	Expect(err).NotTo(HaveOccurred())
+	return signed
+}
+
+var _ = Describe("OidcAuth", func() {
+
+	var (
+		ctx    context.Context
+		idp    *testIdp
+		cfg    *extauthv1.ExtAuthConfig_OidcAuthorizationCodeConfig
+		config Config
+	)
+
+	cookies := func(headers []Header) map[string]*http.Cookie {
+		resp := http.Response{Header: http.Header{}}
+		for _, header := range headers {
+			if header.Key == "set-cookie" {
+				resp.Header.Add("Set-Cookie", header.Value)
+			}
+		}
+		byName := map[string]*http.Cookie{}
+		for _, cookie := range resp.Cookies() {
+			byName[cookie.Name] = cookie
+		}
+		return byName
+	}
+
+	location := func(resp *Response) *url.URL {
+		for _, header := range resp.ResponseHeaders {
+			if header.Key == "location" {
+				parsed, err := url.Parse(header.Value)
+				Expect(err).NotTo(HaveOccurred())
+				return parsed
+			}
+		}
+		Fail("response does not redirect")
+		return nil
+	}
+
+	request := func(path string, cookies ...*http.Cookie) *Request {
+		var values []string
+		for _, cookie := range cookies {
+			values = append(values, cookie.Name+"="+cookie.Value)
+		}
+		return &Request{
+			Method:  http.MethodGet,
+			Scheme:  "https",
+			Host:    "app.example.com",
+			Path:    path,
+			Headers: map[string]string{"cookie": strings.Join(values, "; ")},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		idp = newTestIdp()
+		cfg = &extauthv1.ExtAuthConfig_OidcAuthorizationCodeConfig{
+			ClientId:     testClientId,
+			ClientSecret: testClientSecret,
+			IssuerUrl:    idp.server.URL,
+			AppUrl:       "https://app.example.com",
+			CallbackPath: "/callback",
+			LogoutPath:   "/logout",
+			Scopes:       []string{"email"},
+			Headers:      &extauthv1.HeaderConfiguration{IdTokenHeader: "x-id-token"},
+		}
+		config = NewOidcAuth(cfg, http.DefaultClient)
+	})
+
+	AfterEach(func() {
+		idp.server.Close()
+	})
+
+	It("runs the authorization code flow", func() {
+		By("redirecting unauthenticated requests to the issuer")
+		resp := config.Authorize(ctx, request("/page?q=1"))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Status).To(Equal(http.StatusFound))
+		authorize := location(resp)
+		Expect(authorize.Path).To(Equal("/authorize"))
+		Expect(authorize.Query().Get("response_type")).To(Equal("code"))
+		Expect(authorize.Query().Get("client_id")).To(Equal(testClientId))
+		Expect(authorize.Query().Get("redirect_uri")).To(Equal("https://app.example.com/callback"))
+		Expect(authorize.Query().Get("scope")).To(Equal("openid email"))
+		state := authorize.Query().Get("state")
+		Expect(state).NotTo(BeEmpty())
+		stateCookie := cookies(resp.ResponseHeaders)["oidc_state"]
+		Expect(stateCookie).NotTo(BeNil())
+
+		By("exchanging the code when the issuer redirects back")
+		resp = config.Authorize(ctx, request("/callback?code="+testCode+"&state="+url.QueryEscape(state), stateCookie))
+		Expect(resp.Status).To(Equal(http.StatusFound))
+		Expect(location(resp).String()).To(Equal("https://app.example.com/page?q=1"))
+		session := cookies(resp.ResponseHeaders)
+		Expect(session).To(HaveKey("id_token"))
+		Expect(session).To(HaveKey("access_token"))
+
+		By("allowing requests with the session cookies")
+		resp = config.Authorize(ctx, request("/page", session["id_token"], session["access_token"]))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.UpstreamHeaders).To(ConsistOf(
+			Header{Key: UserIdHeader, Value: "user-1"},
+			Header{Key: "x-id-token", Value: session["id_token"].Value},
+		))
+	})
+
+	It("rejects callbacks whose state does not match the state cookie", func() {
+		resp := config.Authorize(ctx, request("/page"))
+		stateCookie := cookies(resp.ResponseHeaders)["oidc_state"]
+
+		resp = config.Authorize(ctx, request("/callback?code="+testCode+"&state=forged", stateCookie))
+		Expect(resp.Status).To(Equal(http.StatusBadRequest))
+	})
+
+	It("only sends the client back to the app after the callback", func() {
+		req := request("/page")
+		req.Host = "evil.example.com"
+		resp := config.Authorize(ctx, req)
+		state := location(resp).Query().Get("state")
+		stateCookie := cookies(resp.ResponseHeaders)["oidc_state"]
+
+		resp = config.Authorize(ctx, request("/callback?code="+testCode+"&state="+url.QueryEscape(state), stateCookie))
+		Expect(location(resp).String()).To(Equal("https://app.example.com/page"))
+
+		By("ignoring a state cookie that redirects outside the app")
+		forgedCookie := &http.Cookie{
+			Name:  "oidc_state",
+			Value: "forged." + base64.RawURLEncoding.EncodeToString([]byte("https://app.example.com.evil.example.com/page")),
+		}
+		resp = config.Authorize(ctx, request("/callback?code="+testCode+"&state=forged", forgedCookie))
+		Expect(location(resp).String()).To(Equal("https://app.example.com"))
+	})
+
+	DescribeTable("restarts the flow for invalid id tokens",
+		func(mutate func(claims jwt.MapClaims)) {
+			claims := idp.validClaims()
+			mutate(claims)
+			resp := config.Authorize(ctx, request("/page", &http.Cookie{Name: "id_token", Value: idp.sign(claims)}))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(location(resp).Path).To(Equal("/authorize"))
+		},
+		Entry("expired", func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Minute).Unix() }),
+		Entry("other audience", func(claims jwt.MapClaims) { claims["aud"] = "other" }),
+		Entry("other issuer", func(claims jwt.MapClaims) { claims["iss"] = "https://evil.example.com" }),
+	)
+
+	It("clears the session on logout", func() {
+		resp := config.Authorize(ctx, request("/logout"))
+		Expect(location(resp).String()).To(Equal("https://app.example.com"))
+		session := cookies(resp.ResponseHeaders)
+		Expect(session["id_token"].MaxAge).To(BeNumerically("<", 0))
+		Expect(session["access_token"].MaxAge).To(BeNumerically("<", 0))
+	})
+
+	It("prefixes the cookie names", func() {
+		cfg.Session = &extauthv1.UserSession{
+			Session: &extauthv1.UserSession_Cookie{Cookie: &extauthv1.UserSession_InternalSession{KeyPrefix: "app"}},
+		}
+		resp := NewOidcAuth(cfg, http.DefaultClient).Authorize(ctx, request("/page"))
+		Expect(cookies(resp.ResponseHeaders)).To(HaveKey("app_oidc_state"))
+	})
+
+	It("is unavailable when the issuer cannot be discovered", func() {
+		idp.server.Close()
+		resp := config.Authorize(ctx, request("/page"))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Status).To(Equal(http.StatusServiceUnavailable))
+	})
+})
@@ -0,0 +1,31 @@
+package runner
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Settings struct {
+	DebugPort   int    `envconfig:"DEBUG_PORT" default:"9091"`
+	ServerPort  int    `envconfig:"SERVER_PORT" default:"8083"`
+	ServiceName string `envconfig:"SERVICE_NAME" default:"extauth"`
+	PodName     string `envconfig:"POD_NAME" default:"extauth"`
+
+	// GlooAddress is the address of the Gloo xDS server the auth configuration is read from
+	GlooAddress string `envconfig:"GLOO_ADDRESS" default:"gloo:9977"`
+
+	// IdpRequestTimeout bounds the requests made to OpenID Connect issuers
+	IdpRequestTimeout time.Duration `envconfig:"IDP_REQUEST_TIMEOUT" default:"10s"`
+}
+
+func NewSettings() Settings {
+	var s Settings
+
+	err := envconfig.Process("", &s)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
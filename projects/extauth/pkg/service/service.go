@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/extauth/pkg/auth"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/extauth"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	UnsupportedConfigError = func(name string) error {
+		return eris.Errorf("auth config %s is not supported by the open source extauth server", name)
+	}
+)
+
+var _ pb.AuthorizationServer = new(Server)
+
+// Server implements the Envoy external authorization service using the AuthConfigs translated by Gloo.
+type Server struct {
+	httpClient *http.Client
+	chains     atomic.Pointer[map[string]*chain]
+}
+
+func NewServer(httpClient *http.Client) *Server {
+	s := &Server{httpClient: httpClient}
+	s.chains.Store(&map[string]*chain{})
+	return s
+}
+
+// SetConfig replaces the auth configs used to check requests. Configs that cannot be loaded are logged and
+// deny every request that references them.
+func (s *Server) SetConfig(ctx context.Context, configs []*extauthv1.ExtAuthConfig) {
+	chains := make(map[string]*chain, len(configs))
+	for _, cfg := range configs {
+		c, err := s.newChain(cfg)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Errorw("invalid auth config", zap.String("config_id", cfg.GetAuthConfigRefName()), zap.Error(err))
+		}
+		chains[cfg.GetAuthConfigRefName()] = c
+	}
+	s.chains.Store(&chains)
+}
+
+func (s *Server) newChain(cfg *extauthv1.ExtAuthConfig) (*chain, error) {
+	c := &chain{
+		configs:        make(map[string]auth.Config, len(cfg.GetConfigs())),
+		failOnRedirect: cfg.GetFailOnRedirect(),
+	}
+	for i, config := range cfg.GetConfigs() {
+		name := extauth.ConfigName(config.GetName(), i)
+		switch authConfig := config.GetAuthConfig().(type) {
+		case *extauthv1.ExtAuthConfig_Config_BasicAuthInternal:
+			c.configs[name] = auth.NewBasicAuth(authConfig.BasicAuthInternal)
+		case *extauthv1.ExtAuthConfig_Config_ApiKeyAuth:
+			c.configs[name] = auth.NewApiKeyAuth(authConfig.ApiKeyAuth)
+		case *extauthv1.ExtAuthConfig_Config_Oauth2:
+			oidc := authConfig.Oauth2.GetOidcAuthorizationCode()
+			if oidc == nil {
+				return nil, UnsupportedConfigError(name)
+			}
+			c.configs[name] = auth.NewOidcAuth(oidc, s.httpClient)
+		default:
+			return nil, UnsupportedConfigError(name)
+		}
+		c.names = append(c.names, name)
+	}
+
+	if expr := cfg.GetBooleanExpr().GetValue(); expr != "" {
+		parsed, err := extauth.ParseBooleanExpr(expr, c.names)
+		if err != nil {
+			return nil, err
+		}
+		c.expr = parsed
+	} else {
+		c.expr = extauth.AllOf(c.names)
+	}
+	return c, nil
+}
+
+func (s *Server) Check(ctx context.Context, req *pb.CheckRequest) (*pb.CheckResponse, error) {
+	logger := contextutils.LoggerFrom(ctx)
+	configId := req.GetAttributes().GetContextExtensions()[extauth.ConfigIdContextExtension]
+
+	c, ok := (*s.chains.Load())[configId]
+	if !ok {
+		logger.Debugw("no auth config found for request", zap.String("config_id", configId))
+		return toCheckResponse(auth.Deny(http.StatusForbidden)), nil
+	}
+	if c == nil {
+		// the config was rejected when it was loaded
+		return toCheckResponse(auth.Deny(http.StatusForbidden)), nil
+	}
+
+	httpReq := req.GetAttributes().GetRequest().GetHttp()
+	return toCheckResponse(c.authorize(ctx, &auth.Request{
+		Method:  httpReq.GetMethod(),
+		Scheme:  httpReq.GetScheme(),
+		Host:    httpReq.GetHost(),
+		Path:    httpReq.GetPath(),
+		Headers: httpReq.GetHeaders(),
+	})), nil
+}
+
+// chain evaluates the configs of an AuthConfig according to its boolean expression
+type chain struct {
+	names          []string
+	configs        map[string]auth.Config
+	expr           extauth.BooleanExpr
+	failOnRedirect bool
+}
+
+func (c *chain) authorize(ctx context.Context, req *auth.Request) *auth.Response {
+	if c.expr == nil {
+		return auth.Allow()
+	}
+
+	results := make(map[string]*auth.Response, len(c.configs))
+	var lastDenied *auth.Response
+	allowed := c.expr.Eval(func(name string) bool {
+		result, ok := results[name]
+		if !ok {
+			result = c.configs[name].Authorize(ctx, req)
+			results[name] = result
+		}
+		if !result.Allowed {
+			lastDenied = result
+		}
+		return result.Allowed
+	})
+
+	if allowed {
+		// share the headers of every config that authorized the request
+		var headers []auth.Header
+		for _, name := range c.names {
+			if result, ok := results[name]; ok && result.Allowed {
+				headers = append(headers, result.UpstreamHeaders...)
+			}
+		}
+		return auth.Allow(headers...)
+	}
+
+	if lastDenied == nil {
+		// denied by a negated config that authorized the request
+		return auth.Deny(http.StatusForbidden)
+	}
+	if c.failOnRedirect && lastDenied.Status == http.StatusFound {
+		return auth.Deny(http.StatusUnauthorized)
+	}
+	return lastDenied
+}
+
+func toCheckResponse(resp *auth.Response) *pb.CheckResponse {
+	if resp.Allowed {
+		return &pb.CheckResponse{
+			Status: &status.Status{Code: int32(codes.OK)},
+			HttpResponse: &pb.CheckResponse_OkResponse{
+				OkResponse: &pb.OkHttpResponse{
+					Headers: toHeaderValueOptions(resp.UpstreamHeaders, envoy_config_core_v3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD),
+				},
+			},
+		}
+	}
+
+	code := codes.PermissionDenied
+	if resp.Status == http.StatusUnauthorized {
+		code = codes.Unauthenticated
+	}
+	return &pb.CheckResponse{
+		Status: &status.Status{Code: int32(code)},
+		HttpResponse: &pb.CheckResponse_DeniedResponse{
+			DeniedResponse: &pb.DeniedHttpResponse{
+				Status: &envoy_type_v3.HttpStatus{Code: envoy_type_v3.StatusCode(resp.Status)},
+				// responses may set several cookies, so repeated headers must be kept
+				Headers: toHeaderValueOptions(resp.ResponseHeaders, envoy_config_core_v3.HeaderValueOption_APPEND_IF_EXISTS_OR_ADD),
+			},
+		},
+	}
+}
+
+func toHeaderValueOptions(headers []auth.Header, action envoy_config_core_v3.HeaderValueOption_HeaderAppendAction) []*envoy_config_core_v3.HeaderValueOption {
+	var options []*envoy_config_core_v3.HeaderValueOption
+	for _, header := range headers {
+		options = append(options, &envoy_config_core_v3.HeaderValueOption{
+			Header:       &envoy_config_core_v3.HeaderValue{Key: header.Key, Value: header.Value},
+			AppendAction: action,
+		})
+	}
+	return options
+}
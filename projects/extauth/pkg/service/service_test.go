@@ -0,0 +1,196 @@
+package service_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/extauth/pkg/auth"
+	. "github.com/solo-io/gloo/projects/extauth/pkg/service"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/extauth"
+	"google.golang.org/grpc/codes"
+)
+
+var _ = Describe("Server", func() {
+
+	var (
+		ctx    context.Context
+		idp    *httptest.Server
+		server *Server
+	)
+
+	basicAuth := &extauthv1.ExtAuthConfig_Config{
+		Name: &wrappers.StringValue{Value: "basic"},
+		AuthConfig: &extauthv1.ExtAuthConfig_Config_BasicAuthInternal{
+			BasicAuthInternal: &extauthv1.ExtAuthConfig_BasicAuthInternal{
+				UserSource: &extauthv1.ExtAuthConfig_BasicAuthInternal_UserList_{
+					UserList: &extauthv1.ExtAuthConfig_BasicAuthInternal_UserList{
+						Users: map[string]*extauthv1.ExtAuthConfig_BasicAuthInternal_User{
+							"user": {Salt: "TYiryv0/", HashedPassword: "8BvzLUO9IfGPGGsPnAgSu1"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	apiKeyAuth := &extauthv1.ExtAuthConfig_Config{
+		Name: &wrappers.StringValue{Value: "apikey"},
+		AuthConfig: &extauthv1.ExtAuthConfig_Config_ApiKeyAuth{
+			ApiKeyAuth: &extauthv1.ExtAuthConfig_ApiKeyAuthConfig{
+				ValidApiKeys: map[string]*extauthv1.ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata{
+					"key-1": {Username: "alice", Metadata: map[string]string{"team": "infra"}},
+				},
+				HeadersFromKeyMetadata: map[string]string{"x-user-team": "team"},
+			},
+		},
+	}
+
+	oidcAuth := func() *extauthv1.ExtAuthConfig_Config {
+		return &extauthv1.ExtAuthConfig_Config{
+			Name: &wrappers.StringValue{Value: "oidc"},
+			AuthConfig: &extauthv1.ExtAuthConfig_Config_Oauth2{
+				Oauth2: &extauthv1.ExtAuthConfig_OAuth2Config{
+					OauthType: &extauthv1.ExtAuthConfig_OAuth2Config_OidcAuthorizationCode{
+						OidcAuthorizationCode: &extauthv1.ExtAuthConfig_OidcAuthorizationCodeConfig{
+							ClientId:     "gloo",
+							IssuerUrl:    idp.URL,
+							AppUrl:       "https://app.example.com",
+							CallbackPath: "/callback",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	authConfig := func(name, booleanExpr string, configs ...*extauthv1.ExtAuthConfig_Config) *extauthv1.ExtAuthConfig {
+		cfg := &extauthv1.ExtAuthConfig{
+			AuthConfigRefName: extauth.ConfigId("default", name),
+			Configs:           configs,
+		}
+		if booleanExpr != "" {
+			cfg.BooleanExpr = &wrappers.StringValue{Value: booleanExpr}
+		}
+		return cfg
+	}
+
+	check := func(name string, headers map[string]string) *pb.CheckResponse {
+		resp, err := server.Check(ctx, &pb.CheckRequest{
+			Attributes: &pb.AttributeContext{
+				ContextExtensions: map[string]string{extauth.ConfigIdContextExtension: extauth.ConfigId("default", name)},
+				Request: &pb.AttributeContext_Request{
+					Http: &pb.AttributeContext_HttpRequest{
+						Method:  http.MethodGet,
+						Scheme:  "https",
+						Host:    "app.example.com",
+						Path:    "/",
+						Headers: headers,
+					},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return resp
+	}
+
+	basicCredentials := map[string]string{"authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte("user:password"))}
+	apiKey := map[string]string{auth.DefaultApiKeyHeader: "key-1"}
+	bothCredentials := map[string]string{auth.DefaultApiKeyHeader: "key-1", "authorization": basicCredentials["authorization"]}
+
+	expectAllowed := func(resp *pb.CheckResponse) {
+		ExpectWithOffset(1, resp.GetStatus().GetCode()).To(Equal(int32(codes.OK)))
+	}
+
+	expectDenied := func(resp *pb.CheckResponse, status envoy_type_v3.StatusCode) {
+		ExpectWithOffset(1, resp.GetStatus().GetCode()).NotTo(Equal(int32(codes.OK)))
+		ExpectWithOffset(1, resp.GetDeniedResponse().GetStatus().GetCode()).To(Equal(status))
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		idp = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"issuer":                 idp.URL,
+				"authorization_endpoint": idp.URL + "/authorize",
+			})
+		}))
+		server = NewServer(http.DefaultClient)
+		server.SetConfig(ctx, []*extauthv1.ExtAuthConfig{
+			authConfig("basic", "", basicAuth),
+			authConfig("all", "", basicAuth, apiKeyAuth),
+			authConfig("any", "basic || apikey", basicAuth, apiKeyAuth),
+			authConfig("not", "apikey && !basic", basicAuth, apiKeyAuth),
+			authConfig("oidc", "", oidcAuth()),
+			authConfig("invalid", "basic || ldap", basicAuth),
+			{AuthConfigRefName: extauth.ConfigId("default", "fail-on-redirect"), Configs: []*extauthv1.ExtAuthConfig_Config{oidcAuth()}, FailOnRedirect: true},
+		})
+	})
+
+	AfterEach(func() {
+		idp.Close()
+	})
+
+	It("allows authorized requests and forwards the upstream headers", func() {
+		resp := check("basic", basicCredentials)
+		expectAllowed(resp)
+		Expect(resp.GetOkResponse().GetHeaders()).To(HaveLen(1))
+		header := resp.GetOkResponse().GetHeaders()[0]
+		Expect(header.GetHeader().GetKey()).To(Equal(auth.UserIdHeader))
+		Expect(header.GetHeader().GetValue()).To(Equal("user"))
+		Expect(header.GetAppendAction()).To(Equal(envoy_config_core_v3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD))
+	})
+
+	It("returns the denial of the config that rejected the request", func() {
+		resp := check("basic", nil)
+		expectDenied(resp, envoy_type_v3.StatusCode_Unauthorized)
+		Expect(resp.GetStatus().GetCode()).To(Equal(int32(codes.Unauthenticated)))
+		Expect(resp.GetDeniedResponse().GetHeaders()[0].GetHeader().GetKey()).To(Equal("www-authenticate"))
+	})
+
+	It("requires every config when no boolean expression is defined", func() {
+		expectDenied(check("all", basicCredentials), envoy_type_v3.StatusCode_Unauthorized)
+
+		resp := check("all", bothCredentials)
+		expectAllowed(resp)
+		Expect(resp.GetOkResponse().GetHeaders()).To(HaveLen(3))
+	})
+
+	It("evaluates the boolean expression", func() {
+		expectAllowed(check("any", basicCredentials))
+		expectAllowed(check("any", apiKey))
+		expectDenied(check("any", nil), envoy_type_v3.StatusCode_Unauthorized)
+
+		expectAllowed(check("not", apiKey))
+		resp := check("not", bothCredentials)
+		expectDenied(resp, envoy_type_v3.StatusCode_Forbidden)
+		Expect(resp.GetStatus().GetCode()).To(Equal(int32(codes.PermissionDenied)))
+	})
+
+	It("redirects to the issuer unless the config fails on redirect", func() {
+		resp := check("oidc", nil)
+		expectDenied(resp, envoy_type_v3.StatusCode_Found)
+		var headerKeys []string
+		for _, header := range resp.GetDeniedResponse().GetHeaders() {
+			headerKeys = append(headerKeys, header.GetHeader().GetKey())
+			Expect(header.GetAppendAction()).To(Equal(envoy_config_core_v3.HeaderValueOption_APPEND_IF_EXISTS_OR_ADD))
+		}
+		Expect(headerKeys).To(ConsistOf("location", "set-cookie"))
+
+		expectDenied(check("fail-on-redirect", nil), envoy_type_v3.StatusCode_Unauthorized)
+	})
+
+	It("denies requests for unknown or invalid configs", func() {
+		expectDenied(check("missing", basicCredentials), envoy_type_v3.StatusCode_Forbidden)
+		expectDenied(check("invalid", basicCredentials), envoy_type_v3.StatusCode_Forbidden)
+	})
+})
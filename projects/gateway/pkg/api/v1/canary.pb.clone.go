@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gateway/api/v1/canary.proto
+
+package v1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *Canary) Clone() proto.Message {
+	var target *Canary
+	if m == nil {
+		return target
+	}
+	target = &Canary{}
+
+	if h, ok := interface{}(m.GetUpstreamGroup()).(clone.Cloner); ok {
+		target.UpstreamGroup = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.UpstreamGroup = proto.Clone(m.GetUpstreamGroup()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	if h, ok := interface{}(m.GetPrimary()).(clone.Cloner); ok {
+		target.Primary = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.Primary = proto.Clone(m.GetPrimary()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	if h, ok := interface{}(m.GetCanary()).(clone.Cloner); ok {
+		target.Canary = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.Canary = proto.Clone(m.GetCanary()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	if h, ok := interface{}(m.GetAnalysis()).(clone.Cloner); ok {
+		target.Analysis = h.Clone().(*CanaryAnalysis)
+	} else {
+		target.Analysis = proto.Clone(m.GetAnalysis()).(*CanaryAnalysis)
+	}
+
+	target.Paused = m.GetPaused()
+
+	if h, ok := interface{}(m.GetNamespacedStatuses()).(clone.Cloner); ok {
+		target.NamespacedStatuses = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.NamespacedStatuses)
+	} else {
+		target.NamespacedStatuses = proto.Clone(m.GetNamespacedStatuses()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.NamespacedStatuses)
+	}
+
+	if h, ok := interface{}(m.GetMetadata()).(clone.Cloner); ok {
+		target.Metadata = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.Metadata)
+	} else {
+		target.Metadata = proto.Clone(m.GetMetadata()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.Metadata)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *CanaryAnalysis) Clone() proto.Message {
+	var target *CanaryAnalysis
+	if m == nil {
+		return target
+	}
+	target = &CanaryAnalysis{}
+
+	if h, ok := interface{}(m.GetInterval()).(clone.Cloner); ok {
+		target.Interval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.Interval = proto.Clone(m.GetInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if m.GetStepWeights() != nil {
+		target.StepWeights = make([]uint32, len(m.GetStepWeights()))
+		for idx, v := range m.GetStepWeights() {
+
+			target.StepWeights[idx] = v
+
+		}
+	}
+
+	target.FailureThreshold = m.GetFailureThreshold()
+
+	if m.GetMetrics() != nil {
+		target.Metrics = make([]*CanaryMetric, len(m.GetMetrics()))
+		for idx, v := range m.GetMetrics() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Metrics[idx] = h.Clone().(*CanaryMetric)
+			} else {
+				target.Metrics[idx] = proto.Clone(v).(*CanaryMetric)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *CanaryMetric) Clone() proto.Message {
+	var target *CanaryMetric
+	if m == nil {
+		return target
+	}
+	target = &CanaryMetric{}
+
+	target.Name = m.GetName()
+
+	if h, ok := interface{}(m.GetMin()).(clone.Cloner); ok {
+		target.Min = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.Min = proto.Clone(m.GetMin()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	if h, ok := interface{}(m.GetMax()).(clone.Cloner); ok {
+		target.Max = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.Max = proto.Clone(m.GetMax()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	switch m.Provider.(type) {
+
+	case *CanaryMetric_Prometheus:
+
+		if h, ok := interface{}(m.GetPrometheus()).(clone.Cloner); ok {
+			target.Provider = &CanaryMetric_Prometheus{
+				Prometheus: h.Clone().(*CanaryMetric_PrometheusQuery),
+			}
+		} else {
+			target.Provider = &CanaryMetric_Prometheus{
+				Prometheus: proto.Clone(m.GetPrometheus()).(*CanaryMetric_PrometheusQuery),
+			}
+		}
+
+	case *CanaryMetric_Envoy:
+
+		if h, ok := interface{}(m.GetEnvoy()).(clone.Cloner); ok {
+			target.Provider = &CanaryMetric_Envoy{
+				Envoy: h.Clone().(*CanaryMetric_EnvoyStat),
+			}
+		} else {
+			target.Provider = &CanaryMetric_Envoy{
+				Envoy: proto.Clone(m.GetEnvoy()).(*CanaryMetric_EnvoyStat),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *CanaryMetric_PrometheusQuery) Clone() proto.Message {
+	var target *CanaryMetric_PrometheusQuery
+	if m == nil {
+		return target
+	}
+	target = &CanaryMetric_PrometheusQuery{}
+
+	target.Query = m.GetQuery()
+
+	return target
+}
+
+// Clone function
+func (m *CanaryMetric_EnvoyStat) Clone() proto.Message {
+	var target *CanaryMetric_EnvoyStat
+	if m == nil {
+		return target
+	}
+	target = &CanaryMetric_EnvoyStat{}
+
+	target.Metric = m.GetMetric()
+
+	return target
+}
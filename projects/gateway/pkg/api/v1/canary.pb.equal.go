@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gateway/api/v1/canary.proto
+
+package v1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *Canary) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Canary)
+	if !ok {
+		that2, ok := that.(Canary)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUpstreamGroup()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUpstreamGroup()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUpstreamGroup(), target.GetUpstreamGroup()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetPrimary()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPrimary()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPrimary(), target.GetPrimary()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetCanary()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCanary()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCanary(), target.GetCanary()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAnalysis()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAnalysis()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAnalysis(), target.GetAnalysis()) {
+			return false
+		}
+	}
+
+	if m.GetPaused() != target.GetPaused() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetNamespacedStatuses()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetNamespacedStatuses()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetNamespacedStatuses(), target.GetNamespacedStatuses()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMetadata()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMetadata()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMetadata(), target.GetMetadata()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *CanaryAnalysis) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*CanaryAnalysis)
+	if !ok {
+		that2, ok := that.(CanaryAnalysis)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetInterval(), target.GetInterval()) {
+			return false
+		}
+	}
+
+	if len(m.GetStepWeights()) != len(target.GetStepWeights()) {
+		return false
+	}
+	for idx, v := range m.GetStepWeights() {
+
+		if v != target.GetStepWeights()[idx] {
+			return false
+		}
+
+	}
+
+	if m.GetFailureThreshold() != target.GetFailureThreshold() {
+		return false
+	}
+
+	if len(m.GetMetrics()) != len(target.GetMetrics()) {
+		return false
+	}
+	for idx, v := range m.GetMetrics() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetMetrics()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetMetrics()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *CanaryMetric) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*CanaryMetric)
+	if !ok {
+		that2, ok := that.(CanaryMetric)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMin()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMin()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMin(), target.GetMin()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMax()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMax()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMax(), target.GetMax()) {
+			return false
+		}
+	}
+
+	switch m.Provider.(type) {
+
+	case *CanaryMetric_Prometheus:
+		if _, ok := target.Provider.(*CanaryMetric_Prometheus); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetPrometheus()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetPrometheus()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetPrometheus(), target.GetPrometheus()) {
+				return false
+			}
+		}
+
+	case *CanaryMetric_Envoy:
+		if _, ok := target.Provider.(*CanaryMetric_Envoy); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetEnvoy()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetEnvoy()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetEnvoy(), target.GetEnvoy()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Provider != target.Provider {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *CanaryMetric_PrometheusQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*CanaryMetric_PrometheusQuery)
+	if !ok {
+		that2, ok := that.(CanaryMetric_PrometheusQuery)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetQuery(), target.GetQuery()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *CanaryMetric_EnvoyStat) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*CanaryMetric_EnvoyStat)
+	if !ok {
+		that2, ok := that.(CanaryMetric_EnvoyStat)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetMetric() != target.GetMetric() {
+		return false
+	}
+
+	return true
+}
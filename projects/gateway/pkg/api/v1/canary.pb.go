@@ -0,0 +1,755 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo/projects/gateway/api/v1/canary.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	duration "github.com/golang/protobuf/ptypes/duration"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CanaryMetric_EnvoyStat_Metric int32
+
+const (
+	// Percentage of the requests completed by the canary cluster since the previous analysis that did not
+	// result in a 5xx response. Evaluates to 100 when no request was completed.
+	// The first analysis after the controller starts counts the requests completed since Envoy started.
+	CanaryMetric_EnvoyStat_SUCCESS_RATE CanaryMetric_EnvoyStat_Metric = 0
+	// Number of requests completed by the canary cluster since the previous analysis.
+	CanaryMetric_EnvoyStat_REQUEST_COUNT CanaryMetric_EnvoyStat_Metric = 1
+)
+
+// Enum value maps for CanaryMetric_EnvoyStat_Metric.
+var (
+	CanaryMetric_EnvoyStat_Metric_name = map[int32]string{
+		0: "SUCCESS_RATE",
+		1: "REQUEST_COUNT",
+	}
+	CanaryMetric_EnvoyStat_Metric_value = map[string]int32{
+		"SUCCESS_RATE":  0,
+		"REQUEST_COUNT": 1,
+	}
+)
+
+func (x CanaryMetric_EnvoyStat_Metric) Enum() *CanaryMetric_EnvoyStat_Metric {
+	p := new(CanaryMetric_EnvoyStat_Metric)
+	*p = x
+	return p
+}
+
+func (x CanaryMetric_EnvoyStat_Metric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanaryMetric_EnvoyStat_Metric) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_enumTypes[0].Descriptor()
+}
+
+func (CanaryMetric_EnvoyStat_Metric) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_enumTypes[0]
+}
+
+func (x CanaryMetric_EnvoyStat_Metric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanaryMetric_EnvoyStat_Metric.Descriptor instead.
+func (CanaryMetric_EnvoyStat_Metric) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescGZIP(), []int{2, 1, 0}
+}
+
+// A **Canary** progressively shifts traffic from a primary upstream to a canary upstream by updating the weights
+// of the destinations of an **UpstreamGroup**.
+//
+// The canary controller (enabled with `settings.gateway.canary.enabled`) routes the first step weight to the canary,
+// then, once per analysis interval, evaluates the metrics of the analysis. When every metric is within its bounds the
+// canary advances to the next step weight; once the last step succeeds the canary is promoted and receives all
+// traffic. When the number of failed analyses reaches the failure threshold the canary is rolled back and the
+// primary receives all traffic again. Changing the spec of a finished canary starts a new analysis.
+//
+// The progress of the analysis is reported in the `details` of the resource status (`phase`, `canaryWeight`,
+// `step`, `failedChecks`, `lastAnalysis` and `message`), and every transition is recorded as a Kubernetes event.
+//
+// ```yaml
+// apiVersion: gateway.solo.io/v1
+// kind: Canary
+// metadata:
+//
+//	name: petstore
+//	namespace: gloo-system
+//
+// spec:
+//
+//	upstreamGroup:
+//	  name: petstore
+//	  namespace: gloo-system
+//	primary:
+//	  name: petstore-v1
+//	  namespace: gloo-system
+//	canary:
+//	  name: petstore-v2
+//	  namespace: gloo-system
+//	analysis:
+//	  interval: 1m
+//	  stepWeights: [10, 25, 50]
+//	  failureThreshold: 2
+//	  metrics:
+//	  - name: success-rate
+//	    envoy:
+//	      metric: SUCCESS_RATE
+//	    min: 99
+//	  - name: p99-latency
+//	    prometheus:
+//	      query: histogram_quantile(0.99, sum(rate(envoy_cluster_upstream_rq_time_bucket{envoy_cluster_name="{{ .Cluster }}"}[{{ .Interval }}])) by (le))
+//	    max: 500
+//
+// ```
+type Canary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The UpstreamGroup whose weights are managed by the canary controller.
+	// It must contain a destination for both the primary and the canary upstreams; the weights of other
+	// destinations are left untouched.
+	UpstreamGroup *core.ResourceRef `protobuf:"bytes,1,opt,name=upstream_group,json=upstreamGroup,proto3" json:"upstream_group,omitempty"`
+	// The upstream serving the stable version.
+	Primary *core.ResourceRef `protobuf:"bytes,2,opt,name=primary,proto3" json:"primary,omitempty"`
+	// The upstream serving the version being analyzed.
+	Canary *core.ResourceRef `protobuf:"bytes,3,opt,name=canary,proto3" json:"canary,omitempty"`
+	// How traffic is shifted and how the canary is evaluated.
+	Analysis *CanaryAnalysis `protobuf:"bytes,4,opt,name=analysis,proto3" json:"analysis,omitempty"`
+	// When set, the controller keeps the current weights and stops analyzing the canary until it is unset.
+	Paused bool `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+	// NamespacedStatuses indicates the validation status of this resource.
+	// NamespacedStatuses is read-only by clients, and set by gateway during validation
+	NamespacedStatuses *core.NamespacedStatuses `protobuf:"bytes,8,opt,name=namespaced_statuses,json=namespacedStatuses,proto3" json:"namespaced_statuses,omitempty"`
+	// Metadata contains the object metadata for this resource
+	Metadata *core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Canary) Reset() {
+	*x = Canary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Canary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Canary) ProtoMessage() {}
+
+func (x *Canary) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Canary.ProtoReflect.Descriptor instead.
+func (*Canary) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Canary) GetUpstreamGroup() *core.ResourceRef {
+	if x != nil {
+		return x.UpstreamGroup
+	}
+	return nil
+}
+
+func (x *Canary) GetPrimary() *core.ResourceRef {
+	if x != nil {
+		return x.Primary
+	}
+	return nil
+}
+
+func (x *Canary) GetCanary() *core.ResourceRef {
+	if x != nil {
+		return x.Canary
+	}
+	return nil
+}
+
+func (x *Canary) GetAnalysis() *CanaryAnalysis {
+	if x != nil {
+		return x.Analysis
+	}
+	return nil
+}
+
+func (x *Canary) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *Canary) GetNamespacedStatuses() *core.NamespacedStatuses {
+	if x != nil {
+		return x.NamespacedStatuses
+	}
+	return nil
+}
+
+func (x *Canary) GetMetadata() *core.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type CanaryAnalysis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time between two analyses of the canary. Defaults to 1 minute.
+	Interval *duration.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// The weights, out of 100, routed to the canary at each step of the analysis. Weights must be strictly
+	// increasing and lower than 100.
+	StepWeights []uint32 `protobuf:"varint,2,rep,packed,name=step_weights,json=stepWeights,proto3" json:"step_weights,omitempty"`
+	// Number of failed analyses after which the canary is rolled back. Defaults to 1.
+	FailureThreshold uint32 `protobuf:"varint,3,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	// The metrics evaluated at each analysis. An analysis fails when any metric cannot be queried or is out of bounds.
+	// A canary without metrics advances one step per interval.
+	Metrics []*CanaryMetric `protobuf:"bytes,4,rep,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *CanaryAnalysis) Reset() {
+	*x = CanaryAnalysis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanaryAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanaryAnalysis) ProtoMessage() {}
+
+func (x *CanaryAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanaryAnalysis.ProtoReflect.Descriptor instead.
+func (*CanaryAnalysis) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CanaryAnalysis) GetInterval() *duration.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *CanaryAnalysis) GetStepWeights() []uint32 {
+	if x != nil {
+		return x.StepWeights
+	}
+	return nil
+}
+
+func (x *CanaryAnalysis) GetFailureThreshold() uint32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+func (x *CanaryAnalysis) GetMetrics() []*CanaryMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type CanaryMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the metric, used in status messages and events.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Provider:
+	//
+	//	*CanaryMetric_Prometheus
+	//	*CanaryMetric_Envoy
+	Provider isCanaryMetric_Provider `protobuf_oneof:"provider"`
+	// The analysis fails when the value is lower than this bound.
+	Min *wrappers.DoubleValue `protobuf:"bytes,4,opt,name=min,proto3" json:"min,omitempty"`
+	// The analysis fails when the value is greater than this bound.
+	Max *wrappers.DoubleValue `protobuf:"bytes,5,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *CanaryMetric) Reset() {
+	*x = CanaryMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanaryMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanaryMetric) ProtoMessage() {}
+
+func (x *CanaryMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanaryMetric.ProtoReflect.Descriptor instead.
+func (*CanaryMetric) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CanaryMetric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *CanaryMetric) GetProvider() isCanaryMetric_Provider {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
+}
+
+func (x *CanaryMetric) GetPrometheus() *CanaryMetric_PrometheusQuery {
+	if x, ok := x.GetProvider().(*CanaryMetric_Prometheus); ok {
+		return x.Prometheus
+	}
+	return nil
+}
+
+func (x *CanaryMetric) GetEnvoy() *CanaryMetric_EnvoyStat {
+	if x, ok := x.GetProvider().(*CanaryMetric_Envoy); ok {
+		return x.Envoy
+	}
+	return nil
+}
+
+func (x *CanaryMetric) GetMin() *wrappers.DoubleValue {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *CanaryMetric) GetMax() *wrappers.DoubleValue {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+type isCanaryMetric_Provider interface {
+	isCanaryMetric_Provider()
+}
+
+type CanaryMetric_Prometheus struct {
+	// Evaluate the result of a Prometheus query.
+	// Requires `settings.gateway.canary.prometheusUrl` to be set.
+	Prometheus *CanaryMetric_PrometheusQuery `protobuf:"bytes,2,opt,name=prometheus,proto3,oneof"`
+}
+
+type CanaryMetric_Envoy struct {
+	// Evaluate statistics of the canary cluster read from Envoy.
+	// Requires `settings.gateway.canary.envoyStatsUrl` to be set.
+	Envoy *CanaryMetric_EnvoyStat `protobuf:"bytes,3,opt,name=envoy,proto3,oneof"`
+}
+
+func (*CanaryMetric_Prometheus) isCanaryMetric_Provider() {}
+
+func (*CanaryMetric_Envoy) isCanaryMetric_Provider() {}
+
+type CanaryMetric_PrometheusQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A PromQL query returning a single sample. The query is a Go template that can reference
+	// `{{ .Cluster }}` (the Envoy cluster name of the canary upstream), `{{ .Namespace }}` and `{{ .Name }}`
+	// (of the canary upstream) and `{{ .Interval }}` (the analysis interval, e.g. `60s`).
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *CanaryMetric_PrometheusQuery) Reset() {
+	*x = CanaryMetric_PrometheusQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanaryMetric_PrometheusQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanaryMetric_PrometheusQuery) ProtoMessage() {}
+
+func (x *CanaryMetric_PrometheusQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanaryMetric_PrometheusQuery.ProtoReflect.Descriptor instead.
+func (*CanaryMetric_PrometheusQuery) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *CanaryMetric_PrometheusQuery) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type CanaryMetric_EnvoyStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metric CanaryMetric_EnvoyStat_Metric `protobuf:"varint,1,opt,name=metric,proto3,enum=gateway.solo.io.CanaryMetric_EnvoyStat_Metric" json:"metric,omitempty"`
+}
+
+func (x *CanaryMetric_EnvoyStat) Reset() {
+	*x = CanaryMetric_EnvoyStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanaryMetric_EnvoyStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanaryMetric_EnvoyStat) ProtoMessage() {}
+
+func (x *CanaryMetric_EnvoyStat) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanaryMetric_EnvoyStat.ProtoReflect.Descriptor instead.
+func (*CanaryMetric_EnvoyStat) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *CanaryMetric_EnvoyStat) GetMetric() CanaryMetric_EnvoyStat_Metric {
+	if x != nil {
+		return x.Metric
+	}
+	return CanaryMetric_EnvoyStat_SUCCESS_RATE
+}
+
+var File_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDesc = []byte{
+	0x0a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a,
+	0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x6b, 0x69, 0x74,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d,
+	0x6b, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2d, 0x6b, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2d, 0x6b, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2d, 0x6b, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x65, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x03, 0x0a, 0x06, 0x43, 0x61, 0x6e,
+	0x61, 0x72, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x66, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x3b, 0x0a,
+	0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
+	0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x12, 0x57, 0x0a, 0x13, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x42, 0x04, 0xb8, 0xf5, 0x04, 0x01, 0x52, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a,
+	0x12, 0x82, 0xf1, 0x04, 0x0e, 0x0a, 0x02, 0x63, 0x6e, 0x12, 0x08, 0x63, 0x61, 0x6e, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x37, 0x0a,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x07, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xce, 0x03, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x61, 0x72,
+	0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x50,
+	0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x05,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x45, 0x6e, 0x76, 0x6f, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x12, 0x2e, 0x0a,
+	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x2e, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x1a, 0x27, 0x0a,
+	0x0f, 0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x82, 0x01, 0x0a, 0x09, 0x45, 0x6e, 0x76, 0x6f, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x53, 0x74, 0x61, 0x74, 0x2e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x2d, 0x0a, 0x06,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x41, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04,
+	0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescData = file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_goTypes = []interface{}{
+	(CanaryMetric_EnvoyStat_Metric)(0),   // 0: gateway.solo.io.CanaryMetric.EnvoyStat.Metric
+	(*Canary)(nil),                       // 1: gateway.solo.io.Canary
+	(*CanaryAnalysis)(nil),               // 2: gateway.solo.io.CanaryAnalysis
+	(*CanaryMetric)(nil),                 // 3: gateway.solo.io.CanaryMetric
+	(*CanaryMetric_PrometheusQuery)(nil), // 4: gateway.solo.io.CanaryMetric.PrometheusQuery
+	(*CanaryMetric_EnvoyStat)(nil),       // 5: gateway.solo.io.CanaryMetric.EnvoyStat
+	(*core.ResourceRef)(nil),             // 6: core.solo.io.ResourceRef
+	(*core.NamespacedStatuses)(nil),      // 7: core.solo.io.NamespacedStatuses
+	(*core.Metadata)(nil),                // 8: core.solo.io.Metadata
+	(*duration.Duration)(nil),            // 9: google.protobuf.Duration
+	(*wrappers.DoubleValue)(nil),         // 10: google.protobuf.DoubleValue
+}
+var file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_depIdxs = []int32{
+	6,  // 0: gateway.solo.io.Canary.upstream_group:type_name -> core.solo.io.ResourceRef
+	6,  // 1: gateway.solo.io.Canary.primary:type_name -> core.solo.io.ResourceRef
+	6,  // 2: gateway.solo.io.Canary.canary:type_name -> core.solo.io.ResourceRef
+	2,  // 3: gateway.solo.io.Canary.analysis:type_name -> gateway.solo.io.CanaryAnalysis
+	7,  // 4: gateway.solo.io.Canary.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
+	8,  // 5: gateway.solo.io.Canary.metadata:type_name -> core.solo.io.Metadata
+	9,  // 6: gateway.solo.io.CanaryAnalysis.interval:type_name -> google.protobuf.Duration
+	3,  // 7: gateway.solo.io.CanaryAnalysis.metrics:type_name -> gateway.solo.io.CanaryMetric
+	4,  // 8: gateway.solo.io.CanaryMetric.prometheus:type_name -> gateway.solo.io.CanaryMetric.PrometheusQuery
+	5,  // 9: gateway.solo.io.CanaryMetric.envoy:type_name -> gateway.solo.io.CanaryMetric.EnvoyStat
+	10, // 10: gateway.solo.io.CanaryMetric.min:type_name -> google.protobuf.DoubleValue
+	10, // 11: gateway.solo.io.CanaryMetric.max:type_name -> google.protobuf.DoubleValue
+	0,  // 12: gateway.solo.io.CanaryMetric.EnvoyStat.metric:type_name -> gateway.solo.io.CanaryMetric.EnvoyStat.Metric
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_init() }
+func file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_init() {
+	if File_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Canary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CanaryAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CanaryMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CanaryMetric_PrometheusQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CanaryMetric_EnvoyStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*CanaryMetric_Prometheus)(nil),
+		(*CanaryMetric_Envoy)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_enumTypes,
+		MessageInfos:      file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto = out.File
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_goTypes = nil
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_canary_proto_depIdxs = nil
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/solo-io/protoc-gen-ext/pkg/clone"
 	"google.golang.org/protobuf/proto"
 
+	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
 	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
@@ -130,6 +132,18 @@ func (m *Gateway) Clone() proto.Message {
 			}
 		}
 
+	case *Gateway_UdpGateway:
+
+		if h, ok := interface{}(m.GetUdpGateway()).(clone.Cloner); ok {
+			target.GatewayType = &Gateway_UdpGateway{
+				UdpGateway: h.Clone().(*UdpGateway),
+			}
+		} else {
+			target.GatewayType = &Gateway_UdpGateway{
+				UdpGateway: proto.Clone(m.GetUdpGateway()).(*UdpGateway),
+			}
+		}
+
 	}
 
 	return target
@@ -165,6 +179,35 @@ func (m *TcpGateway) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *UdpGateway) Clone() proto.Message {
+	var target *UdpGateway
+	if m == nil {
+		return target
+	}
+	target = &UdpGateway{}
+
+	if h, ok := interface{}(m.GetUpstream()).(clone.Cloner); ok {
+		target.Upstream = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.Upstream = proto.Clone(m.GetUpstream()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(clone.Cloner); ok {
+		target.IdleTimeout = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.IdleTimeout = proto.Clone(m.GetIdleTimeout()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if h, ok := interface{}(m.GetHashPolicy()).(clone.Cloner); ok {
+		target.HashPolicy = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.UdpListener_HashPolicy)
+	} else {
+		target.HashPolicy = proto.Clone(m.GetHashPolicy()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.UdpListener_HashPolicy)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *HybridGateway) Clone() proto.Message {
 	var target *HybridGateway
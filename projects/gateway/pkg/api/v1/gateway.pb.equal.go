@@ -166,6 +166,21 @@ func (m *Gateway) Equal(that interface{}) bool {
 			}
 		}
 
+	case *Gateway_UdpGateway:
+		if _, ok := target.GatewayType.(*Gateway_UdpGateway); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetUdpGateway()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUdpGateway()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetUdpGateway(), target.GetUdpGateway()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.GatewayType != target.GatewayType {
@@ -227,6 +242,60 @@ func (m *TcpGateway) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *UdpGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpGateway)
+	if !ok {
+		that2, ok := that.(UdpGateway)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUpstream()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUpstream()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUpstream(), target.GetUpstream()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetIdleTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetIdleTimeout(), target.GetIdleTimeout()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetHashPolicy()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHashPolicy()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHashPolicy(), target.GetHashPolicy()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *HybridGateway) Equal(that interface{}) bool {
 	if that == nil {
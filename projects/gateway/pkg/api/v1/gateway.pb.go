@@ -10,6 +10,7 @@ import (
 	reflect "reflect"
 	sync "sync"
 
+	duration "github.com/golang/protobuf/ptypes/duration"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -61,12 +62,14 @@ type Gateway struct {
 	// HttpGateway creates a listener with an http_connection_manager
 	// TcpGateway creates a listener with a tcp proxy filter
 	// HybridGateway creates a listener with any number of filter chains that each may have either an http_connection_manager or a tcp proxy filter
+	// UdpGateway creates a UDP listener with a udp proxy filter
 	//
 	// Types that are assignable to GatewayType:
 	//
 	//	*Gateway_HttpGateway
 	//	*Gateway_TcpGateway
 	//	*Gateway_HybridGateway
+	//	*Gateway_UdpGateway
 	GatewayType isGateway_GatewayType `protobuf_oneof:"GatewayType"`
 	// Names of the [`Proxy`](https://docs.solo.io/gloo-edge/latest/reference/api/github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto.sk/)
 	// resources to generate from this gateway. If other gateways exist which point to the same proxy,
@@ -200,6 +203,13 @@ func (x *Gateway) GetHybridGateway() *HybridGateway {
 	return nil
 }
 
+func (x *Gateway) GetUdpGateway() *UdpGateway {
+	if x, ok := x.GetGatewayType().(*Gateway_UdpGateway); ok {
+		return x.UdpGateway
+	}
+	return nil
+}
+
 func (x *Gateway) GetProxyNames() []string {
 	if x != nil {
 		return x.ProxyNames
@@ -230,12 +240,18 @@ type Gateway_HybridGateway struct {
 	HybridGateway *HybridGateway `protobuf:"bytes,11,opt,name=hybrid_gateway,json=hybridGateway,proto3,oneof"`
 }
 
+type Gateway_UdpGateway struct {
+	UdpGateway *UdpGateway `protobuf:"bytes,15,opt,name=udp_gateway,json=udpGateway,proto3,oneof"`
+}
+
 func (*Gateway_HttpGateway) isGateway_GatewayType() {}
 
 func (*Gateway_TcpGateway) isGateway_GatewayType() {}
 
 func (*Gateway_HybridGateway) isGateway_GatewayType() {}
 
+func (*Gateway_UdpGateway) isGateway_GatewayType() {}
+
 type TcpGateway struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -293,6 +309,75 @@ func (x *TcpGateway) GetOptions() *v1.TcpListenerOptions {
 	return nil
 }
 
+// A UdpGateway proxies the UDP datagrams received on the bind address of the gateway to an upstream.
+// The ssl field and the options of the gateway do not apply to UDP gateways.
+// A UdpGateway may share its bind address with an HTTP or TCP gateway of the same proxy.
+type UdpGateway struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the upstream to forward the datagrams to
+	Upstream *core.ResourceRef `protobuf:"bytes,1,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	// sessions are closed when no datagram was sent or received for this duration. Defaults to 60 seconds.
+	IdleTimeout *duration.Duration `protobuf:"bytes,2,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+	// hash policy for session affinity
+	HashPolicy *v1.UdpListener_HashPolicy `protobuf:"bytes,3,opt,name=hash_policy,json=hashPolicy,proto3" json:"hash_policy,omitempty"`
+}
+
+func (x *UdpGateway) Reset() {
+	*x = UdpGateway{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UdpGateway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UdpGateway) ProtoMessage() {}
+
+func (x *UdpGateway) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UdpGateway.ProtoReflect.Descriptor instead.
+func (*UdpGateway) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UdpGateway) GetUpstream() *core.ResourceRef {
+	if x != nil {
+		return x.Upstream
+	}
+	return nil
+}
+
+func (x *UdpGateway) GetIdleTimeout() *duration.Duration {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return nil
+}
+
+func (x *UdpGateway) GetHashPolicy() *v1.UdpListener_HashPolicy {
+	if x != nil {
+		return x.HashPolicy
+	}
+	return nil
+}
+
 type HybridGateway struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -316,7 +401,7 @@ type HybridGateway struct {
 func (x *HybridGateway) Reset() {
 	*x = HybridGateway{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[2]
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -329,7 +414,7 @@ func (x *HybridGateway) String() string {
 func (*HybridGateway) ProtoMessage() {}
 
 func (x *HybridGateway) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[2]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -342,7 +427,7 @@ func (x *HybridGateway) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HybridGateway.ProtoReflect.Descriptor instead.
 func (*HybridGateway) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{2}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *HybridGateway) GetMatchedGateways() []*MatchedGateway {
@@ -395,7 +480,7 @@ type DelegatedHttpGateway struct {
 func (x *DelegatedHttpGateway) Reset() {
 	*x = DelegatedHttpGateway{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[3]
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -408,7 +493,7 @@ func (x *DelegatedHttpGateway) String() string {
 func (*DelegatedHttpGateway) ProtoMessage() {}
 
 func (x *DelegatedHttpGateway) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[3]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,7 +506,7 @@ func (x *DelegatedHttpGateway) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelegatedHttpGateway.ProtoReflect.Descriptor instead.
 func (*DelegatedHttpGateway) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{3}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{4}
 }
 
 func (m *DelegatedHttpGateway) GetSelectionType() isDelegatedHttpGateway_SelectionType {
@@ -501,7 +586,7 @@ type DelegatedTcpGateway struct {
 func (x *DelegatedTcpGateway) Reset() {
 	*x = DelegatedTcpGateway{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4]
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -514,7 +599,7 @@ func (x *DelegatedTcpGateway) String() string {
 func (*DelegatedTcpGateway) ProtoMessage() {}
 
 func (x *DelegatedTcpGateway) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +612,7 @@ func (x *DelegatedTcpGateway) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelegatedTcpGateway.ProtoReflect.Descriptor instead.
 func (*DelegatedTcpGateway) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{4}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{5}
 }
 
 func (m *DelegatedTcpGateway) GetSelectionType() isDelegatedTcpGateway_SelectionType {
@@ -589,7 +674,7 @@ type MatchedGateway struct {
 func (x *MatchedGateway) Reset() {
 	*x = MatchedGateway{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5]
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -602,7 +687,7 @@ func (x *MatchedGateway) String() string {
 func (*MatchedGateway) ProtoMessage() {}
 
 func (x *MatchedGateway) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,7 +700,7 @@ func (x *MatchedGateway) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchedGateway.ProtoReflect.Descriptor instead.
 func (*MatchedGateway) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{5}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MatchedGateway) GetMatcher() *Matcher {
@@ -684,7 +769,7 @@ type Matcher struct {
 func (x *Matcher) Reset() {
 	*x = Matcher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[6]
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -697,7 +782,7 @@ func (x *Matcher) String() string {
 func (*Matcher) ProtoMessage() {}
 
 func (x *Matcher) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[6]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +795,7 @@ func (x *Matcher) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Matcher.ProtoReflect.Descriptor instead.
 func (*Matcher) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{6}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Matcher) GetSslConfig() *ssl.SslConfig {
@@ -745,6 +830,8 @@ var file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDesc =
 	0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x6b, 0x69,
 	0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
@@ -787,7 +874,7 @@ var file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDesc =
 	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x78, 0x74, 0x65,
 	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd, 0x05, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x06, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65,
 	0x77, 0x61, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x73, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
 	0x52, 0x03, 0x73, 0x73, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x64, 0x5f, 0x61, 0x64,
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6e,
@@ -823,6 +910,10 @@ var file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDesc =
 	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
 	0x2e, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x00,
 	0x52, 0x0d, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12,
+	0x3e, 0x0a, 0x0b, 0x75, 0x64, 0x70, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x55, 0x64, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x64, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12,
 	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x0c,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73,
 	0x12, 0x4c, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
@@ -840,91 +931,104 @@ var file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDesc =
 	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6c, 0x6f,
 	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x63, 0x70, 0x4c, 0x69, 0x73,
 	0x74, 0x65, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x96, 0x02, 0x0a, 0x0d, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64,
-	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x4a, 0x0a, 0x10, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x65, 0x64, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x52, 0x0f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x73, 0x12, 0x5d, 0x0a, 0x17, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
-	0x48, 0x74, 0x74, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x15, 0x64, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x48, 0x74, 0x74, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x73, 0x12, 0x5a, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x74, 0x63, 0x70, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x54, 0x63,
-	0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x64, 0x54, 0x63, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x22, 0x8f,
-	0x03, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x48, 0x74, 0x74, 0x70,
-	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x48,
-	0x00, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x48,
-	0x00, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x70,
-	0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x76, 0x65,
-	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x70, 0x72,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
-	0x64, 0x65, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x20, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f,
-	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37,
-	0x2e, 0x68, 0x63, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f,
-	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x1d, 0x68, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x73, 0x6c, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6c, 0x6f,
-	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x73, 0x6c, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x10,
-	0x0a, 0x0e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x22, 0x9b, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x54, 0x63,
-	0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66,
-	0x48, 0x00, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x48, 0x00, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x10, 0x0a, 0x0e,
-	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd6,
-	0x01, 0x0a, 0x0e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x74,
-	0x74, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x68, 0x74, 0x74,
-	0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x74, 0x63, 0x70, 0x5f,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x0a, 0x55, 0x64, 0x70, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x66, 0x52, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x3c, 0x0a, 0x0c, 0x69,
+	0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x64,
+	0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x45, 0x0a, 0x0b, 0x68, 0x61, 0x73,
+	0x68, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x55, 0x64,
+	0x70, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0x96, 0x02, 0x0a, 0x0d, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x12, 0x4a, 0x0a, 0x10, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x0f, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x5d,
+	0x0a, 0x17, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x74, 0x74, 0x70,
+	0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x48, 0x74, 0x74, 0x70, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x15, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x64, 0x48, 0x74, 0x74, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x5a, 0x0a,
+	0x16, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
-	0x54, 0x63, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x63,
-	0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x07, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x73, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x73, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x09, 0x73, 0x73, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x59, 0x0a, 0x14, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x72, 0x61, 0x6e,
-	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x69, 0x64, 0x72, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x12, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x61, 0x73, 0x73, 0x74, 0x68,
-	0x72, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x69,
-	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x70, 0x61, 0x73, 0x73, 0x74,
-	0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74,
-	0x65, 0x73, 0x42, 0x41, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01,
-	0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x54, 0x63, 0x70, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x52, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x54, 0x63,
+	0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x22, 0x8f, 0x03, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x48, 0x74, 0x74, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x03, 0x72, 0x65,
+	0x66, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x08, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x80,
+	0x01, 0x0a, 0x20, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x68, 0x63, 0x6d, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x1d, 0x68, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x73, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x73, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09,
+	0x73, 0x73, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x10, 0x0a, 0x0e, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x54, 0x63, 0x70, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x03, 0x72,
+	0x65, 0x66, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x32, 0x0a, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
+	0x12, 0x41, 0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x74, 0x63, 0x70, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x63, 0x70, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x63, 0x70, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x36,
+	0x0a, 0x0a, 0x73, 0x73, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x53, 0x73, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x73, 0x73, 0x6c,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x59, 0x0a, 0x14, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x65,
+	0x6e, 0x76, 0x6f, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x33, 0x2e, 0x43, 0x69, 0x64, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x12, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68,
+	0x5f, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x70, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67,
+	0x68, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x42, 0x41, 0xb8,
+	0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -939,59 +1043,66 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescG
 	return file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_goTypes = []interface{}{
 	(*Gateway)(nil),                           // 0: gateway.solo.io.Gateway
 	(*TcpGateway)(nil),                        // 1: gateway.solo.io.TcpGateway
-	(*HybridGateway)(nil),                     // 2: gateway.solo.io.HybridGateway
-	(*DelegatedHttpGateway)(nil),              // 3: gateway.solo.io.DelegatedHttpGateway
-	(*DelegatedTcpGateway)(nil),               // 4: gateway.solo.io.DelegatedTcpGateway
-	(*MatchedGateway)(nil),                    // 5: gateway.solo.io.MatchedGateway
-	(*Matcher)(nil),                           // 6: gateway.solo.io.Matcher
-	(*v1.ListenerOptions)(nil),                // 7: gloo.solo.io.ListenerOptions
-	(*core.NamespacedStatuses)(nil),           // 8: core.solo.io.NamespacedStatuses
-	(*core.Metadata)(nil),                     // 9: core.solo.io.Metadata
-	(*wrappers.BoolValue)(nil),                // 10: google.protobuf.BoolValue
-	(*HttpGateway)(nil),                       // 11: gateway.solo.io.HttpGateway
-	(*v1.RouteConfigurationOptions)(nil),      // 12: gloo.solo.io.RouteConfigurationOptions
-	(*v1.TcpHost)(nil),                        // 13: gloo.solo.io.TcpHost
-	(*v1.TcpListenerOptions)(nil),             // 14: gloo.solo.io.TcpListenerOptions
-	(*core.ResourceRef)(nil),                  // 15: core.solo.io.ResourceRef
-	(*selectors.Selector)(nil),                // 16: selectors.core.gloo.solo.io.Selector
-	(*hcm.HttpConnectionManagerSettings)(nil), // 17: hcm.options.gloo.solo.io.HttpConnectionManagerSettings
-	(*ssl.SslConfig)(nil),                     // 18: gloo.solo.io.SslConfig
-	(*v3.CidrRange)(nil),                      // 19: solo.io.envoy.config.core.v3.CidrRange
+	(*UdpGateway)(nil),                        // 2: gateway.solo.io.UdpGateway
+	(*HybridGateway)(nil),                     // 3: gateway.solo.io.HybridGateway
+	(*DelegatedHttpGateway)(nil),              // 4: gateway.solo.io.DelegatedHttpGateway
+	(*DelegatedTcpGateway)(nil),               // 5: gateway.solo.io.DelegatedTcpGateway
+	(*MatchedGateway)(nil),                    // 6: gateway.solo.io.MatchedGateway
+	(*Matcher)(nil),                           // 7: gateway.solo.io.Matcher
+	(*v1.ListenerOptions)(nil),                // 8: gloo.solo.io.ListenerOptions
+	(*core.NamespacedStatuses)(nil),           // 9: core.solo.io.NamespacedStatuses
+	(*core.Metadata)(nil),                     // 10: core.solo.io.Metadata
+	(*wrappers.BoolValue)(nil),                // 11: google.protobuf.BoolValue
+	(*HttpGateway)(nil),                       // 12: gateway.solo.io.HttpGateway
+	(*v1.RouteConfigurationOptions)(nil),      // 13: gloo.solo.io.RouteConfigurationOptions
+	(*v1.TcpHost)(nil),                        // 14: gloo.solo.io.TcpHost
+	(*v1.TcpListenerOptions)(nil),             // 15: gloo.solo.io.TcpListenerOptions
+	(*core.ResourceRef)(nil),                  // 16: core.solo.io.ResourceRef
+	(*duration.Duration)(nil),                 // 17: google.protobuf.Duration
+	(*v1.UdpListener_HashPolicy)(nil),         // 18: gloo.solo.io.UdpListener.HashPolicy
+	(*selectors.Selector)(nil),                // 19: selectors.core.gloo.solo.io.Selector
+	(*hcm.HttpConnectionManagerSettings)(nil), // 20: hcm.options.gloo.solo.io.HttpConnectionManagerSettings
+	(*ssl.SslConfig)(nil),                     // 21: gloo.solo.io.SslConfig
+	(*v3.CidrRange)(nil),                      // 22: solo.io.envoy.config.core.v3.CidrRange
 }
 var file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_depIdxs = []int32{
-	7,  // 0: gateway.solo.io.Gateway.options:type_name -> gloo.solo.io.ListenerOptions
-	8,  // 1: gateway.solo.io.Gateway.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
-	9,  // 2: gateway.solo.io.Gateway.metadata:type_name -> core.solo.io.Metadata
-	10, // 3: gateway.solo.io.Gateway.use_proxy_proto:type_name -> google.protobuf.BoolValue
-	11, // 4: gateway.solo.io.Gateway.http_gateway:type_name -> gateway.solo.io.HttpGateway
+	8,  // 0: gateway.solo.io.Gateway.options:type_name -> gloo.solo.io.ListenerOptions
+	9,  // 1: gateway.solo.io.Gateway.namespaced_statuses:type_name -> core.solo.io.NamespacedStatuses
+	10, // 2: gateway.solo.io.Gateway.metadata:type_name -> core.solo.io.Metadata
+	11, // 3: gateway.solo.io.Gateway.use_proxy_proto:type_name -> google.protobuf.BoolValue
+	12, // 4: gateway.solo.io.Gateway.http_gateway:type_name -> gateway.solo.io.HttpGateway
 	1,  // 5: gateway.solo.io.Gateway.tcp_gateway:type_name -> gateway.solo.io.TcpGateway
-	2,  // 6: gateway.solo.io.Gateway.hybrid_gateway:type_name -> gateway.solo.io.HybridGateway
-	12, // 7: gateway.solo.io.Gateway.route_options:type_name -> gloo.solo.io.RouteConfigurationOptions
-	13, // 8: gateway.solo.io.TcpGateway.tcp_hosts:type_name -> gloo.solo.io.TcpHost
-	14, // 9: gateway.solo.io.TcpGateway.options:type_name -> gloo.solo.io.TcpListenerOptions
-	5,  // 10: gateway.solo.io.HybridGateway.matched_gateways:type_name -> gateway.solo.io.MatchedGateway
-	3,  // 11: gateway.solo.io.HybridGateway.delegated_http_gateways:type_name -> gateway.solo.io.DelegatedHttpGateway
-	4,  // 12: gateway.solo.io.HybridGateway.delegated_tcp_gateways:type_name -> gateway.solo.io.DelegatedTcpGateway
-	15, // 13: gateway.solo.io.DelegatedHttpGateway.ref:type_name -> core.solo.io.ResourceRef
-	16, // 14: gateway.solo.io.DelegatedHttpGateway.selector:type_name -> selectors.core.gloo.solo.io.Selector
-	17, // 15: gateway.solo.io.DelegatedHttpGateway.http_connection_manager_settings:type_name -> hcm.options.gloo.solo.io.HttpConnectionManagerSettings
-	18, // 16: gateway.solo.io.DelegatedHttpGateway.ssl_config:type_name -> gloo.solo.io.SslConfig
-	15, // 17: gateway.solo.io.DelegatedTcpGateway.ref:type_name -> core.solo.io.ResourceRef
-	16, // 18: gateway.solo.io.DelegatedTcpGateway.selector:type_name -> selectors.core.gloo.solo.io.Selector
-	6,  // 19: gateway.solo.io.MatchedGateway.matcher:type_name -> gateway.solo.io.Matcher
-	11, // 20: gateway.solo.io.MatchedGateway.http_gateway:type_name -> gateway.solo.io.HttpGateway
-	1,  // 21: gateway.solo.io.MatchedGateway.tcp_gateway:type_name -> gateway.solo.io.TcpGateway
-	18, // 22: gateway.solo.io.Matcher.ssl_config:type_name -> gloo.solo.io.SslConfig
-	19, // 23: gateway.solo.io.Matcher.source_prefix_ranges:type_name -> solo.io.envoy.config.core.v3.CidrRange
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	3,  // 6: gateway.solo.io.Gateway.hybrid_gateway:type_name -> gateway.solo.io.HybridGateway
+	2,  // 7: gateway.solo.io.Gateway.udp_gateway:type_name -> gateway.solo.io.UdpGateway
+	13, // 8: gateway.solo.io.Gateway.route_options:type_name -> gloo.solo.io.RouteConfigurationOptions
+	14, // 9: gateway.solo.io.TcpGateway.tcp_hosts:type_name -> gloo.solo.io.TcpHost
+	15, // 10: gateway.solo.io.TcpGateway.options:type_name -> gloo.solo.io.TcpListenerOptions
+	16, // 11: gateway.solo.io.UdpGateway.upstream:type_name -> core.solo.io.ResourceRef
+	17, // 12: gateway.solo.io.UdpGateway.idle_timeout:type_name -> google.protobuf.Duration
+	18, // 13: gateway.solo.io.UdpGateway.hash_policy:type_name -> gloo.solo.io.UdpListener.HashPolicy
+	6,  // 14: gateway.solo.io.HybridGateway.matched_gateways:type_name -> gateway.solo.io.MatchedGateway
+	4,  // 15: gateway.solo.io.HybridGateway.delegated_http_gateways:type_name -> gateway.solo.io.DelegatedHttpGateway
+	5,  // 16: gateway.solo.io.HybridGateway.delegated_tcp_gateways:type_name -> gateway.solo.io.DelegatedTcpGateway
+	16, // 17: gateway.solo.io.DelegatedHttpGateway.ref:type_name -> core.solo.io.ResourceRef
+	19, // 18: gateway.solo.io.DelegatedHttpGateway.selector:type_name -> selectors.core.gloo.solo.io.Selector
+	20, // 19: gateway.solo.io.DelegatedHttpGateway.http_connection_manager_settings:type_name -> hcm.options.gloo.solo.io.HttpConnectionManagerSettings
+	21, // 20: gateway.solo.io.DelegatedHttpGateway.ssl_config:type_name -> gloo.solo.io.SslConfig
+	16, // 21: gateway.solo.io.DelegatedTcpGateway.ref:type_name -> core.solo.io.ResourceRef
+	19, // 22: gateway.solo.io.DelegatedTcpGateway.selector:type_name -> selectors.core.gloo.solo.io.Selector
+	7,  // 23: gateway.solo.io.MatchedGateway.matcher:type_name -> gateway.solo.io.Matcher
+	12, // 24: gateway.solo.io.MatchedGateway.http_gateway:type_name -> gateway.solo.io.HttpGateway
+	1,  // 25: gateway.solo.io.MatchedGateway.tcp_gateway:type_name -> gateway.solo.io.TcpGateway
+	21, // 26: gateway.solo.io.Matcher.ssl_config:type_name -> gloo.solo.io.SslConfig
+	22, // 27: gateway.solo.io.Matcher.source_prefix_ranges:type_name -> solo.io.envoy.config.core.v3.CidrRange
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_init() }
@@ -1026,7 +1137,7 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_init() {
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HybridGateway); i {
+			switch v := v.(*UdpGateway); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1038,7 +1149,7 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_init() {
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelegatedHttpGateway); i {
+			switch v := v.(*HybridGateway); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1050,7 +1161,7 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_init() {
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelegatedTcpGateway); i {
+			switch v := v.(*DelegatedHttpGateway); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1062,7 +1173,7 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_init() {
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MatchedGateway); i {
+			switch v := v.(*DelegatedTcpGateway); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1074,6 +1185,18 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_init() {
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchedGateway); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Matcher); i {
 			case 0:
 				return &v.state
@@ -1090,16 +1213,17 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_init() {
 		(*Gateway_HttpGateway)(nil),
 		(*Gateway_TcpGateway)(nil),
 		(*Gateway_HybridGateway)(nil),
+		(*Gateway_UdpGateway)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*DelegatedHttpGateway_Ref)(nil),
 		(*DelegatedHttpGateway_Selector)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*DelegatedTcpGateway_Ref)(nil),
 		(*DelegatedTcpGateway_Selector)(nil),
 	}
-	file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*MatchedGateway_HttpGateway)(nil),
 		(*MatchedGateway_TcpGateway)(nil),
 	}
@@ -1109,7 +1233,7 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_projects_gateway_api_v1_gateway_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -27,6 +27,8 @@ func Resource(resource string) schema.GroupResource {
 
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&Canary{},
+		&CanaryList{},
 		&Gateway{},
 		&GatewayList{},
 		&MatchableHttpGateway{},
@@ -17,6 +17,69 @@ type metaOnly struct {
 	v1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resourceName=canaries
+// +genclient
+type Canary struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the implementation of this definition.
+	// +optional
+	Spec   api.Canary              `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status core.NamespacedStatuses `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+func (o *Canary) MarshalJSON() ([]byte, error) {
+	spec, err := protoutils.MarshalMap(&o.Spec)
+	if err != nil {
+		return nil, err
+	}
+	delete(spec, "metadata")
+	delete(spec, "namespacedStatuses")
+	asMap := map[string]interface{}{
+		"metadata":   o.ObjectMeta,
+		"apiVersion": o.TypeMeta.APIVersion,
+		"kind":       o.TypeMeta.Kind,
+		"status":     o.Status,
+		"spec":       spec,
+	}
+	return json.Marshal(asMap)
+}
+
+func (o *Canary) UnmarshalJSON(data []byte) error {
+	var metaOnly metaOnly
+	if err := json.Unmarshal(data, &metaOnly); err != nil {
+		return err
+	}
+	var spec api.Canary
+	if err := protoutils.UnmarshalResource(data, &spec); err != nil {
+		return err
+	}
+	spec.Metadata = nil
+	*o = Canary{
+		ObjectMeta: metaOnly.ObjectMeta,
+		TypeMeta:   metaOnly.TypeMeta,
+		Spec:       spec,
+	}
+	if spec.GetNamespacedStatuses() != nil {
+		o.Status = *spec.NamespacedStatuses
+		o.Spec.NamespacedStatuses = nil
+	}
+
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// CanaryList is a collection of Canarys.
+type CanaryList struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items       []Canary `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +resourceName=gateways
 // +genclient
@@ -0,0 +1,349 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/bootstrap/leaderelector"
+	"github.com/solo-io/gloo/pkg/bootstrap/leaderelector/singlereplica"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/statusutils"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DefaultInterval is the time between two analyses of a canary that does not define an interval
+	DefaultInterval = time.Minute
+	// DefaultSyncPeriod is how often canaries are checked when the settings do not define a sync period
+	DefaultSyncPeriod = 10 * time.Second
+
+	// event reasons
+	ReasonStarted        = "Started"
+	ReasonAdvanced       = "Advanced"
+	ReasonAnalysisFailed = "AnalysisFailed"
+	ReasonPromoted       = "Promoted"
+	ReasonRolledBack     = "RolledBack"
+	ReasonPaused         = "Paused"
+	ReasonResumed        = "Resumed"
+	ReasonInvalid        = "Invalid"
+)
+
+var (
+	MissingRefError = func(field string) error {
+		return eris.Errorf("%s must be set", field)
+	}
+
+	NoStepWeightsError = eris.New("analysis.stepWeights must define at least one weight")
+
+	InvalidStepWeightsError = func(weights []uint32) error {
+		return eris.Errorf("analysis.stepWeights must be strictly increasing and lower than 100, got %v", weights)
+	}
+
+	UpstreamGroupNotFoundError = func(ref *core.ResourceRef, err error) error {
+		return eris.Wrapf(err, "reading upstream group %s.%s", ref.GetNamespace(), ref.GetName())
+	}
+
+	MissingDestinationError = func(upstreamGroup, upstream *core.ResourceRef) error {
+		return eris.Errorf("upstream group %s.%s has no destination for upstream %s.%s",
+			upstreamGroup.GetNamespace(), upstreamGroup.GetName(), upstream.GetNamespace(), upstream.GetName())
+	}
+)
+
+type Opts struct {
+	// Namespaces the Canary resources are read from
+	WatchNamespaces []string
+	// Namespace of the status written on Canary resources
+	StatusReporterNamespace string
+	// How often canaries are checked; defaults to DefaultSyncPeriod
+	SyncPeriod time.Duration
+
+	Canaries       v1.CanaryClient
+	UpstreamGroups gloov1.UpstreamGroupClient
+	Metrics        MetricsProvider
+	Events         EventRecorder
+
+	// Identity of this replica; only the leader reconciles canaries. Defaults to a single replica, which always leads.
+	Identity leaderelector.Identity
+
+	// Now returns the current time; defaults to time.Now
+	Now func() time.Time
+}
+
+// Controller progressively shifts the weights of UpstreamGroups from primary to canary upstreams as described by
+// Canary resources, promoting or rolling back canaries according to the result of their analysis.
+type Controller struct {
+	opts Opts
+}
+
+func NewController(opts Opts) *Controller {
+	if opts.SyncPeriod == 0 {
+		opts.SyncPeriod = DefaultSyncPeriod
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	if opts.Identity == nil {
+		opts.Identity = singlereplica.Identity()
+	}
+	return &Controller{opts: opts}
+}
+
+// Run syncs the canaries once per sync period until ctx is done.
+func (c *Controller) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.opts.SyncPeriod)
+	defer ticker.Stop()
+	for {
+		if err := c.Sync(ctx); err != nil {
+			contextutils.LoggerFrom(ctx).Warnw("failed to sync canaries", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync reconciles every canary once. Replicas which are not the leader do nothing, so that steps are only
+// advanced, and weights and statuses only written, once.
+func (c *Controller) Sync(ctx context.Context) error {
+	if !c.opts.Identity.IsLeader() {
+		return nil
+	}
+
+	var errs *multierror.Error
+	for _, namespace := range c.opts.WatchNamespaces {
+		canaries, err := c.opts.Canaries.List(namespace, clients.ListOpts{Ctx: ctx})
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		for _, canary := range canaries {
+			if err := c.reconcile(ctx, canary); err != nil {
+				errs = multierror.Append(errs, eris.Wrapf(err, "reconciling canary %s.%s",
+					canary.GetMetadata().GetNamespace(), canary.GetMetadata().GetName()))
+			}
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+type event struct {
+	eventType string
+	reason    string
+	message   string
+}
+
+func (c *Controller) reconcile(ctx context.Context, canary *v1.Canary) error {
+	status := canary.GetNamespacedStatuses().GetStatuses()[c.opts.StatusReporterNamespace]
+	state := stateFromStruct(status.GetDetails())
+
+	hash, err := specHash(canary)
+	if err != nil {
+		return err
+	}
+	if state != nil && state.SpecHash == hash && state.Terminal() {
+		// finished canaries no longer manage the upstream group, so that it can be edited to finish the rollout
+		return nil
+	}
+	upstreamGroup, err := c.validate(ctx, canary)
+	if err != nil {
+		if status.GetState() == core.Status_Rejected && status.GetReason() == err.Error() {
+			return nil
+		}
+		c.opts.Events.Event(ctx, canary, corev1.EventTypeWarning, ReasonInvalid, err.Error())
+		return c.writeStatus(ctx, canary, core.Status_Rejected, err.Error(), state)
+	}
+
+	var events []event
+	record := func(eventType, reason, message string) {
+		events = append(events, event{eventType: eventType, reason: reason, message: message})
+	}
+
+	previous := state
+	stepWeights := canary.GetAnalysis().GetStepWeights()
+	now := c.opts.Now()
+	switch {
+	case state == nil || state.SpecHash != hash:
+		state = &State{
+			Phase:        PhaseProgressing,
+			CanaryWeight: stepWeights[0],
+			LastAnalysis: now,
+			SpecHash:     hash,
+		}
+		state.Message = fmt.Sprintf("routing %d%% of the traffic to the canary", state.CanaryWeight)
+		record(corev1.EventTypeNormal, ReasonStarted, "started the analysis, "+state.Message)
+
+	case canary.GetPaused():
+		if state.Phase != PhasePaused {
+			state = copyState(state)
+			state.Phase = PhasePaused
+			state.Message = "paused the analysis"
+			record(corev1.EventTypeNormal, ReasonPaused, state.Message)
+		}
+
+	default:
+		state = copyState(state)
+		if state.Phase == PhasePaused {
+			state.Phase = PhaseProgressing
+			state.LastAnalysis = now
+			state.Message = "resumed the analysis"
+			record(corev1.EventTypeNormal, ReasonResumed, state.Message)
+			break
+		}
+		if now.Sub(state.LastAnalysis) < analysisInterval(canary) {
+			break
+		}
+		state.LastAnalysis = now
+
+		failures := c.analyze(ctx, canary)
+		switch {
+		case len(failures) > 0:
+			state.FailedChecks++
+			state.Message = "analysis failed: " + strings.Join(failures, "; ")
+			if state.FailedChecks >= failureThreshold(canary) {
+				state.Phase = PhaseFailed
+				state.CanaryWeight = 0
+				state.Message = fmt.Sprintf("rolled back after %d failed analyses, %s", state.FailedChecks, state.Message)
+				record(corev1.EventTypeWarning, ReasonRolledBack, state.Message)
+			} else {
+				record(corev1.EventTypeWarning, ReasonAnalysisFailed, state.Message)
+			}
+		case state.Step+1 < len(stepWeights):
+			state.Step++
+			state.CanaryWeight = stepWeights[state.Step]
+			state.Message = fmt.Sprintf("routing %d%% of the traffic to the canary", state.CanaryWeight)
+			record(corev1.EventTypeNormal, ReasonAdvanced, "analysis succeeded, "+state.Message)
+		default:
+			state.Phase = PhaseSucceeded
+			state.CanaryWeight = 100
+			state.Message = "promoted the canary, routing all the traffic to the canary"
+			record(corev1.EventTypeNormal, ReasonPromoted, state.Message)
+		}
+	}
+
+	if err := c.setWeights(ctx, canary, upstreamGroup, state.CanaryWeight); err != nil {
+		return err
+	}
+	if previous == nil || *previous != *state || status.GetState() != core.Status_Accepted {
+		if err := c.writeStatus(ctx, canary, core.Status_Accepted, "", state); err != nil {
+			return err
+		}
+	}
+	for _, e := range events {
+		c.opts.Events.Event(ctx, canary, e.eventType, e.reason, e.message)
+	}
+	return nil
+}
+
+// validate returns the upstream group of a valid canary
+func (c *Controller) validate(ctx context.Context, canary *v1.Canary) (*gloov1.UpstreamGroup, error) {
+	for field, ref := range map[string]*core.ResourceRef{
+		"upstreamGroup": canary.GetUpstreamGroup(),
+		"primary":       canary.GetPrimary(),
+		"canary":        canary.GetCanary(),
+	} {
+		if ref.GetName() == "" {
+			return nil, MissingRefError(field)
+		}
+	}
+
+	stepWeights := canary.GetAnalysis().GetStepWeights()
+	if len(stepWeights) == 0 {
+		return nil, NoStepWeightsError
+	}
+	for i, weight := range stepWeights {
+		if weight >= 100 || (i > 0 && weight <= stepWeights[i-1]) {
+			return nil, InvalidStepWeightsError(stepWeights)
+		}
+	}
+
+	ref := canary.GetUpstreamGroup()
+	upstreamGroup, err := c.opts.UpstreamGroups.Read(ref.GetNamespace(), ref.GetName(), clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		return nil, UpstreamGroupNotFoundError(ref, err)
+	}
+	for _, upstream := range []*core.ResourceRef{canary.GetPrimary(), canary.GetCanary()} {
+		if findDestination(upstreamGroup, upstream) == nil {
+			return nil, MissingDestinationError(ref, upstream)
+		}
+	}
+	return upstreamGroup, nil
+}
+
+// analyze returns a description of every metric of the canary that failed
+func (c *Controller) analyze(ctx context.Context, canary *v1.Canary) []string {
+	var failures []string
+	for _, metric := range canary.GetAnalysis().GetMetrics() {
+		value, err := c.opts.Metrics.Query(ctx, canary, metric)
+		switch {
+		case err != nil:
+			failures = append(failures, fmt.Sprintf("metric %s could not be queried: %v", metric.GetName(), err))
+		case metric.GetMin() != nil && value < metric.GetMin().GetValue():
+			failures = append(failures, fmt.Sprintf("metric %s is %v, lower than %v", metric.GetName(), value, metric.GetMin().GetValue()))
+		case metric.GetMax() != nil && value > metric.GetMax().GetValue():
+			failures = append(failures, fmt.Sprintf("metric %s is %v, greater than %v", metric.GetName(), value, metric.GetMax().GetValue()))
+		}
+	}
+	return failures
+}
+
+func (c *Controller) setWeights(ctx context.Context, canary *v1.Canary, upstreamGroup *gloov1.UpstreamGroup, canaryWeight uint32) error {
+	primary := findDestination(upstreamGroup, canary.GetPrimary())
+	canaryDestination := findDestination(upstreamGroup, canary.GetCanary())
+	if primary.GetWeight().GetValue() == 100-canaryWeight && canaryDestination.GetWeight().GetValue() == canaryWeight {
+		return nil
+	}
+	primary.Weight = &wrappers.UInt32Value{Value: 100 - canaryWeight}
+	canaryDestination.Weight = &wrappers.UInt32Value{Value: canaryWeight}
+	_, err := c.opts.UpstreamGroups.Write(upstreamGroup, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+	return err
+}
+
+func (c *Controller) writeStatus(ctx context.Context, canary *v1.Canary, state core.Status_State, reason string, canaryState *State) error {
+	status := &core.Status{
+		State:      state,
+		Reason:     reason,
+		ReportedBy: "canary-controller",
+	}
+	if canaryState != nil {
+		status.Details = canaryState.toStruct()
+	}
+	updated := resources.Clone(canary).(*v1.Canary)
+	statusutils.NewNamespacedStatusesClient(c.opts.StatusReporterNamespace).SetStatus(updated, status)
+	_, err := c.opts.Canaries.Write(updated, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+	return err
+}
+
+func findDestination(upstreamGroup *gloov1.UpstreamGroup, upstream *core.ResourceRef) *gloov1.WeightedDestination {
+	for _, destination := range upstreamGroup.GetDestinations() {
+		ref := destination.GetDestination().GetUpstream()
+		if ref.GetName() == upstream.GetName() && ref.GetNamespace() == upstream.GetNamespace() {
+			return destination
+		}
+	}
+	return nil
+}
+
+func failureThreshold(canary *v1.Canary) uint32 {
+	if threshold := canary.GetAnalysis().GetFailureThreshold(); threshold > 0 {
+		return threshold
+	}
+	return 1
+}
+
+func copyState(state *State) *State {
+	copied := *state
+	return &copied
+}
@@ -0,0 +1,282 @@
+package canary_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/bootstrap/leaderelector"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/canary"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+type recordedEvent struct {
+	eventType string
+	reason    string
+}
+
+type fakeEventRecorder struct {
+	events []recordedEvent
+}
+
+func (f *fakeEventRecorder) Event(_ context.Context, _ *v1.Canary, eventType, reason, _ string) {
+	f.events = append(f.events, recordedEvent{eventType: eventType, reason: reason})
+}
+
+func (f *fakeEventRecorder) reasons() []string {
+	var reasons []string
+	for _, e := range f.events {
+		reasons = append(reasons, e.reason)
+	}
+	return reasons
+}
+
+var _ = Describe("Controller", func() {
+
+	const (
+		namespace         = defaults.GlooSystem
+		reporterNamespace = defaults.GlooSystem
+	)
+
+	var (
+		ctx          context.Context
+		now          time.Time
+		canaryClient v1.CanaryClient
+		ugClient     gloov1.UpstreamGroupClient
+		metrics      *FakeMetricsProvider
+		events       *fakeEventRecorder
+		controller   *Controller
+	)
+
+	ref := func(name string) *core.ResourceRef {
+		return &core.ResourceRef{Name: name, Namespace: namespace}
+	}
+
+	destination := func(upstream string, weight uint32) *gloov1.WeightedDestination {
+		return &gloov1.WeightedDestination{
+			Destination: &gloov1.Destination{
+				DestinationType: &gloov1.Destination_Upstream{Upstream: ref(upstream)},
+			},
+			Weight: &wrappers.UInt32Value{Value: weight},
+		}
+	}
+
+	readCanary := func() *v1.Canary {
+		canary, err := canaryClient.Read(namespace, "petstore", clients.ReadOpts{Ctx: ctx})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return canary
+	}
+
+	weights := func() map[string]uint32 {
+		ug, err := ugClient.Read(namespace, "petstore", clients.ReadOpts{Ctx: ctx})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		result := map[string]uint32{}
+		for _, d := range ug.GetDestinations() {
+			result[d.GetDestination().GetUpstream().GetName()] = d.GetWeight().GetValue()
+		}
+		return result
+	}
+
+	state := func() *State {
+		return StateFor(readCanary(), reporterNamespace)
+	}
+
+	sync := func(elapsed time.Duration) {
+		now = now.Add(elapsed)
+		ExpectWithOffset(1, controller.Sync(ctx)).NotTo(HaveOccurred())
+	}
+
+	updateCanary := func(update func(canary *v1.Canary)) {
+		canary := readCanary()
+		update(canary)
+		_, err := canaryClient.Write(canary, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		canaryClient, err = v1.NewCanaryClient(ctx, &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		ugClient, err = gloov1.NewUpstreamGroupClient(ctx, &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = ugClient.Write(&gloov1.UpstreamGroup{
+			Metadata: &core.Metadata{Name: "petstore", Namespace: namespace},
+			Destinations: []*gloov1.WeightedDestination{
+				destination("petstore-v1", 100),
+				destination("petstore-v2", 0),
+				destination("petstore-mirror", 5),
+			},
+		}, clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = canaryClient.Write(&v1.Canary{
+			Metadata:      &core.Metadata{Name: "petstore", Namespace: namespace},
+			UpstreamGroup: ref("petstore"),
+			Primary:       ref("petstore-v1"),
+			Canary:        ref("petstore-v2"),
+			Analysis: &v1.CanaryAnalysis{
+				Interval:         &duration.Duration{Seconds: 60},
+				StepWeights:      []uint32{10, 50},
+				FailureThreshold: 2,
+				Metrics: []*v1.CanaryMetric{{
+					Name: "success-rate",
+					Min:  &wrappers.DoubleValue{Value: 99},
+				}},
+			},
+		}, clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		metrics = NewFakeMetricsProvider()
+		events = &fakeEventRecorder{}
+		controller = NewController(Opts{
+			WatchNamespaces:         []string{namespace},
+			StatusReporterNamespace: reporterNamespace,
+			Canaries:                canaryClient,
+			UpstreamGroups:          ugClient,
+			Metrics:                 metrics,
+			Events:                  events,
+			Now:                     func() time.Time { return now },
+		})
+
+		sync(0)
+	})
+
+	It("starts the analysis by routing the first step weight to the canary", func() {
+		Expect(weights()).To(Equal(map[string]uint32{"petstore-v1": 90, "petstore-v2": 10, "petstore-mirror": 5}))
+		Expect(state().Phase).To(Equal(PhaseProgressing))
+		Expect(state().CanaryWeight).To(BeEquivalentTo(10))
+		Expect(readCanary().GetNamespacedStatuses().GetStatuses()[reporterNamespace].GetState()).To(Equal(core.Status_Accepted))
+		Expect(events.reasons()).To(Equal([]string{ReasonStarted}))
+	})
+
+	It("leaves canaries to the leader", func() {
+		metrics.SetValue("success-rate", 100)
+		follower := NewController(Opts{
+			WatchNamespaces:         []string{namespace},
+			StatusReporterNamespace: reporterNamespace,
+			Canaries:                canaryClient,
+			UpstreamGroups:          ugClient,
+			Metrics:                 metrics,
+			Events:                  events,
+			Identity:                leaderelector.NewIdentity(make(chan struct{})),
+			Now:                     func() time.Time { return now.Add(time.Minute) },
+		})
+
+		Expect(follower.Sync(ctx)).To(Succeed())
+		Expect(weights()["petstore-v2"]).To(BeEquivalentTo(10))
+		Expect(state().Step).To(Equal(0))
+		Expect(events.reasons()).To(Equal([]string{ReasonStarted}))
+	})
+
+	It("analyzes the canary once per interval", func() {
+		sync(30 * time.Second)
+		Expect(weights()["petstore-v2"]).To(BeEquivalentTo(10))
+		Expect(events.reasons()).To(Equal([]string{ReasonStarted}))
+	})
+
+	It("advances and promotes the canary while the analysis succeeds", func() {
+		metrics.SetValue("success-rate", 100)
+
+		sync(time.Minute)
+		Expect(weights()).To(Equal(map[string]uint32{"petstore-v1": 50, "petstore-v2": 50, "petstore-mirror": 5}))
+		Expect(state().Step).To(Equal(1))
+
+		sync(time.Minute)
+		Expect(weights()).To(Equal(map[string]uint32{"petstore-v1": 0, "petstore-v2": 100, "petstore-mirror": 5}))
+		Expect(state().Phase).To(Equal(PhaseSucceeded))
+		Expect(events.reasons()).To(Equal([]string{ReasonStarted, ReasonAdvanced, ReasonPromoted}))
+
+		By("leaving the upstream group alone once promoted")
+		ug, err := ugClient.Read(namespace, "petstore", clients.ReadOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		ug.Destinations = ug.GetDestinations()[1:]
+		_, err = ugClient.Write(ug, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+		sync(time.Minute)
+		Expect(weights()).To(Equal(map[string]uint32{"petstore-v2": 100, "petstore-mirror": 5}))
+		Expect(events.reasons()).To(HaveLen(3))
+	})
+
+	It("rolls back the canary once the failure threshold is reached", func() {
+		metrics.SetValue("success-rate", 90)
+
+		sync(time.Minute)
+		Expect(weights()["petstore-v2"]).To(BeEquivalentTo(10))
+		Expect(state().FailedChecks).To(BeEquivalentTo(1))
+		Expect(state().Message).To(ContainSubstring("metric success-rate is 90, lower than 99"))
+
+		metrics.SetError("success-rate", eris.New("connection refused"))
+		sync(time.Minute)
+		Expect(weights()).To(Equal(map[string]uint32{"petstore-v1": 100, "petstore-v2": 0, "petstore-mirror": 5}))
+		Expect(state().Phase).To(Equal(PhaseFailed))
+		Expect(state().Message).To(ContainSubstring("connection refused"))
+		Expect(events.events).To(Equal([]recordedEvent{
+			{eventType: "Normal", reason: ReasonStarted},
+			{eventType: "Warning", reason: ReasonAnalysisFailed},
+			{eventType: "Warning", reason: ReasonRolledBack},
+		}))
+	})
+
+	It("holds the weights while the canary is paused", func() {
+		metrics.SetValue("success-rate", 100)
+		updateCanary(func(canary *v1.Canary) { canary.Paused = true })
+
+		sync(time.Minute)
+		sync(time.Minute)
+		Expect(weights()["petstore-v2"]).To(BeEquivalentTo(10))
+		Expect(state().Phase).To(Equal(PhasePaused))
+
+		updateCanary(func(canary *v1.Canary) { canary.Paused = false })
+		sync(time.Minute)
+		Expect(state().Phase).To(Equal(PhaseProgressing))
+		Expect(weights()["petstore-v2"]).To(BeEquivalentTo(10))
+
+		sync(time.Minute)
+		Expect(weights()["petstore-v2"]).To(BeEquivalentTo(50))
+		Expect(events.reasons()).To(Equal([]string{ReasonStarted, ReasonPaused, ReasonResumed, ReasonAdvanced}))
+	})
+
+	It("restarts the analysis when the spec of a finished canary changes", func() {
+		metrics.SetValue("success-rate", 0)
+		sync(time.Minute)
+		sync(time.Minute)
+		Expect(state().Phase).To(Equal(PhaseFailed))
+
+		updateCanary(func(canary *v1.Canary) { canary.GetAnalysis().StepWeights = []uint32{20} })
+		sync(0)
+		Expect(state().Phase).To(Equal(PhaseProgressing))
+		Expect(state().FailedChecks).To(BeZero())
+		Expect(weights()["petstore-v2"]).To(BeEquivalentTo(20))
+	})
+
+	DescribeTable("rejects invalid canaries",
+		func(update func(canary *v1.Canary), reason string) {
+			updateCanary(update)
+			sync(0)
+			sync(0)
+
+			status := readCanary().GetNamespacedStatuses().GetStatuses()[reporterNamespace]
+			Expect(status.GetState()).To(Equal(core.Status_Rejected))
+			Expect(status.GetReason()).To(ContainSubstring(reason))
+			Expect(events.reasons()).To(Equal([]string{ReasonStarted, ReasonInvalid}))
+		},
+		Entry("missing primary", func(canary *v1.Canary) { canary.Primary = nil }, MissingRefError("primary").Error()),
+		Entry("decreasing step weights", func(canary *v1.Canary) { canary.GetAnalysis().StepWeights = []uint32{50, 10} }, "strictly increasing"),
+		Entry("missing destination", func(canary *v1.Canary) { canary.Canary = ref("petstore-v3") }, "has no destination for upstream gloo-system.petstore-v3"),
+		Entry("missing upstream group", func(canary *v1.Canary) { canary.UpstreamGroup = ref("missing") }, "reading upstream group gloo-system.missing"),
+	)
+})
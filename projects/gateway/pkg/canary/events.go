@@ -0,0 +1,70 @@
+package canary
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// EventRecorder records the transitions of canaries.
+type EventRecorder interface {
+	Event(ctx context.Context, canary *v1.Canary, eventType, reason, message string)
+}
+
+// NewKubeEventRecorder records transitions as Kubernetes events involving the Canary resource.
+func NewKubeEventRecorder(kubeClient kubernetes.Interface, component string) EventRecorder {
+	return &kubeEventRecorder{kubeClient: kubeClient, component: component}
+}
+
+type kubeEventRecorder struct {
+	kubeClient kubernetes.Interface
+	component  string
+}
+
+func (k *kubeEventRecorder) Event(ctx context.Context, canary *v1.Canary, eventType, reason, message string) {
+	now := metav1.NewTime(time.Now())
+	metadata := canary.GetMetadata()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: metadata.GetName() + ".",
+			Namespace:    metadata.GetNamespace(),
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      v1.CanaryGVK.GroupVersion().String(),
+			Kind:            v1.CanaryGVK.Kind,
+			Name:            metadata.GetName(),
+			Namespace:       metadata.GetNamespace(),
+			ResourceVersion: metadata.GetResourceVersion(),
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Source:         corev1.EventSource{Component: k.component},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	if _, err := k.kubeClient.CoreV1().Events(metadata.GetNamespace()).Create(ctx, event, metav1.CreateOptions{}); err != nil {
+		contextutils.LoggerFrom(ctx).Warnw("failed to record canary event", zap.String("reason", reason), zap.Error(err))
+	}
+}
+
+// NewLoggingEventRecorder logs transitions, for environments without Kubernetes events.
+func NewLoggingEventRecorder() EventRecorder {
+	return loggingEventRecorder{}
+}
+
+type loggingEventRecorder struct{}
+
+func (loggingEventRecorder) Event(ctx context.Context, canary *v1.Canary, eventType, reason, message string) {
+	contextutils.LoggerFrom(ctx).Infow(message,
+		zap.String("canary", canary.GetMetadata().Ref().String()),
+		zap.String("type", eventType),
+		zap.String("reason", reason))
+}
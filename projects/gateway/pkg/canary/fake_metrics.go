@@ -0,0 +1,54 @@
+package canary
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+)
+
+var _ MetricsProvider = new(FakeMetricsProvider)
+
+// FakeMetricsProvider serves metric values set by the caller, so that canaries can be driven through their whole
+// lifecycle without a metrics backend.
+type FakeMetricsProvider struct {
+	lock   sync.Mutex
+	values map[string]float64
+	errors map[string]error
+}
+
+func NewFakeMetricsProvider() *FakeMetricsProvider {
+	return &FakeMetricsProvider{
+		values: map[string]float64{},
+		errors: map[string]error{},
+	}
+}
+
+// SetValue sets the value returned for the metrics with the given name.
+func (f *FakeMetricsProvider) SetValue(metricName string, value float64) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.values[metricName] = value
+	delete(f.errors, metricName)
+}
+
+// SetError makes queries for the metrics with the given name fail.
+func (f *FakeMetricsProvider) SetError(metricName string, err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.errors[metricName] = err
+}
+
+func (f *FakeMetricsProvider) Query(_ context.Context, _ *v1.Canary, metric *v1.CanaryMetric) (float64, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if err, ok := f.errors[metric.GetName()]; ok {
+		return 0, err
+	}
+	value, ok := f.values[metric.GetName()]
+	if !ok {
+		return 0, eris.Errorf("no value set for metric %s", metric.GetName())
+	}
+	return value, nil
+}
@@ -0,0 +1,254 @@
+package canary
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+var (
+	MetricsProviderNotConfiguredError = func(provider, setting string) error {
+		return eris.Errorf("%s metrics require settings.gateway.canary.%s to be set", provider, setting)
+	}
+
+	UnknownMetricProviderError = func(name string) error {
+		return eris.Errorf("metric %s does not define a provider", name)
+	}
+
+	InvalidQueryError = func(err error) error {
+		return eris.Wrap(err, "invalid prometheus query template")
+	}
+
+	UnexpectedQueryResultError = func(reason string) error {
+		return eris.Errorf("prometheus query must return a single sample: %s", reason)
+	}
+)
+
+// MetricsProvider returns the current value of a metric of a canary.
+type MetricsProvider interface {
+	Query(ctx context.Context, canary *v1.Canary, metric *v1.CanaryMetric) (float64, error)
+}
+
+// NewMetricsProvider returns a provider evaluating the metrics of canaries with the providers configured in the settings.
+func NewMetricsProvider(opts *gloov1.GatewayOptions_CanaryOptions, httpClient *http.Client) MetricsProvider {
+	return &metricsProvider{
+		prometheus: &prometheusProvider{url: opts.GetPrometheusUrl(), httpClient: httpClient},
+		envoy:      &envoyStatsProvider{url: opts.GetEnvoyStatsUrl(), httpClient: httpClient, previous: map[string]clusterStats{}},
+	}
+}
+
+type metricsProvider struct {
+	prometheus *prometheusProvider
+	envoy      *envoyStatsProvider
+}
+
+func (m *metricsProvider) Query(ctx context.Context, canary *v1.Canary, metric *v1.CanaryMetric) (float64, error) {
+	switch provider := metric.GetProvider().(type) {
+	case *v1.CanaryMetric_Prometheus:
+		return m.prometheus.query(ctx, canary, provider.Prometheus)
+	case *v1.CanaryMetric_Envoy:
+		return m.envoy.query(ctx, canary, metric.GetName(), provider.Envoy)
+	default:
+		return 0, UnknownMetricProviderError(metric.GetName())
+	}
+}
+
+type prometheusProvider struct {
+	url        string
+	httpClient *http.Client
+}
+
+// queryContext holds the values the prometheus query templates can reference
+type queryContext struct {
+	Cluster   string
+	Namespace string
+	Name      string
+	Interval  string
+}
+
+func (p *prometheusProvider) query(ctx context.Context, canary *v1.Canary, query *v1.CanaryMetric_PrometheusQuery) (float64, error) {
+	if p.url == "" {
+		return 0, MetricsProviderNotConfiguredError("prometheus", "prometheusUrl")
+	}
+
+	tmpl, err := template.New("query").Parse(query.GetQuery())
+	if err != nil {
+		return 0, InvalidQueryError(err)
+	}
+	var rendered bytes.Buffer
+	err = tmpl.Execute(&rendered, queryContext{
+		Cluster:   translator.UpstreamToClusterName(canary.GetCanary()),
+		Namespace: canary.GetCanary().GetNamespace(),
+		Name:      canary.GetCanary().GetName(),
+		Interval:  fmt.Sprintf("%ds", int64(analysisInterval(canary).Seconds())),
+	})
+	if err != nil {
+		return 0, InvalidQueryError(err)
+	}
+
+	target := strings.TrimSuffix(p.url, "/") + "/api/v1/query?" + url.Values{"query": {rendered.String()}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			ResultType string          `json:"resultType"`
+			Result     json.RawMessage `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, eris.Wrapf(err, "decoding prometheus response with status %d", resp.StatusCode)
+	}
+	if result.Status != "success" {
+		return 0, eris.Errorf("prometheus query failed: %s", result.Error)
+	}
+
+	// samples are encoded as [<timestamp>, "<value>"]
+	var sample []interface{}
+	switch result.Data.ResultType {
+	case "scalar":
+		if err := json.Unmarshal(result.Data.Result, &sample); err != nil {
+			return 0, err
+		}
+	case "vector":
+		var vector []struct {
+			Value []interface{} `json:"value"`
+		}
+		if err := json.Unmarshal(result.Data.Result, &vector); err != nil {
+			return 0, err
+		}
+		if len(vector) != 1 {
+			return 0, UnexpectedQueryResultError(fmt.Sprintf("got %d samples", len(vector)))
+		}
+		sample = vector[0].Value
+	default:
+		return 0, UnexpectedQueryResultError(fmt.Sprintf("got a %s", result.Data.ResultType))
+	}
+	if len(sample) != 2 {
+		return 0, UnexpectedQueryResultError("malformed sample")
+	}
+	value, ok := sample[1].(string)
+	if !ok {
+		return 0, UnexpectedQueryResultError("malformed sample")
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+type envoyStatsProvider struct {
+	url        string
+	httpClient *http.Client
+
+	lock sync.Mutex
+	// the stats read by the previous analysis of each canary metric
+	previous map[string]clusterStats
+}
+
+type clusterStats struct {
+	completed float64
+	errors    float64
+}
+
+func (e *envoyStatsProvider) query(ctx context.Context, canary *v1.Canary, metricName string, stat *v1.CanaryMetric_EnvoyStat) (float64, error) {
+	if e.url == "" {
+		return 0, MetricsProviderNotConfiguredError("envoy", "envoyStatsUrl")
+	}
+
+	cluster := translator.UpstreamToClusterName(canary.GetCanary())
+	current, err := e.readClusterStats(ctx, cluster)
+	if err != nil {
+		return 0, err
+	}
+
+	e.lock.Lock()
+	key := fmt.Sprintf("%s.%s/%s", canary.GetMetadata().GetNamespace(), canary.GetMetadata().GetName(), metricName)
+	previous := e.previous[key]
+	if current.completed < previous.completed {
+		// the counters were reset, e.g. because Envoy restarted
+		previous = clusterStats{}
+	}
+	e.previous[key] = current
+	e.lock.Unlock()
+
+	completed := current.completed - previous.completed
+	switch stat.GetMetric() {
+	case v1.CanaryMetric_EnvoyStat_REQUEST_COUNT:
+		return completed, nil
+	default:
+		if completed == 0 {
+			return 100, nil
+		}
+		return 100 * (completed - (current.errors - previous.errors)) / completed, nil
+	}
+}
+
+func (e *envoyStatsProvider) readClusterStats(ctx context.Context, cluster string) (clusterStats, error) {
+	prefix := "cluster." + cluster + "."
+	target := strings.TrimSuffix(e.url, "/") + "/stats?" + url.Values{"filter": {"^" + regexp.QuoteMeta(prefix) + "upstream_rq_"}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return clusterStats{}, err
+	}
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return clusterStats{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return clusterStats{}, eris.Errorf("envoy stats responded with status %d", resp.StatusCode)
+	}
+
+	// stats are formatted as `<name>: <value>`, one per line
+	var stats clusterStats
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		name, value, ok := strings.Cut(scanner.Text(), ": ")
+		if !ok {
+			continue
+		}
+		var field *float64
+		switch strings.TrimPrefix(name, prefix) {
+		case "upstream_rq_completed":
+			field = &stats.completed
+		case "upstream_rq_5xx":
+			field = &stats.errors
+		default:
+			continue
+		}
+		if *field, err = strconv.ParseFloat(value, 64); err != nil {
+			return clusterStats{}, eris.Wrapf(err, "parsing envoy stat %s", name)
+		}
+	}
+	return stats, scanner.Err()
+}
+
+// analysisInterval returns the configured interval between two analyses of a canary
+func analysisInterval(canary *v1.Canary) time.Duration {
+	if interval := canary.GetAnalysis().GetInterval(); interval != nil {
+		return interval.AsDuration()
+	}
+	return DefaultInterval
+}
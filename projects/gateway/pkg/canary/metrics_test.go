@@ -0,0 +1,136 @@
+package canary_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/canary"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("MetricsProvider", func() {
+
+	var (
+		ctx    context.Context
+		canary *v1.Canary
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		canary = &v1.Canary{
+			Metadata: &core.Metadata{Name: "petstore", Namespace: "gloo-system"},
+			Canary:   &core.ResourceRef{Name: "petstore-v2", Namespace: "gloo-system"},
+			Analysis: &v1.CanaryAnalysis{Interval: &duration.Duration{Seconds: 30}},
+		}
+	})
+
+	Context("prometheus", func() {
+
+		var (
+			server *httptest.Server
+			query  string
+			result string
+		)
+
+		metric := &v1.CanaryMetric{
+			Name: "latency",
+			Provider: &v1.CanaryMetric_Prometheus{Prometheus: &v1.CanaryMetric_PrometheusQuery{
+				Query: `max(rate(envoy_cluster_upstream_rq_time_sum{envoy_cluster_name="{{ .Cluster }}"}[{{ .Interval }}]))`,
+			}},
+		}
+
+		BeforeEach(func() {
+			result = `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000.1,"12.5"]}]}}`
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/api/v1/query"))
+				query = r.URL.Query().Get("query")
+				_, _ = fmt.Fprint(w, result)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("renders the query and returns its single sample", func() {
+			provider := NewMetricsProvider(&gloov1.GatewayOptions_CanaryOptions{PrometheusUrl: server.URL}, http.DefaultClient)
+			value, err := provider.Query(ctx, canary, metric)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal(12.5))
+			Expect(query).To(Equal(`max(rate(envoy_cluster_upstream_rq_time_sum{envoy_cluster_name="petstore-v2_gloo-system"}[30s]))`))
+		})
+
+		It("returns an error when the query does not return a single sample", func() {
+			result = `{"status":"success","data":{"resultType":"vector","result":[]}}`
+			provider := NewMetricsProvider(&gloov1.GatewayOptions_CanaryOptions{PrometheusUrl: server.URL}, http.DefaultClient)
+			_, err := provider.Query(ctx, canary, metric)
+			Expect(err).To(MatchError(UnexpectedQueryResultError("got 0 samples")))
+		})
+
+		It("requires the prometheus url", func() {
+			provider := NewMetricsProvider(&gloov1.GatewayOptions_CanaryOptions{}, http.DefaultClient)
+			_, err := provider.Query(ctx, canary, metric)
+			Expect(err).To(MatchError(MetricsProviderNotConfiguredError("prometheus", "prometheusUrl")))
+		})
+	})
+
+	Context("envoy", func() {
+
+		var (
+			server    *httptest.Server
+			completed int
+			errors    int
+		)
+
+		envoyMetric := func(name string, metric v1.CanaryMetric_EnvoyStat_Metric) *v1.CanaryMetric {
+			return &v1.CanaryMetric{
+				Name:     name,
+				Provider: &v1.CanaryMetric_Envoy{Envoy: &v1.CanaryMetric_EnvoyStat{Metric: metric}},
+			}
+		}
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/stats"))
+				Expect(r.URL.Query().Get("filter")).To(Equal(`^cluster\.petstore-v2_gloo-system\.upstream_rq_`))
+				_, _ = fmt.Fprintf(w, "cluster.petstore-v2_gloo-system.upstream_rq_2xx: %d\n", completed-errors)
+				_, _ = fmt.Fprintf(w, "cluster.petstore-v2_gloo-system.upstream_rq_5xx: %d\n", errors)
+				_, _ = fmt.Fprintf(w, "cluster.petstore-v2_gloo-system.upstream_rq_completed: %d\n", completed)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("computes the success rate and request count since the previous analysis", func() {
+			provider := NewMetricsProvider(&gloov1.GatewayOptions_CanaryOptions{EnvoyStatsUrl: server.URL}, http.DefaultClient)
+			successRate := envoyMetric("success-rate", v1.CanaryMetric_EnvoyStat_SUCCESS_RATE)
+			requests := envoyMetric("requests", v1.CanaryMetric_EnvoyStat_REQUEST_COUNT)
+
+			completed, errors = 100, 10
+			Expect(provider.Query(ctx, canary, successRate)).To(Equal(90.0))
+			Expect(provider.Query(ctx, canary, requests)).To(Equal(100.0))
+
+			completed, errors = 150, 11
+			Expect(provider.Query(ctx, canary, successRate)).To(Equal(98.0))
+			Expect(provider.Query(ctx, canary, requests)).To(Equal(50.0))
+
+			By("reporting full success when no request was completed")
+			Expect(provider.Query(ctx, canary, successRate)).To(Equal(100.0))
+		})
+
+		It("requires the envoy stats url", func() {
+			provider := NewMetricsProvider(&gloov1.GatewayOptions_CanaryOptions{}, http.DefaultClient)
+			_, err := provider.Query(ctx, canary, envoyMetric("requests", v1.CanaryMetric_EnvoyStat_REQUEST_COUNT))
+			Expect(err).To(MatchError(MetricsProviderNotConfiguredError("envoy", "envoyStatsUrl")))
+		})
+	})
+})
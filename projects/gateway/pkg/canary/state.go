@@ -0,0 +1,112 @@
+package canary
+
+import (
+	"strconv"
+	"time"
+
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+)
+
+// Phase is the stage of the analysis of a canary.
+type Phase string
+
+const (
+	// PhaseProgressing canaries are analyzed once per interval and advance through their step weights
+	PhaseProgressing Phase = "Progressing"
+	// PhasePaused canaries keep their current weights until they are resumed
+	PhasePaused Phase = "Paused"
+	// PhaseSucceeded canaries were promoted and receive all the traffic
+	PhaseSucceeded Phase = "Succeeded"
+	// PhaseFailed canaries were rolled back and the primary receives all the traffic
+	PhaseFailed Phase = "Failed"
+)
+
+// keys of the status details the state is persisted in
+const (
+	phaseKey        = "phase"
+	canaryWeightKey = "canaryWeight"
+	stepKey         = "step"
+	failedChecksKey = "failedChecks"
+	lastAnalysisKey = "lastAnalysis"
+	messageKey      = "message"
+	specHashKey     = "specHash"
+)
+
+// State is the progress of the analysis of a canary, persisted in the details of its status.
+type State struct {
+	Phase        Phase
+	CanaryWeight uint32
+	// Step is the index of the current step weight
+	Step         int
+	FailedChecks uint32
+	LastAnalysis time.Time
+	// Message describes the last transition or the failures of the last analysis
+	Message string
+	// SpecHash identifies the spec the analysis was started for, so that changing the spec restarts the analysis
+	SpecHash string
+}
+
+// Terminal returns true once the canary was promoted or rolled back.
+func (s *State) Terminal() bool {
+	return s.Phase == PhaseSucceeded || s.Phase == PhaseFailed
+}
+
+func (s *State) toStruct() *_struct.Struct {
+	return &_struct.Struct{
+		Fields: map[string]*_struct.Value{
+			phaseKey:        stringValue(string(s.Phase)),
+			canaryWeightKey: numberValue(float64(s.CanaryWeight)),
+			stepKey:         numberValue(float64(s.Step)),
+			failedChecksKey: numberValue(float64(s.FailedChecks)),
+			lastAnalysisKey: stringValue(s.LastAnalysis.UTC().Format(time.RFC3339)),
+			messageKey:      stringValue(s.Message),
+			specHashKey:     stringValue(s.SpecHash),
+		},
+	}
+}
+
+// StateFor returns the state of the analysis of a canary reported in the status written by the controller running
+// in the given namespace, or nil when the analysis has not started.
+func StateFor(canary *v1.Canary, statusReporterNamespace string) *State {
+	return stateFromStruct(canary.GetNamespacedStatuses().GetStatuses()[statusReporterNamespace].GetDetails())
+}
+
+// stateFromStruct returns nil when the details do not hold the state of an analysis
+func stateFromStruct(details *_struct.Struct) *State {
+	fields := details.GetFields()
+	if fields[phaseKey].GetStringValue() == "" {
+		return nil
+	}
+	lastAnalysis, _ := time.Parse(time.RFC3339, fields[lastAnalysisKey].GetStringValue())
+	return &State{
+		Phase:        Phase(fields[phaseKey].GetStringValue()),
+		CanaryWeight: uint32(fields[canaryWeightKey].GetNumberValue()),
+		Step:         int(fields[stepKey].GetNumberValue()),
+		FailedChecks: uint32(fields[failedChecksKey].GetNumberValue()),
+		LastAnalysis: lastAnalysis,
+		Message:      fields[messageKey].GetStringValue(),
+		SpecHash:     fields[specHashKey].GetStringValue(),
+	}
+}
+
+// specHash hashes the parts of the spec that define an analysis
+func specHash(canary *v1.Canary) (string, error) {
+	spec := canary.Clone().(*v1.Canary)
+	spec.Metadata = nil
+	spec.NamespacedStatuses = nil
+	spec.Paused = false
+	hash, err := spec.Hash(nil)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatUint(hash, 16), nil
+}
+
+func stringValue(s string) *_struct.Value {
+	return &_struct.Value{Kind: &_struct.Value_StringValue{StringValue: s}}
+}
+
+func numberValue(n float64) *_struct.Value {
+	return &_struct.Value{Kind: &_struct.Value_NumberValue{NumberValue: n}}
+}
@@ -157,6 +157,8 @@ func getListenerLevelErrors(listenerReport *validation.ListenerReport) []error {
 		for _, hostReport := range tcpListener.GetTcpHostReports() {
 			listenerErrs = append(listenerErrs, validationutils.GetTcpHostErr(hostReport)...)
 		}
+	case *validation.ListenerReport_UdpListenerReport:
+		listenerErrs = append(listenerErrs, validationutils.GetUdpListenerErr(listenerType.UdpListenerReport)...)
 	case *validation.ListenerReport_HybridListenerReport:
 		for _, matchedListenerReport := range listenerType.HybridListenerReport.GetMatchedListenerReports() {
 			switch matchedListenerType := matchedListenerReport.GetListenerReportType().(type) {
@@ -59,6 +59,7 @@ func NewDefaultTranslator(opts Opts) *GwTranslator {
 	//	- tcpTranslator produces a TcpListener
 	//	- hybridTranslator produces a HybridListener
 	//	- aggregateTranslator produces an AggregateListener
+	//	- udpTranslator produces a UdpListener
 	httpTranslator := &HttpTranslator{
 		VirtualServiceTranslator: virtualServiceTranslator,
 	}
@@ -72,11 +73,14 @@ func NewDefaultTranslator(opts Opts) *GwTranslator {
 		TcpTranslator:            tcpTranslator,
 	}
 
+	udpTranslator := &UdpTranslator{}
+
 	translatorsByName := map[string]ListenerTranslator{
 		HttpTranslatorName:      httpTranslator,
 		TcpTranslatorName:       tcpTranslator,
 		HybridTranslatorName:    hybridTranslator,
 		AggregateTranslatorName: aggregateTranslator,
+		UdpTranslatorName:       udpTranslator,
 	}
 
 	return &GwTranslator{
@@ -156,6 +160,9 @@ func (t *GwTranslator) getListenerTranslatorForGateway(gateway *v1.Gateway) List
 		} else {
 			listenerTranslatorImpl = t.listenerTranslators[HybridTranslatorName]
 		}
+
+	case *v1.Gateway_UdpGateway:
+		listenerTranslatorImpl = t.listenerTranslators[UdpTranslatorName]
 	}
 
 	if listenerTranslatorImpl == nil {
@@ -197,6 +204,10 @@ func makeListener(gateway *v1.Gateway) *gloov1.Listener {
 }
 
 func ListenerName(gateway *v1.Gateway) string {
+	if gateway.GetUdpGateway() != nil {
+		// a udp gateway may share its bind address with a tcp gateway
+		return fmt.Sprintf("listener-%s-%d-udp", gateway.GetBindAddress(), gateway.GetBindPort())
+	}
 	return fmt.Sprintf("listener-%s-%d", gateway.GetBindAddress(), gateway.GetBindPort())
 }
 
@@ -211,6 +222,10 @@ func validateGateways(gateways v1.GatewayList, virtualServices v1.VirtualService
 	// they are invalid.
 	for _, gw := range gateways {
 		bindAddress := fmt.Sprintf("%s:%d", gw.GetBindAddress(), gw.GetBindPort())
+		if gw.GetUdpGateway() != nil {
+			// a udp gateway may share its bind address with a tcp gateway
+			bindAddress += "/udp"
+		}
 		bindAddresses[bindAddress] = append(bindAddresses[bindAddress], gw)
 
 		var gatewayVirtualServices []*core.ResourceRef
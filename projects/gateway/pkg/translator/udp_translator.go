@@ -0,0 +1,35 @@
+package translator
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+var _ ListenerTranslator = new(UdpTranslator)
+
+const UdpTranslatorName = "udp"
+
+type UdpTranslator struct{}
+
+func (t *UdpTranslator) ComputeListener(params Params, proxyName string, gateway *v1.Gateway) *gloov1.Listener {
+	udpGateway := gateway.GetUdpGateway()
+	if udpGateway == nil {
+		return nil
+	}
+
+	listener := makeListener(gateway)
+	listener.ListenerType = &gloov1.Listener_UdpListener{
+		UdpListener: &gloov1.UdpListener{
+			Upstream:    udpGateway.GetUpstream(),
+			IdleTimeout: udpGateway.GetIdleTimeout(),
+			HashPolicy:  udpGateway.GetHashPolicy(),
+		},
+	}
+
+	if err := appendSource(listener, gateway); err != nil {
+		// should never happen
+		params.reports.AddError(gateway, err)
+	}
+
+	return listener
+}
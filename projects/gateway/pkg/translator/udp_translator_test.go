@@ -0,0 +1,85 @@
+package translator_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+)
+
+var _ = Describe("Udp Translator", func() {
+
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		params     Params
+		translator *UdpTranslator
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		params = NewTranslatorParams(ctx, &gloov1snap.ApiSnapshot{}, make(reporter.ResourceReports))
+		translator = &UdpTranslator{}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("Udp Gateway", func() {
+
+		It("translates the upstream, idle timeout and hash policy", func() {
+			hashPolicy := &gloov1.UdpListener_HashPolicy{
+				PolicySpecifier: &gloov1.UdpListener_HashPolicy_Key{Key: "session"},
+			}
+			gw := &v1.Gateway{
+				Metadata: &core.Metadata{Namespace: ns, Name: "name"},
+				GatewayType: &v1.Gateway_UdpGateway{
+					UdpGateway: &v1.UdpGateway{
+						Upstream:    &core.ResourceRef{Namespace: ns, Name: "dns"},
+						IdleTimeout: prototime.DurationToProto(10 * time.Second),
+						HashPolicy:  hashPolicy,
+					},
+				},
+				BindPort: 53,
+			}
+
+			listener := translator.ComputeListener(params, defaults.GatewayProxyName, gw)
+			Expect(listener).NotTo(BeNil())
+			Expect(listener.GetBindPort()).To(BeEquivalentTo(53))
+
+			udpListener := listener.ListenerType.(*gloov1.Listener_UdpListener).UdpListener
+			Expect(udpListener.GetUpstream()).To(Equal(&core.ResourceRef{Namespace: ns, Name: "dns"}))
+			Expect(udpListener.GetIdleTimeout().AsDuration()).To(Equal(10 * time.Second))
+			Expect(udpListener.GetHashPolicy()).To(Equal(hashPolicy))
+		})
+
+	})
+
+	Context("Non-Udp Gateway", func() {
+
+		It("returns nil", func() {
+			gw := &v1.Gateway{
+				Metadata:    &core.Metadata{Namespace: ns, Name: "name"},
+				GatewayType: &v1.Gateway_TcpGateway{},
+				BindPort:    2,
+			}
+
+			listener := translator.ComputeListener(params, defaults.GatewayProxyName, gw)
+			Expect(listener).To(BeNil())
+		})
+
+	})
+
+})
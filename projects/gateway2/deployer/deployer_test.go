@@ -582,6 +582,50 @@ var _ = Describe("Deployer", func() {
 					return nil
 				},
 			}),
+			Entry("UDP ports for http3", &input{
+				dInputs: defaultDeployerInputs(),
+				gw: &api.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "foo",
+						Namespace:   defaultNamespace,
+						UID:         "1235",
+						Annotations: map[string]string{wellknown.Http3AnnotationName: "true"},
+					},
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Gateway",
+						APIVersion: "gateway.solo.io/v1beta1",
+					},
+					Spec: api.GatewaySpec{
+						GatewayClassName: "gloo-gateway",
+						Listeners: []api.Listener{
+							{
+								Name:     "http",
+								Port:     80,
+								Protocol: api.HTTPProtocolType,
+							},
+							{
+								Name:     "https",
+								Port:     443,
+								Protocol: api.HTTPSProtocolType,
+							},
+						},
+					},
+				},
+				gwp: defaultGatewayParams(),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					svc := objs.findService(defaultNamespace, defaultServiceName)
+					Expect(svc).NotTo(BeNil())
+
+					Expect(svc.Spec.Ports).To(HaveLen(3))
+					port := svc.Spec.Ports[2]
+					Expect(port.Name).To(Equal("https-quic"))
+					Expect(port.Protocol).To(Equal(corev1.ProtocolUDP))
+					Expect(port.Port).To(Equal(int32(443)))
+					Expect(port.TargetPort.IntVal).To(Equal(int32(8443)))
+					return nil
+				},
+			}),
 			Entry("propagates version.Version to deployment", &input{
 				dInputs:        defaultDeployerInputs(),
 				gw:             defaultGateway(),
@@ -9,6 +9,7 @@ import (
 	"github.com/solo-io/gloo/projects/gateway2/extensions"
 	v1alpha1kube "github.com/solo-io/gloo/projects/gateway2/pkg/api/gateway.gloo.solo.io/v1alpha1/kube"
 	"github.com/solo-io/gloo/projects/gateway2/ports"
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
 	"golang.org/x/exp/slices"
 	api "sigs.k8s.io/gateway-api/apis/v1"
 )
@@ -46,9 +47,38 @@ func getPortsValues(gw *api.Gateway) []helmPort {
 			Protocol:   &protocol,
 		})
 	}
+	if gw.GetAnnotations()[wellknown.Http3AnnotationName] == "true" {
+		gwPorts = append(gwPorts, getQuicPortsValues(gw)...)
+	}
 	return gwPorts
 }
 
+// Extract the UDP ports of the QUIC listeners serving HTTP/3 for the HTTPS listeners of a Gateway.
+func getQuicPortsValues(gw *api.Gateway) []helmPort {
+	quicPorts := []helmPort{}
+	for _, l := range gw.Spec.Listeners {
+		if l.Protocol != api.HTTPSProtocolType {
+			continue
+		}
+		listenerPort := uint16(l.Port)
+		if slices.IndexFunc(quicPorts, func(p helmPort) bool { return *p.Port == listenerPort }) != -1 {
+			continue
+		}
+
+		targetPort := ports.TranslatePort(listenerPort)
+		portName := string(l.Name) + "-quic"
+		protocol := "UDP"
+
+		quicPorts = append(quicPorts, helmPort{
+			Port:       &listenerPort,
+			TargetPort: &targetPort,
+			Name:       &portName,
+			Protocol:   &protocol,
+		})
+	}
+	return quicPorts
+}
+
 // Convert autoscaling values from GatewayParameters into helm values to be used by the deployer.
 func getAutoscalingValues(autoscaling *v1alpha1kube.Autoscaling) *helmAutoscaling {
 	hpaConfig := autoscaling.GetHorizontalPodAutoscaler()
@@ -171,6 +171,7 @@ func (ml *mergedListeners) appendHttpsListener(
 		port:              gwv1.PortNumber(ports.TranslatePort(uint16(listener.Port))),
 		httpsFilterChains: []httpsFilterChain{mfc},
 		listenerReporter:  reporter,
+		listener:          listener,
 	})
 }
 
@@ -0,0 +1,53 @@
+package http3
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/projects/gateway2/translator/plugins"
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/http3"
+)
+
+var _ plugins.ListenerPlugin = &plugin{}
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+// ApplyListenerPlugin enables HTTP/3 on the listeners with HTTPS filter chains, if the Gateway is annotated with
+// wellknown.Http3AnnotationName.
+func (p *plugin) ApplyListenerPlugin(
+	ctx context.Context,
+	listenerCtx *plugins.ListenerContext,
+	outputListener *v1.Listener,
+) error {
+	if listenerCtx.Gateway.GetAnnotations()[wellknown.Http3AnnotationName] != "true" {
+		return nil
+	}
+	if !hasHttpsFilterChain(outputListener) {
+		return nil
+	}
+
+	if outputListener.GetOptions() == nil {
+		outputListener.Options = &v1.ListenerOptions{}
+	}
+	outputListener.GetOptions().Http3 = &http3.Http3{
+		Enabled: &wrappers.BoolValue{Value: true},
+		// the proxy binds a translated port, while clients reach the port of the Gateway listener
+		AdvertisedPort: &wrappers.UInt32Value{Value: uint32(listenerCtx.GwListener.Port)},
+	}
+	return nil
+}
+
+func hasHttpsFilterChain(listener *v1.Listener) bool {
+	for _, fc := range listener.GetAggregateListener().GetHttpFilterChains() {
+		if fc.GetMatcher().GetSslConfig() != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package http3_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gateway2/translator/plugins"
+	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/http3"
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+var _ = Describe("Http3Plugin", func() {
+
+	listenerCtx := func(annotations map[string]string) *plugins.ListenerContext {
+		return &plugins.ListenerContext{
+			Gateway: &gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gw", Annotations: annotations},
+			},
+			GwListener: &gwv1.Listener{Name: "https", Port: 443, Protocol: gwv1.HTTPSProtocolType},
+		}
+	}
+
+	listener := func(sslConfig *ssl.SslConfig) *v1.Listener {
+		return &v1.Listener{
+			Name:     "https",
+			BindPort: 8443,
+			ListenerType: &v1.Listener_AggregateListener{
+				AggregateListener: &v1.AggregateListener{
+					HttpFilterChains: []*v1.AggregateListener_HttpFilterChain{{
+						Matcher: &v1.Matcher{SslConfig: sslConfig},
+					}},
+				},
+			},
+		}
+	}
+
+	It("enables http3 on HTTPS listeners of annotated gateways, advertising the gateway port", func() {
+		out := listener(&ssl.SslConfig{SniDomains: []string{"api.example.com"}})
+		err := http3.NewPlugin().ApplyListenerPlugin(context.Background(), listenerCtx(map[string]string{
+			wellknown.Http3AnnotationName: "true",
+		}), out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetOptions().GetHttp3().GetEnabled().GetValue()).To(BeTrue())
+		Expect(out.GetOptions().GetHttp3().GetAdvertisedPort().GetValue()).To(Equal(uint32(443)))
+	})
+
+	It("does not enable http3 on HTTP listeners", func() {
+		out := listener(nil)
+		err := http3.NewPlugin().ApplyListenerPlugin(context.Background(), listenerCtx(map[string]string{
+			wellknown.Http3AnnotationName: "true",
+		}), out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetOptions()).To(BeNil())
+	})
+
+	It("does not enable http3 on gateways without the annotation", func() {
+		out := listener(&ssl.SslConfig{})
+		err := http3.NewPlugin().ApplyListenerPlugin(context.Background(), listenerCtx(nil), out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetOptions()).To(BeNil())
+	})
+})
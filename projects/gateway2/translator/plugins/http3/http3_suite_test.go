@@ -0,0 +1,13 @@
+package http3_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestHttp3(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Http3 Suite")
+}
@@ -5,6 +5,7 @@ import (
 	gwquery "github.com/solo-io/gloo/projects/gateway2/query"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/headermodifier"
+	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/http3"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/mirror"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/redirect"
 	"github.com/solo-io/gloo/projects/gateway2/translator/plugins/routeoptions"
@@ -87,5 +88,6 @@ func BuildPlugins(
 		routeoptions.NewPlugin(queries, client, routeOptionClient, statusReporter),
 		virtualhostoptions.NewPlugin(queries, client),
 		urlrewrite.NewPlugin(),
+		http3.NewPlugin(),
 	}
 }
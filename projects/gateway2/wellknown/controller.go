@@ -13,4 +13,9 @@ const (
 	// resources for the Gateway. The GatewayParameters is assumed to be in the same namespace
 	// as the Gateway.
 	GatewayParametersAnnotationName = "gateway.gloo.solo.io/gateway-parameters-name"
+
+	// Http3AnnotationName is the name of the Gateway annotation that enables HTTP/3 on the HTTPS
+	// listeners of the Gateway, when set to "true". Each HTTPS listener is then also served over
+	// QUIC, on a UDP port with the same number.
+	Http3AnnotationName = "gateway.gloo.solo.io/http3"
 )
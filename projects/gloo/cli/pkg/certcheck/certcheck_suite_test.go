@@ -0,0 +1,13 @@
+package certcheck_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCertCheck(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CertCheck Suite")
+}
@@ -0,0 +1,251 @@
+package certcheck
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Problem is an issue found with a TLS secret. Errors are issues that break TLS for the clients of the references,
+// warnings are issues that will, or may for some clients.
+type Problem struct {
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+// Certificate summarizes a certificate of a TLS secret.
+type Certificate struct {
+	Subject   string    `json:"subject"`
+	Issuer    string    `json:"issuer"`
+	DnsNames  []string  `json:"dnsNames,omitempty"`
+	NotBefore time.Time `json:"notBefore"`
+	NotAfter  time.Time `json:"notAfter"`
+	// Root is true for the certificates of the root CA of the secret, which the certificates of peers are verified against
+	Root bool `json:"root,omitempty"`
+}
+
+// Report is the result of the check of a referenced TLS secret.
+type Report struct {
+	// Secret is the namespace and name of the secret, formatted as namespace.name
+	Secret       string         `json:"secret"`
+	References   []*Reference   `json:"references"`
+	Certificates []*Certificate `json:"certificates,omitempty"`
+	Problems     []*Problem     `json:"problems,omitempty"`
+}
+
+// Errors returns the problems of the report with the error severity.
+func (r *Report) Errors() []*Problem {
+	return r.problemsWithSeverity(SeverityError)
+}
+
+// Warnings returns the problems of the report with the warning severity.
+func (r *Report) Warnings() []*Problem {
+	return r.problemsWithSeverity(SeverityWarning)
+}
+
+func (r *Report) problemsWithSeverity(severity Severity) []*Problem {
+	var problems []*Problem
+	for _, problem := range r.Problems {
+		if problem.Severity == severity {
+			problems = append(problems, problem)
+		}
+	}
+	return problems
+}
+
+func (r *Report) addError(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, &Problem{Severity: SeverityError, Message: fmt.Sprintf(format, args...)})
+}
+
+func (r *Report) addWarning(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, &Problem{Severity: SeverityWarning, Message: fmt.Sprintf(format, args...)})
+}
+
+// Options configure the checks of the certificates.
+type Options struct {
+	// Now is the time the validity of the certificates is checked at
+	Now time.Time
+	// ExpiryWarning is how long before their expiry certificates are reported
+	ExpiryWarning time.Duration
+	// Roots are the roots the certificate chains are verified against. If nil, the system roots are used.
+	Roots *x509.CertPool
+}
+
+// Check checks every secret of the inventory, in the order of Inventory.Secrets.
+func Check(inventory *Inventory, secrets v1.SecretList, opts Options) []*Report {
+	var reports []*Report
+	for _, ref := range inventory.Secrets() {
+		report := &Report{
+			Secret:     ref.GetNamespace() + "." + ref.GetName(),
+			References: inventory.References(ref),
+		}
+		reports = append(reports, report)
+
+		secret, err := secrets.Find(ref.GetNamespace(), ref.GetName())
+		if err != nil {
+			report.addError("secret not found")
+			continue
+		}
+		if secret.GetTls() == nil {
+			report.addError("secret is not a TLS secret")
+			continue
+		}
+		checkTlsSecret(report, secret.GetTls(), opts)
+	}
+	return reports
+}
+
+func checkTlsSecret(report *Report, secret *v1.TlsSecret, opts Options) {
+	chain, err := parseCertificates(secret.GetCertChain())
+	if err != nil {
+		report.addError("parsing the certificate chain: %v", err)
+		return
+	}
+	roots, err := parseCertificates(secret.GetRootCa())
+	if err != nil {
+		report.addError("parsing the root CA: %v", err)
+		return
+	}
+	for _, cert := range chain {
+		report.Certificates = append(report.Certificates, summarize(cert, false))
+	}
+	for _, cert := range roots {
+		report.Certificates = append(report.Certificates, summarize(cert, true))
+	}
+
+	for _, cert := range chain {
+		checkValidity(report, cert, opts)
+	}
+	for _, cert := range roots {
+		checkValidity(report, cert, opts)
+	}
+
+	if len(chain) == 0 {
+		if len(roots) == 0 {
+			report.addError("secret has neither a certificate chain nor a root CA")
+		}
+		return
+	}
+	if secret.GetPrivateKey() == "" {
+		report.addError("secret has a certificate chain but no private key")
+	} else if _, err := tls.X509KeyPair([]byte(secret.GetCertChain()), []byte(secret.GetPrivateKey())); err != nil {
+		report.addError("private key does not match the certificate: %v", err)
+	}
+	checkChain(report, chain, opts)
+	checkSniDomains(report, chain[0])
+}
+
+// parseCertificates parses the certificates of a PEM bundle. Blocks of other types are ignored.
+func parseCertificates(bundle string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(bundle)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 && strings.TrimSpace(bundle) != "" {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return certs, nil
+}
+
+func summarize(cert *x509.Certificate, root bool) *Certificate {
+	return &Certificate{
+		Subject:   cert.Subject.String(),
+		Issuer:    cert.Issuer.String(),
+		DnsNames:  cert.DNSNames,
+		NotBefore: cert.NotBefore,
+		NotAfter:  cert.NotAfter,
+		Root:      root,
+	}
+}
+
+func checkValidity(report *Report, cert *x509.Certificate, opts Options) {
+	switch {
+	case opts.Now.After(cert.NotAfter):
+		report.addError("certificate %q expired on %v", cert.Subject.String(), cert.NotAfter.UTC().Format(time.RFC3339))
+	case opts.Now.Before(cert.NotBefore):
+		report.addError("certificate %q is not valid before %v", cert.Subject.String(), cert.NotBefore.UTC().Format(time.RFC3339))
+	case cert.NotAfter.Sub(opts.Now) < opts.ExpiryWarning:
+		report.addWarning("certificate %q expires in %v, on %v", cert.Subject.String(),
+			cert.NotAfter.Sub(opts.Now).Round(time.Hour), cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+}
+
+// checkChain checks that each certificate of the chain is signed by the next one, and that the last one is issued by a
+// trusted root, which clients need when the issuer of the leaf is an intermediate CA. The root CA of the secret is not
+// used, as it verifies the certificates of the peers rather than the chain of the secret.
+func checkChain(report *Report, chain []*x509.Certificate, opts Options) {
+	for i := 0; i < len(chain)-1; i++ {
+		if err := chain[i].CheckSignatureFrom(chain[i+1]); err != nil {
+			report.addError("certificate %q is not signed by the next certificate of the chain, %q", chain[i].Subject.String(), chain[i+1].Subject.String())
+			return
+		}
+	}
+
+	last := chain[len(chain)-1]
+	if last.CheckSignatureFrom(last) == nil {
+		// the chain ends with a root, which clients have to trust on their own
+		return
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         opts.Roots,
+		Intermediates: intermediates,
+		// the validity of the certificates is reported on its own
+		CurrentTime: last.NotBefore.Add(last.NotAfter.Sub(last.NotBefore) / 2),
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	var unknownAuthority x509.UnknownAuthorityError
+	if errors.As(err, &unknownAuthority) {
+		report.addWarning("the chain does not end with a certificate issued by a trusted root, intermediate certificates may be missing: no certificate for issuer %q", last.Issuer.String())
+	}
+}
+
+// checkSniDomains checks that the leaf certificate is valid for the domains it is served for.
+func checkSniDomains(report *Report, leaf *x509.Certificate) {
+	checked := map[string]bool{}
+	var domains []string
+	for _, reference := range report.References {
+		for _, domain := range reference.SniDomains {
+			if !checked[domain] {
+				checked[domain] = true
+				domains = append(domains, domain)
+			}
+		}
+	}
+	sort.Strings(domains)
+	for _, domain := range domains {
+		if err := leaf.VerifyHostname(domain); err != nil {
+			report.addError("certificate %q is not valid for the SNI domain %s, its names are: %s", leaf.Subject.String(), domain, strings.Join(leaf.DNSNames, ", "))
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package certcheck_test
+
+import (
+	"crypto/x509"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/certcheck"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Check", func() {
+
+	var (
+		now          time.Time
+		root         *testCert
+		intermediate *testCert
+		opts         certcheck.Options
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		root = issue("root", nil, true, now.Add(-time.Hour), now.Add(10*365*24*time.Hour), nil)
+		intermediate = issue("intermediate", nil, true, now.Add(-time.Hour), now.Add(5*365*24*time.Hour), root)
+		roots := x509.NewCertPool()
+		roots.AddCert(root.cert)
+		opts = certcheck.Options{
+			Now:           now,
+			ExpiryWarning: 30 * 24 * time.Hour,
+			Roots:         roots,
+		}
+	})
+
+	// check checks a tls secret served for the sni domains
+	check := func(tlsSecret *v1.TlsSecret, sniDomains ...string) *certcheck.Report {
+		inventory := certcheck.NewInventory()
+		inventory.AddVirtualService(&gatewayv1.VirtualService{
+			Metadata: &core.Metadata{Namespace: "default", Name: "vs"},
+			SslConfig: &ssl.SslConfig{
+				SslSecrets: &ssl.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Namespace: "gloo-system", Name: "tls"}},
+				SniDomains: sniDomains,
+			},
+		})
+		secrets := v1.SecretList{{
+			Metadata: &core.Metadata{Namespace: "gloo-system", Name: "tls"},
+			Kind:     &v1.Secret_Tls{Tls: tlsSecret},
+		}}
+		reports := certcheck.Check(inventory, secrets, opts)
+		Expect(reports).To(HaveLen(1))
+		return reports[0]
+	}
+
+	leaf := func(notAfter time.Time, dnsNames ...string) *testCert {
+		return issue("leaf", dnsNames, false, now.Add(-time.Hour), notAfter, intermediate)
+	}
+
+	messages := func(problems []*certcheck.Problem) []string {
+		var result []string
+		for _, problem := range problems {
+			result = append(result, problem.Message)
+		}
+		return result
+	}
+
+	It("reports no problem for a valid chain", func() {
+		cert := leaf(now.Add(365*24*time.Hour), "petstore.example.com")
+		report := check(&v1.TlsSecret{
+			CertChain:  chainPem(cert, intermediate),
+			PrivateKey: cert.keyPem(),
+		}, "petstore.example.com")
+
+		Expect(report.Secret).To(Equal("gloo-system.tls"))
+		Expect(report.Problems).To(BeEmpty())
+		Expect(report.Certificates).To(HaveLen(2))
+		Expect(report.Certificates[0].Subject).To(Equal("CN=leaf"))
+		Expect(report.Certificates[0].Issuer).To(Equal("CN=intermediate"))
+		Expect(report.Certificates[0].DnsNames).To(ConsistOf("petstore.example.com"))
+	})
+
+	It("reports expired certificates as errors and expiring ones as warnings", func() {
+		expired := issue("expired", nil, false, now.Add(-48*time.Hour), now.Add(-24*time.Hour), intermediate)
+		report := check(&v1.TlsSecret{
+			CertChain:  chainPem(expired, intermediate),
+			PrivateKey: expired.keyPem(),
+		})
+		Expect(messages(report.Errors())).To(ConsistOf(ContainSubstring(`certificate "CN=expired" expired on`)))
+
+		expiring := leaf(now.Add(10 * 24 * time.Hour))
+		report = check(&v1.TlsSecret{
+			CertChain:  chainPem(expiring, intermediate),
+			PrivateKey: expiring.keyPem(),
+		})
+		Expect(report.Errors()).To(BeEmpty())
+		Expect(messages(report.Warnings())).To(ConsistOf(ContainSubstring(`certificate "CN=leaf" expires in 240h0m0s`)))
+	})
+
+	It("reports the expiry of the root CA", func() {
+		cert := leaf(now.Add(365 * 24 * time.Hour))
+		clientCa := issue("client-ca", nil, true, now.Add(-48*time.Hour), now.Add(-time.Hour), nil)
+		report := check(&v1.TlsSecret{
+			CertChain:  chainPem(cert, intermediate),
+			PrivateKey: cert.keyPem(),
+			RootCa:     clientCa.certPem(),
+		})
+		Expect(messages(report.Errors())).To(ConsistOf(ContainSubstring(`certificate "CN=client-ca" expired on`)))
+		Expect(report.Certificates[2].Root).To(BeTrue())
+	})
+
+	It("reports private keys that do not match the certificate", func() {
+		cert := leaf(now.Add(365 * 24 * time.Hour))
+		other := leaf(now.Add(365 * 24 * time.Hour))
+		report := check(&v1.TlsSecret{
+			CertChain:  chainPem(cert, intermediate),
+			PrivateKey: other.keyPem(),
+		})
+		Expect(messages(report.Errors())).To(ConsistOf(ContainSubstring("private key does not match the certificate")))
+
+		report = check(&v1.TlsSecret{CertChain: chainPem(cert, intermediate)})
+		Expect(messages(report.Errors())).To(ConsistOf("secret has a certificate chain but no private key"))
+	})
+
+	It("reports missing intermediates", func() {
+		cert := leaf(now.Add(365 * 24 * time.Hour))
+		report := check(&v1.TlsSecret{
+			CertChain:  cert.certPem(),
+			PrivateKey: cert.keyPem(),
+		})
+		Expect(report.Errors()).To(BeEmpty())
+		Expect(messages(report.Warnings())).To(ConsistOf(ContainSubstring(`intermediate certificates may be missing: no certificate for issuer "CN=intermediate"`)))
+	})
+
+	It("reports chains in the wrong order", func() {
+		cert := leaf(now.Add(365 * 24 * time.Hour))
+		report := check(&v1.TlsSecret{
+			CertChain:  chainPem(cert, root, intermediate),
+			PrivateKey: cert.keyPem(),
+		})
+		Expect(messages(report.Errors())).To(ConsistOf(`certificate "CN=leaf" is not signed by the next certificate of the chain, "CN=root"`))
+	})
+
+	It("reports SNI domains the certificate is not valid for", func() {
+		cert := leaf(now.Add(365*24*time.Hour), "petstore.example.com", "*.api.example.com")
+		report := check(&v1.TlsSecret{
+			CertChain:  chainPem(cert, intermediate),
+			PrivateKey: cert.keyPem(),
+		}, "petstore.example.com", "v1.api.example.com", "store.example.com")
+		Expect(messages(report.Errors())).To(ConsistOf(`certificate "CN=leaf" is not valid for the SNI domain store.example.com, its names are: petstore.example.com, *.api.example.com`))
+	})
+
+	It("reports missing secrets and secrets of other kinds", func() {
+		inventory := certcheck.NewInventory()
+		for _, name := range []string{"missing", "not-tls"} {
+			inventory.AddUpstream(&v1.Upstream{
+				Metadata: &core.Metadata{Namespace: "default", Name: name},
+				SslConfig: &ssl.UpstreamSslConfig{
+					SslSecrets: &ssl.UpstreamSslConfig_SecretRef{SecretRef: &core.ResourceRef{Namespace: "default", Name: name}},
+				},
+			})
+		}
+		secrets := v1.SecretList{{
+			Metadata: &core.Metadata{Namespace: "default", Name: "not-tls"},
+			Kind:     &v1.Secret_Aws{Aws: &v1.AwsSecret{AccessKey: "access", SecretKey: "secret"}},
+		}}
+
+		reports := certcheck.Check(inventory, secrets, opts)
+		Expect(reports).To(HaveLen(2))
+		Expect(reports[0].Secret).To(Equal("default.missing"))
+		Expect(messages(reports[0].Errors())).To(ConsistOf("secret not found"))
+		Expect(reports[1].Secret).To(Equal("default.not-tls"))
+		Expect(messages(reports[1].Errors())).To(ConsistOf("secret is not a TLS secret"))
+	})
+})
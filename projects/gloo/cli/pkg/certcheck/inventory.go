@@ -0,0 +1,151 @@
+package certcheck
+
+import (
+	"fmt"
+	"sort"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	VirtualServiceKind = "VirtualService"
+	GatewayKind        = "Gateway"
+	UpstreamKind       = "Upstream"
+	KubeGatewayKind    = "Gateway.gateway.networking.k8s.io"
+)
+
+// Reference is a field of a resource that references a TLS secret.
+type Reference struct {
+	Kind string `json:"kind"`
+	// Resource is the namespace and name of the resource, formatted as namespace.name
+	Resource string `json:"resource"`
+	// Field is the path of the field holding the reference
+	Field string `json:"field"`
+	// SniDomains are the domains the certificate is served for. They are empty for the client certificates of
+	// upstreams, which are not matched against a domain.
+	SniDomains []string `json:"sniDomains,omitempty"`
+}
+
+// Inventory collects the TLS secrets referenced by the resources of a cluster, along with the fields referencing them.
+type Inventory struct {
+	references map[secretKey][]*Reference
+}
+
+// secretKey identifies a secret, ResourceRefs can't be used as map keys as they are protos
+type secretKey struct {
+	namespace, name string
+}
+
+func NewInventory() *Inventory {
+	return &Inventory{references: map[secretKey][]*Reference{}}
+}
+
+// Secrets returns the referenced secrets, sorted by namespace and name.
+func (i *Inventory) Secrets() []*core.ResourceRef {
+	secrets := make([]*core.ResourceRef, 0, len(i.references))
+	for secret := range i.references {
+		secrets = append(secrets, &core.ResourceRef{Namespace: secret.namespace, Name: secret.name})
+	}
+	sort.Slice(secrets, func(a, b int) bool {
+		if secrets[a].GetNamespace() != secrets[b].GetNamespace() {
+			return secrets[a].GetNamespace() < secrets[b].GetNamespace()
+		}
+		return secrets[a].GetName() < secrets[b].GetName()
+	})
+	return secrets
+}
+
+// References returns the fields referencing a secret, in the order they were added.
+func (i *Inventory) References(secret *core.ResourceRef) []*Reference {
+	return i.references[secretKey{namespace: secret.GetNamespace(), name: secret.GetName()}]
+}
+
+func (i *Inventory) AddVirtualService(vs *gatewayv1.VirtualService) {
+	i.addSslConfig(VirtualServiceKind, vs.GetMetadata(), "sslConfig", vs.GetSslConfig())
+}
+
+func (i *Inventory) AddGateway(gw *gatewayv1.Gateway) {
+	for j, host := range gw.GetTcpGateway().GetTcpHosts() {
+		field := fmt.Sprintf("tcpGateway.tcpHosts[%d].sslConfig", j)
+		i.addSslConfig(GatewayKind, gw.GetMetadata(), field, host.GetSslConfig())
+	}
+	hybrid := gw.GetHybridGateway()
+	for j, matched := range hybrid.GetMatchedGateways() {
+		field := fmt.Sprintf("hybridGateway.matchedGateways[%d].matcher.sslConfig", j)
+		i.addSslConfig(GatewayKind, gw.GetMetadata(), field, matched.GetMatcher().GetSslConfig())
+		for k, host := range matched.GetTcpGateway().GetTcpHosts() {
+			field := fmt.Sprintf("hybridGateway.matchedGateways[%d].tcpGateway.tcpHosts[%d].sslConfig", j, k)
+			i.addSslConfig(GatewayKind, gw.GetMetadata(), field, host.GetSslConfig())
+		}
+	}
+	i.addSslConfig(GatewayKind, gw.GetMetadata(), "hybridGateway.delegatedHttpGateways.sslConfig", hybrid.GetDelegatedHttpGateways().GetSslConfig())
+}
+
+func (i *Inventory) AddUpstream(us *v1.Upstream) {
+	i.addUpstreamSslConfig(us.GetMetadata(), "sslConfig", us.GetSslConfig())
+	i.addUpstreamSslConfig(us.GetMetadata(), "httpConnectSslConfig", us.GetHttpConnectSslConfig())
+}
+
+// AddKubeGateway adds the certificates of the listeners of a Kubernetes Gateway API Gateway. References to kinds other
+// than core Secrets are skipped, as they are not served by Gloo.
+func (i *Inventory) AddKubeGateway(gw *gwv1.Gateway) {
+	for _, listener := range gw.Spec.Listeners {
+		if listener.TLS == nil {
+			continue
+		}
+		var sniDomains []string
+		if listener.Hostname != nil && *listener.Hostname != "" {
+			sniDomains = []string{string(*listener.Hostname)}
+		}
+		for j, certRef := range listener.TLS.CertificateRefs {
+			if certRef.Group != nil && *certRef.Group != "" {
+				continue
+			}
+			if certRef.Kind != nil && *certRef.Kind != "Secret" {
+				continue
+			}
+			namespace := gw.GetNamespace()
+			if certRef.Namespace != nil {
+				namespace = string(*certRef.Namespace)
+			}
+			i.add(namespace, string(certRef.Name), &Reference{
+				Kind:       KubeGatewayKind,
+				Resource:   gw.GetNamespace() + "." + gw.GetName(),
+				Field:      fmt.Sprintf("listeners[%s].tls.certificateRefs[%d]", listener.Name, j),
+				SniDomains: sniDomains,
+			})
+		}
+	}
+}
+
+func (i *Inventory) addSslConfig(kind string, metadata *core.Metadata, field string, sslConfig *ssl.SslConfig) {
+	if sslConfig.GetSecretRef() == nil {
+		return
+	}
+	i.add(sslConfig.GetSecretRef().GetNamespace(), sslConfig.GetSecretRef().GetName(), &Reference{
+		Kind:       kind,
+		Resource:   metadata.GetNamespace() + "." + metadata.GetName(),
+		Field:      field,
+		SniDomains: sslConfig.GetSniDomains(),
+	})
+}
+
+func (i *Inventory) addUpstreamSslConfig(metadata *core.Metadata, field string, sslConfig *ssl.UpstreamSslConfig) {
+	if sslConfig.GetSecretRef() == nil {
+		return
+	}
+	i.add(sslConfig.GetSecretRef().GetNamespace(), sslConfig.GetSecretRef().GetName(), &Reference{
+		Kind:     UpstreamKind,
+		Resource: metadata.GetNamespace() + "." + metadata.GetName(),
+		Field:    field,
+	})
+}
+
+func (i *Inventory) add(namespace, name string, reference *Reference) {
+	key := secretKey{namespace: namespace, name: name}
+	i.references[key] = append(i.references[key], reference)
+}
@@ -0,0 +1,118 @@
+package certcheck_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/certcheck"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+var _ = Describe("Inventory", func() {
+
+	var inventory *certcheck.Inventory
+
+	BeforeEach(func() {
+		inventory = certcheck.NewInventory()
+	})
+
+	secretRef := func(namespace, name string) *ssl.SslConfig_SecretRef {
+		return &ssl.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Namespace: namespace, Name: name}}
+	}
+
+	It("collects the secrets of virtual services, gateways and upstreams", func() {
+		inventory.AddVirtualService(&gatewayv1.VirtualService{
+			Metadata: &core.Metadata{Namespace: "default", Name: "petstore"},
+			SslConfig: &ssl.SslConfig{
+				SslSecrets: secretRef("gloo-system", "petstore-tls"),
+				SniDomains: []string{"petstore.example.com"},
+			},
+		})
+		inventory.AddGateway(&gatewayv1.Gateway{
+			Metadata: &core.Metadata{Namespace: "gloo-system", Name: "gateway-proxy-ssl"},
+			GatewayType: &gatewayv1.Gateway_HybridGateway{HybridGateway: &gatewayv1.HybridGateway{
+				MatchedGateways: []*gatewayv1.MatchedGateway{{
+					Matcher: &gatewayv1.Matcher{SslConfig: &ssl.SslConfig{
+						SslSecrets: secretRef("gloo-system", "petstore-tls"),
+						SniDomains: []string{"*.example.com"},
+					}},
+				}},
+			}},
+		})
+		inventory.AddUpstream(&v1.Upstream{
+			Metadata: &core.Metadata{Namespace: "gloo-system", Name: "backend"},
+			SslConfig: &ssl.UpstreamSslConfig{
+				SslSecrets: &ssl.UpstreamSslConfig_SecretRef{SecretRef: &core.ResourceRef{Namespace: "default", Name: "backend-client"}},
+				Sni:        "backend.internal",
+			},
+		})
+		// ssl configs without a secret ref are not collected
+		inventory.AddVirtualService(&gatewayv1.VirtualService{
+			Metadata:  &core.Metadata{Namespace: "default", Name: "files"},
+			SslConfig: &ssl.SslConfig{SslSecrets: &ssl.SslConfig_SslFiles{SslFiles: &ssl.SSLFiles{TlsCert: "/etc/tls.crt"}}},
+		})
+
+		Expect(inventory.Secrets()).To(Equal([]*core.ResourceRef{
+			{Namespace: "default", Name: "backend-client"},
+			{Namespace: "gloo-system", Name: "petstore-tls"},
+		}))
+		Expect(inventory.References(&core.ResourceRef{Namespace: "gloo-system", Name: "petstore-tls"})).To(Equal([]*certcheck.Reference{
+			{
+				Kind:       certcheck.VirtualServiceKind,
+				Resource:   "default.petstore",
+				Field:      "sslConfig",
+				SniDomains: []string{"petstore.example.com"},
+			},
+			{
+				Kind:       certcheck.GatewayKind,
+				Resource:   "gloo-system.gateway-proxy-ssl",
+				Field:      "hybridGateway.matchedGateways[0].matcher.sslConfig",
+				SniDomains: []string{"*.example.com"},
+			},
+		}))
+		Expect(inventory.References(&core.ResourceRef{Namespace: "default", Name: "backend-client"})).To(Equal([]*certcheck.Reference{{
+			Kind:     certcheck.UpstreamKind,
+			Resource: "gloo-system.backend",
+			Field:    "sslConfig",
+		}}))
+	})
+
+	It("collects the secrets of Kubernetes Gateway API listeners", func() {
+		hostname := gwv1.Hostname("api.example.com")
+		otherNamespace := gwv1.Namespace("certs")
+		otherGroup := gwv1.Group("cert-manager.io")
+		inventory.AddKubeGateway(&gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "http"},
+			Spec: gwv1.GatewaySpec{
+				Listeners: []gwv1.Listener{
+					{Name: "http", Port: 80},
+					{
+						Name:     "https",
+						Port:     443,
+						Hostname: &hostname,
+						TLS: &gwv1.GatewayTLSConfig{CertificateRefs: []gwv1.SecretObjectReference{
+							{Name: "api-tls"},
+							{Name: "shared-tls", Namespace: &otherNamespace},
+							{Name: "not-a-secret", Group: &otherGroup},
+						}},
+					},
+				},
+			},
+		})
+
+		Expect(inventory.Secrets()).To(Equal([]*core.ResourceRef{
+			{Namespace: "certs", Name: "shared-tls"},
+			{Namespace: "default", Name: "api-tls"},
+		}))
+		Expect(inventory.References(&core.ResourceRef{Namespace: "certs", Name: "shared-tls"})).To(Equal([]*certcheck.Reference{{
+			Kind:       certcheck.KubeGatewayKind,
+			Resource:   "default.http",
+			Field:      "listeners[https].tls.certificateRefs[1]",
+			SniDomains: []string{"api.example.com"},
+		}}))
+	})
+})
@@ -12,7 +12,9 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	testhelpers "github.com/solo-io/gloo/test/helpers"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	appsv1 "k8s.io/api/apps/v1"
@@ -37,8 +39,10 @@ var _ = Describe("Check", func() {
 	})
 
 	Context("glooctl check", func() {
-		It("should error if resource has no status", func() {
 
+		// setupGloo creates the gloo namespace, a deployment and the default settings, so that the checks of the gloo
+		// resources run
+		setupGloo := func() {
 			client := helpers.MustKubeClient()
 			client.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
 				ObjectMeta: metav1.ObjectMeta{
@@ -70,6 +74,10 @@ var _ = Describe("Check", func() {
 						ProxyDebugBindAddr: "0.0.0.0:1111",
 					},
 				}, clients.WriteOpts{})
+		}
+
+		It("should error if resource has no status", func() {
+			setupGloo()
 
 			noStatusUpstream := &v1.Upstream{
 				Metadata: &core.Metadata{
@@ -157,5 +165,36 @@ var _ = Describe("Check", func() {
 			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("Found gateway with no status: %s %s", noStatusGateway.GetMetadata().GetNamespace(), noStatusGateway.GetMetadata().GetName())))
 			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("Found virtual service with no status: %s %s", noStatusVS.GetMetadata().GetNamespace(), noStatusVS.GetMetadata().GetName())))
 		})
+
+		It("should error on certificate problems of referenced TLS secrets", func() {
+			setupGloo()
+
+			tlsSecret := testhelpers.GetTlsSecret("petstore-tls", "gloo-system")
+			_, err := helpers.MustSecretClient(ctx).Write(tlsSecret, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			vsClient := helpers.MustNamespacedVirtualServiceClient(ctx, "gloo-system")
+			for name, secret := range map[string]string{"petstore": "petstore-tls", "missing": "missing-tls"} {
+				_, err := vsClient.Write(&gatewaysoloiov1.VirtualService{
+					Metadata: &core.Metadata{
+						Name:      name,
+						Namespace: "gloo-system",
+					},
+					SslConfig: &ssl.SslConfig{
+						SslSecrets: &ssl.SslConfig_SecretRef{
+							SecretRef: &core.ResourceRef{Name: secret, Namespace: "gloo-system"},
+						},
+						SniDomains: []string{"petstore.example.com"},
+					},
+				}, clients.WriteOpts{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, err = testutils.GlooctlOut("check -x xds-metrics,proxies")
+
+			Expect(err.Error()).To(ContainSubstring("Found certificate problem in secret gloo-system.missing-tls, referenced by VirtualService gloo-system.missing (sslConfig): secret not found"))
+			Expect(err.Error()).To(ContainSubstring("Found certificate problem in secret gloo-system.petstore-tls, referenced by VirtualService gloo-system.petstore (sslConfig): certificate"))
+			Expect(err.Error()).To(ContainSubstring("is not valid for the SNI domain petstore.example.com"))
+		})
 	})
 })
@@ -3,177 +3,65 @@ package internal
 import (
 	"context"
 	"fmt"
-	"reflect"
+	"sort"
 
 	"github.com/hashicorp/go-multierror"
-	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gateway2/query"
 	"github.com/solo-io/gloo/projects/gateway2/wellknown"
-	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
-	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
 	"golang.org/x/exp/slices"
-	appsv1 "k8s.io/api/apps/v1"
-	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
-	gwclient "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
 )
 
-// Checks whether the cluster that the kubeconfig points at is available
-// The timeout for the kubernetes client is set to a low value to notify the user of the failure
-func CheckConnection(ctx context.Context, _ printers.P, opts *options.Options) error {
-	client, err := helpers.GetKubernetesClient(opts.Top.KubeContext)
-	if err != nil {
-		return eris.Wrapf(err, "Could not get kubernetes client")
-	}
-	_, err = client.CoreV1().Namespaces().Get(ctx, opts.Metadata.GetNamespace(), metav1.GetOptions{})
-	if err != nil {
-		return eris.Wrapf(err, "Could not communicate with kubernetes cluster")
-	}
-	return nil
-}
-
-func CheckDeployments(ctx context.Context, printer printers.P, opts *options.Options) error {
-	printer.AppendCheck("Checking deployments... ")
-	client, err := helpers.GetKubernetesClient(opts.Top.KubeContext)
-	if err != nil {
-		errMessage := "error getting KubeClient"
-		fmt.Println(errMessage)
-		return fmt.Errorf(errMessage+": %v", err)
-	}
-	_, err = client.CoreV1().Namespaces().Get(ctx, opts.Metadata.GetNamespace(), metav1.GetOptions{})
-	if err != nil {
-		errMessage := "Gloo namespace does not exist"
-		fmt.Println(errMessage)
-		return fmt.Errorf(errMessage)
-	}
-	deployments, err := client.AppsV1().Deployments(opts.Metadata.GetNamespace()).List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return err
-	}
-	if len(deployments.Items) == 0 {
-		errMessage := "Gloo is not installed"
-		fmt.Println(errMessage)
-		return fmt.Errorf(errMessage)
-	}
-	var multiErr *multierror.Error
-	var message string
-	setMessage := func(c appsv1.DeploymentCondition) {
-		if c.Message != "" {
-			message = fmt.Sprintf(" Message: %s", c.Message)
-		}
-	}
-
-	for _, deployment := range deployments.Items {
-		// possible condition types listed at https://godoc.org/k8s.io/api/apps/v1#DeploymentConditionType
-		// check for each condition independently because multiple conditions will be True and DeploymentReplicaFailure
-		// tends to provide the most explicit error message.
-		for _, condition := range deployment.Status.Conditions {
-			setMessage(condition)
-			if condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue {
-				err := fmt.Errorf("Deployment %s in namespace %s failed to create pods!%s", deployment.Name, deployment.Namespace, message)
-				multiErr = multierror.Append(multiErr, err)
-			}
-		}
-
-		for _, condition := range deployment.Status.Conditions {
-			setMessage(condition)
-			if condition.Type == appsv1.DeploymentProgressing && condition.Status != corev1.ConditionTrue {
-				err := fmt.Errorf("Deployment %s in namespace %s is not progressing!%s", deployment.Name, deployment.Namespace, message)
-				multiErr = multierror.Append(multiErr, err)
-			}
-		}
-
-		for _, condition := range deployment.Status.Conditions {
-			setMessage(condition)
-			if condition.Type == appsv1.DeploymentAvailable && condition.Status != corev1.ConditionTrue {
-				err := fmt.Errorf("Deployment %s in namespace %s is not available!%s", deployment.Name, deployment.Namespace, message)
-				multiErr = multierror.Append(multiErr, err)
-			}
+// GatewayApiCheck checks Gateway API resources, reporting errors in the returned error and warnings as messages of the
+// printer.
+type GatewayApiCheck = func(printer printers.P, resources *GatewayApiResources) error
 
+// CheckGatewayClasses checks that the GatewayClasses managed by Gloo Gateway were accepted by the controller.
+func CheckGatewayClasses(printer printers.P, resources *GatewayApiResources) error {
+	printer.AppendCheck("Checking GatewayClasses... ")
+	multierr := &multierror.Error{}
+	for _, gc := range resources.GatewayClasses {
+		if gc.Spec.ControllerName != wellknown.GatewayControllerName {
+			continue
 		}
-
-		for _, condition := range deployment.Status.Conditions {
-			if condition.Type != appsv1.DeploymentAvailable &&
-				condition.Type != appsv1.DeploymentReplicaFailure &&
-				condition.Type != appsv1.DeploymentProgressing {
-				err := fmt.Errorf("Deployment %s has an unhandled deployment condition %s", deployment.Name, condition.Type)
-				multiErr = multierror.Append(multiErr, err)
-			}
+		expectedConditions := []expectedCondition[gwv1.GatewayClassConditionType]{
+			{condition: gwv1.GatewayClassConditionStatusAccepted, status: metav1.ConditionTrue},
+			{condition: gwv1.GatewayClassConditionStatusSupportedVersion, status: metav1.ConditionTrue},
 		}
+		processConditions(
+			fmt.Sprintf("GatewayClass %s", gc.Name),
+			multierr,
+			expectedConditions,
+			gc.Status.Conditions,
+			gc.Generation,
+		)
 	}
-	if multiErr.ErrorOrNil() != nil {
-		printer.AppendStatus("deployments", fmt.Sprintf("%v Errors!", multiErr.Len()))
-		return multiErr.ErrorOrNil()
-	}
-	printer.AppendStatus("deployments", "OK")
-	return nil
-}
-
-func CheckGatewayClass(ctx context.Context, printer printers.P, opts *options.Options) error {
-	printer.AppendCheck("Checking GatewayClass... ")
-	cfg := config.GetConfigOrDie()
-	cli := gwclient.NewForConfigOrDie(cfg)
-
-	gc, err := cli.GatewayV1().GatewayClasses().Get(ctx, "gloo-gateway", metav1.GetOptions{})
-	if err != nil {
-		errMessage := "Could not find solo GatewayClass gloo-gateway"
-		fmt.Println(errMessage)
-		return fmt.Errorf(errMessage)
-	}
-
-	expectedConditions := []expectedCondition[gwv1.GatewayClassConditionType]{
-		{condition: gwv1.GatewayClassConditionStatusAccepted, status: metav1.ConditionTrue},
-		{condition: gwv1.GatewayClassConditionStatusSupportedVersion, status: metav1.ConditionTrue},
-	}
-
-	multierr := &multierror.Error{}
-	processConditions(
-		fmt.Sprintf("GatewayClass %s", gc.Name),
-		multierr,
-		expectedConditions,
-		gc.Status.Conditions,
-		gc.Generation,
-	)
-
-	if multierr.ErrorOrNil() != nil {
-		printer.AppendStatus("GatewayClass", fmt.Sprintf("%v Errors!", multierr.Len()))
-		return multierr.ErrorOrNil()
-	}
-	printer.AppendStatus("GatewayClass", "OK")
-	return nil
+	return appendStatus(printer, "GatewayClasses", multierr)
 }
 
-func CheckGatewys(ctx context.Context, printer printers.P, opts *options.Options) error {
+// CheckGateways checks that the Gateways of the GatewayClasses managed by Gloo Gateway, and each of their listeners,
+// are accepted and programmed.
+func CheckGateways(printer printers.P, resources *GatewayApiResources) error {
 	printer.AppendCheck("Checking Gateways... ")
-	cfg := config.GetConfigOrDie()
-	cli := gwclient.NewForConfigOrDie(cfg)
-
-	// Maybe this will be all NS?
-	gwList, err := cli.GatewayV1().Gateways("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		errMessage := "could not list Gateways"
-		fmt.Println(errMessage)
-		return fmt.Errorf(errMessage)
-	}
-
 	multierr := &multierror.Error{}
-	for _, gw := range gwList.Items {
-		// Pike until go 1.22
-		gw := gw
-
-		if gw.Spec.GatewayClassName != "gloo-gateway" {
-			// #not_my_gateway
-			continue
+	classes := resources.managedClasses()
+	for _, gw := range resources.Gateways {
+		if gw.Spec.GatewayClassName == wellknown.GatewayClassName && !classes[wellknown.GatewayClassName] {
+			multierr = multierror.Append(multierr, fmt.Errorf(
+				"Gateway %s.%s uses the GatewayClass %s, which does not exist or is not managed by %s",
+				gw.Namespace, gw.Name, wellknown.GatewayClassName, wellknown.GatewayControllerName,
+			))
 		}
+	}
 
+	for _, gw := range resources.managedGateways() {
 		expectedConditions := []expectedCondition[gwv1.GatewayConditionType]{
 			{condition: gwv1.GatewayConditionAccepted, status: metav1.ConditionTrue},
 			{condition: gwv1.GatewayConditionProgrammed, status: metav1.ConditionTrue},
 		}
-
 		processConditions(
 			fmt.Sprintf("Gateway %s.%s", gw.Namespace, gw.Name),
 			multierr,
@@ -182,17 +70,16 @@ func CheckGatewys(ctx context.Context, printer printers.P, opts *options.Options
 			gw.Generation,
 		)
 
-		// Go through listeners and process them
-
-		for spec_idx := range gw.Spec.Listeners {
-
-			status_idx := slices.IndexFunc(gw.Status.Listeners, func(ls gwv1.ListenerStatus) bool {
-				return ls.Name == gw.Spec.Listeners[spec_idx].Name
+		for _, listener := range gw.Spec.Listeners {
+			statusIdx := slices.IndexFunc(gw.Status.Listeners, func(ls gwv1.ListenerStatus) bool {
+				return ls.Name == listener.Name
 			})
-
 			// No status found for listener, that's an error
-			if status_idx == -1 {
-				multierror.Append(multierr, fmt.Errorf("no status found for listener %s", gw.Spec.Listeners[spec_idx].Name))
+			if statusIdx == -1 {
+				multierr = multierror.Append(multierr, fmt.Errorf(
+					"Listener %s.%s.%s has no status, most likely it has not reconciled yet",
+					gw.Namespace, gw.Name, listener.Name,
+				))
 				continue
 			}
 
@@ -202,82 +89,238 @@ func CheckGatewys(ctx context.Context, printer printers.P, opts *options.Options
 				{condition: gwv1.ListenerConditionAccepted, status: metav1.ConditionTrue},
 				{condition: gwv1.ListenerConditionResolvedRefs, status: metav1.ConditionTrue},
 			}
-
 			processConditions(
-				fmt.Sprintf("Listener %s.%s.%s", gw.Namespace, gw.Name, gw.Spec.Listeners[spec_idx].Name),
+				fmt.Sprintf("Listener %s.%s.%s", gw.Namespace, gw.Name, listener.Name),
 				multierr,
 				expectedConditions,
-				gw.Status.Listeners[status_idx].Conditions,
+				gw.Status.Listeners[statusIdx].Conditions,
 				gw.Generation,
 			)
-
 		}
 	}
-
-	if multierr.ErrorOrNil() != nil {
-		printer.AppendStatus("Gateway", fmt.Sprintf("%v Errors!", multierr.Len()))
-		return multierr.ErrorOrNil()
-	}
-	printer.AppendStatus("Gateway", "OK")
-	return nil
+	return appendStatus(printer, "Gateways", multierr)
 }
 
-func CheckHTTPRoutes(ctx context.Context, printer printers.P, opts *options.Options) error {
+// CheckHTTPRoutes checks that the HTTPRoutes attached to Gateways managed by Gloo Gateway were accepted by each of them,
+// and that their backendRefs were resolved.
+func CheckHTTPRoutes(printer printers.P, resources *GatewayApiResources) error {
 	printer.AppendCheck("Checking HTTPRoutes... ")
-	cfg := config.GetConfigOrDie()
-	cli := gwclient.NewForConfigOrDie(cfg)
+	multierr := &multierror.Error{}
+	classes := resources.managedClasses()
+	for _, route := range resources.HTTPRoutes {
+		for _, parentRef := range route.Spec.ParentRefs {
+			if !isGatewayRef(parentRef) {
+				continue
+			}
+			gwNamespace := namespaceOrDefault(parentRef.Namespace, route.Namespace)
+			gw := resources.gateway(gwNamespace, string(parentRef.Name))
+			if gw == nil {
+				multierr = multierror.Append(multierr, fmt.Errorf(
+					"HTTPRoute %s.%s references the Gateway %s.%s, which does not exist",
+					route.Namespace, route.Name, gwNamespace, parentRef.Name,
+				))
+				continue
+			}
+			if !classes[string(gw.Spec.GatewayClassName)] {
+				continue
+			}
 
-	// Maybe this will be all NS?
-	httpRouteList, err := cli.GatewayV1().HTTPRoutes("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		errMessage := "Could not list HTTPRoutes"
-		fmt.Println(errMessage)
-		return fmt.Errorf(errMessage)
+			parent := fmt.Sprintf("HTTPRoute %s.%s parent %s", route.Namespace, route.Name, renderParentRef(parentRef, route.Namespace))
+			statusIdx := slices.IndexFunc(route.Status.Parents, func(status gwv1.RouteParentStatus) bool {
+				return status.ControllerName == wellknown.GatewayControllerName && sameParentRef(status.ParentRef, parentRef, route.Namespace)
+			})
+			if statusIdx == -1 {
+				multierr = multierror.Append(multierr, fmt.Errorf(
+					"%s has no status, most likely it has not reconciled yet", parent,
+				))
+				continue
+			}
+
+			expectedConditions := []expectedCondition[gwv1.RouteConditionType]{
+				{condition: gwv1.RouteConditionAccepted, status: metav1.ConditionTrue},
+				{condition: gwv1.RouteConditionResolvedRefs, status: metav1.ConditionTrue},
+			}
+			processConditions(
+				parent,
+				multierr,
+				expectedConditions,
+				route.Status.Parents[statusIdx].Conditions,
+				route.Generation,
+			)
+		}
 	}
+	return appendStatus(printer, "HTTPRoutes", multierr)
+}
 
+// CheckReferenceGrants checks that the cross namespace references of the HTTPRoutes attached to Gateways managed by
+// Gloo Gateway, and of the certificates of the listeners of these Gateways, are allowed by a ReferenceGrant.
+func CheckReferenceGrants(printer printers.P, resources *GatewayApiResources) error {
+	printer.AppendCheck("Checking ReferenceGrants... ")
 	multierr := &multierror.Error{}
-	for route_idx := range httpRouteList.Items {
-		// For each parent_ref in the spec, check that one exists in the status.
 
-		for parent_idx := range httpRouteList.Items[route_idx].Spec.ParentRefs {
-			parent_ref := httpRouteList.Items[route_idx].Spec.ParentRefs[parent_idx]
-			child_idx := slices.IndexFunc(
-				httpRouteList.Items[route_idx].Status.Parents,
-				func(status_parent gwv1.RouteParentStatus) bool {
-					// Return the idx if we find the parent_ref in the status
-					return reflect.DeepEqual(status_parent.ParentRef, parent_ref)
-				},
-			)
+	gatewayGK := metav1.GroupKind{Group: gwv1.GroupName, Kind: wellknown.GatewayKind}
+	secretGK := metav1.GroupKind{Group: "", Kind: "Secret"}
+	for _, gw := range resources.managedGateways() {
+		for _, listener := range gw.Spec.Listeners {
+			if listener.TLS == nil {
+				continue
+			}
+			for _, certRef := range listener.TLS.CertificateRefs {
+				if certRef.Namespace == nil || string(*certRef.Namespace) == gw.Namespace {
+					continue
+				}
+				toGK := groupKindOrDefault(certRef.Group, certRef.Kind, secretGK)
+				grants := resources.referenceGrants(string(*certRef.Namespace))
+				if !query.ReferenceAllowed(context.Background(), gatewayGK, gw.Namespace, toGK, string(certRef.Name), grants) {
+					multierr = multierror.Append(multierr, fmt.Errorf(
+						"Listener %s.%s.%s references the %s %s.%s, but no ReferenceGrant in namespace %s allows it",
+						gw.Namespace, gw.Name, listener.Name, toGK.Kind, *certRef.Namespace, certRef.Name, *certRef.Namespace,
+					))
+				}
+			}
+		}
+	}
 
-			if child_idx == -1 {
-				// Error, parent was not recorded in the status
-				multierr = multierror.Append(multierr,
-					fmt.Errorf("unable to find matching status for ParentRef %+v", parent_ref),
-				)
-			} else {
-				// Check the validity of the status
-				expectedConditions := []expectedCondition[gwv1.RouteConditionType]{
-					{condition: gwv1.RouteConditionAccepted, status: metav1.ConditionTrue},
-					{condition: gwv1.RouteConditionResolvedRefs, status: metav1.ConditionTrue},
+	routeGK := metav1.GroupKind{Group: gwv1.GroupName, Kind: wellknown.HTTPRouteKind}
+	serviceGK := metav1.GroupKind{Group: "", Kind: "Service"}
+	for _, route := range resources.HTTPRoutes {
+		if !resources.attachedToManagedGateway(&route) {
+			continue
+		}
+		for _, rule := range route.Spec.Rules {
+			for _, backendRef := range rule.BackendRefs {
+				ref := backendRef.BackendObjectReference
+				if ref.Namespace == nil || string(*ref.Namespace) == route.Namespace {
+					continue
+				}
+				toGK := groupKindOrDefault(ref.Group, ref.Kind, serviceGK)
+				grants := resources.referenceGrants(string(*ref.Namespace))
+				if !query.ReferenceAllowed(context.Background(), routeGK, route.Namespace, toGK, string(ref.Name), grants) {
+					multierr = multierror.Append(multierr, fmt.Errorf(
+						"HTTPRoute %s.%s references the %s %s.%s, but no ReferenceGrant in namespace %s allows it",
+						route.Namespace, route.Name, toGK.Kind, *ref.Namespace, ref.Name, *ref.Namespace,
+					))
 				}
-				processConditions(
-					fmt.Sprintf("HTTPRoute %s.%s.%s", httpRouteList.Items[route_idx].Namespace, httpRouteList.Items[route_idx].Name, parent_ref.Name),
-					multierr,
-					expectedConditions,
-					httpRouteList.Items[route_idx].Status.Parents[parent_idx].Conditions,
-					httpRouteList.Items[route_idx].Generation,
-				)
 			}
 		}
 	}
+	return appendStatus(printer, "ReferenceGrants", multierr)
+}
+
+// CheckGatewayParameters checks that the GatewayParameters referenced by Gateways exist, and warns about the ones no
+// Gateway references.
+func CheckGatewayParameters(printer printers.P, resources *GatewayApiResources) error {
+	printer.AppendCheck("Checking GatewayParameters... ")
+	multierr := &multierror.Error{}
+
+	referenced := map[string]bool{}
+	for _, gw := range resources.managedGateways() {
+		name := gw.GetAnnotations()[wellknown.GatewayParametersAnnotationName]
+		if name == "" {
+			continue
+		}
+		// the GatewayParameters must live in the same namespace as the Gateway
+		key := gw.Namespace + "." + name
+		referenced[key] = true
+		found := false
+		for i := range resources.GatewayParameters {
+			if resources.GatewayParameters[i].Namespace == gw.Namespace && resources.GatewayParameters[i].Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			multierr = multierror.Append(multierr, fmt.Errorf(
+				"Gateway %s.%s references the GatewayParameters %s, which does not exist", gw.Namespace, gw.Name, key,
+			))
+		}
+	}
+
+	var orphaned []string
+	for i := range resources.GatewayParameters {
+		key := resources.GatewayParameters[i].Namespace + "." + resources.GatewayParameters[i].Name
+		if !referenced[key] {
+			orphaned = append(orphaned, key)
+		}
+	}
+	sort.Strings(orphaned)
+
+	err := appendStatus(printer, "GatewayParameters", multierr)
+	for _, key := range orphaned {
+		printer.AppendMessage(fmt.Sprintf("Warning: GatewayParameters %s is not referenced by any Gateway", key))
+	}
+	return err
+}
+
+// attachedToManagedGateway returns true if one of the parents of the route is a Gateway managed by Gloo Gateway
+func (r *GatewayApiResources) attachedToManagedGateway(route *gwv1.HTTPRoute) bool {
+	classes := r.managedClasses()
+	for _, parentRef := range route.Spec.ParentRefs {
+		if !isGatewayRef(parentRef) {
+			continue
+		}
+		gw := r.gateway(namespaceOrDefault(parentRef.Namespace, route.Namespace), string(parentRef.Name))
+		if gw != nil && classes[string(gw.Spec.GatewayClassName)] {
+			return true
+		}
+	}
+	return false
+}
 
+func appendStatus(printer printers.P, name string, multierr *multierror.Error) error {
 	if multierr.ErrorOrNil() != nil {
-		printer.AppendStatus(wellknown.HTTPRouteKind, fmt.Sprintf("%v Errors!", multierr.Len()))
+		printer.AppendStatus(name, fmt.Sprintf("%v Errors!", multierr.Len()))
 		return multierr.ErrorOrNil()
 	}
-	printer.AppendStatus(wellknown.HTTPRouteKind, "OK")
+	printer.AppendStatus(name, "OK")
 	return nil
+}
+
+func isGatewayRef(ref gwv1.ParentReference) bool {
+	return (ref.Group == nil || string(*ref.Group) == gwv1.GroupName) &&
+		(ref.Kind == nil || string(*ref.Kind) == wellknown.GatewayKind)
+}
+
+// sameParentRef compares parent references, with their defaults applied
+func sameParentRef(a, b gwv1.ParentReference, routeNamespace string) bool {
+	return isGatewayRef(a) == isGatewayRef(b) &&
+		namespaceOrDefault(a.Namespace, routeNamespace) == namespaceOrDefault(b.Namespace, routeNamespace) &&
+		a.Name == b.Name &&
+		ptrEqual(a.SectionName, b.SectionName) &&
+		ptrEqual(a.Port, b.Port)
+}
+
+func renderParentRef(ref gwv1.ParentReference, routeNamespace string) string {
+	rendered := fmt.Sprintf("%s.%s", namespaceOrDefault(ref.Namespace, routeNamespace), ref.Name)
+	if ref.SectionName != nil {
+		rendered += "." + string(*ref.SectionName)
+	}
+	return rendered
+}
 
+func namespaceOrDefault(namespace *gwv1.Namespace, defaultNamespace string) string {
+	if namespace == nil || *namespace == "" {
+		return defaultNamespace
+	}
+	return string(*namespace)
+}
+
+func groupKindOrDefault(group *gwv1.Group, kind *gwv1.Kind, defaultGK metav1.GroupKind) metav1.GroupKind {
+	gk := defaultGK
+	if group != nil {
+		gk.Group = string(*group)
+	}
+	if kind != nil {
+		gk.Kind = string(*kind)
+	}
+	return gk
+}
+
+func ptrEqual[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
 }
 
 type expectedCondition[T ~string] struct {
@@ -0,0 +1,229 @@
+package internal_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gateway2/controller/scheme"
+	"github.com/solo-io/gloo/projects/gateway2/pkg/api/gateway.gloo.solo.io/v1alpha1"
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/check/internal"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+var _ = Describe("Gateway API checks", func() {
+
+	var (
+		printer   printers.P
+		resources *internal.GatewayApiResources
+	)
+
+	condition := func(conditionType string, status metav1.ConditionStatus, reason string) metav1.Condition {
+		return metav1.Condition{Type: conditionType, Status: status, Reason: reason, ObservedGeneration: 1}
+	}
+
+	acceptedClass := func(name, controller string) gwv1.GatewayClass {
+		return gwv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+			Spec:       gwv1.GatewayClassSpec{ControllerName: gwv1.GatewayController(controller)},
+			Status: gwv1.GatewayClassStatus{Conditions: []metav1.Condition{
+				condition("Accepted", metav1.ConditionTrue, "Accepted"),
+				condition("SupportedVersion", metav1.ConditionTrue, "SupportedVersion"),
+			}},
+		}
+	}
+
+	programmedGateway := func(namespace, name, class string, listeners ...gwv1.Listener) gwv1.Gateway {
+		gw := gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Generation: 1},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: gwv1.ObjectName(class), Listeners: listeners},
+			Status: gwv1.GatewayStatus{Conditions: []metav1.Condition{
+				condition("Accepted", metav1.ConditionTrue, "Accepted"),
+				condition("Programmed", metav1.ConditionTrue, "Programmed"),
+			}},
+		}
+		for _, listener := range listeners {
+			gw.Status.Listeners = append(gw.Status.Listeners, gwv1.ListenerStatus{
+				Name: listener.Name,
+				Conditions: []metav1.Condition{
+					condition("Accepted", metav1.ConditionTrue, "Accepted"),
+					condition("Conflicted", metav1.ConditionFalse, "NoConflicts"),
+					condition("Programmed", metav1.ConditionTrue, "Programmed"),
+					condition("ResolvedRefs", metav1.ConditionTrue, "ResolvedRefs"),
+				},
+			})
+		}
+		return gw
+	}
+
+	route := func(namespace, name string, parentRef gwv1.ParentReference, backendNamespace string, conditions ...metav1.Condition) gwv1.HTTPRoute {
+		backendNs := gwv1.Namespace(backendNamespace)
+		return gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Generation: 1},
+			Spec: gwv1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1.CommonRouteSpec{ParentRefs: []gwv1.ParentReference{parentRef}},
+				Rules: []gwv1.HTTPRouteRule{{
+					BackendRefs: []gwv1.HTTPBackendRef{{BackendRef: gwv1.BackendRef{
+						BackendObjectReference: gwv1.BackendObjectReference{Name: "petstore", Namespace: &backendNs},
+					}}},
+				}},
+			},
+			Status: gwv1.HTTPRouteStatus{RouteStatus: gwv1.RouteStatus{Parents: []gwv1.RouteParentStatus{{
+				ParentRef:      parentRef,
+				ControllerName: wellknown.GatewayControllerName,
+				Conditions:     conditions,
+			}}}},
+		}
+	}
+
+	BeforeEach(func() {
+		printer = printers.P{OutputType: printers.JSON}
+		printer.CheckResult = printer.NewCheckResult()
+		resources = &internal.GatewayApiResources{
+			GatewayClasses: []gwv1.GatewayClass{
+				acceptedClass(wellknown.GatewayClassName, wellknown.GatewayControllerName),
+				acceptedClass("other", "example.com/other"),
+			},
+			Gateways: []gwv1.Gateway{
+				programmedGateway("default", "http", wellknown.GatewayClassName, gwv1.Listener{Name: "http", Port: 8080, Protocol: gwv1.HTTPProtocolType}),
+			},
+		}
+	})
+
+	It("skips the resources of other implementations", func() {
+		resources.GatewayClasses[1].Status.Conditions = nil
+		resources.Gateways = append(resources.Gateways, gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "other"},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: "other"},
+		})
+
+		Expect(resources.InUse()).To(BeTrue())
+		Expect(internal.CheckGatewayClasses(printer, resources)).NotTo(HaveOccurred())
+		Expect(internal.CheckGateways(printer, resources)).NotTo(HaveOccurred())
+		Expect(printer.CheckResult.Resources).To(ConsistOf(
+			printers.CheckStatus{Name: "GatewayClasses", Status: "OK"},
+			printers.CheckStatus{Name: "Gateways", Status: "OK"},
+		))
+
+		resources.GatewayClasses = resources.GatewayClasses[1:]
+		resources.Gateways = resources.Gateways[1:]
+		Expect(resources.InUse()).To(BeFalse())
+	})
+
+	It("reports GatewayClasses that were not accepted", func() {
+		resources.GatewayClasses[0].Status.Conditions = []metav1.Condition{
+			condition("Accepted", metav1.ConditionFalse, "InvalidParameters"),
+		}
+		err := internal.CheckGatewayClasses(printer, resources)
+		Expect(err).To(MatchError(And(
+			ContainSubstring("GatewayClass gloo-gateway status (Accepted) is not set to expected (True). Reason: InvalidParameters"),
+			ContainSubstring("GatewayClass gloo-gateway status (SupportedVersion) was not found"),
+		)))
+	})
+
+	It("reports Gateways with listeners that are not programmed", func() {
+		resources.Gateways[0].Status.Listeners[0].Conditions[2] = condition("Programmed", metav1.ConditionFalse, "Invalid")
+		resources.Gateways = append(resources.Gateways, gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "missing-class"},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: "gloo-gateway"},
+		})
+		resources.GatewayClasses[0].Name = "custom"
+		resources.Gateways[0].Spec.GatewayClassName = "custom"
+
+		err := internal.CheckGateways(printer, resources)
+		Expect(err).To(MatchError(And(
+			ContainSubstring("Listener default.http.http status (Programmed) is not set to expected (True). Reason: Invalid"),
+			ContainSubstring("Gateway default.missing-class uses the GatewayClass gloo-gateway, which does not exist"),
+		)))
+	})
+
+	It("reports HTTPRoutes that were not accepted or have unresolved backendRefs", func() {
+		parentRef := gwv1.ParentReference{Name: "http"}
+		resources.HTTPRoutes = []gwv1.HTTPRoute{
+			route("default", "ok", parentRef, "default",
+				condition("Accepted", metav1.ConditionTrue, "Accepted"),
+				condition("ResolvedRefs", metav1.ConditionTrue, "ResolvedRefs"),
+			),
+			route("default", "not-accepted", parentRef, "default",
+				condition("Accepted", metav1.ConditionFalse, "NoMatchingListenerHostname"),
+				condition("ResolvedRefs", metav1.ConditionTrue, "ResolvedRefs"),
+			),
+			route("default", "unresolved", parentRef, "default",
+				condition("Accepted", metav1.ConditionTrue, "Accepted"),
+				condition("ResolvedRefs", metav1.ConditionFalse, "BackendNotFound"),
+			),
+			route("default", "missing-gateway", gwv1.ParentReference{Name: "missing"}, "default"),
+		}
+
+		err := internal.CheckHTTPRoutes(printer, resources)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).NotTo(ContainSubstring("default.ok"))
+		Expect(err).To(MatchError(And(
+			ContainSubstring("HTTPRoute default.not-accepted parent default.http status (Accepted) is not set to expected (True). Reason: NoMatchingListenerHostname"),
+			ContainSubstring("HTTPRoute default.unresolved parent default.http status (ResolvedRefs) is not set to expected (True). Reason: BackendNotFound"),
+			ContainSubstring("HTTPRoute default.missing-gateway references the Gateway default.missing, which does not exist"),
+		)))
+	})
+
+	It("reports cross namespace references without a ReferenceGrant", func() {
+		resources.HTTPRoutes = []gwv1.HTTPRoute{
+			route("default", "granted", gwv1.ParentReference{Name: "http"}, "petstore"),
+			route("default", "not-granted", gwv1.ParentReference{Name: "http"}, "other"),
+		}
+		certNs := gwv1.Namespace("certs")
+		resources.Gateways[0].Spec.Listeners = append(resources.Gateways[0].Spec.Listeners, gwv1.Listener{
+			Name:     "https",
+			Port:     8443,
+			Protocol: gwv1.HTTPSProtocolType,
+			TLS: &gwv1.GatewayTLSConfig{CertificateRefs: []gwv1.SecretObjectReference{
+				{Name: "tls", Namespace: &certNs},
+			}},
+		})
+		resources.ReferenceGrants = []gwv1beta1.ReferenceGrant{{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "petstore", Name: "from-default"},
+			Spec: gwv1beta1.ReferenceGrantSpec{
+				From: []gwv1beta1.ReferenceGrantFrom{{Group: gwv1.GroupName, Kind: "HTTPRoute", Namespace: "default"}},
+				To:   []gwv1beta1.ReferenceGrantTo{{Group: "", Kind: "Service"}},
+			},
+		}}
+
+		err := internal.CheckReferenceGrants(printer, resources)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).NotTo(ContainSubstring("default.granted"))
+		Expect(err).To(MatchError(And(
+			ContainSubstring("HTTPRoute default.not-granted references the Service other.petstore, but no ReferenceGrant in namespace other allows it"),
+			ContainSubstring("Listener default.http.https references the Secret certs.tls, but no ReferenceGrant in namespace certs allows it"),
+		)))
+	})
+
+	It("reports missing and orphaned GatewayParameters", func() {
+		resources.Gateways[0].Annotations = map[string]string{wellknown.GatewayParametersAnnotationName: "missing"}
+		resources.GatewayParameters = []v1alpha1.GatewayParameters{
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "orphaned"}},
+		}
+
+		err := internal.CheckGatewayParameters(printer, resources)
+		Expect(err).To(MatchError(ContainSubstring("Gateway default.http references the GatewayParameters default.missing, which does not exist")))
+		Expect(printer.CheckResult.Messages).To(ConsistOf("Warning: GatewayParameters default.orphaned is not referenced by any Gateway"))
+	})
+
+	It("lists the resources with a client", func() {
+		cli := fake.NewClientBuilder().WithScheme(scheme.NewScheme()).WithObjects(
+			&resources.GatewayClasses[0],
+			&resources.Gateways[0],
+			&v1alpha1.GatewayParameters{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "params"}},
+		).Build()
+
+		listed, err := internal.ListGatewayApiResources(context.Background(), cli)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listed.GatewayClasses).To(HaveLen(1))
+		Expect(listed.Gateways).To(HaveLen(1))
+		Expect(listed.GatewayParameters).To(HaveLen(1))
+		Expect(listed.InUse()).To(BeTrue())
+	})
+})
@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gateway2/pkg/api/gateway.gloo.solo.io/v1alpha1"
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+var (
+	ErrGatewayApiNotInstalled = eris.New("the Kubernetes Gateway API CRDs are not installed")
+)
+
+// GatewayApiResources are the Kubernetes Gateway API resources of a cluster, along with the GatewayParameters that
+// configure the proxies deployed for them.
+type GatewayApiResources struct {
+	GatewayClasses    []gwv1.GatewayClass
+	Gateways          []gwv1.Gateway
+	HTTPRoutes        []gwv1.HTTPRoute
+	ReferenceGrants   []gwv1beta1.ReferenceGrant
+	GatewayParameters []v1alpha1.GatewayParameters
+}
+
+// ListGatewayApiResources lists the resources in every namespace. It returns ErrGatewayApiNotInstalled if the Gateway
+// API CRDs are not installed. The GatewayParameters CRD is installed with Gloo Gateway only, so it is optional.
+func ListGatewayApiResources(ctx context.Context, cli client.Client) (*GatewayApiResources, error) {
+	var classes gwv1.GatewayClassList
+	if err := cli.List(ctx, &classes); err != nil {
+		if isNotInstalled(err) {
+			return nil, ErrGatewayApiNotInstalled
+		}
+		return nil, eris.Wrap(err, "listing GatewayClasses")
+	}
+	var gateways gwv1.GatewayList
+	if err := cli.List(ctx, &gateways); err != nil {
+		return nil, eris.Wrap(err, "listing Gateways")
+	}
+	var routes gwv1.HTTPRouteList
+	if err := cli.List(ctx, &routes); err != nil {
+		return nil, eris.Wrap(err, "listing HTTPRoutes")
+	}
+	var grants gwv1beta1.ReferenceGrantList
+	if err := cli.List(ctx, &grants); err != nil && !isNotInstalled(err) {
+		return nil, eris.Wrap(err, "listing ReferenceGrants")
+	}
+	var parameters v1alpha1.GatewayParametersList
+	if err := cli.List(ctx, &parameters); err != nil && !isNotInstalled(err) {
+		return nil, eris.Wrap(err, "listing GatewayParameters")
+	}
+	return &GatewayApiResources{
+		GatewayClasses:    classes.Items,
+		Gateways:          gateways.Items,
+		HTTPRoutes:        routes.Items,
+		ReferenceGrants:   grants.Items,
+		GatewayParameters: parameters.Items,
+	}, nil
+}
+
+func isNotInstalled(err error) bool {
+	return meta.IsNoMatchError(err) || apierrors.IsNotFound(err)
+}
+
+// InUse returns true if a GatewayClass is managed by Gloo Gateway, or a Gateway uses the default Gloo Gateway class.
+// The checks are skipped otherwise, as the resources belong to other implementations.
+func (r *GatewayApiResources) InUse() bool {
+	if len(r.managedClasses()) > 0 {
+		return true
+	}
+	for _, gw := range r.Gateways {
+		if gw.Spec.GatewayClassName == wellknown.GatewayClassName {
+			return true
+		}
+	}
+	return false
+}
+
+// managedClasses returns the names of the GatewayClasses managed by Gloo Gateway
+func (r *GatewayApiResources) managedClasses() map[string]bool {
+	classes := map[string]bool{}
+	for _, gc := range r.GatewayClasses {
+		if gc.Spec.ControllerName == wellknown.GatewayControllerName {
+			classes[gc.Name] = true
+		}
+	}
+	return classes
+}
+
+// managedGateways returns the Gateways of the GatewayClasses managed by Gloo Gateway
+func (r *GatewayApiResources) managedGateways() []*gwv1.Gateway {
+	classes := r.managedClasses()
+	var gateways []*gwv1.Gateway
+	for i := range r.Gateways {
+		if classes[string(r.Gateways[i].Spec.GatewayClassName)] {
+			gateways = append(gateways, &r.Gateways[i])
+		}
+	}
+	return gateways
+}
+
+func (r *GatewayApiResources) gateway(namespace, name string) *gwv1.Gateway {
+	for i := range r.Gateways {
+		if r.Gateways[i].Namespace == namespace && r.Gateways[i].Name == name {
+			return &r.Gateways[i]
+		}
+	}
+	return nil
+}
+
+func (r *GatewayApiResources) referenceGrants(namespace string) []gwv1beta1.ReferenceGrant {
+	var grants []gwv1beta1.ReferenceGrant
+	for _, grant := range r.ReferenceGrants {
+		if grant.Namespace == namespace {
+			grants = append(grants, grant)
+		}
+	}
+	return grants
+}
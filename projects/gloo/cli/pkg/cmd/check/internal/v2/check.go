@@ -3,28 +3,71 @@ package v2
 import (
 	"context"
 
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/check/internal"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
 )
 
-type CheckFunc = func(ctx context.Context, printer printers.P, opts *options.Options) error
+// checks are the Gateway API checks, along with the names they are excluded with
+var checks = []struct {
+	name  string
+	check internal.GatewayApiCheck
+}{
+	{name: "gateway-classes", check: internal.CheckGatewayClasses},
+	{name: "kube-gateways", check: internal.CheckGateways},
+	{name: "http-routes", check: internal.CheckHTTPRoutes},
+	{name: "reference-grants", check: internal.CheckReferenceGrants},
+	{name: "gateway-parameters", check: internal.CheckGatewayParameters},
+}
 
+// Check runs the checks of the Kubernetes Gateway API resources handled by Gloo Gateway. They are skipped if the
+// Gateway API CRDs are not installed, or if no GatewayClass is managed by Gloo Gateway.
 func Check(ctx context.Context, printer printers.P, opts *options.Options) error {
-	checks := []CheckFunc{
-		internal.CheckConnection,
-		internal.CheckDeployments,
-		internal.CheckGatewayClass,
-		internal.CheckGatewys,
-		internal.CheckHTTPRoutes,
+	var excluded []string
+	for _, check := range checks {
+		if !included(opts, check.name) {
+			excluded = append(excluded, check.name)
+		}
+	}
+	if len(excluded) == len(checks) {
+		return nil
 	}
 
-	for _, check := range checks {
-		if err := check(ctx, printer, opts); err != nil {
-			return err
+	cli, err := helpers.GatewayApiClient(opts.Top.KubeContext)
+	if err != nil {
+		return err
+	}
+	resources, err := internal.ListGatewayApiResources(ctx, cli)
+	if err != nil {
+		if eris.Is(err, internal.ErrGatewayApiNotInstalled) {
+			return nil
 		}
+		return err
+	}
+	if !resources.InUse() {
+		return nil
 	}
 
-	return nil
+	var multiErr *multierror.Error
+	for _, check := range checks {
+		if !included(opts, check.name) {
+			continue
+		}
+		if err := check.check(printer, resources); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
 
+func included(opts *options.Options, name string) bool {
+	for _, excluded := range opts.Top.CheckName {
+		if excluded == name {
+			return false
+		}
+	}
+	return true
 }
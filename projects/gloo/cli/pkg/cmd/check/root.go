@@ -5,14 +5,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
-
-	"github.com/solo-io/gloo/pkg/utils/kubeutils"
+	"strings"
+	"time"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/rotisserie/eris"
 	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/certcheck"
+	v2 "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/check/internal/v2"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
@@ -31,8 +33,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 var (
@@ -73,15 +77,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 			printer := printers.P{OutputType: opts.Top.Output}
 			printer.CheckResult = printer.NewCheckResult()
 
-			// TODO add back the v2 check (probably better to pass in as a flag rather than try to infer it)
-			// var err error
-			// call v2 check if k8s gateway crds are detected, otherwise call v1 check
-			// isV2 := detectCrdsV2(opts.Top.KubeContext)
-			// if isV2 {
-			// 	err = v2.Check(ctx, printer, opts)
-			// } else {
 			err := CheckResources(ctx, printer, opts)
-			// }
 
 			if err != nil {
 				// Not returning error here because this shouldn't propagate as a standard CLI error, which prints usage.
@@ -92,10 +88,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 				printer.AppendMessage("No problems detected.")
 			}
 
-			// TODO add back the v2 check
-			// if !isV2 {
 			CheckMulticlusterResources(ctx, printer, opts)
-			// }
 
 			if opts.Top.Output.IsJSON() {
 				printer.PrintChecks(new(bytes.Buffer))
@@ -111,6 +104,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	flagutils.AddResourceNamespaceFlag(pflags, &opts.Top.ResourceNamespaces)
 	flagutils.AddExcludeCheckFlag(pflags, &opts.Top.CheckName)
 	flagutils.AddReadOnlyFlag(pflags, &opts.Top.ReadOnly)
+	pflags.DurationVar(&opts.Check.CertificateExpiryWarning, "certificate-expiry-warning", 30*24*time.Hour, "warn about the certificates of TLS secrets that expire within this duration")
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
@@ -215,6 +209,13 @@ func CheckResources(ctx context.Context, printer printers.P, opts *options.Optio
 		}
 	}
 
+	if included := doesNotContain(opts.Top.CheckName, "certificates"); included {
+		err := checkCertificates(ctx, printer, opts, namespaces)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+
 	if included := doesNotContain(opts.Top.CheckName, "virtual-services"); included {
 		err = checkVirtualServices(ctx, printer, opts, namespaces, knownUpstreams, knownAuthConfigs, knownRateLimitConfigs, knownVirtualHostOptions, knownRouteOptions)
 		if err != nil {
@@ -229,6 +230,11 @@ func CheckResources(ctx context.Context, printer printers.P, opts *options.Optio
 		}
 	}
 
+	// the Kubernetes Gateway API checks are excluded by name on their own
+	if err := v2.Check(ctx, printer, opts); err != nil {
+		multiErr = multierror.Append(multiErr, err)
+	}
+
 	if included := doesNotContain(opts.Top.CheckName, "proxies"); included {
 		err := checkProxies(ctx, printer, opts, namespaces, opts.Metadata.GetNamespace(), deployments, deploymentsIncluded, settings)
 		if err != nil {
@@ -953,6 +959,134 @@ func checkSecrets(ctx context.Context, printer printers.P, _ *options.Options, n
 	return nil
 }
 
+// checkCertificates checks the TLS secrets referenced by virtual services, gateways, upstreams and Kubernetes Gateway
+// API listeners for expired or expiring certificates, mismatched keys, incomplete chains and SNI domains the certificates
+// are not valid for.
+func checkCertificates(ctx context.Context, printer printers.P, opts *options.Options, namespaces []string) error {
+	printer.AppendCheck("Checking certificates... ")
+	var multiErr *multierror.Error
+	inventory := certcheck.NewInventory()
+	for _, ns := range namespaces {
+		vsClient, err := helpers.VirtualServiceClient(ctx, []string{ns})
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+			continue
+		}
+		virtualServices, err := vsClient.List(ns, clients.ListOpts{})
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+			continue
+		}
+		for _, vs := range virtualServices {
+			inventory.AddVirtualService(vs)
+		}
+
+		gatewayClient, err := helpers.GatewayClient(ctx, []string{ns})
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+			continue
+		}
+		gateways, err := gatewayClient.List(ns, clients.ListOpts{})
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+			continue
+		}
+		for _, gateway := range gateways {
+			inventory.AddGateway(gateway)
+		}
+
+		upstreamClient, err := helpers.UpstreamClient(ctx, []string{ns})
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+			continue
+		}
+		upstreams, err := upstreamClient.List(ns, clients.ListOpts{})
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+			continue
+		}
+		for _, upstream := range upstreams {
+			inventory.AddUpstream(upstream)
+		}
+	}
+
+	gwClient, err := helpers.GatewayApiClient(opts.Top.KubeContext)
+	if err != nil {
+		multiErr = multierror.Append(multiErr, err)
+	} else {
+		for _, ns := range namespaces {
+			var kubeGateways gwv1.GatewayList
+			err := gwClient.List(ctx, &kubeGateways, client.InNamespace(ns))
+			if err != nil {
+				// the Gateway API CRDs are optional
+				if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+					break
+				}
+				multiErr = multierror.Append(multiErr, err)
+				continue
+			}
+			for i := range kubeGateways.Items {
+				inventory.AddKubeGateway(&kubeGateways.Items[i])
+			}
+		}
+	}
+
+	// secrets referenced by Gateway API listeners may live outside of the watched namespaces
+	var secrets v1.SecretList
+	listed := map[string]bool{}
+	for _, ref := range inventory.Secrets() {
+		if listed[ref.GetNamespace()] {
+			continue
+		}
+		listed[ref.GetNamespace()] = true
+		secretClient, err := helpers.GetSecretClient(ctx, []string{ref.GetNamespace()})
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+			continue
+		}
+		nsSecrets, err := secretClient.List(ref.GetNamespace(), clients.ListOpts{})
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+			continue
+		}
+		secrets = append(secrets, nsSecrets...)
+	}
+
+	reports := certcheck.Check(inventory, secrets, certcheck.Options{
+		Now:           time.Now(),
+		ExpiryWarning: opts.Check.CertificateExpiryWarning,
+	})
+	printer.AppendCertificates(reports)
+	var warnings []string
+	for _, report := range reports {
+		for _, problem := range report.Errors() {
+			errMessage := fmt.Sprintf("Found certificate problem in secret %s, referenced by %s: %s\n", report.Secret, renderReferences(report.References), problem.Message)
+			multiErr = multierror.Append(multiErr, errors.New(errMessage))
+		}
+		for _, problem := range report.Warnings() {
+			warnings = append(warnings, fmt.Sprintf("Warning: certificate problem in secret %s, referenced by %s: %s", report.Secret, renderReferences(report.References), problem.Message))
+		}
+	}
+
+	if multiErr != nil {
+		printer.AppendStatus("certificates", fmt.Sprintf("%v Errors!", multiErr.Len()))
+		return multiErr
+	}
+	printer.AppendStatus("certificates", "OK")
+	for _, warning := range warnings {
+		printer.AppendMessage(warning)
+	}
+	return nil
+}
+
+func renderReferences(references []*certcheck.Reference) string {
+	rendered := make([]string, 0, len(references))
+	for _, reference := range references {
+		rendered = append(rendered, fmt.Sprintf("%s %s (%s)", reference.Kind, reference.Resource, reference.Field))
+	}
+	return strings.Join(rendered, ", ")
+}
+
 func renderMetadata(metadata *core.Metadata) string {
 	return renderNamespaceName(metadata.GetNamespace(), metadata.GetName())
 }
@@ -998,29 +1132,3 @@ func isCrdNotFoundErr(crd crd.Crd, err error) bool {
 		return false
 	}
 }
-
-// copied from projects/gloo/cli/pkg/cmd/install/v2/install.go (TODO: dedupe/clean up)
-func detectCrdsV2(kubeContext string) bool {
-	cfg, err := kubeutils.GetRestConfigWithKubeContext(kubeContext)
-	if err != nil {
-		return false
-	}
-	discClient, err := discovery.NewDiscoveryClientForConfig(cfg)
-	if err != nil {
-		return false
-	}
-
-	groups, err := discClient.ServerGroups()
-	if err != nil {
-		return false
-	}
-
-	// Check if gateway group exists
-	for _, group := range groups.Groups {
-		if group.Name == "gateway.networking.k8s.io" {
-			return true
-		}
-	}
-
-	return false
-}
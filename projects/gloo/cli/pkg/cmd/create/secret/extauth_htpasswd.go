@@ -0,0 +1,95 @@
+package secret
+
+import (
+	"context"
+	"os"
+
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/argsutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/surveyutils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	MissingHtpasswdFileError = errors.Errorf("htpasswd file must be provided for htpasswd secret")
+)
+
+type htpasswdSecret struct {
+	HtpasswdFilename string
+}
+
+func ExtAuthHtpasswdCmd(opts *options.Options) *cobra.Command {
+	meta := &opts.Metadata
+	input := &htpasswdSecret{}
+	cmd := &cobra.Command{
+		Use:   "htpasswd",
+		Short: `Create an htpasswd secret with the given name`,
+		Long: "Create an htpasswd secret with the given name. The htpasswd secret contains users and hashed passwords " +
+			"in the Apache htpasswd format, and is used by basic auth in the open source external auth server. " +
+			"The file contents will be stored in the secret data under the key `htpasswd`.",
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := argsutils.MetadataArgsParse(opts, args); err != nil {
+				return err
+			}
+			if opts.Top.Interactive {
+				if err := htpasswdSecretArgsInteractive(opts.Top.Ctx, meta, input); err != nil {
+					return err
+				}
+			}
+			if err := createHtpasswdSecret(opts.Top.Ctx, meta, input, opts.Create.DryRun, opts.Top.Output); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&input.HtpasswdFilename, "htpasswd-file", "", "filename of the htpasswd file to be stored in secret")
+	return cmd
+}
+
+func htpasswdSecretArgsInteractive(ctx context.Context, meta *core.Metadata, input *htpasswdSecret) error {
+	if err := surveyutils.InteractiveNamespace(ctx, &meta.Namespace); err != nil {
+		return err
+	}
+
+	if err := cliutil.GetStringInput("Name of secret:", &meta.Name); err != nil {
+		return err
+	}
+
+	if err := cliutil.GetStringInput("filename of the htpasswd file:", &input.HtpasswdFilename); err != nil {
+		return err
+	}
+	return nil
+}
+
+func createHtpasswdSecret(ctx context.Context, meta *core.Metadata, input *htpasswdSecret, dryRun bool, outputType printers.OutputType) error {
+	if input.HtpasswdFilename == "" {
+		return MissingHtpasswdFileError
+	}
+	htpasswd, err := os.ReadFile(input.HtpasswdFilename)
+	if err != nil {
+		return errors.Wrapf(err, "reading htpasswd file: %v", input.HtpasswdFilename)
+	}
+	secret := &gloov1.Secret{
+		Metadata: meta,
+		Kind: &gloov1.Secret_Htpasswd{
+			Htpasswd: &gloov1.HtpasswdSecret{
+				Htpasswd: string(htpasswd),
+			},
+		},
+	}
+	if !dryRun {
+		secretClient := helpers.MustSecretClient(ctx)
+		if _, err := secretClient.Write(secret, clients.WriteOpts{Ctx: ctx}); err != nil {
+			return err
+		}
+	}
+	return printers.PrintSecrets(gloov1.SecretList{secret}, outputType)
+}
@@ -0,0 +1,45 @@
+package secret_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/create/secret"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+var _ = Describe("ExtauthHtpasswd", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() { cancel() })
+
+	It("should create secret", func() {
+		htpasswd := "user:$apr1$TYiryv0/$8BvzLUO9IfGPGGsPnAgSu1\n"
+		htpasswdFile := filepath.Join(GinkgoT().TempDir(), "htpasswd")
+		Expect(os.WriteFile(htpasswdFile, []byte(htpasswd), 0644)).NotTo(HaveOccurred())
+
+		err := testutils.Glooctl("create secret htpasswd --name users --namespace gloo-system --htpasswd-file " + htpasswdFile)
+		Expect(err).NotTo(HaveOccurred())
+		secret, err := helpers.MustSecretClient(ctx).Read("gloo-system", "users", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.GetHtpasswd().GetHtpasswd()).To(Equal(htpasswd))
+	})
+	It("should error when no htpasswd file provided", func() {
+		err := testutils.Glooctl("create secret htpasswd --name users --namespace gloo-system")
+		Expect(err).To(Equal(secret.MissingHtpasswdFileError))
+	})
+})
@@ -0,0 +1,99 @@
+package secret
+
+import (
+	"context"
+	"os"
+
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/argsutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/surveyutils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gcp/tokenserver"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	MissingServiceAccountKeyFileError = errors.Errorf("service account key file must be provided for gcp secret")
+)
+
+type gcpSecret struct {
+	ServiceAccountKeyFilename string
+}
+
+func gcpCmd(opts *options.Options) *cobra.Command {
+	meta := &opts.Metadata
+	input := &gcpSecret{}
+	cmd := &cobra.Command{
+		Use:   "gcp",
+		Short: `Create a GCP service account secret with the given name`,
+		Long: "Create a GCP service account secret with the given name. The secret contains the JSON key of a service " +
+			"account, and is used to issue the ID tokens for GCP upstreams when referenced by `settings.gloo.gcpOptions`. " +
+			"The key will be stored in the secret data under the key `service-account.json`.",
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := argsutils.MetadataArgsParse(opts, args); err != nil {
+				return err
+			}
+			if opts.Top.Interactive {
+				if err := gcpSecretArgsInteractive(opts.Top.Ctx, meta, input); err != nil {
+					return err
+				}
+			}
+			if err := createGcpSecret(opts.Top.Ctx, meta, input, opts.Create.DryRun, opts.Top.Output); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&input.ServiceAccountKeyFilename, "service-account-key-file", "", "filename of the JSON key of the service account to be stored in secret")
+	return cmd
+}
+
+func gcpSecretArgsInteractive(ctx context.Context, meta *core.Metadata, input *gcpSecret) error {
+	if err := surveyutils.InteractiveNamespace(ctx, &meta.Namespace); err != nil {
+		return err
+	}
+
+	if err := cliutil.GetStringInput("Name of secret:", &meta.Name); err != nil {
+		return err
+	}
+
+	if err := cliutil.GetStringInput("filename of the service account key:", &input.ServiceAccountKeyFilename); err != nil {
+		return err
+	}
+	return nil
+}
+
+func createGcpSecret(ctx context.Context, meta *core.Metadata, input *gcpSecret, dryRun bool, outputType printers.OutputType) error {
+	if input.ServiceAccountKeyFilename == "" {
+		return MissingServiceAccountKeyFileError
+	}
+	serviceAccountKey, err := os.ReadFile(input.ServiceAccountKeyFilename)
+	if err != nil {
+		return errors.Wrapf(err, "reading service account key file: %v", input.ServiceAccountKeyFilename)
+	}
+	if _, err := tokenserver.ParseServiceAccountKey(serviceAccountKey); err != nil {
+		return err
+	}
+	secret := &gloov1.Secret{
+		Metadata: meta,
+		Kind: &gloov1.Secret_Gcp{
+			Gcp: &gloov1.GcpSecret{
+				ServiceAccountKey: string(serviceAccountKey),
+			},
+		},
+	}
+	if !dryRun {
+		secretClient := helpers.MustSecretClient(ctx)
+		if _, err := secretClient.Write(secret, clients.WriteOpts{Ctx: ctx}); err != nil {
+			return err
+		}
+	}
+	return printers.PrintSecrets(gloov1.SecretList{secret}, outputType)
+}
@@ -35,11 +35,13 @@ func CreateCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobr
 	}
 	cmd.AddCommand(awsCmd(opts))
 	cmd.AddCommand(azureCmd(opts))
+	cmd.AddCommand(gcpCmd(opts))
 	cmd.AddCommand(headerCmd(opts))
 	cmd.AddCommand(tlsCmd(opts))
 	cmd.AddCommand(ExtAuthApiKeyCmd(opts))
 	cmd.AddCommand(ExtAuthOathCmd(opts))
 	cmd.AddCommand(ExtAuthAccountCredentialsCmd(opts))
+	cmd.AddCommand(ExtAuthHtpasswdCmd(opts))
 	cmd.AddCommand(EncryptionKeyCmd(opts))
 	flagutils.AddVaultSecretFlags(cmd.PersistentFlags(), &opts.Create.Vault)
 	cliutils.ApplyOptions(cmd, optionsFunc)
@@ -0,0 +1,249 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/pkg/utils/kubeutils/portforward"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options/contextoptions"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/envoyadmin"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/cobra"
+)
+
+func clusterHealthCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cluster-health",
+		Short: "show the health of the hosts of each upstream, as seen by one of the proxy instances",
+		Long: "Reads the clusters of one of the proxy instances from the Envoy admin API, and shows the health " +
+			"status, weight and request counts of their hosts. Clusters are named after the Upstream they were " +
+			"translated from.",
+		Example: `# show the hosts of every upstream
+glooctl proxy cluster-health
+
+# show the hosts of a single upstream
+glooctl proxy cluster-health --upstream gloo-system.default-petstore-8080`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withEnvoyAdmin(opts, func(ctx context.Context, client *envoyadmin.Client) error {
+				clusters, err := client.Clusters(ctx)
+				if err != nil {
+					return err
+				}
+				upstreams, err := helpers.MustUpstreamClient(ctx).List("", clients.ListOpts{Ctx: ctx})
+				if err != nil {
+					return err
+				}
+				envoyadmin.PrintClustersHealth(envoyadmin.ClustersHealth(clusters, upstreams, opts.Proxy.Health.Upstream), cmd.OutOrStdout())
+				return nil
+			})
+		},
+	}
+	cmd.Flags().StringVar(&opts.Proxy.Health.Upstream, "upstream", "", "only show the hosts of this upstream, given as name or namespace.name")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func logLevelCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log-level",
+		Short: "temporarily change the log level of Envoy components on one of the proxy instances",
+		Long: "Sets the level of the given Envoy loggers, waits for the duration or for an interrupt, then reverts the " +
+			"loggers to their previous levels. The logger `all` sets the level of every logger. Without levels, the " +
+			"current level of every logger is printed.",
+		Example: `# debug the router and the upstream connections for 5 minutes
+glooctl proxy log-level --level router=debug --level connection=debug --duration 5m
+
+# list the loggers and their levels
+glooctl proxy log-level`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			levels, err := envoyadmin.ParseLogLevels(opts.Proxy.LogLevel.Levels)
+			if err != nil {
+				return err
+			}
+			return withEnvoyAdmin(opts, func(ctx context.Context, client *envoyadmin.Client) error {
+				out := cmd.OutOrStdout()
+				if len(levels) == 0 {
+					current, err := client.LogLevels(ctx)
+					if err != nil {
+						return err
+					}
+					printLogLevels(current, out)
+					return nil
+				}
+				// interrupting the command reverts the log levels early
+				ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+				defer stop()
+				err := envoyadmin.SetLogLevelsTemporarily(ctx, client, levels, opts.Proxy.LogLevel.Duration, func(previous map[string]string) {
+					fmt.Fprintf(out, "log levels set, reverting them in %v or on interrupt\n", opts.Proxy.LogLevel.Duration)
+				})
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(out, "log levels reverted")
+				return nil
+			})
+		},
+	}
+	cmd.Flags().StringSliceVar(&opts.Proxy.LogLevel.Levels, "level", nil, "level of an Envoy logger, given as <logger>=<level> (can be repeated)")
+	cmd.Flags().DurationVar(&opts.Proxy.LogLevel.Duration, "duration", 5*time.Minute, "how long the log levels are changed for")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func tapCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tap",
+		Short: "capture the requests and responses of a route on one of the proxy instances",
+		Long: "Streams the full requests and responses matching the host, path prefix and headers from the Envoy " +
+			"admin API, as json traces, until the count is reached or the timeout elapses. This requires the " +
+			"listener to have a tap filter with an admin sink configured with the config id.",
+		Example: `# capture 5 requests to /api/pets on petstore.example.com
+glooctl proxy tap --host petstore.example.com --prefix /api/pets --count 5`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tapOpts := opts.Proxy.Tap
+			headers, err := envoyadmin.ParseTapHeaders(tapOpts.Headers)
+			if err != nil {
+				return err
+			}
+			request := envoyadmin.NewTapRequest(tapOpts.ConfigId, envoyadmin.TapMatch{
+				Host:       tapOpts.Host,
+				PathPrefix: tapOpts.PathPrefix,
+				Headers:    headers,
+			})
+			return withEnvoyAdmin(opts, func(ctx context.Context, client *envoyadmin.Client) error {
+				ctx, cancel := context.WithTimeout(ctx, tapOpts.Timeout)
+				defer cancel()
+				ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+				defer stop()
+
+				out := cmd.OutOrStdout()
+				captured := 0
+				var writeErr error
+				err := client.Tap(ctx, request, func(trace json.RawMessage) bool {
+					captured++
+					if writeErr = printTrace(trace, out); writeErr != nil {
+						return false
+					}
+					return tapOpts.Count <= 0 || captured < tapOpts.Count
+				})
+				if err != nil {
+					return err
+				}
+				if writeErr != nil {
+					return writeErr
+				}
+				fmt.Fprintf(cmd.ErrOrStderr(), "captured %d requests\n", captured)
+				return nil
+			})
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&opts.Proxy.Tap.ConfigId, "config-id", "glooctl", "admin config id of the tap filter")
+	flags.StringVar(&opts.Proxy.Tap.Host, "host", "", "only capture requests with this host")
+	flags.StringVar(&opts.Proxy.Tap.PathPrefix, "prefix", "", "only capture requests whose path starts with this prefix")
+	flags.StringSliceVar(&opts.Proxy.Tap.Headers, "header", nil, "only capture requests with this header, given as name:value (can be repeated)")
+	flags.IntVar(&opts.Proxy.Tap.Count, "count", 10, "number of requests to capture, 0 to capture until the timeout")
+	flags.DurationVar(&opts.Proxy.Tap.Timeout, "timeout", time.Minute, "how long requests are captured for")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func responseFlagsCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "response-flags",
+		Short: "summarize the response flags of one of the proxy instances over a time window",
+		Long: "Samples the stats of one of the proxy instances at the start and the end of the window, and counts " +
+			"the responses of each Envoy response flag, such as UH (no healthy upstream) or UT (upstream request " +
+			"timeout), per upstream cluster or http listener.",
+		Example: `# summarize the response flags over the next 30 seconds
+glooctl proxy response-flags --window 30s`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withEnvoyAdmin(opts, func(ctx context.Context, client *envoyadmin.Client) error {
+				before, err := client.Stats(ctx)
+				if err != nil {
+					return err
+				}
+				window := opts.Proxy.ResponseFlags.Window
+				fmt.Fprintf(cmd.ErrOrStderr(), "sampling stats for %v\n", window)
+				select {
+				case <-time.After(window):
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+				after, err := client.Stats(ctx)
+				if err != nil {
+					return err
+				}
+				envoyadmin.PrintResponseFlags(envoyadmin.SummarizeResponseFlags(before, after), cmd.OutOrStdout())
+				return nil
+			})
+		},
+	}
+	cmd.Flags().DurationVar(&opts.Proxy.ResponseFlags.Window, "window", 30*time.Second, "time window the response flags are counted over")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+// withEnvoyAdmin port-forwards the admin port of one of the proxy instances for the duration of fn.
+// The port-forward runs in process rather than with kubectl, so that it survives interrupts sent to the command, which
+// the log-level and tap commands rely on to clean up.
+func withEnvoyAdmin(opts *options.Options, fn func(ctx context.Context, client *envoyadmin.Client) error) error {
+	ctx := opts.Top.Ctx
+	logger := cliutil.GetLogger()
+	var outWriter io.Writer = logger
+	if opts.Top.Verbose {
+		outWriter = io.MultiWriter(logger, os.Stdout)
+	}
+	portForwarder := portforward.NewApiPortForwarder(
+		portforward.WithDeployment(opts.Proxy.Name, opts.Metadata.GetNamespace()),
+		portforward.WithKubeContext(contextoptions.KubecontextFrom(ctx)),
+		portforward.WithRemotePort(int(defaults.EnvoyAdminPort)),
+		portforward.WithWriters(outWriter, io.MultiWriter(logger, os.Stderr)),
+	)
+	if err := portForwarder.Start(
+		ctx,
+		retry.LastErrorOnly(true),
+		retry.Delay(100*time.Millisecond),
+		retry.DelayType(retry.BackOffDelay),
+		retry.Attempts(5),
+	); err != nil {
+		return err
+	}
+	defer portForwarder.Close()
+
+	return fn(ctx, envoyadmin.NewClient(portForwarder.Address(), &http.Client{}))
+}
+
+func printLogLevels(levels map[string]string, out io.Writer) {
+	loggers := make([]string, 0, len(levels))
+	for logger := range levels {
+		loggers = append(loggers, logger)
+	}
+	sort.Strings(loggers)
+	for _, logger := range loggers {
+		fmt.Fprintf(out, "%v: %v\n", logger, levels[logger])
+	}
+}
+
+func printTrace(trace json.RawMessage, out io.Writer) error {
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, trace, "", "  "); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(out, indented.String())
+	return err
+}
@@ -0,0 +1,17 @@
+package diff
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+// ProxyCommands adds the snapshot and diff commands to the proxy command. They are kept out of the proxy command's
+// package because the offline translation of files depends on every gloo plugin, and that package is used by tests
+// of the plugins.
+func ProxyCommands(opts *options.Options) cliutils.OptionsFunc {
+	return func(cmd *cobra.Command) {
+		cmd.AddCommand(SnapshotCmd(opts))
+		cmd.AddCommand(DiffCmd(opts))
+	}
+}
@@ -0,0 +1,68 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/proxydiff"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+func DiffCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff <before> [after]",
+		Short: "compare the Envoy config of a proxy between two configuration sources",
+		Long: "Prints the listeners, routes, clusters and endpoints that were added, removed or modified between two " +
+			"configuration sources, and the fields that changed in each modified resource. Resources, and the elements " +
+			"of lists such as virtual hosts and endpoints, are matched by name, so the order they are served in does " +
+			"not show as a change, except for lists envoy evaluates in order such as routes and http filters. " +
+			"The second source is the cluster by default.\n\n" + sourcesHelp,
+		Example: `# compare the config served to the gateway-proxy with a snapshot saved before a deploy
+glooctl proxy diff before.yaml
+
+# compare the config served by two clusters
+glooctl proxy diff cluster:staging cluster:production
+
+# preview the change to the config of a change to the resources
+glooctl proxy diff files:./gloo-config/ files:./gloo-config-changed/`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			after := clusterSource
+			if len(args) > 1 {
+				after = args[1]
+			}
+			return diffProxy(opts, args[0], after, cmd.OutOrStdout())
+		},
+	}
+	opts.Proxy.Diff.Output = printers.TABLE
+	cmd.Flags().VarP(&opts.Proxy.Diff.Output, flagutils.OutputFlag, "o", "output format: (table, json)")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func diffProxy(opts *options.Options, beforeSource, afterSource string, out io.Writer) error {
+	before, err := captureSnapshot(opts, beforeSource)
+	if err != nil {
+		return err
+	}
+	after, err := captureSnapshot(opts, afterSource)
+	if err != nil {
+		return err
+	}
+	result := proxydiff.Diff(before, after)
+	if !opts.Proxy.Diff.Output.IsJSON() {
+		proxydiff.PrintText(result, out)
+		return nil
+	}
+	b, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(out, string(b))
+	return err
+}
@@ -0,0 +1,84 @@
+package diff_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+)
+
+const diffResources = `
+apiVersion: gateway.solo.io/v1
+kind: Gateway
+metadata:
+  name: gateway-proxy
+  namespace: gloo-system
+spec:
+  bindAddress: '::'
+  bindPort: 8080
+  httpGateway: {}
+---
+apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: petstore
+  namespace: gloo-system
+spec:
+  virtualHost:
+    domains: ['petstore.example.com']
+    routes:
+    - matchers:
+      - prefix: /
+      routeAction:
+        single:
+          upstream:
+            name: petstore
+            namespace: gloo-system
+---
+apiVersion: gloo.solo.io/v1
+kind: Upstream
+metadata:
+  name: petstore
+  namespace: gloo-system
+spec:
+  static:
+    hosts:
+    - addr: petstore.example.com
+      port: 8080
+`
+
+var _ = Describe("Diff", func() {
+
+	var before, after string
+
+	BeforeEach(func() {
+		dir := GinkgoT().TempDir()
+		before = filepath.Join(dir, "before.yaml")
+		after = filepath.Join(dir, "after.yaml")
+		Expect(os.WriteFile(before, []byte(diffResources), 0644)).To(Succeed())
+		changed := strings.Replace(diffResources, "port: 8080\n", "port: 9090\n", 1)
+		Expect(os.WriteFile(after, []byte(changed), 0644)).To(Succeed())
+	})
+
+	It("compares the translation of two sets of files", func() {
+		out, err := testutils.GlooctlOut("proxy diff --name gateway-proxy files:" + before + " files:" + after)
+		Expect(err).NotTo(HaveOccurred())
+		// static upstreams inline their endpoints in the cluster
+		Expect(out).To(ContainSubstring("~ cluster petstore_gloo-system"))
+		Expect(out).To(ContainSubstring("+ loadAssignment.endpoints[0].lbEndpoints[petstore.example.com:9090]"))
+		Expect(out).To(ContainSubstring("0 added, 0 removed, 1 modified"))
+	})
+
+	It("compares a saved snapshot", func() {
+		snapshot := filepath.Join(GinkgoT().TempDir(), "snapshot.yaml")
+		_, err := testutils.GlooctlOut("proxy snapshot --name gateway-proxy files:" + before + " --out " + snapshot)
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := testutils.GlooctlOut("proxy diff --name gateway-proxy " + snapshot + " files:" + before)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("no differences"))
+	})
+})
@@ -0,0 +1,97 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options/contextoptions"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/translate"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/proxydiff"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	clusterSource = "cluster"
+	filesSource   = "files:"
+)
+
+const sourcesHelp = `A configuration source is one of:
+  cluster               the configuration served by gloo in the current kube context
+  cluster:<context>     the configuration served by gloo in the given kube context
+  files:<path>[,<path>] the offline translation of the resources in the files and directories
+  <path>                a snapshot saved with glooctl proxy snapshot`
+
+func SnapshotCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot [source]",
+		Short: "save the Envoy config of a proxy, to compare it later with glooctl proxy diff",
+		Long: "Captures the listeners, routes, clusters and endpoints of a proxy from a configuration source, " +
+			"cluster by default, and saves them as yaml.\n\n" + sourcesHelp,
+		Example: `# save the config served to the gateway-proxy before a deploy
+glooctl proxy snapshot --out before.yaml
+
+# save the config of the resources in a directory
+glooctl proxy snapshot files:./gloo-config/ --out candidate.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			source := clusterSource
+			if len(args) > 0 {
+				source = args[0]
+			}
+			return saveSnapshot(opts, source, cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().StringVar(&opts.Proxy.Snapshot.File, "out", "", "file to save the snapshot to, if empty it is printed")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func saveSnapshot(opts *options.Options, source string, out io.Writer) error {
+	snap, err := captureSnapshot(opts, source)
+	if err != nil {
+		return err
+	}
+	if file := opts.Proxy.Snapshot.File; file != "" {
+		return snap.Save(file)
+	}
+	b, err := snap.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(out, string(b))
+	return err
+}
+
+// captures the configuration of the proxy of the options from a configuration source
+func captureSnapshot(opts *options.Options, source string) (*proxydiff.Snapshot, error) {
+	switch {
+	case source == clusterSource || strings.HasPrefix(source, clusterSource+":"):
+		ctx := opts.Top.Ctx
+		kubeContext := contextoptions.KubecontextFrom(ctx)
+		if strings.HasPrefix(source, clusterSource+":") {
+			kubeContext = strings.TrimPrefix(source, clusterSource+":")
+			top := contextoptions.ContextAccessibleFrom(ctx)
+			top.KubeContext = kubeContext
+			ctx = context.WithValue(ctx, "top", top)
+		}
+		dump, err := xdsinspection.GetGlooXdsDump(ctx, opts.Proxy.Name, opts.Metadata.GetNamespace(), false)
+		if err != nil {
+			return nil, err
+		}
+		description := clusterSource
+		if kubeContext != "" {
+			description = fmt.Sprintf("%v %v", clusterSource, kubeContext)
+		}
+		return proxydiff.FromXdsDump(description, dump)
+	case strings.HasPrefix(source, filesSource):
+		files := strings.Split(strings.TrimPrefix(source, filesSource), ",")
+		return translate.ProxySnapshot(opts.Top.Ctx, opts.Metadata.GetNamespace(), opts.Proxy.Name, files)
+	default:
+		return proxydiff.Load(source)
+	}
+}
@@ -28,6 +28,10 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	cmd.AddCommand(logsCmd(opts))
 	cmd.AddCommand(statsCmd(opts))
 	cmd.AddCommand(servedConfigCmd(opts))
+	cmd.AddCommand(clusterHealthCmd(opts))
+	cmd.AddCommand(logLevelCmd(opts))
+	cmd.AddCommand(tapCmd(opts))
+	cmd.AddCommand(responseFlagsCmd(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
@@ -49,6 +49,7 @@ func init() {
 		"ratelimitconfigs.ratelimit.solo.io",
 		"virtualhostoptions.gateway.solo.io",
 		"routeoptions.gateway.solo.io",
+		"canaries.gateway.solo.io",
 		"graphqlapis.graphql.gloo.solo.io",
 		// CRDs used for k8s gateway API integration:
 		"gatewayparameters.gateway.gloo.solo.io",
@@ -0,0 +1,425 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	solokubev1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1/kube/apis/gateway.solo.io/v1"
+	gwtranslator "github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/gloo/projects/gateway/pkg/utils"
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// limits of the Gateway API CRDs
+	maxListeners     = 64
+	maxRules         = 16
+	maxMatches       = 8
+	maxHostnames     = 16
+	secretKind       = "Secret"
+	serviceKind      = "Service"
+	routeOptionsKind = "RouteOption"
+)
+
+// Output holds the Gateway API resources converted from the edge resources,
+// and the report of the configuration that could not be converted exactly.
+type Output struct {
+	Gateways           []*gwv1.Gateway
+	HTTPRoutes         []*gwv1.HTTPRoute
+	ReferenceGrants    []*gwv1beta1.ReferenceGrant
+	RouteOptions       []*solokubev1.RouteOption
+	VirtualHostOptions []*solokubev1.VirtualHostOption
+	Report             []*ReportEntry
+}
+
+// Convert converts the edge Gateways, and the VirtualServices and RouteTables they select, into Gateway API
+// resources for the gloo-gateway GatewayClass.
+//
+// Each proxy becomes a Gateway, with a listener per edge Gateway. Delegation is resolved by the edge gateway
+// translator, and the routes of each VirtualService are flattened into HTTPRoutes. Route and virtual host options
+// are carried by RouteOptions and VirtualHostOptions, and cross namespace references to Services and Secrets are
+// allowed with ReferenceGrants. Only what the gateway2 translator supports is converted, anything else is reported.
+func Convert(ctx context.Context, snap *gloosnapshot.ApiSnapshot) *Output {
+	c := &converter{
+		ctx:             ctx,
+		snap:            snap,
+		report:          &report{},
+		referenceGrants: map[referenceGrantKey]*gwv1beta1.ReferenceGrant{},
+	}
+	output := &Output{}
+
+	gatewaysByProxy := utils.GatewaysByProxyName(snap.Gateways)
+	var proxyNames []string
+	for proxyName := range gatewaysByProxy {
+		proxyNames = append(proxyNames, proxyName)
+	}
+	sort.Strings(proxyNames)
+
+	for _, proxyName := range proxyNames {
+		gateways := gatewaysByProxy[proxyName]
+		gateways.Sort()
+		c.convertProxy(proxyName, gateways, output)
+	}
+
+	output.ReferenceGrants = c.sortedReferenceGrants()
+	output.Report = c.report.sorted()
+	return output
+}
+
+type converter struct {
+	ctx             context.Context
+	snap            *gloosnapshot.ApiSnapshot
+	report          *report
+	referenceGrants map[referenceGrantKey]*gwv1beta1.ReferenceGrant
+}
+
+// the state of the Gateway converted from the edge Gateways of a proxy
+type gatewayBuilder struct {
+	gateway *gwv1.Gateway
+	// VirtualHostOptions by the name of the listener they target
+	virtualHostOptions map[gwv1.SectionName]*solokubev1.VirtualHostOption
+	// the number of VirtualServices bound to each listener
+	virtualServices map[gwv1.SectionName]int
+}
+
+func (c *converter) convertProxy(proxyName string, gateways gatewayv1.GatewayList, output *Output) {
+	builder := &gatewayBuilder{
+		gateway: &gwv1.Gateway{
+			TypeMeta: metav1.TypeMeta{APIVersion: gwv1.GroupVersion.String(), Kind: wellknown.GatewayKind},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      proxyName,
+				Namespace: gateways[0].GetMetadata().GetNamespace(),
+			},
+			Spec: gwv1.GatewaySpec{GatewayClassName: wellknown.GatewayClassName},
+		},
+		virtualHostOptions: map[gwv1.SectionName]*solokubev1.VirtualHostOption{},
+		virtualServices:    map[gwv1.SectionName]int{},
+	}
+
+	reports := reporter.ResourceReports{}
+	for _, gateway := range gateways {
+		if gateway.GetMetadata().GetNamespace() != builder.gateway.GetNamespace() {
+			c.report.warn(gateway, "", "the Gateway is converted to a listener of Gateway %v.%v, in the namespace of the first edge Gateway of the proxy",
+				builder.gateway.GetNamespace(), proxyName)
+		}
+		switch gatewayType := gateway.GetGatewayType().(type) {
+		case *gatewayv1.Gateway_HttpGateway:
+			c.convertHttpGateway(proxyName, gateway, gatewayType.HttpGateway, builder, reports, output)
+		case *gatewayv1.Gateway_TcpGateway:
+			c.report.unsupported(gateway, "", "tcp gateways are not converted, only HTTP and HTTPS listeners are supported")
+		case *gatewayv1.Gateway_HybridGateway:
+			c.report.unsupported(gateway, "", "hybrid gateways are not converted, listeners cannot select routes by the source of the connection")
+		case *gatewayv1.Gateway_UdpGateway:
+			c.report.unsupported(gateway, "", "udp gateways are not converted, only HTTP and HTTPS listeners are supported")
+		default:
+			c.report.unsupported(gateway, "", "the gateway has no type, it is not converted")
+		}
+	}
+	c.report.addTranslationReports(reports)
+
+	if len(builder.gateway.Spec.Listeners) == 0 {
+		return
+	}
+	if len(builder.gateway.Spec.Listeners) > maxListeners {
+		c.report.warn(gateways[0], "", "Gateway %v.%v has %v listeners, more than the %v a Gateway supports, it must be split",
+			builder.gateway.GetNamespace(), proxyName, len(builder.gateway.Spec.Listeners), maxListeners)
+	}
+	output.Gateways = append(output.Gateways, builder.gateway)
+
+	var sectionNames []string
+	for sectionName := range builder.virtualHostOptions {
+		sectionNames = append(sectionNames, string(sectionName))
+	}
+	sort.Strings(sectionNames)
+	for _, sectionName := range sectionNames {
+		output.VirtualHostOptions = append(output.VirtualHostOptions, builder.virtualHostOptions[gwv1.SectionName(sectionName)])
+	}
+}
+
+func (c *converter) convertHttpGateway(
+	proxyName string,
+	gateway *gatewayv1.Gateway,
+	httpGateway *gatewayv1.HttpGateway,
+	builder *gatewayBuilder,
+	reports reporter.ResourceReports,
+	output *Output,
+) {
+	if gateway.GetOptions() != nil || gateway.GetUseProxyProto() != nil {
+		c.report.unsupported(gateway, "", "listener options are not converted")
+	}
+	if httpGateway.GetOptions() != nil {
+		c.report.unsupported(gateway, "", "http listener options are not converted")
+	}
+
+	var virtualServices gatewayv1.VirtualServiceList
+	for _, vs := range c.snap.VirtualServices {
+		contains, err := gwtranslator.HttpGatewayContainsVirtualService(httpGateway, vs, gateway.GetSsl())
+		if err != nil {
+			reports.AddError(gateway, err)
+			continue
+		}
+		if contains {
+			virtualServices = append(virtualServices, vs)
+		}
+	}
+	virtualServices.Sort()
+
+	// the edge translator resolves the delegation to route tables, and merges the delegated options
+	vsTranslator := &gwtranslator.VirtualServiceTranslator{}
+	virtualHosts := vsTranslator.ComputeVirtualHosts(gwtranslator.NewTranslatorParams(c.ctx, c.snap, reports), gateway, virtualServices, proxyName)
+	virtualHostsByName := map[string]*gloov1.VirtualHost{}
+	for _, virtualHost := range virtualHosts {
+		virtualHostsByName[virtualHost.GetName()] = virtualHost
+	}
+
+	// plain http gateways have a listener shared by the virtual services,
+	// https gateways need a listener for each host, as the certificates are configured on the listeners
+	sharedListener := gwv1.SectionName(sanitizeName(gateway.GetMetadata().GetName()))
+	if !gateway.GetSsl() {
+		builder.addListener(gwv1.Listener{
+			Name:     sharedListener,
+			Port:     gwv1.PortNumber(gateway.GetBindPort()),
+			Protocol: gwv1.HTTPProtocolType,
+		})
+	}
+
+	for _, vs := range virtualServices {
+		virtualHost, ok := virtualHostsByName[gwtranslator.VirtualHostName(vs)]
+		if !ok {
+			// the translator reported why
+			continue
+		}
+		hostnames, ok := c.convertDomains(vs)
+		if !ok {
+			continue
+		}
+
+		hasOptions := proto.Size(virtualHost.GetOptions()) > 0
+		var listeners []gwv1.SectionName
+		switch {
+		case gateway.GetSsl():
+			tls, ok := c.convertSslConfig(vs, builder.gateway.GetNamespace())
+			if !ok {
+				continue
+			}
+			listeners = builder.addHostListeners(gateway, vs, hostnames, gwv1.HTTPSProtocolType, tls)
+		case hasOptions && len(hostnames) > 0:
+			// the virtual host options apply to all the hosts of a listener
+			listeners = builder.addHostListeners(gateway, vs, hostnames, gwv1.HTTPProtocolType, nil)
+		default:
+			listeners = []gwv1.SectionName{sharedListener}
+		}
+
+		for _, listener := range listeners {
+			builder.virtualServices[listener]++
+			if hasOptions {
+				c.addVirtualHostOption(builder, vs, listener, virtualHost.GetOptions())
+			}
+		}
+		c.convertVirtualHost(builder.gateway, listeners, vs, virtualHost, hostnames, output)
+	}
+
+	if !gateway.GetSsl() && builder.virtualServices[sharedListener] > 1 {
+		if vho, ok := builder.virtualHostOptions[sharedListener]; ok {
+			c.report.warn(gateway, "", "the options of %v apply to all the %v virtual services of listener %v, as it matches all hosts",
+				vho.Annotations[sourceAnnotation], builder.virtualServices[sharedListener], sharedListener)
+		}
+	}
+}
+
+func (b *gatewayBuilder) addListener(listener gwv1.Listener) {
+	all := gwv1.NamespacesFromAll
+	listener.AllowedRoutes = &gwv1.AllowedRoutes{Namespaces: &gwv1.RouteNamespaces{From: &all}}
+	b.gateway.Spec.Listeners = append(b.gateway.Spec.Listeners, listener)
+}
+
+// adds a listener for each of the hostnames of the virtual service, or a single one matching any host
+func (b *gatewayBuilder) addHostListeners(
+	gateway *gatewayv1.Gateway,
+	vs *gatewayv1.VirtualService,
+	hostnames []gwv1.Hostname,
+	protocol gwv1.ProtocolType,
+	tls *gwv1.GatewayTLSConfig,
+) []gwv1.SectionName {
+	name := sanitizeName(fmt.Sprintf("%v-%v-%v", gateway.GetMetadata().GetName(), vs.GetMetadata().GetNamespace(), vs.GetMetadata().GetName()))
+	if len(hostnames) == 0 {
+		listenerName := gwv1.SectionName(name)
+		b.addListener(gwv1.Listener{Name: listenerName, Port: gwv1.PortNumber(gateway.GetBindPort()), Protocol: protocol, TLS: tls})
+		return []gwv1.SectionName{listenerName}
+	}
+	var listeners []gwv1.SectionName
+	for i := range hostnames {
+		listenerName := gwv1.SectionName(fmt.Sprintf("%v-%v", name, i))
+		b.addListener(gwv1.Listener{
+			Name:     listenerName,
+			Hostname: &hostnames[i],
+			Port:     gwv1.PortNumber(gateway.GetBindPort()),
+			Protocol: protocol,
+			TLS:      tls,
+		})
+		listeners = append(listeners, listenerName)
+	}
+	return listeners
+}
+
+// sourceAnnotation records the edge resource a converted resource comes from
+const sourceAnnotation = "gateway.solo.io/migrated-from"
+
+func (c *converter) addVirtualHostOption(builder *gatewayBuilder, vs *gatewayv1.VirtualService, listener gwv1.SectionName, options *gloov1.VirtualHostOptions) {
+	if existing, ok := builder.virtualHostOptions[listener]; ok {
+		c.report.unsupported(vs, "", "the virtual host options are not converted, listener %v already has the options of %v",
+			listener, existing.Annotations[sourceAnnotation])
+		return
+	}
+	gateway := builder.gateway
+	builder.virtualHostOptions[listener] = &solokubev1.VirtualHostOption{
+		TypeMeta: metav1.TypeMeta{APIVersion: gatewayv1.VirtualHostOptionGVK.GroupVersion().String(), Kind: gatewayv1.VirtualHostOptionGVK.Kind},
+		ObjectMeta: metav1.ObjectMeta{
+			// VirtualHostOptions must be in the namespace of the Gateway they target
+			Name:        sanitizeName(fmt.Sprintf("%v-%v", gateway.GetName(), listener)),
+			Namespace:   gateway.GetNamespace(),
+			Annotations: map[string]string{sourceAnnotation: describeResource(vs)},
+		},
+		Spec: gatewayv1.VirtualHostOption{
+			Options: options,
+			TargetRef: &skv2corev1.PolicyTargetReferenceWithSectionName{
+				Group:       gwv1.GroupName,
+				Kind:        wellknown.GatewayKind,
+				Name:        gateway.GetName(),
+				SectionName: &wrappers.StringValue{Value: string(listener)},
+			},
+		},
+	}
+}
+
+// converts the domains of the virtual service to the hostnames of its HTTPRoutes.
+// No hostnames match any host. Returns false if none of the domains can be converted.
+func (c *converter) convertDomains(vs *gatewayv1.VirtualService) ([]gwv1.Hostname, bool) {
+	domains := vs.GetVirtualHost().GetDomains()
+	var hostnames []gwv1.Hostname
+	for _, domain := range domains {
+		domain = strings.ToLower(domain)
+		switch {
+		case domain == "*":
+			return nil, true
+		case strings.Contains(domain, ":"):
+			c.report.unsupported(vs, "", "domain %v is not converted, hostnames cannot have a port", domain)
+		case strings.Contains(strings.TrimPrefix(domain, "*."), "*"):
+			c.report.unsupported(vs, "", "domain %v is not converted, only wildcards of a full label such as *.example.com are supported", domain)
+		default:
+			hostnames = append(hostnames, gwv1.Hostname(domain))
+		}
+	}
+	if len(domains) == 0 {
+		// virtual services without domains match any host
+		return nil, true
+	}
+	if len(hostnames) == 0 {
+		c.report.unsupported(vs, "", "the virtual service is not converted, none of its domains can be converted")
+		return nil, false
+	}
+	if len(hostnames) > maxHostnames {
+		c.report.warn(vs, "", "the virtual service has %v domains, more than the %v an HTTPRoute supports", len(hostnames), maxHostnames)
+	}
+	return hostnames, true
+}
+
+// converts the certificate of the virtual service to the tls config of a listener
+func (c *converter) convertSslConfig(vs *gatewayv1.VirtualService, gatewayNamespace string) (*gwv1.GatewayTLSConfig, bool) {
+	sslConfig := vs.GetSslConfig()
+	secretRef := sslConfig.GetSecretRef()
+	if secretRef == nil {
+		c.report.unsupported(vs, "", "the virtual service is not converted, only certificates from secrets are supported")
+		return nil, false
+	}
+
+	rest := proto.Clone(sslConfig).(*ssl.SslConfig)
+	rest.SslSecrets = nil
+	if proto.Size(rest) > 0 {
+		c.report.unsupported(vs, "", "only the certificate of the ssl config is converted, other settings such as sni domains, client certificate validation and tls parameters are not")
+	}
+
+	certificateRef := gwv1.SecretObjectReference{
+		Group: ptr(gwv1.Group(corev1.GroupName)),
+		Kind:  ptr(gwv1.Kind(secretKind)),
+		Name:  gwv1.ObjectName(secretRef.GetName()),
+	}
+	if namespace := secretRef.GetNamespace(); namespace != "" && namespace != gatewayNamespace {
+		certificateRef.Namespace = ptr(gwv1.Namespace(namespace))
+		c.allowReference(wellknown.GatewayKind, gatewayNamespace, corev1.GroupName, secretKind, namespace)
+	}
+	return &gwv1.GatewayTLSConfig{
+		Mode:            ptr(gwv1.TLSModeTerminate),
+		CertificateRefs: []gwv1.SecretObjectReference{certificateRef},
+	}, true
+}
+
+type referenceGrantKey struct {
+	fromKind, fromNamespace, toNamespace string
+}
+
+// allows the resources of a kind in a namespace to reference resources of another namespace
+func (c *converter) allowReference(fromKind, fromNamespace, toGroup, toKind, toNamespace string) {
+	key := referenceGrantKey{fromKind: fromKind, fromNamespace: fromNamespace, toNamespace: toNamespace}
+	grant, ok := c.referenceGrants[key]
+	if !ok {
+		grant = &gwv1beta1.ReferenceGrant{
+			TypeMeta: metav1.TypeMeta{APIVersion: gwv1beta1.GroupVersion.String(), Kind: "ReferenceGrant"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      sanitizeName(fmt.Sprintf("allow-%vs-from-%v", strings.ToLower(fromKind), fromNamespace)),
+				Namespace: toNamespace,
+			},
+			Spec: gwv1beta1.ReferenceGrantSpec{
+				From: []gwv1beta1.ReferenceGrantFrom{{
+					Group:     gwv1.GroupName,
+					Kind:      gwv1.Kind(fromKind),
+					Namespace: gwv1.Namespace(fromNamespace),
+				}},
+			},
+		}
+		c.referenceGrants[key] = grant
+	}
+	for _, to := range grant.Spec.To {
+		if string(to.Group) == toGroup && string(to.Kind) == toKind {
+			return
+		}
+	}
+	grant.Spec.To = append(grant.Spec.To, gwv1beta1.ReferenceGrantTo{Group: gwv1.Group(toGroup), Kind: gwv1.Kind(toKind)})
+}
+
+func (c *converter) sortedReferenceGrants() []*gwv1beta1.ReferenceGrant {
+	var grants []*gwv1beta1.ReferenceGrant
+	for _, grant := range c.referenceGrants {
+		grants = append(grants, grant)
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		if grants[i].GetNamespace() != grants[j].GetNamespace() {
+			return grants[i].GetNamespace() < grants[j].GetNamespace()
+		}
+		return grants[i].GetName() < grants[j].GetName()
+	})
+	return grants
+}
+
+// returns a valid kubernetes name, or listener name, from the name of edge resources
+func sanitizeName(name string) string {
+	name = strings.ToLower(strings.ReplaceAll(name, ".", "-"))
+	if len(name) > 253 {
+		name = strings.TrimRight(name[:253], "-")
+	}
+	return name
+}
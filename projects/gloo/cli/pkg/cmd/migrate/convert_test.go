@@ -0,0 +1,255 @@
+package migrate_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/migrate"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/translate"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const migrateResources = `
+apiVersion: gateway.solo.io/v1
+kind: Gateway
+metadata:
+  name: gateway-proxy
+  namespace: gloo-system
+spec:
+  bindAddress: '::'
+  bindPort: 8080
+  httpGateway: {}
+  proxyNames: [gateway-proxy]
+---
+apiVersion: gateway.solo.io/v1
+kind: Gateway
+metadata:
+  name: gateway-proxy-ssl
+  namespace: gloo-system
+spec:
+  bindAddress: '::'
+  bindPort: 8443
+  ssl: true
+  httpGateway: {}
+  proxyNames: [gateway-proxy]
+---
+apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: petstore
+  namespace: apps
+spec:
+  virtualHost:
+    domains: ['petstore.example.com']
+    options:
+      cors:
+        allowOrigin: ['https://example.com']
+    routes:
+    - matchers:
+      - prefix: /api
+      delegateAction:
+        ref:
+          name: pets
+          namespace: apps
+    - matchers:
+      - prefix: /
+      directResponseAction:
+        status: 404
+---
+apiVersion: gateway.solo.io/v1
+kind: RouteTable
+metadata:
+  name: pets
+  namespace: apps
+spec:
+  routes:
+  - matchers:
+    - prefix: /api/pets
+      methods: [GET, POST]
+    routeAction:
+      single:
+        upstream:
+          name: backend-petstore-8080
+          namespace: gloo-system
+    options:
+      prefixRewrite: /pets
+  - matchers:
+    - exact: /api/static
+    routeAction:
+      single:
+        upstream:
+          name: static
+          namespace: gloo-system
+---
+apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: secure
+  namespace: apps
+spec:
+  sslConfig:
+    secretRef:
+      name: tls
+      namespace: apps
+  virtualHost:
+    domains: ['secure.example.com']
+    routes:
+    - matchers:
+      - prefix: /
+      routeAction:
+        single:
+          kube:
+            ref:
+              name: secure
+              namespace: apps
+            port: 443
+---
+apiVersion: gloo.solo.io/v1
+kind: Upstream
+metadata:
+  name: backend-petstore-8080
+  namespace: gloo-system
+spec:
+  kube:
+    serviceName: petstore
+    serviceNamespace: backend
+    servicePort: 8080
+---
+apiVersion: gloo.solo.io/v1
+kind: Upstream
+metadata:
+  name: static
+  namespace: gloo-system
+spec:
+  static:
+    hosts:
+    - addr: static.example.com
+      port: 80
+`
+
+var _ = Describe("Convert", func() {
+
+	var (
+		file   string
+		output *migrate.Output
+	)
+
+	BeforeEach(func() {
+		file = filepath.Join(GinkgoT().TempDir(), "resources.yaml")
+		Expect(os.WriteFile(file, []byte(migrateResources), 0644)).To(Succeed())
+		resources, err := translate.LoadResources(context.Background(), "gloo-system", []string{file})
+		Expect(err).NotTo(HaveOccurred())
+		output = migrate.Convert(context.Background(), resources.Snapshot)
+	})
+
+	It("converts the proxy to a Gateway with a listener for each gateway", func() {
+		Expect(output.Gateways).To(HaveLen(1))
+		gateway := output.Gateways[0]
+		Expect(gateway.GetName()).To(Equal("gateway-proxy"))
+		Expect(gateway.GetNamespace()).To(Equal("gloo-system"))
+		Expect(string(gateway.Spec.GatewayClassName)).To(Equal("gloo-gateway"))
+
+		var ports []gwv1.PortNumber
+		var tlsListener *gwv1.Listener
+		for i, listener := range gateway.Spec.Listeners {
+			ports = append(ports, listener.Port)
+			if listener.TLS != nil {
+				tlsListener = &gateway.Spec.Listeners[i]
+			}
+		}
+		Expect(ports).To(ContainElements(gwv1.PortNumber(8080), gwv1.PortNumber(8443)))
+		Expect(tlsListener).NotTo(BeNil())
+		Expect(tlsListener.Protocol).To(Equal(gwv1.HTTPSProtocolType))
+		Expect(string(*tlsListener.Hostname)).To(Equal("secure.example.com"))
+		Expect(string(tlsListener.TLS.CertificateRefs[0].Name)).To(Equal("tls"))
+	})
+
+	It("flattens delegation into an HTTPRoute, with a ReferenceGrant for the Service in another namespace", func() {
+		var petstore *gwv1.HTTPRoute
+		for _, httpRoute := range output.HTTPRoutes {
+			if httpRoute.GetName() == "petstore" {
+				petstore = httpRoute
+			}
+		}
+		Expect(petstore).NotTo(BeNil())
+		Expect(petstore.GetNamespace()).To(Equal("apps"))
+		Expect(petstore.Spec.Hostnames).To(ConsistOf(gwv1.Hostname("petstore.example.com")))
+
+		Expect(petstore.Spec.Rules).To(HaveLen(1))
+		rule := petstore.Spec.Rules[0]
+		Expect(rule.Matches).To(HaveLen(2))
+		Expect(*rule.Matches[0].Path.Value).To(Equal("/api/pets"))
+		Expect(*rule.Matches[0].Method).To(Equal(gwv1.HTTPMethodGet))
+		Expect(*rule.Matches[1].Method).To(Equal(gwv1.HTTPMethodPost))
+
+		Expect(rule.BackendRefs).To(HaveLen(1))
+		Expect(string(rule.BackendRefs[0].Name)).To(Equal("petstore"))
+		Expect(string(*rule.BackendRefs[0].Namespace)).To(Equal("backend"))
+		Expect(int(*rule.BackendRefs[0].Port)).To(Equal(8080))
+
+		Expect(output.ReferenceGrants).To(HaveLen(2))
+		grant := output.ReferenceGrants[1]
+		Expect(grant.GetNamespace()).To(Equal("backend"))
+		Expect(string(grant.Spec.From[0].Kind)).To(Equal("HTTPRoute"))
+		Expect(string(grant.Spec.From[0].Namespace)).To(Equal("apps"))
+		Expect(string(grant.Spec.To[0].Kind)).To(Equal("Service"))
+	})
+
+	It("allows the Gateway to reference the Secret of a VirtualService in another namespace", func() {
+		grant := output.ReferenceGrants[0]
+		Expect(grant.GetNamespace()).To(Equal("apps"))
+		Expect(string(grant.Spec.From[0].Kind)).To(Equal("Gateway"))
+		Expect(string(grant.Spec.From[0].Namespace)).To(Equal("gloo-system"))
+		Expect(string(grant.Spec.To[0].Kind)).To(Equal("Secret"))
+	})
+
+	It("carries the options in RouteOptions and VirtualHostOptions", func() {
+		Expect(output.RouteOptions).To(HaveLen(1))
+		Expect(output.RouteOptions[0].Spec.GetOptions().GetPrefixRewrite().GetValue()).To(Equal("/pets"))
+
+		var filterRef *gwv1.LocalObjectReference
+		for _, httpRoute := range output.HTTPRoutes {
+			for _, rule := range httpRoute.Spec.Rules {
+				for _, filter := range rule.Filters {
+					if filter.ExtensionRef != nil {
+						filterRef = filter.ExtensionRef
+					}
+				}
+			}
+		}
+		Expect(filterRef).NotTo(BeNil())
+		Expect(string(filterRef.Name)).To(Equal(output.RouteOptions[0].GetName()))
+
+		Expect(output.VirtualHostOptions).To(HaveLen(1))
+		Expect(output.VirtualHostOptions[0].Spec.GetOptions().GetCors().GetAllowOrigin()).To(ConsistOf("https://example.com"))
+	})
+
+	It("reports the configuration that is not converted", func() {
+		var messages []string
+		for _, entry := range output.Report {
+			messages = append(messages, entry.String())
+		}
+		Expect(messages).To(ContainElements(
+			ContainSubstring("direct response actions are not supported"),
+			ContainSubstring("upstream gloo-system.static is not a kubernetes upstream"),
+		))
+	})
+
+	It("prints the objects and the report as json", func() {
+		out, err := testutils.GlooctlOut("migrate gateway-api -f " + file + " -o json")
+		Expect(err).NotTo(HaveOccurred())
+		var printed struct {
+			Objects []map[string]interface{} `json:"objects"`
+			Report  []*migrate.ReportEntry   `json:"report"`
+		}
+		Expect(json.Unmarshal([]byte(out), &printed)).To(Succeed())
+		Expect(printed.Objects[0]["kind"]).To(Equal("Gateway"))
+		Expect(printed.Objects[0]).NotTo(HaveKey("status"))
+		Expect(printed.Report).NotTo(BeEmpty())
+	})
+})
@@ -0,0 +1,90 @@
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"sigs.k8s.io/yaml"
+)
+
+// the json output, holding the converted objects and the report
+type jsonOutput struct {
+	Objects []map[string]interface{} `json:"objects"`
+	Report  []*ReportEntry           `json:"report"`
+}
+
+// Objects returns the converted objects in the order they are applied, without their status and creation timestamp.
+func (o *Output) Objects() ([]map[string]interface{}, error) {
+	var objects []interface{}
+	for _, gateway := range o.Gateways {
+		objects = append(objects, gateway)
+	}
+	for _, option := range o.VirtualHostOptions {
+		objects = append(objects, option)
+	}
+	for _, httpRoute := range o.HTTPRoutes {
+		objects = append(objects, httpRoute)
+	}
+	for _, option := range o.RouteOptions {
+		objects = append(objects, option)
+	}
+	for _, grant := range o.ReferenceGrants {
+		objects = append(objects, grant)
+	}
+
+	var cleaned []map[string]interface{}
+	for _, object := range objects {
+		b, err := json.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(b, &fields); err != nil {
+			return nil, err
+		}
+		delete(fields, "status")
+		if metadata, ok := fields["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "creationTimestamp")
+		}
+		cleaned = append(cleaned, fields)
+	}
+	return cleaned, nil
+}
+
+// Print prints the converted objects to out, as a json document holding the objects and the report,
+// or as yaml documents. In yaml, the report is printed to errOut.
+func (o *Output) Print(outputType printers.OutputType, out, errOut io.Writer) error {
+	objects, err := o.Objects()
+	if err != nil {
+		return err
+	}
+	if outputType.IsJSON() {
+		b, err := json.MarshalIndent(&jsonOutput{Objects: objects, Report: o.Report}, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(b))
+		return err
+	}
+
+	var documents []string
+	for _, object := range objects {
+		b, err := yaml.Marshal(object)
+		if err != nil {
+			return err
+		}
+		documents = append(documents, string(b))
+	}
+	if _, err := fmt.Fprint(out, strings.Join(documents, "---\n")); err != nil {
+		return err
+	}
+	for _, entry := range o.Report {
+		if _, err := fmt.Fprintln(errOut, entry.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package migrate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+const (
+	// SeverityUnsupported marks configuration that has no equivalent, and is not converted
+	SeverityUnsupported = "Unsupported"
+	// SeverityWarning marks configuration that is converted, but may behave differently
+	SeverityWarning = "Warning"
+)
+
+// ReportEntry describes configuration that is not converted, or not converted exactly
+type ReportEntry struct {
+	Severity string `json:"severity"`
+	// Resource is the edge resource holding the configuration, such as VirtualService apps.petstore
+	Resource string `json:"resource"`
+	// Route identifies the route within the resource, and the resources it was delegated from
+	Route   string `json:"route,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e *ReportEntry) String() string {
+	location := e.Resource
+	if e.Route != "" {
+		location = fmt.Sprintf("%v, route %v", location, e.Route)
+	}
+	return fmt.Sprintf("%v: %v: %v", e.Severity, location, e.Message)
+}
+
+type report struct {
+	entries []*ReportEntry
+}
+
+func (r *report) add(severity string, resource resources.InputResource, route, format string, args ...interface{}) {
+	r.entries = append(r.entries, &ReportEntry{
+		Severity: severity,
+		Resource: describeResource(resource),
+		Route:    route,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (r *report) unsupported(resource resources.InputResource, route, format string, args ...interface{}) {
+	r.add(SeverityUnsupported, resource, route, format, args...)
+}
+
+func (r *report) warn(resource resources.InputResource, route, format string, args ...interface{}) {
+	r.add(SeverityWarning, resource, route, format, args...)
+}
+
+// adds the errors and warnings the gateway translator reported while resolving delegation
+func (r *report) addTranslationReports(reports reporter.ResourceReports) {
+	for resource, resourceReport := range reports {
+		if resourceReport.Errors != nil {
+			r.unsupported(resource, "", "translation error: %v", resourceReport.Errors)
+		}
+		for _, warning := range resourceReport.Warnings {
+			r.warn(resource, "", "translation warning: %v", warning)
+		}
+	}
+}
+
+func (r *report) sorted() []*ReportEntry {
+	sort.SliceStable(r.entries, func(i, j int) bool {
+		return r.entries[i].Resource < r.entries[j].Resource
+	})
+	return r.entries
+}
+
+// describes a resource by its kind and namespace.name, such as VirtualService apps.petstore
+func describeResource(resource resources.InputResource) string {
+	return fmt.Sprintf("%v %v", shortKind(resources.Kind(resource)), resource.GetMetadata().Ref().Key())
+}
+
+// returns the kind of a go type name such as *v1.VirtualService
+func shortKind(kind string) string {
+	return kind[strings.LastIndex(kind, ".")+1:]
+}
+
+// describes a route of a virtual host by its name or index, and the resources it was delegated from
+func describeRoute(route *gloov1.Route, index int) string {
+	description := route.GetName()
+	if description == "" {
+		description = fmt.Sprintf("#%v", index)
+	}
+	var sources []string
+	refs := route.GetMetadataStatic().GetSources()
+	// the sources are listed from the innermost route table
+	for i := len(refs) - 1; i >= 0; i-- {
+		sources = append(sources, fmt.Sprintf("%v %v", shortKind(refs[i].GetResourceKind()), refs[i].GetResourceRef().Key()))
+	}
+	if len(sources) > 1 {
+		description = fmt.Sprintf("%v (%v)", description, strings.Join(sources, " -> "))
+	}
+	return description
+}
@@ -0,0 +1,100 @@
+package migrate
+
+import (
+	"io"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/translate"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/cobra"
+)
+
+func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.MIGRATE_COMMAND.Use,
+		Short: constants.MIGRATE_COMMAND.Short,
+	}
+	cmd.AddCommand(GatewayApi(opts))
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func GatewayApi(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway-api",
+		Short: "convert Gateways, VirtualServices and RouteTables to the Kubernetes Gateway API",
+		Long: "Converts the edge Gateways, and the VirtualServices and RouteTables they select, to Gateways, HTTPRoutes, " +
+			"RouteOptions, VirtualHostOptions and ReferenceGrants for the gloo-gateway GatewayClass. Each proxy becomes a " +
+			"Gateway, delegation is flattened into HTTPRoutes, and cross namespace references are allowed with ReferenceGrants.\n\n" +
+			"Configuration that has no equivalent, or may behave differently, is listed in a report, printed to stderr " +
+			"with yaml output and included in json output. The resources are read from files with -f, or from the cluster.",
+		Example: `# convert the resources in the cluster
+glooctl migrate gateway-api > gateway-api.yaml
+
+# convert the resources in a directory, as json including the report
+glooctl migrate gateway-api -f ./gloo-config/ -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return migrateGatewayApi(opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+	opts.Migrate.GatewayApi.Output = printers.YAML
+	flagutils.AddMigrateGatewayApiFlags(cmd.Flags(), &opts.Migrate.GatewayApi)
+	cmd.Flags().StringVarP(&opts.Metadata.Namespace, "namespace", "n", flagutils.DefaultNamespace,
+		"namespace of the resources in the files that do not set one")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func migrateGatewayApi(opts *options.Options, out, errOut io.Writer) error {
+	var snap *gloosnapshot.ApiSnapshot
+	if files := opts.Migrate.GatewayApi.Files; len(files) > 0 {
+		resources, err := translate.LoadResources(opts.Top.Ctx, opts.Metadata.GetNamespace(), files)
+		if err != nil {
+			return err
+		}
+		snap = resources.Snapshot
+	} else {
+		var err error
+		if snap, err = readCluster(opts); err != nil {
+			return err
+		}
+	}
+	return Convert(opts.Top.Ctx, snap).Print(opts.Migrate.GatewayApi.Output, out, errOut)
+}
+
+// reads the resources that are converted, in all namespaces
+func readCluster(opts *options.Options) (*gloosnapshot.ApiSnapshot, error) {
+	ctx := opts.Top.Ctx
+	listOpts := clients.ListOpts{Ctx: ctx}
+	snap := &gloosnapshot.ApiSnapshot{}
+	var err error
+	if snap.Gateways, err = helpers.MustGatewayClient(ctx).List("", listOpts); err != nil {
+		return nil, eris.Wrapf(err, "listing gateways")
+	}
+	if snap.VirtualServices, err = helpers.MustVirtualServiceClient(ctx).List("", listOpts); err != nil {
+		return nil, eris.Wrapf(err, "listing virtual services")
+	}
+	if snap.RouteTables, err = helpers.MustRouteTableClient(ctx).List("", listOpts); err != nil {
+		return nil, eris.Wrapf(err, "listing route tables")
+	}
+	if snap.VirtualHostOptions, err = helpers.MustVirtualHostOptionClient(ctx).List("", listOpts); err != nil {
+		return nil, eris.Wrapf(err, "listing virtual host options")
+	}
+	if snap.RouteOptions, err = helpers.MustRouteOptionClient(ctx).List("", listOpts); err != nil {
+		return nil, eris.Wrapf(err, "listing route options")
+	}
+	if snap.Upstreams, err = helpers.MustUpstreamClient(ctx).List("", listOpts); err != nil {
+		return nil, eris.Wrapf(err, "listing upstreams")
+	}
+	if snap.UpstreamGroups, err = helpers.MustUpstreamGroupClient(ctx).List("", listOpts); err != nil {
+		return nil, eris.Wrapf(err, "listing upstream groups")
+	}
+	return snap, nil
+}
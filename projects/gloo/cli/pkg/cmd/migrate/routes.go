@@ -0,0 +1,510 @@
+package migrate
+
+import (
+	"fmt"
+	"strings"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	solokubev1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1/kube/apis/gateway.solo.io/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gateway2/translator/routeutils"
+	"github.com/solo-io/gloo/projects/gateway2/wellknown"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// a route of a virtual host, converted to the rules of an HTTPRoute
+type convertedRoute struct {
+	// the rules share the options of the route, they are split as a rule has at most 8 matches
+	rules   []gwv1.HTTPRouteRule
+	options *gloov1.RouteOptions
+	// one matcher for each match of the rules, to check the precedence of the matches
+	matchers []*matchers.Matcher
+	name     string
+}
+
+// converts the flattened routes of a virtual service to HTTPRoutes, bound to the listeners of the Gateway
+func (c *converter) convertVirtualHost(
+	gateway *gwv1.Gateway,
+	listeners []gwv1.SectionName,
+	vs *gatewayv1.VirtualService,
+	virtualHost *gloov1.VirtualHost,
+	hostnames []gwv1.Hostname,
+	output *Output,
+) {
+	var routes []*convertedRoute
+	for i, route := range virtualHost.GetRoutes() {
+		if converted := c.convertRoute(vs, route, i); converted != nil {
+			routes = append(routes, converted)
+		}
+	}
+	c.checkPrecedence(vs, routes)
+
+	var parentRefs []gwv1.ParentReference
+	for i := range listeners {
+		namespace := gwv1.Namespace(gateway.GetNamespace())
+		parentRefs = append(parentRefs, gwv1.ParentReference{
+			Name:        gwv1.ObjectName(gateway.GetName()),
+			Namespace:   &namespace,
+			SectionName: &listeners[i],
+		})
+	}
+
+	// an HTTPRoute has at most 16 rules, the routes are split across HTTPRoutes
+	var httpRoute *gwv1.HTTPRoute
+	httpRoutes := 0
+	routeOptions := map[*gloov1.RouteOptions]string{}
+	for _, route := range routes {
+		for _, rule := range route.rules {
+			if httpRoute == nil || len(httpRoute.Spec.Rules) == maxRules {
+				name := sanitizeName(vs.GetMetadata().GetName())
+				if httpRoutes > 0 {
+					name = fmt.Sprintf("%v-%v", name, httpRoutes)
+				}
+				httpRoutes++
+				httpRoute = &gwv1.HTTPRoute{
+					TypeMeta: metav1.TypeMeta{APIVersion: gwv1.GroupVersion.String(), Kind: wellknown.HTTPRouteKind},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        name,
+						Namespace:   vs.GetMetadata().GetNamespace(),
+						Annotations: map[string]string{sourceAnnotation: describeResource(vs)},
+					},
+					Spec: gwv1.HTTPRouteSpec{
+						CommonRouteSpec: gwv1.CommonRouteSpec{ParentRefs: parentRefs},
+						Hostnames:       hostnames,
+					},
+				}
+				output.HTTPRoutes = append(output.HTTPRoutes, httpRoute)
+				routeOptions = map[*gloov1.RouteOptions]string{}
+			}
+
+			if route.options != nil {
+				// RouteOptions are referenced from the namespace of the HTTPRoute
+				name, ok := routeOptions[route.options]
+				if !ok {
+					name = fmt.Sprintf("%v-%v", httpRoute.GetName(), len(httpRoute.Spec.Rules))
+					routeOptions[route.options] = name
+					output.RouteOptions = append(output.RouteOptions, newRouteOption(name, httpRoute.GetNamespace(), vs, route, route.options))
+				}
+				rule.Filters = append(append([]gwv1.HTTPRouteFilter{}, rule.Filters...), gwv1.HTTPRouteFilter{
+					Type: gwv1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: &gwv1.LocalObjectReference{
+						Group: gwv1.Group(gatewayv1.RouteOptionGVK.Group),
+						Kind:  routeOptionsKind,
+						Name:  gwv1.ObjectName(name),
+					},
+				})
+			}
+			httpRoute.Spec.Rules = append(httpRoute.Spec.Rules, rule)
+		}
+	}
+}
+
+func newRouteOption(name, namespace string, vs *gatewayv1.VirtualService, route *convertedRoute, options *gloov1.RouteOptions) *solokubev1.RouteOption {
+	return &solokubev1.RouteOption{
+		TypeMeta: metav1.TypeMeta{APIVersion: gatewayv1.RouteOptionGVK.GroupVersion().String(), Kind: gatewayv1.RouteOptionGVK.Kind},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				sourceAnnotation: fmt.Sprintf("%v, route %v", describeResource(vs), route.name),
+			},
+		},
+		Spec: gatewayv1.RouteOption{Options: options},
+	}
+}
+
+// converts a route to rules, or returns nil if it cannot be converted
+func (c *converter) convertRoute(vs *gatewayv1.VirtualService, route *gloov1.Route, index int) *convertedRoute {
+	converted := &convertedRoute{name: describeRoute(route, index)}
+	unsupported := func(format string, args ...interface{}) *convertedRoute {
+		c.report.unsupported(vs, converted.name, "the route is not converted, "+format, args...)
+		return nil
+	}
+
+	routeMatchers := route.GetMatchers()
+	if len(routeMatchers) == 0 {
+		routeMatchers = []*matchers.Matcher{defaults.DefaultMatcher()}
+	}
+	var matches []gwv1.HTTPRouteMatch
+	for _, matcher := range routeMatchers {
+		routeMatches, precedenceMatchers, err := convertMatcher(matcher)
+		if err != nil {
+			c.report.unsupported(vs, converted.name, "matcher %v is not converted, %v", describeMatcher(matcher), err)
+			continue
+		}
+		matches = append(matches, routeMatches...)
+		converted.matchers = append(converted.matchers, precedenceMatchers...)
+	}
+	if len(matches) == 0 {
+		return unsupported("none of its matchers can be converted")
+	}
+
+	var filters []gwv1.HTTPRouteFilter
+	var backendRefs []gwv1.HTTPBackendRef
+	switch action := route.GetAction().(type) {
+	case *gloov1.Route_RouteAction:
+		var err error
+		if backendRefs, err = c.convertRouteAction(vs, converted.name, action.RouteAction); err != nil {
+			return unsupported("%v", err)
+		}
+	case *gloov1.Route_RedirectAction:
+		filter, err := c.convertRedirectAction(vs, converted.name, action.RedirectAction)
+		if err != nil {
+			return unsupported("%v", err)
+		}
+		filters = append(filters, filter)
+	case *gloov1.Route_DirectResponseAction:
+		return unsupported("direct response actions are not supported")
+	default:
+		return unsupported("%T actions are not supported", action)
+	}
+
+	if proto.Size(route.GetOptions()) > 0 {
+		converted.options = route.GetOptions()
+	}
+
+	for start := 0; start < len(matches); start += maxMatches {
+		end := start + maxMatches
+		if end > len(matches) {
+			end = len(matches)
+		}
+		converted.rules = append(converted.rules, gwv1.HTTPRouteRule{
+			Matches:     matches[start:end],
+			Filters:     filters,
+			BackendRefs: backendRefs,
+		})
+	}
+	return converted
+}
+
+// converts a matcher to HTTPRouteMatches, one for each method as a match has at most one method.
+// It also returns a matcher with a single method for each match, to check the precedence of the matches.
+func convertMatcher(matcher *matchers.Matcher) ([]gwv1.HTTPRouteMatch, []*matchers.Matcher, error) {
+	if matcher.GetCaseSensitive() != nil && !matcher.GetCaseSensitive().GetValue() {
+		return nil, nil, fmt.Errorf("case insensitive matching is not supported")
+	}
+
+	var match gwv1.HTTPRouteMatch
+	switch path := matcher.GetPathSpecifier().(type) {
+	case *matchers.Matcher_Prefix:
+		match.Path = &gwv1.HTTPPathMatch{Type: ptr(gwv1.PathMatchPathPrefix), Value: ptr(path.Prefix)}
+	case *matchers.Matcher_Exact:
+		match.Path = &gwv1.HTTPPathMatch{Type: ptr(gwv1.PathMatchExact), Value: ptr(path.Exact)}
+	case *matchers.Matcher_Regex:
+		match.Path = &gwv1.HTTPPathMatch{Type: ptr(gwv1.PathMatchRegularExpression), Value: ptr(path.Regex)}
+	case *matchers.Matcher_ConnectMatcher_:
+		return nil, nil, fmt.Errorf("CONNECT matchers are not supported")
+	default:
+		return nil, nil, fmt.Errorf("a path specifier is required")
+	}
+
+	for _, header := range matcher.GetHeaders() {
+		if header.GetInvertMatch() {
+			return nil, nil, fmt.Errorf("inverted header matchers are not supported")
+		}
+		if header.GetValue() == "" {
+			return nil, nil, fmt.Errorf("header presence matchers are not supported")
+		}
+		headerMatch := gwv1.HTTPHeaderMatch{
+			Type:  ptr(gwv1.HeaderMatchExact),
+			Name:  gwv1.HTTPHeaderName(header.GetName()),
+			Value: header.GetValue(),
+		}
+		if header.GetRegex() {
+			headerMatch.Type = ptr(gwv1.HeaderMatchRegularExpression)
+		}
+		match.Headers = append(match.Headers, headerMatch)
+	}
+
+	for _, queryParameter := range matcher.GetQueryParameters() {
+		if queryParameter.GetRegex() {
+			return nil, nil, fmt.Errorf("regex query parameter matchers are not supported")
+		}
+		if queryParameter.GetValue() == "" {
+			return nil, nil, fmt.Errorf("query parameter presence matchers are not supported")
+		}
+		match.QueryParams = append(match.QueryParams, gwv1.HTTPQueryParamMatch{
+			Type:  ptr(gwv1.QueryParamMatchExact),
+			Name:  gwv1.HTTPHeaderName(queryParameter.GetName()),
+			Value: queryParameter.GetValue(),
+		})
+	}
+
+	if len(matcher.GetMethods()) == 0 {
+		return []gwv1.HTTPRouteMatch{match}, []*matchers.Matcher{matcher}, nil
+	}
+	var matches []gwv1.HTTPRouteMatch
+	var precedenceMatchers []*matchers.Matcher
+	for _, method := range matcher.GetMethods() {
+		if !validMethods[strings.ToUpper(method)] {
+			return nil, nil, fmt.Errorf("method %v is not supported", method)
+		}
+		methodMatch := match
+		methodMatch.Method = ptr(gwv1.HTTPMethod(strings.ToUpper(method)))
+		matches = append(matches, methodMatch)
+
+		precedenceMatcher := proto.Clone(matcher).(*matchers.Matcher)
+		precedenceMatcher.Methods = []string{method}
+		precedenceMatchers = append(precedenceMatchers, precedenceMatcher)
+	}
+	return matches, precedenceMatchers, nil
+}
+
+var validMethods = map[string]bool{}
+
+func init() {
+	for _, method := range []gwv1.HTTPMethod{
+		gwv1.HTTPMethodGet, gwv1.HTTPMethodHead, gwv1.HTTPMethodPost, gwv1.HTTPMethodPut, gwv1.HTTPMethodDelete,
+		gwv1.HTTPMethodConnect, gwv1.HTTPMethodOptions, gwv1.HTTPMethodTrace, gwv1.HTTPMethodPatch,
+	} {
+		validMethods[string(method)] = true
+	}
+}
+
+// describes a matcher by its path, such as prefix /api
+func describeMatcher(matcher *matchers.Matcher) string {
+	switch path := matcher.GetPathSpecifier().(type) {
+	case *matchers.Matcher_Prefix:
+		return fmt.Sprintf("prefix %v", path.Prefix)
+	case *matchers.Matcher_Exact:
+		return fmt.Sprintf("exact %v", path.Exact)
+	case *matchers.Matcher_Regex:
+		return fmt.Sprintf("regex %v", path.Regex)
+	case *matchers.Matcher_ConnectMatcher_:
+		return "connect"
+	}
+	return "without path"
+}
+
+// converts the destinations of a route action to backend refs
+func (c *converter) convertRouteAction(vs *gatewayv1.VirtualService, routeName string, action *gloov1.RouteAction) ([]gwv1.HTTPBackendRef, error) {
+	var destinations []*gloov1.WeightedDestination
+	switch destination := action.GetDestination().(type) {
+	case *gloov1.RouteAction_Single:
+		backendRef, err := c.convertDestination(vs, routeName, destination.Single)
+		if err != nil {
+			return nil, err
+		}
+		return []gwv1.HTTPBackendRef{backendRef}, nil
+	case *gloov1.RouteAction_Multi:
+		destinations = destination.Multi.GetDestinations()
+	case *gloov1.RouteAction_UpstreamGroup:
+		ref := destination.UpstreamGroup
+		upstreamGroup, err := c.snap.UpstreamGroups.Find(ref.GetNamespace(), ref.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("upstream group %v not found", ref.Key())
+		}
+		destinations = upstreamGroup.GetDestinations()
+	case *gloov1.RouteAction_ClusterHeader:
+		return nil, fmt.Errorf("cluster header destinations are not supported")
+	case *gloov1.RouteAction_DynamicForwardProxy:
+		return nil, fmt.Errorf("dynamic forward proxy destinations are not supported")
+	default:
+		return nil, fmt.Errorf("a destination is required")
+	}
+
+	var backendRefs []gwv1.HTTPBackendRef
+	for _, weighted := range destinations {
+		backendRef, err := c.convertDestination(vs, routeName, weighted.GetDestination())
+		if err != nil {
+			return nil, err
+		}
+		if weighted.GetWeight() != nil {
+			backendRef.Weight = ptr(int32(weighted.GetWeight().GetValue()))
+		}
+		if proto.Size(weighted.GetOptions()) > 0 {
+			c.report.warn(vs, routeName, "the options of weighted destination %v are not converted", describeBackendRef(backendRef))
+		}
+		backendRefs = append(backendRefs, backendRef)
+	}
+	return backendRefs, nil
+}
+
+// converts a destination to a backend ref to a kubernetes Service
+func (c *converter) convertDestination(vs *gatewayv1.VirtualService, routeName string, destination *gloov1.Destination) (gwv1.HTTPBackendRef, error) {
+	if destination.GetSubset() != nil {
+		return gwv1.HTTPBackendRef{}, fmt.Errorf("subset destinations are not supported")
+	}
+	if destination.GetDestinationSpec() != nil {
+		return gwv1.HTTPBackendRef{}, fmt.Errorf("destination specs are not supported")
+	}
+
+	var serviceName, serviceNamespace string
+	var port uint32
+	switch typed := destination.GetDestinationType().(type) {
+	case *gloov1.Destination_Upstream:
+		ref := typed.Upstream
+		upstream, err := c.snap.Upstreams.Find(ref.GetNamespace(), ref.GetName())
+		if err != nil {
+			return gwv1.HTTPBackendRef{}, fmt.Errorf("upstream %v not found", ref.Key())
+		}
+		kube := upstream.GetKube()
+		if kube == nil {
+			return gwv1.HTTPBackendRef{}, fmt.Errorf("upstream %v is not a kubernetes upstream, only Services are supported as backends", ref.Key())
+		}
+		if len(kube.GetSelector()) > 0 {
+			c.report.warn(vs, routeName, "the selector of upstream %v is not converted, all the endpoints of Service %v.%v are selected",
+				ref.Key(), kube.GetServiceNamespace(), kube.GetServiceName())
+		}
+		if hasUpstreamSettings(upstream) {
+			c.report.warn(vs, routeName, "the settings of upstream %v are not converted", ref.Key())
+		}
+		serviceName, serviceNamespace, port = kube.GetServiceName(), kube.GetServiceNamespace(), kube.GetServicePort()
+	case *gloov1.Destination_Kube:
+		serviceName, serviceNamespace, port = typed.Kube.GetRef().GetName(), typed.Kube.GetRef().GetNamespace(), typed.Kube.GetPort()
+	case *gloov1.Destination_Consul:
+		return gwv1.HTTPBackendRef{}, fmt.Errorf("consul destinations are not supported")
+	default:
+		return gwv1.HTTPBackendRef{}, fmt.Errorf("a destination type is required")
+	}
+
+	vsNamespace := vs.GetMetadata().GetNamespace()
+	backendRef := gwv1.HTTPBackendRef{
+		BackendRef: gwv1.BackendRef{
+			BackendObjectReference: gwv1.BackendObjectReference{
+				Name: gwv1.ObjectName(serviceName),
+				Port: ptr(gwv1.PortNumber(port)),
+			},
+		},
+	}
+	if serviceNamespace != "" && serviceNamespace != vsNamespace {
+		backendRef.Namespace = ptr(gwv1.Namespace(serviceNamespace))
+		c.allowReference(wellknown.HTTPRouteKind, vsNamespace, corev1.GroupName, serviceKind, serviceNamespace)
+	}
+	return backendRef, nil
+}
+
+// returns true if the upstream has settings other than its kubernetes service
+func hasUpstreamSettings(upstream *gloov1.Upstream) bool {
+	settings := proto.Clone(upstream).(*gloov1.Upstream)
+	settings.Metadata = nil
+	settings.NamespacedStatuses = nil
+	settings.DiscoveryMetadata = nil
+	settings.UpstreamType = nil
+	return proto.Size(settings) > 0
+}
+
+func describeBackendRef(backendRef gwv1.HTTPBackendRef) string {
+	namespace := ""
+	if backendRef.Namespace != nil {
+		namespace = string(*backendRef.Namespace) + "."
+	}
+	return fmt.Sprintf("%v%v", namespace, backendRef.Name)
+}
+
+// converts a redirect action to a RequestRedirect filter
+func (c *converter) convertRedirectAction(vs *gatewayv1.VirtualService, routeName string, action *gloov1.RedirectAction) (gwv1.HTTPRouteFilter, error) {
+	redirect := &gwv1.HTTPRequestRedirectFilter{}
+	if action.GetHttpsRedirect() {
+		redirect.Scheme = ptr("https")
+	}
+	if action.GetHostRedirect() != "" {
+		redirect.Hostname = ptr(gwv1.PreciseHostname(action.GetHostRedirect()))
+	}
+	switch path := action.GetPathRewriteSpecifier().(type) {
+	case *gloov1.RedirectAction_PathRedirect:
+		redirect.Path = &gwv1.HTTPPathModifier{Type: gwv1.FullPathHTTPPathModifier, ReplaceFullPath: ptr(path.PathRedirect)}
+	case *gloov1.RedirectAction_PrefixRewrite:
+		redirect.Path = &gwv1.HTTPPathModifier{Type: gwv1.PrefixMatchHTTPPathModifier, ReplacePrefixMatch: ptr(path.PrefixRewrite)}
+	case *gloov1.RedirectAction_RegexRewrite:
+		return gwv1.HTTPRouteFilter{}, fmt.Errorf("regex rewrites of redirects are not supported")
+	}
+	if action.GetStripQuery() {
+		c.report.warn(vs, routeName, "the query is not stripped from redirects")
+	}
+	if action.GetPortRedirect() != nil {
+		redirect.Port = ptr(gwv1.PortNumber(action.GetPortRedirect().GetValue()))
+	}
+
+	// the gateway2 translator requires a status code, and only 301 and 302 are allowed
+	switch action.GetResponseCode() {
+	case gloov1.RedirectAction_MOVED_PERMANENTLY:
+		redirect.StatusCode = ptr(301)
+	case gloov1.RedirectAction_PERMANENT_REDIRECT:
+		redirect.StatusCode = ptr(301)
+		c.report.warn(vs, routeName, "redirect status %v is converted to 301", action.GetResponseCode())
+	default:
+		redirect.StatusCode = ptr(302)
+		if action.GetResponseCode() != gloov1.RedirectAction_FOUND {
+			c.report.warn(vs, routeName, "redirect status %v is converted to 302", action.GetResponseCode())
+		}
+	}
+	return gwv1.HTTPRouteFilter{Type: gwv1.HTTPRouteFilterRequestRedirect, RequestRedirect: redirect}, nil
+}
+
+// the edge gateway selects the first route that matches, while the Gateway API orders the matches by precedence.
+// Warns about the matches that take precedence over the matches of an earlier route they may overlap with.
+func (c *converter) checkPrecedence(vs *gatewayv1.VirtualService, routes []*convertedRoute) {
+	// all the matches are ordered as if they were in the same HTTPRoute, as the route order is the tie breaker
+	httpRoute := &gwv1.HTTPRoute{}
+	type indexedMatcher struct {
+		route    *convertedRoute
+		sortable *routeutils.SortableRoute
+	}
+	var indexed []indexedMatcher
+	for _, route := range routes {
+		for _, matcher := range route.matchers {
+			indexed = append(indexed, indexedMatcher{
+				route: route,
+				sortable: &routeutils.SortableRoute{
+					Route:     &gloov1.Route{Matchers: []*matchers.Matcher{matcher}},
+					HttpRoute: httpRoute,
+					Idx:       len(indexed),
+				},
+			})
+		}
+	}
+
+	for i, earlier := range indexed {
+		for _, later := range indexed[i+1:] {
+			if later.route == earlier.route {
+				continue
+			}
+			// Less orders the routes from the highest precedence
+			if routeutils.SortableRoutes([]*routeutils.SortableRoute{earlier.sortable, later.sortable}).Less(0, 1) {
+				continue
+			}
+			earlierMatcher, laterMatcher := earlier.sortable.Route.GetMatchers()[0], later.sortable.Route.GetMatchers()[0]
+			if !mayOverlap(earlierMatcher, laterMatcher) {
+				continue
+			}
+			c.report.warn(vs, later.route.name, "matcher %v takes precedence over matcher %v of the earlier route %v",
+				describeMatcher(laterMatcher), describeMatcher(earlierMatcher), earlier.route.name)
+		}
+	}
+}
+
+// returns false if no request can match both matchers, based on their paths and methods
+func mayOverlap(a, b *matchers.Matcher) bool {
+	if len(a.GetMethods()) == 1 && len(b.GetMethods()) == 1 && !strings.EqualFold(a.GetMethods()[0], b.GetMethods()[0]) {
+		return false
+	}
+	matches := func(path string, m *matchers.Matcher) bool {
+		switch typed := m.GetPathSpecifier().(type) {
+		case *matchers.Matcher_Prefix:
+			return strings.HasPrefix(path, typed.Prefix)
+		case *matchers.Matcher_Exact:
+			return path == typed.Exact
+		}
+		return true
+	}
+	switch typed := a.GetPathSpecifier().(type) {
+	case *matchers.Matcher_Prefix:
+		if bPrefix, ok := b.GetPathSpecifier().(*matchers.Matcher_Prefix); ok {
+			return strings.HasPrefix(typed.Prefix, bPrefix.Prefix) || strings.HasPrefix(bPrefix.Prefix, typed.Prefix)
+		}
+		return matches(b.GetExact(), a) || b.GetRegex() != ""
+	case *matchers.Matcher_Exact:
+		return matches(typed.Exact, b)
+	}
+	return true
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
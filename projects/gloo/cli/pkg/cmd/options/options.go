@@ -35,6 +35,8 @@ type Options struct {
 	Cluster   Cluster
 	Check     Check
 	CheckCRD  CheckCRD
+	Translate Translate
+	Migrate   Migrate
 }
 type Top struct {
 	contextoptions.ContextAccessible
@@ -92,6 +94,46 @@ type Proxy struct {
 	Port             string
 	FollowLogs       bool
 	DebugLogs        bool
+	Diff             ProxyDiff
+	Snapshot         ProxySnapshot
+	Health           ProxyHealth
+	LogLevel         ProxyLogLevel
+	Tap              ProxyTap
+	ResponseFlags    ProxyResponseFlags
+}
+
+type ProxyDiff struct {
+	Output printTypes.OutputType
+}
+
+type ProxySnapshot struct {
+	// File the snapshot is saved to, if empty it is printed
+	File string
+}
+
+type ProxyHealth struct {
+	// Upstream whose clusters are shown, as name or namespace.name, if empty all the clusters are shown
+	Upstream string
+}
+
+type ProxyLogLevel struct {
+	// Levels formatted as <logger>=<level>
+	Levels   []string
+	Duration time.Duration
+}
+
+type ProxyTap struct {
+	ConfigId   string
+	Host       string
+	PathPrefix string
+	// Headers are given as name:value
+	Headers []string
+	Count   int
+	Timeout time.Duration
+}
+
+type ProxyResponseFlags struct {
+	Window time.Duration
 }
 
 type Upgrade struct {
@@ -135,6 +177,23 @@ type Edit struct {
 }
 
 type Route struct {
+	Explain RouteExplain
+}
+
+type RouteExplain struct {
+	// Files and directories holding the resources to translate, if empty the proxies are read from the cluster
+	Files     []string
+	ProxyName string
+	Method    string
+	Host      string
+	Path      string
+	// Headers are given as name:value
+	Headers  []string
+	Port     uint32
+	Tls      bool
+	Sni      string
+	SourceIp string
+	Output   printTypes.OutputType
 }
 
 type Vault struct {
@@ -491,6 +550,8 @@ type Register struct {
 type Check struct {
 	// The maximum length of time alloted to `glooctl check`. A value of zero means no timeout.
 	CheckTimeout time.Duration
+	// How long before their expiry the certificates of TLS secrets are reported by the certificates check
+	CertificateExpiryWarning time.Duration
 }
 
 type CheckCRD struct {
@@ -498,3 +559,21 @@ type CheckCRD struct {
 	LocalChart string
 	ShowYaml   bool
 }
+
+type Migrate struct {
+	GatewayApi MigrateGatewayApi
+}
+
+type MigrateGatewayApi struct {
+	// Files and directories holding the edge resources to convert, if empty the resources are read from the cluster
+	Files  []string
+	Output printTypes.OutputType
+}
+
+type Translate struct {
+	// Files and directories holding the resources to translate
+	Files []string
+	// ProxyName limits the output to the proxy with this name
+	ProxyName string
+	Output    printTypes.OutputType
+}
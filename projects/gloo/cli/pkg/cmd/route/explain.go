@@ -0,0 +1,153 @@
+package route
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/translate"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/routeexplain"
+	gloosnapshot "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	ProxyNotFoundError = func(name string) error {
+		return eris.Errorf("proxy %v not found", name)
+	}
+)
+
+func Explain(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain",
+		Short: "explain how a proxy routes a request",
+		Long: "Explain simulates the matching envoy does for a request: it selects the listener, filter chain, " +
+			"virtual host and route, lists the routes that were skipped and why, and prints the upstreams the request is " +
+			"sent to, along with the virtual service and route tables the matched route comes from.\n\n" +
+			"The resources are read from files with -f, or the proxy is read from the cluster.",
+		Example: `# explain a request to the gateway-proxy in the cluster
+glooctl route explain --host petstore.example.com --path /api/pets?limit=10 -H x-version:v2
+
+# explain an https request from the resources in a directory, on the listener on port 8443
+glooctl route explain -f ./gloo-config/ --port 8443 --sni petstore.example.com --path /api/pets`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return explainRoute(opts, cmd.OutOrStdout())
+		},
+	}
+	opts.Route.Explain.Output = printers.TABLE
+	flagutils.AddRouteExplainFlags(cmd.Flags(), &opts.Route.Explain)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func explainRoute(opts *options.Options, out io.Writer) error {
+	explainOpts := opts.Route.Explain
+	req, err := routeexplain.NewRequest(explainOpts.Method, explainOpts.Host, explainOpts.Path, explainOpts.Headers)
+	if err != nil {
+		return err
+	}
+	req.Port = explainOpts.Port
+	req.Tls = explainOpts.Tls
+	req.Sni = explainOpts.Sni
+	req.SourceIp = explainOpts.SourceIp
+
+	var result *translate.Result
+	if len(explainOpts.Files) > 0 {
+		result, err = translateFiles(opts)
+	} else {
+		result, err = translateCluster(opts)
+	}
+	if err != nil {
+		return err
+	}
+
+	var proxy *translate.ProxyResult
+	for _, proxyResult := range result.Proxies {
+		if proxyResult.Proxy.GetMetadata().GetName() == explainOpts.ProxyName {
+			proxy = proxyResult
+			break
+		}
+	}
+	if proxy == nil {
+		return ProxyNotFoundError(explainOpts.ProxyName)
+	}
+
+	explanation, err := routeexplain.Explain(proxy.Proxy, proxy.Snapshot, req)
+	if err != nil {
+		return err
+	}
+	return printExplanation(explanation, explainOpts.Output, out)
+}
+
+func translateFiles(opts *options.Options) (*translate.Result, error) {
+	resources, err := translate.LoadResources(opts.Top.Ctx, opts.Metadata.GetNamespace(), opts.Route.Explain.Files)
+	if err != nil {
+		return nil, err
+	}
+	settings := resources.Settings
+	if settings == nil {
+		settings = translate.DefaultSettings(opts.Metadata.GetNamespace())
+	}
+	return translate.Translate(opts.Top.Ctx, resources.Snapshot, settings), nil
+}
+
+// the proxy read from the control plane is translated again with the upstreams and secrets of the cluster,
+// which gives the same xds configuration envoy receives
+func translateCluster(opts *options.Options) (*translate.Result, error) {
+	ctx := opts.Top.Ctx
+	settings, err := common.GetSettings(opts)
+	if err != nil {
+		return nil, err
+	}
+	proxies, err := common.GetProxies(opts.Route.Explain.ProxyName, opts)
+	if err != nil {
+		return nil, err
+	}
+	listOpts := clients.ListOpts{Ctx: ctx}
+	upstreams, err := helpers.MustUpstreamClient(ctx).List("", listOpts)
+	if err != nil {
+		return nil, eris.Wrapf(err, "listing upstreams")
+	}
+	upstreamGroups, err := helpers.MustUpstreamGroupClient(ctx).List("", listOpts)
+	if err != nil {
+		return nil, eris.Wrapf(err, "listing upstream groups")
+	}
+	secrets, err := helpers.MustSecretClient(ctx).List("", listOpts)
+	if err != nil {
+		return nil, eris.Wrapf(err, "listing secrets")
+	}
+	snap := &gloosnapshot.ApiSnapshot{
+		Proxies:        proxies,
+		Upstreams:      upstreams,
+		UpstreamGroups: upstreamGroups,
+		Secrets:        secrets,
+	}
+	return translate.Translate(ctx, snap, settings), nil
+}
+
+func printExplanation(explanation *routeexplain.Explanation, outputType printers.OutputType, out io.Writer) error {
+	if outputType.IsTable() {
+		explanation.PrintText(out)
+		return nil
+	}
+	b, err := json.MarshalIndent(explanation, "", "  ")
+	if err != nil {
+		return err
+	}
+	if !outputType.IsJSON() {
+		if b, err = yaml.JSONToYAML(b); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintln(out, string(b))
+	return err
+}
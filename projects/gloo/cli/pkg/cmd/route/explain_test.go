@@ -0,0 +1,152 @@
+package route_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/routeexplain"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+)
+
+const explainResources = `
+apiVersion: gateway.solo.io/v1
+kind: Gateway
+metadata:
+  name: gateway-proxy
+  namespace: gloo-system
+spec:
+  bindAddress: '::'
+  bindPort: 8080
+  httpGateway: {}
+  proxyNames: [gateway-proxy]
+---
+apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: petstore
+  namespace: apps
+spec:
+  virtualHost:
+    domains: ['petstore.example.com']
+    routes:
+    - matchers:
+      - prefix: /api
+      delegateAction:
+        ref:
+          name: pets
+          namespace: apps
+    - matchers:
+      - prefix: /
+      directResponseAction:
+        status: 404
+---
+apiVersion: gateway.solo.io/v1
+kind: RouteTable
+metadata:
+  name: pets
+  namespace: apps
+spec:
+  routes:
+  - matchers:
+    - prefix: /api/pets
+      headers:
+      - name: x-version
+        value: v2
+    routeAction:
+      single:
+        upstream:
+          name: petstore-v2
+          namespace: apps
+  - matchers:
+    - prefix: /api/pets
+    routeAction:
+      single:
+        upstream:
+          name: petstore
+          namespace: apps
+---
+apiVersion: gloo.solo.io/v1
+kind: Upstream
+metadata:
+  name: petstore
+  namespace: apps
+spec:
+  static:
+    hosts:
+    - addr: petstore.apps.svc.cluster.local
+      port: 8080
+---
+apiVersion: gloo.solo.io/v1
+kind: Upstream
+metadata:
+  name: petstore-v2
+  namespace: apps
+spec:
+  static:
+    hosts:
+    - addr: petstore-v2.apps.svc.cluster.local
+      port: 8080
+`
+
+var _ = Describe("Explain", func() {
+
+	var file string
+
+	BeforeEach(func() {
+		file = filepath.Join(GinkgoT().TempDir(), "resources.yaml")
+		Expect(os.WriteFile(file, []byte(explainResources), 0644)).To(Succeed())
+	})
+
+	explain := func(args string) *routeexplain.Explanation {
+		out, err := testutils.GlooctlOut("route explain -f " + file + " -o json " + args)
+		Expect(err).NotTo(HaveOccurred())
+		var explanation routeexplain.Explanation
+		Expect(json.Unmarshal([]byte(out), &explanation)).To(Succeed())
+		return &explanation
+	}
+
+	It("explains the route selected through a route table", func() {
+		explanation := explain("--host petstore.example.com --path /api/pets/1")
+		Expect(explanation.Proxy).To(Equal("gloo-system.gateway-proxy"))
+		Expect(explanation.Domain).To(Equal("petstore.example.com"))
+
+		Expect(explanation.SkippedRoutes).To(HaveLen(1))
+		Expect(explanation.SkippedRoutes[0].Reason).To(Equal(`header x-version does not match exact "v2"`))
+		Expect(explanation.SkippedRoutes[0].Sources).To(Equal([]string{"VirtualService apps.petstore", "RouteTable apps.pets"}))
+
+		Expect(explanation.Route.Index).To(Equal(1))
+		Expect(explanation.Route.Sources).To(Equal([]string{"VirtualService apps.petstore", "RouteTable apps.pets"}))
+		Expect(explanation.Destination.Clusters).To(ConsistOf(&routeexplain.WeightedCluster{Cluster: "petstore_apps", Upstream: "apps.petstore"}))
+	})
+
+	It("explains a request matching a header", func() {
+		explanation := explain("--host petstore.example.com --path /api/pets -H x-version:v2")
+		Expect(explanation.Route.Index).To(Equal(0))
+		Expect(explanation.Destination.Clusters[0].Upstream).To(Equal("apps.petstore-v2"))
+	})
+
+	It("explains requests that are not routed", func() {
+		explanation := explain("--host petstore.example.com --path /other")
+		Expect(explanation.Route.Sources).To(Equal([]string{"VirtualService apps.petstore"}))
+		Expect(explanation.Destination.DirectResponse).To(BeEquivalentTo(404))
+
+		explanation = explain("--host other.example.com")
+		Expect(explanation.VirtualHost).To(BeEmpty())
+		Expect(explanation.NoMatch).To(Equal(`no virtual host matches host "other.example.com"`))
+	})
+
+	It("prints the explanation as text", func() {
+		out, err := testutils.GlooctlOut("route explain -f " + file + " --host petstore.example.com --path /api/pets")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("matched route:        #1"))
+		Expect(out).To(ContainSubstring("cluster:              petstore_apps (upstream apps.petstore)"))
+	})
+
+	It("returns an error if the proxy does not exist", func() {
+		_, err := testutils.GlooctlOut("route explain -f " + file + " --proxy other")
+		Expect(err).To(MatchError("proxy other not found"))
+	})
+})
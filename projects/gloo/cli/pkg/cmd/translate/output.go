@@ -0,0 +1,154 @@
+package translate
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	skprotoutils "github.com/solo-io/solo-kit/pkg/utils/protoutils"
+
+	// register the envoy filter types, so the typed configs of the xds resources can be printed
+	_ "github.com/solo-io/gloo/projects/envoyinit/hack/filter_types"
+)
+
+const (
+	StateAccepted = "Accepted"
+	StateWarning  = "Warning"
+	StateRejected = "Rejected"
+)
+
+// Output is the printable form of a Result. Every list is sorted, so that the output is deterministic.
+type Output struct {
+	Proxies []map[string]interface{} `json:"proxies"`
+	Reports []*ResourceReport        `json:"reports"`
+	// Xds holds the xds resources of each proxy, keyed by the namespace.name of the proxy
+	Xds map[string]*XdsResources `json:"xds"`
+}
+
+type ResourceReport struct {
+	Kind      string   `json:"kind"`
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+	State     string   `json:"state"`
+	Errors    []string `json:"errors,omitempty"`
+	Warnings  []string `json:"warnings,omitempty"`
+}
+
+type XdsResources struct {
+	Clusters  []map[string]interface{} `json:"clusters"`
+	Endpoints []map[string]interface{} `json:"endpoints"`
+	Listeners []map[string]interface{} `json:"listeners"`
+	Routes    []map[string]interface{} `json:"routes"`
+}
+
+// Output converts the result to its printable form, only including the proxy with the given name if it is not empty.
+func (r *Result) Output(proxyName string) (*Output, error) {
+	out := &Output{
+		Proxies: []map[string]interface{}{},
+		Reports: reportsOutput(r.Reports),
+		Xds:     map[string]*XdsResources{},
+	}
+	for _, proxyResult := range r.Proxies {
+		proxy := proxyResult.Proxy
+		if proxyName != "" && proxy.GetMetadata().GetName() != proxyName {
+			continue
+		}
+		proxyMap, err := skprotoutils.MarshalMap(proxy)
+		if err != nil {
+			return nil, eris.Wrapf(err, "marshalling proxy %v", proxy.GetMetadata().Ref().Key())
+		}
+		out.Proxies = append(out.Proxies, proxyMap)
+
+		xds := &XdsResources{}
+		for _, typed := range []struct {
+			typeUrl string
+			into    *[]map[string]interface{}
+		}{
+			{types.ClusterTypeV3, &xds.Clusters},
+			{types.EndpointTypeV3, &xds.Endpoints},
+			{types.ListenerTypeV3, &xds.Listeners},
+			{types.RouteTypeV3, &xds.Routes},
+		} {
+			if *typed.into, err = xdsResourcesOutput(proxyResult, typed.typeUrl); err != nil {
+				return nil, eris.Wrapf(err, "marshalling xds resources of proxy %v", proxy.GetMetadata().Ref().Key())
+			}
+		}
+		out.Xds[proxy.GetMetadata().Ref().Key()] = xds
+	}
+	return out, nil
+}
+
+// HasErrors returns true if any of the translated resources was rejected.
+func (r *Result) HasErrors() bool {
+	for _, report := range r.Reports {
+		if report.Errors != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func xdsResourcesOutput(proxyResult *ProxyResult, typeUrl string) ([]map[string]interface{}, error) {
+	out := []map[string]interface{}{}
+	if proxyResult.Snapshot == nil {
+		return out, nil
+	}
+	items := proxyResult.Snapshot.GetResources(typeUrl).Items
+	names := make([]string, 0, len(items))
+	for name := range items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resourceMap, err := skprotoutils.MarshalMapFromProto(items[name].ResourceProto())
+		if err != nil {
+			return nil, eris.Wrapf(err, "marshalling %v", name)
+		}
+		out = append(out, resourceMap)
+	}
+	return out, nil
+}
+
+func reportsOutput(reports reporter.ResourceReports) []*ResourceReport {
+	out := []*ResourceReport{}
+	for resource, report := range reports {
+		// resources.Kind is the go type of the resource, such as *v1.VirtualService
+		kind := resources.Kind(resource)
+		kind = kind[strings.LastIndex(kind, ".")+1:]
+		resourceReport := &ResourceReport{
+			Kind:      kind,
+			Name:      resource.GetMetadata().GetName(),
+			Namespace: resource.GetMetadata().GetNamespace(),
+			State:     StateAccepted,
+			Warnings:  report.Warnings,
+		}
+		if len(report.Warnings) > 0 {
+			resourceReport.State = StateWarning
+		}
+		if report.Errors != nil {
+			resourceReport.State = StateRejected
+			if multiErr, ok := report.Errors.(*multierror.Error); ok {
+				for _, err := range multiErr.Errors {
+					resourceReport.Errors = append(resourceReport.Errors, err.Error())
+				}
+			} else {
+				resourceReport.Errors = []string{report.Errors.Error()}
+			}
+		}
+		out = append(out, resourceReport)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Kind != out[j].Kind {
+			return out[i].Kind < out[j].Kind
+		}
+		if out[i].Namespace != out[j].Namespace {
+			return out[i].Namespace < out[j].Namespace
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
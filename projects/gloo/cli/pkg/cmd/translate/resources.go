@@ -0,0 +1,218 @@
+package translate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	kubeconverters "github.com/solo-io/gloo/projects/gloo/pkg/api/converters/kube"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kubesecret"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	skprotoutils "github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// StdinPath reads the resources from stdin instead of a file
+const StdinPath = "-"
+
+var (
+	NoFilesError = eris.New("no files to translate, specify them with -f")
+
+	DuplicateSettingsError = func(first, second *core.ResourceRef) error {
+		return eris.Errorf("found more than one Settings resource: %v and %v", first.Key(), second.Key())
+	}
+
+	secretGVK    = corev1.SchemeGroupVersion.WithKind("Secret")
+	configMapGVK = corev1.SchemeGroupVersion.WithKind("ConfigMap")
+)
+
+// Resources are the resources read from files, in the form the translators consume them.
+type Resources struct {
+	Snapshot *gloosnapshot.ApiSnapshot
+	// Settings is nil if no Settings resource was read
+	Settings *gloov1.Settings
+	// Skipped describes the objects that were read but are not translated, such as Deployments
+	Skipped []string
+}
+
+// LoadResources reads the resources from the given files and directories (recursively), in YAML or JSON,
+// with any number of documents per file, including Lists.
+// Resources without a namespace are placed in defaultNamespace, like kubectl does with its current namespace.
+func LoadResources(ctx context.Context, defaultNamespace string, paths []string) (*Resources, error) {
+	if len(paths) == 0 {
+		return nil, NoFilesError
+	}
+	loader := &resourceLoader{
+		ctx:              ctx,
+		defaultNamespace: defaultNamespace,
+		resources:        &Resources{Snapshot: &gloosnapshot.ApiSnapshot{}},
+	}
+	var err error
+	// kube secrets are converted the same way the gloo kube secret client converts them
+	loader.secretClient, err = kubesecret.NewResourceClientWithSecretConverter(nil, &gloov1.Secret{}, nil, kubeconverters.GlooSecretConverterChain)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range paths {
+		if err := loader.loadPath(path); err != nil {
+			return nil, err
+		}
+	}
+	return loader.resources, nil
+}
+
+type resourceLoader struct {
+	ctx              context.Context
+	defaultNamespace string
+	secretClient     *kubesecret.ResourceClient
+	resources        *Resources
+}
+
+func (l *resourceLoader) loadPath(path string) error {
+	if path == StdinPath {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return eris.Wrapf(err, "reading stdin")
+		}
+		return l.loadData("stdin", data)
+	}
+	return filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return eris.Wrapf(err, "reading %v", file)
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		// files given explicitly are read whatever their extension, files in directories only if they hold yaml or json
+		if file != path && !isManifestFile(file) {
+			return nil
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return eris.Wrapf(err, "reading %v", file)
+		}
+		return l.loadData(file, data)
+	})
+}
+
+func isManifestFile(file string) bool {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+func (l *resourceLoader) loadData(source string, data []byte) error {
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		var obj unstructured.Unstructured
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return eris.Wrapf(err, "decoding %v", source)
+		}
+		if len(obj.Object) == 0 {
+			// empty document
+			continue
+		}
+		if obj.IsList() {
+			err := obj.EachListItem(func(item runtime.Object) error {
+				return l.loadObject(source, item.(*unstructured.Unstructured))
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if err := l.loadObject(source, &obj); err != nil {
+			return err
+		}
+	}
+}
+
+func (l *resourceLoader) loadObject(source string, obj *unstructured.Unstructured) error {
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(l.defaultNamespace)
+	}
+	gvk := obj.GroupVersionKind()
+	description := fmt.Sprintf("%v %v.%v in %v", gvk.Kind, obj.GetNamespace(), obj.GetName(), source)
+	raw, err := obj.MarshalJSON()
+	if err != nil {
+		return eris.Wrapf(err, "encoding %v", description)
+	}
+
+	resource, err := l.convert(gvk, raw)
+	if err != nil {
+		return eris.Wrapf(err, "reading %v", description)
+	}
+	if resource == nil {
+		l.resources.Skipped = append(l.resources.Skipped, description)
+		return nil
+	}
+	if settings, ok := resource.(*gloov1.Settings); ok {
+		if existing := l.resources.Settings; existing != nil {
+			return DuplicateSettingsError(existing.GetMetadata().Ref(), settings.GetMetadata().Ref())
+		}
+		l.resources.Settings = settings
+		return nil
+	}
+	return l.resources.Snapshot.UpsertToResourceList(resource)
+}
+
+// returns nil if the object is not a resource that is translated
+func (l *resourceLoader) convert(gvk schema.GroupVersionKind, raw []byte) (resources.Resource, error) {
+	switch gvk {
+	case gloov1.SettingsGVK:
+		settings := &gloov1.Settings{}
+		if err := skprotoutils.UnmarshalResource(raw, settings); err != nil {
+			return nil, err
+		}
+		return settings, nil
+	case secretGVK:
+		var secret corev1.Secret
+		if err := json.Unmarshal(raw, &secret); err != nil {
+			return nil, err
+		}
+		// the api server merges stringData into data when the secret is written
+		for key, value := range secret.StringData {
+			if secret.Data == nil {
+				secret.Data = map[string][]byte{}
+			}
+			secret.Data[key] = []byte(value)
+		}
+		return kubeconverters.GlooSecretConverterChain.FromKubeSecret(l.ctx, l.secretClient, &secret)
+	case configMapGVK:
+		var configMap corev1.ConfigMap
+		if err := json.Unmarshal(raw, &configMap); err != nil {
+			return nil, err
+		}
+		return kubeconverters.KubeConfigMapToArtifact(&configMap), nil
+	}
+
+	newResource, ok := gloosnapshot.ApiGvkToHashableResource[gvk]
+	if !ok {
+		return nil, nil
+	}
+	resource := newResource()
+	if err := skprotoutils.UnmarshalResource(raw, resource); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
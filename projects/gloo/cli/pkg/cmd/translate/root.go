@@ -0,0 +1,88 @@
+package translate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	TranslationErrorsError = eris.New("translation reported errors, see the reports in the output")
+)
+
+func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.TRANSLATE_COMMAND.Use,
+		Short: constants.TRANSLATE_COMMAND.Short,
+		Long:  constants.TRANSLATE_COMMAND.Long,
+		Example: `# render the envoy configuration of the resources in a directory
+glooctl translate -f ./gloo-config/
+
+# only print the output for the gateway-proxy, as json
+glooctl translate -f gateway.yaml -f vs.yaml -f upstream.yaml --proxy gateway-proxy -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunTranslate(opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+	opts.Translate.Output = printers.YAML
+	pflags := cmd.PersistentFlags()
+	flagutils.AddTranslateFlags(pflags, &opts.Translate)
+	pflags.StringVarP(&opts.Metadata.Namespace, "namespace", "n", flagutils.DefaultNamespace,
+		"namespace of the resources that do not set one, and of the default settings if none are given")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+// RunTranslate translates the resources in the files of the options and prints the output.
+// Resources without a namespace, and the default Settings if none are given, are placed in the namespace of the options.
+// Returns an error if any of the resources is rejected.
+func RunTranslate(opts *options.Options, out, errOut io.Writer) error {
+	ctx := opts.Top.Ctx
+	resources, err := LoadResources(ctx, opts.Metadata.GetNamespace(), opts.Translate.Files)
+	if err != nil {
+		return err
+	}
+	for _, skipped := range resources.Skipped {
+		fmt.Fprintf(errOut, "skipping %v, it is not translated\n", skipped)
+	}
+	settings := resources.Settings
+	if settings == nil {
+		settings = DefaultSettings(opts.Metadata.GetNamespace())
+	}
+
+	result := Translate(ctx, resources.Snapshot, settings)
+	output, err := result.Output(opts.Translate.ProxyName)
+	if err != nil {
+		return err
+	}
+	if err := printOutput(output, opts.Translate.Output, out); err != nil {
+		return err
+	}
+	if result.HasErrors() {
+		return TranslationErrorsError
+	}
+	return nil
+}
+
+func printOutput(output *Output, outputType printers.OutputType, out io.Writer) error {
+	b, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return err
+	}
+	if !outputType.IsJSON() {
+		if b, err = yaml.JSONToYAML(b); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintln(out, string(b))
+	return err
+}
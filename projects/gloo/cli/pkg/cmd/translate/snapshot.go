@@ -0,0 +1,58 @@
+package translate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/proxydiff"
+)
+
+var (
+	ProxyNotTranslatedError = func(proxyName string, files string) error {
+		return eris.Errorf("the resources in %v do not translate to a proxy named %v", files, proxyName)
+	}
+	AmbiguousProxyError = func(proxyName string, proxies []string) error {
+		return eris.Errorf("more than one proxy is named %v: %v, set the namespace of the proxy to choose one", proxyName, strings.Join(proxies, ", "))
+	}
+)
+
+// ProxySnapshot translates the resources in the files and directories offline, and captures the configuration of the
+// proxy with the given name, to compare it with glooctl proxy diff. The proxy in the given namespace is preferred when
+// proxies with the same name are translated in several namespaces.
+func ProxySnapshot(ctx context.Context, namespace, proxyName string, paths []string) (*proxydiff.Snapshot, error) {
+	files := strings.Join(paths, ",")
+	resources, err := LoadResources(ctx, namespace, paths)
+	if err != nil {
+		return nil, err
+	}
+	settings := resources.Settings
+	if settings == nil {
+		settings = DefaultSettings(namespace)
+	}
+	output, err := Translate(ctx, resources.Snapshot, settings).Output(proxyName)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := namespace + "." + proxyName
+	if _, ok := output.Xds[proxy]; !ok {
+		var proxies []string
+		for key := range output.Xds {
+			proxies = append(proxies, key)
+		}
+		sort.Strings(proxies)
+		switch len(proxies) {
+		case 0:
+			return nil, ProxyNotTranslatedError(proxyName, files)
+		case 1:
+			proxy = proxies[0]
+		default:
+			return nil, AmbiguousProxyError(proxyName, proxies)
+		}
+	}
+	xds := output.Xds[proxy]
+	return proxydiff.FromXdsResources(fmt.Sprintf("files %v", files), proxy, xds.Listeners, xds.Routes, xds.Clusters, xds.Endpoints), nil
+}
@@ -0,0 +1,110 @@
+package translate
+
+import (
+	"context"
+
+	gwtranslator "github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/gloo/projects/gateway/pkg/utils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+// Result is the output of translating a set of resources.
+type Result struct {
+	// Proxies holds the translated proxies, sorted by namespace and name
+	Proxies []*ProxyResult
+	// Reports holds the reports of every resource that was translated
+	Reports reporter.ResourceReports
+}
+
+type ProxyResult struct {
+	Proxy *gloov1.Proxy
+	// Snapshot is the xDS configuration that Envoy receives for the proxy
+	Snapshot envoycache.Snapshot
+}
+
+// DefaultSettings are used when no Settings resource is given,
+// and match the Settings written by the helm chart in the given namespace.
+func DefaultSettings(namespace string) *gloov1.Settings {
+	return &gloov1.Settings{
+		Metadata: &core.Metadata{
+			Name:      defaults.SettingsName,
+			Namespace: namespace,
+		},
+		DiscoveryNamespace: namespace,
+		WatchNamespaces:    []string{},
+	}
+}
+
+// Translate runs the gateway translator and then the gloo translator on the resources, the same way the gloo
+// translation loop does, without writing anything.
+// Proxies read from the resources are translated alongside the ones generated from the Gateways.
+func Translate(ctx context.Context, snap *gloosnapshot.ApiSnapshot, settings *gloov1.Settings) *Result {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	writeNamespace := settings.GetDiscoveryNamespace()
+	if writeNamespace == "" {
+		writeNamespace = settings.GetMetadata().GetNamespace()
+	}
+
+	result := &Result{Reports: reporter.ResourceReports{}}
+
+	gatewayTranslator := gwtranslator.NewDefaultTranslator(gwtranslator.Opts{
+		GlooNamespace:                  writeNamespace,
+		WriteNamespace:                 writeNamespace,
+		ReadGatewaysFromAllNamespaces:  settings.GetGateway().GetReadGatewaysFromAllNamespaces(),
+		IsolateVirtualHostsBySslConfig: settings.GetGateway().GetIsolateVirtualHostsBySslConfig().GetValue(),
+		Validation: &gwtranslator.ValidationOpts{
+			WarnOnRouteShortCircuiting: settings.GetGateway().GetValidation().GetWarnRouteShortCircuiting().GetValue(),
+		},
+	})
+	for proxyName, gateways := range utils.GatewaysByProxyName(snap.Gateways) {
+		proxy, reports := gatewayTranslator.Translate(ctx, proxyName, snap, gateways)
+		result.Reports.Merge(reports)
+		if proxy != nil {
+			snap.Proxies = append(snap.Proxies, proxy)
+		}
+	}
+	snap.Proxies.Sort()
+
+	result.Reports.Accept(snap.Upstreams.AsInputResources()...)
+	result.Reports.Accept(snap.UpstreamGroups.AsInputResources()...)
+	result.Reports.Accept(snap.Proxies.AsInputResources()...)
+
+	pluginRegistry := registry.GetPluginRegistryFactory(bootstrap.Opts{
+		Settings:  settings,
+		Secrets:   &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()},
+		WatchOpts: clients.WatchOpts{Ctx: ctx},
+	})(ctx)
+	glooTranslator := translator.NewDefaultTranslator(settings, pluginRegistry)
+	for _, proxy := range snap.Proxies {
+		params := plugins.Params{
+			Ctx:      ctx,
+			Snapshot: snap,
+			Messages: map[*core.ResourceRef][]string{},
+		}
+		xdsSnapshot, reports, _ := glooTranslator.Translate(params, proxy)
+		// Messages are aggregated during translation, and need to be added to reports
+		for _, messages := range params.Messages {
+			reports.AddMessages(proxy, messages...)
+		}
+		result.Reports.Merge(reports)
+		result.Proxies = append(result.Proxies, &ProxyResult{
+			Proxy:    proxy,
+			Snapshot: xdsSnapshot,
+		})
+	}
+	return result
+}
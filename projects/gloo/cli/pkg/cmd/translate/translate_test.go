@@ -0,0 +1,270 @@
+package translate_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/translate"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/gloo/test/helpers"
+)
+
+const (
+	gatewayYaml = `
+apiVersion: gateway.solo.io/v1
+kind: Gateway
+metadata:
+  name: gateway-proxy
+spec:
+  bindAddress: '::'
+  bindPort: 8080
+  httpGateway: {}
+  proxyNames: [gateway-proxy]
+`
+	virtualServiceYaml = `
+apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: petstore
+  namespace: apps
+spec:
+  virtualHost:
+    domains: ['petstore.example.com']
+    routes:
+    - matchers:
+      - prefix: /
+      routeAction:
+        single:
+          upstream:
+            name: petstore
+            namespace: apps
+`
+	upstreamYaml = `
+apiVersion: gloo.solo.io/v1
+kind: Upstream
+metadata:
+  name: petstore
+  namespace: apps
+spec:
+  static:
+    hosts:
+    - addr: petstore.apps.svc.cluster.local
+      port: 8080
+`
+	settingsYaml = `
+apiVersion: gloo.solo.io/v1
+kind: Settings
+metadata:
+  name: default
+  namespace: gloo-system
+spec:
+  discoveryNamespace: gloo-system
+  gateway:
+    readGatewaysFromAllNamespaces: true
+`
+	deploymentYaml = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: petstore
+  namespace: apps
+`
+)
+
+var _ = Describe("Translate", func() {
+
+	var (
+		dir  string
+		opts *options.Options
+		out  *bytes.Buffer
+		errs *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		opts = &options.Options{
+			Top:       options.Top{Ctx: context.Background()},
+			Translate: options.Translate{Output: printers.JSON},
+		}
+		opts.Metadata.Namespace = "gloo-system"
+		opts.Translate.Files = []string{dir}
+		out = &bytes.Buffer{}
+		errs = &bytes.Buffer{}
+	})
+
+	writeFile := func(name string, documents ...string) {
+		var content []byte
+		for _, doc := range documents {
+			content = append(content, []byte("---"+doc)...)
+		}
+		Expect(os.WriteFile(filepath.Join(dir, name), content, 0644)).To(Succeed())
+	}
+
+	runTranslate := func() (*translate.Output, error) {
+		err := translate.RunTranslate(opts, out, errs)
+		var output translate.Output
+		Expect(json.Unmarshal(out.Bytes(), &output)).To(Succeed())
+		return &output, err
+	}
+
+	It("translates the resources of a directory into proxies and xds resources", func() {
+		writeFile("gateway.yaml", gatewayYaml, settingsYaml)
+		writeFile("petstore.yml", virtualServiceYaml, upstreamYaml, deploymentYaml)
+		writeFile("README.md", "not a manifest")
+
+		output, err := runTranslate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errs.String()).To(ContainSubstring("skipping Deployment apps.petstore"))
+
+		Expect(output.Proxies).To(HaveLen(1))
+		Expect(output.Proxies[0]).To(HaveKeyWithValue("metadata", HaveKeyWithValue("name", "gateway-proxy")))
+
+		var states []string
+		for _, report := range output.Reports {
+			states = append(states, report.Kind+" "+report.Namespace+"."+report.Name+" "+report.State)
+		}
+		Expect(states).To(Equal([]string{
+			"Gateway gloo-system.gateway-proxy Accepted",
+			"Proxy gloo-system.gateway-proxy Accepted",
+			"Upstream apps.petstore Accepted",
+			"VirtualService apps.petstore Accepted",
+		}))
+
+		Expect(output.Xds).To(HaveKey("gloo-system.gateway-proxy"))
+		xds := output.Xds["gloo-system.gateway-proxy"]
+		Expect(xds.Clusters).To(HaveLen(1))
+		Expect(xds.Clusters[0]).To(HaveKeyWithValue("name", "petstore_apps"))
+		Expect(xds.Listeners).To(HaveLen(1))
+		Expect(xds.Listeners[0]).To(HaveKeyWithValue("name", "listener-::-8080"))
+		Expect(xds.Routes).To(HaveLen(1))
+		Expect(xds.Routes[0]).To(HaveKeyWithValue("virtualHosts", ContainElement(HaveKeyWithValue("domains", ConsistOf("petstore.example.com")))))
+	})
+
+	It("places resources without a namespace and the default settings in the given namespace", func() {
+		writeFile("resources.yaml", gatewayYaml, virtualServiceYaml, upstreamYaml)
+		opts.Metadata.Namespace = "apps"
+
+		output, err := runTranslate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.Proxies).To(HaveLen(1))
+		Expect(output.Proxies[0]).To(HaveKeyWithValue("metadata", HaveKeyWithValue("namespace", "apps")))
+	})
+
+	It("reports warnings without returning an error", func() {
+		writeFile("resources.yaml", gatewayYaml, virtualServiceYaml)
+
+		output, err := runTranslate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.Reports).To(ContainElement(And(
+			HaveField("Kind", "Proxy"),
+			HaveField("State", translate.StateWarning),
+			HaveField("Warnings", ContainElement(ContainSubstring("*v1.Upstream { apps.petstore } not found"))),
+		)))
+	})
+
+	It("returns an error after printing the output when a resource is rejected", func() {
+		conflictingVirtualServiceYaml := strings.ReplaceAll(virtualServiceYaml, "name: petstore\n  namespace: apps", "name: petstore-v2\n  namespace: apps")
+		writeFile("resources.yaml", gatewayYaml, virtualServiceYaml, conflictingVirtualServiceYaml, upstreamYaml)
+
+		output, err := runTranslate()
+		Expect(err).To(MatchError(translate.TranslationErrorsError))
+		Expect(output.Xds).To(HaveKey("gloo-system.gateway-proxy"))
+		Expect(output.Reports).To(ContainElement(And(
+			HaveField("Kind", "Gateway"),
+			HaveField("State", translate.StateRejected),
+			HaveField("Errors", ContainElement(ContainSubstring("domain conflict"))),
+		)))
+	})
+
+	It("prints the output for the given proxy only", func() {
+		writeFile("resources.yaml", gatewayYaml, virtualServiceYaml, upstreamYaml)
+		opts.Translate.ProxyName = "other-proxy"
+
+		output, err := runTranslate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.Proxies).To(BeEmpty())
+		Expect(output.Xds).To(BeEmpty())
+	})
+
+	Context("proxy snapshots", func() {
+
+		It("captures the proxy with the given name", func() {
+			writeFile("resources.yaml", gatewayYaml, settingsYaml, virtualServiceYaml, upstreamYaml)
+
+			// the proxy is written to the discovery namespace of the settings
+			snap, err := translate.ProxySnapshot(context.Background(), "apps", "gateway-proxy", []string{dir})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snap.Proxy).To(Equal("gloo-system.gateway-proxy"))
+			Expect(snap.Clusters).To(ConsistOf(HaveKeyWithValue("name", "petstore_apps")))
+		})
+
+		It("returns an error when no proxy has the given name", func() {
+			writeFile("resources.yaml", gatewayYaml, virtualServiceYaml, upstreamYaml)
+
+			_, err := translate.ProxySnapshot(context.Background(), "gloo-system", "other-proxy", []string{dir})
+			Expect(err).To(MatchError(translate.ProxyNotTranslatedError("other-proxy", dir)))
+		})
+	})
+
+	Context("loading resources", func() {
+
+		It("converts kubernetes secrets and config maps, and reads lists", func() {
+			writeFile("list.yaml", `
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Secret
+  type: kubernetes.io/tls
+  metadata:
+    name: petstore-tls
+  stringData:
+    tls.crt: `+jsonString(helpers.Certificate())+`
+    tls.key: `+jsonString(helpers.PrivateKey())+`
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: descriptors
+    namespace: apps
+  data:
+    key: value
+`)
+			resources, err := translate.LoadResources(context.Background(), "gloo-system", []string{dir})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources.Settings).To(BeNil())
+
+			secret, err := resources.Snapshot.Secrets.Find("gloo-system", "petstore-tls")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.GetTls().GetCertChain()).To(Equal(helpers.Certificate()))
+
+			artifact, err := resources.Snapshot.Artifacts.Find("apps", "descriptors")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(artifact.GetData()).To(Equal(map[string]string{"key": "value"}))
+		})
+
+		It("rejects more than one settings resource", func() {
+			writeFile("settings.yaml", settingsYaml, settingsYaml)
+			_, err := translate.LoadResources(context.Background(), "gloo-system", []string{dir})
+			Expect(err).To(MatchError(ContainSubstring("found more than one Settings resource")))
+		})
+
+		It("requires files", func() {
+			_, err := translate.LoadResources(context.Background(), "gloo-system", nil)
+			Expect(err).To(MatchError(translate.NoFilesError))
+		})
+	})
+})
+
+// multi-line values are embedded in the yaml as json strings
+func jsonString(s string) string {
+	b, err := json.Marshal(s)
+	Expect(err).NotTo(HaveOccurred())
+	return string(b)
+}
@@ -71,6 +71,19 @@ var (
 		Short: "Checks Gloos CRDs for consistency against an official (or local) helm charts CRDs",
 	}
 
+	TRANSLATE_COMMAND = cobra.Command{
+		Use:   "translate",
+		Short: "Translate Gloo resources from files into Envoy configuration, without a cluster",
+		Long: "Runs the gateway and gloo translators on the Gateways, VirtualServices, RouteTables, Upstreams, Secrets " +
+			"and Settings read from the given files and directories, and prints the resulting Proxies, the reports of " +
+			"the resources and the xDS configuration that Envoy would receive.",
+	}
+
+	MIGRATE_COMMAND = cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate Gloo resources to other APIs",
+	}
+
 	CREATE_COMMAND = cobra.Command{
 		Use:     "create",
 		Aliases: []string{"c"},
@@ -0,0 +1,178 @@
+package envoyadmin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/envoyutils/admincli"
+	"github.com/solo-io/gloo/pkg/utils/protoutils"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	UnexpectedStatusError = func(path string, status int, body string) error {
+		return eris.Errorf("envoy admin %s responded with status %d: %s", path, status, strings.TrimSpace(body))
+	}
+)
+
+// Client executes requests against the Envoy admin API over HTTP, typically through a port-forward to a proxy.
+type Client struct {
+	baseUrl    string
+	httpClient *http.Client
+}
+
+// NewClient returns a client for the admin API listening on address, in the host:port form.
+func NewClient(address string, httpClient *http.Client) *Client {
+	return &Client{
+		baseUrl:    "http://" + address,
+		httpClient: httpClient,
+	}
+}
+
+// Clusters returns the clusters of the proxy and the status of their hosts.
+func (c *Client) Clusters(ctx context.Context) (*adminv3.Clusters, error) {
+	body, err := c.get(ctx, admincli.ClustersPath, url.Values{"format": {"json"}})
+	if err != nil {
+		return nil, err
+	}
+	var clusters adminv3.Clusters
+	if err := protoutils.UnmarshalAllowUnknown(bytes.NewBuffer(body), &clusters); err != nil {
+		return nil, eris.Wrap(err, "decoding envoy clusters")
+	}
+	return &clusters, nil
+}
+
+// Stats returns the value of the counters and gauges of the proxy.
+func (c *Client) Stats(ctx context.Context) (map[string]uint64, error) {
+	body, err := c.get(ctx, admincli.StatsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseStats(body), nil
+}
+
+// LogLevels returns the level of every logger of the proxy.
+func (c *Client) LogLevels(ctx context.Context) (map[string]string, error) {
+	// the logging endpoint lists the active loggers when it is called without parameters
+	body, err := c.post(ctx, admincli.LoggingPath, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseLogLevels(body), nil
+}
+
+// SetLogLevel sets the level of a single logger of the proxy.
+func (c *Client) SetLogLevel(ctx context.Context, logger, level string) error {
+	_, err := c.post(ctx, admincli.LoggingPath, url.Values{logger: {level}}, nil)
+	return err
+}
+
+// Tap streams the traces captured by the tap filter configured with the config id of the request, passing each
+// trace to traces until ctx is done or traces returns false.
+func (c *Client) Tap(ctx context.Context, request *adminv3.TapRequest, traces func(trace json.RawMessage) bool) error {
+	body, err := protojson.Marshal(request)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseUrl+"/tap", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return UnexpectedStatusError("/tap", resp.StatusCode, string(respBody))
+	}
+
+	// traces are streamed as consecutive json documents
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var trace json.RawMessage
+		if err := decoder.Decode(&trace); err != nil {
+			if ctx.Err() != nil || err == io.EOF {
+				return nil
+			}
+			return eris.Wrap(err, "decoding tap trace")
+		}
+		if !traces(trace) {
+			return nil
+		}
+	}
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	return c.do(ctx, http.MethodGet, path, query, nil)
+}
+
+func (c *Client) post(ctx context.Context, path string, query url.Values, body io.Reader) ([]byte, error) {
+	return c.do(ctx, http.MethodPost, path, query, body)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body io.Reader) ([]byte, error) {
+	target := c.baseUrl + "/" + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, UnexpectedStatusError("/"+path, resp.StatusCode, string(respBody))
+	}
+	return respBody, nil
+}
+
+// parseStats reads the `<name>: <value>` lines of the stats endpoint, skipping histograms
+func parseStats(body []byte) map[string]uint64 {
+	stats := map[string]uint64{}
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		name, value, ok := strings.Cut(scanner.Text(), ": ")
+		if !ok {
+			continue
+		}
+		if n, err := strconv.ParseUint(value, 10, 64); err == nil {
+			stats[name] = n
+		}
+	}
+	return stats
+}
+
+// parseLogLevels reads the `  <logger>: <level>` lines listed after `active loggers:`
+func parseLogLevels(body []byte) map[string]string {
+	levels := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, " ") {
+			continue
+		}
+		if logger, level, ok := strings.Cut(strings.TrimSpace(line), ": "); ok {
+			levels[logger] = level
+		}
+	}
+	return levels
+}
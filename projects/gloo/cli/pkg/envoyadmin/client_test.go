@@ -0,0 +1,242 @@
+package envoyadmin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gloo/cli/pkg/envoyadmin"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func tapRequest(body []byte) *adminv3.TapRequest {
+	var request adminv3.TapRequest
+	ExpectWithOffset(1, protojson.Unmarshal(body, &request)).NotTo(HaveOccurred())
+	return &request
+}
+
+var _ = Describe("Client", func() {
+
+	var (
+		ctx    context.Context
+		admin  *fakeAdmin
+		server *httptest.Server
+		client *Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		admin, server = newFakeAdmin()
+		client = NewClient(strings.TrimPrefix(server.URL, "http://"), http.DefaultClient)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("cluster health", func() {
+
+		BeforeEach(func() {
+			admin.clusters = `{"cluster_statuses": [
+  {"name": "petstore_default", "host_statuses": [
+    {"address": {"socket_address": {"address": "10.0.0.1", "port_value": 8080}}, "weight": 1,
+     "stats": [{"name": "rq_total", "value": "12", "type": "COUNTER"}, {"name": "rq_error", "value": "2", "type": "COUNTER"}],
+     "health_status": {"eds_health_status": "HEALTHY"}},
+    {"address": {"socket_address": {"address": "10.0.0.2", "port_value": 8080}}, "weight": 1,
+     "health_status": {"failed_outlier_check": true, "eds_health_status": "DRAINING"}}
+  ]},
+  {"name": "xds_cluster", "added_via_api": false, "host_statuses": [
+    {"address": {"socket_address": {"address": "gloo", "port_value": 9977}}, "health_status": {}}
+  ]},
+  {"name": "httpbin_default", "host_statuses": []}
+]}`
+		})
+
+		upstream := func(name, namespace string) *v1.Upstream {
+			return &v1.Upstream{Metadata: &core.Metadata{Name: name, Namespace: namespace}}
+		}
+
+		It("names the upstream of each cluster", func() {
+			clusters, err := client.Clusters(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			health := ClustersHealth(clusters, v1.UpstreamList{upstream("petstore", "default"), upstream("httpbin", "default")}, "")
+			Expect(health).To(HaveLen(3))
+
+			Expect(health[0].Cluster).To(Equal("httpbin_default"))
+			Expect(health[0].Upstream.GetName()).To(Equal("httpbin"))
+			Expect(health[0].Hosts).To(BeEmpty())
+
+			petstore := health[1]
+			Expect(petstore.Upstream.GetNamespace()).To(Equal("default"))
+			Expect(petstore.HealthyHosts()).To(Equal(1))
+			Expect(*petstore.Hosts[0]).To(Equal(HostHealth{Address: "10.0.0.1:8080", Weight: 1, Requests: 12, Errors: 2}))
+			Expect(petstore.Hosts[1].Failures).To(Equal([]string{"eds status draining", "ejected by outlier detection"}))
+
+			Expect(health[2].Cluster).To(Equal("xds_cluster"))
+			Expect(health[2].Upstream).To(BeNil())
+		})
+
+		It("filters the clusters of an upstream", func() {
+			clusters, err := client.Clusters(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			upstreams := v1.UpstreamList{upstream("petstore", "default"), upstream("httpbin", "default")}
+
+			for _, filter := range []string{"petstore", "default.petstore"} {
+				health := ClustersHealth(clusters, upstreams, filter)
+				Expect(health).To(HaveLen(1))
+				Expect(health[0].Cluster).To(Equal("petstore_default"))
+			}
+			Expect(ClustersHealth(clusters, upstreams, "xds_cluster")).To(BeEmpty())
+		})
+
+		It("prints the hosts of each cluster", func() {
+			clusters, err := client.Clusters(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			var out strings.Builder
+			PrintClustersHealth(ClustersHealth(clusters, v1.UpstreamList{upstream("petstore", "default")}, "petstore"), &out)
+			Expect(out.String()).To(And(
+				ContainSubstring("default.petstore"),
+				ContainSubstring("1/2"),
+				ContainSubstring("ejected by outlier detection"),
+			))
+		})
+	})
+
+	Context("response flags", func() {
+
+		It("counts the responses of each flag in the window", func() {
+			admin.stats = `cluster.petstore_default.upstream_cx_none_healthy: 3
+cluster.petstore_default.upstream_rq_timeout: 1
+cluster.petstore_default.ext_authz.denied: 4
+cluster.httpbin_default.upstream_rq_timeout: 7
+http.http.no_route: 10
+http.http.downstream_rq_time: P0(nan,1.0) P25(nan,2.0)
+`
+			before, err := client.Stats(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(before).NotTo(HaveKey("http.http.downstream_rq_time"))
+
+			admin.stats = `cluster.petstore_default.upstream_cx_none_healthy: 8
+cluster.petstore_default.upstream_rq_timeout: 1
+cluster.petstore_default.ext_authz.denied: 6
+cluster.httpbin_default.upstream_rq_timeout: 2
+http.http.no_route: 11
+http.https.no_route: 0
+`
+			after, err := client.Stats(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			counts := SummarizeResponseFlags(before, after)
+			Expect(counts).To(HaveLen(4))
+			Expect(*counts[0]).To(Equal(ResponseFlagCount{Flag: "UH", Description: "no healthy upstream", Source: "petstore_default", Count: 5}))
+			Expect(*counts[1]).To(Equal(ResponseFlagCount{Flag: "UAEX", Description: "denied by external authorization", Source: "petstore_default", Count: 2}))
+			// the counter was reset, e.g. because envoy restarted
+			Expect(*counts[2]).To(Equal(ResponseFlagCount{Flag: "UT", Description: "upstream request timeout", Source: "httpbin_default", Count: 2}))
+			Expect(*counts[3]).To(Equal(ResponseFlagCount{Flag: "NR", Description: "no route", Source: "http", Count: 1}))
+		})
+	})
+
+	Context("log levels", func() {
+
+		BeforeEach(func() {
+			admin.levels = map[string]string{"router": "info", "http": "info", "upstream": "warning"}
+		})
+
+		It("reverts the levels after the duration", func() {
+			var previous map[string]string
+			err := SetLogLevelsTemporarily(ctx, client, map[string]string{"router": "debug", "http": "trace"}, 50*time.Millisecond, func(p map[string]string) {
+				previous = p
+				Expect(admin.logLevels()).To(Equal(map[string]string{"router": "debug", "http": "trace", "upstream": "warning"}))
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(previous).To(Equal(map[string]string{"router": "info", "http": "info"}))
+			Expect(admin.logLevels()).To(Equal(map[string]string{"router": "info", "http": "info", "upstream": "warning"}))
+		})
+
+		It("reverts the levels when the context is done", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			err := SetLogLevelsTemporarily(ctx, client, map[string]string{"all": "debug", "upstream": "trace"}, time.Hour, func(map[string]string) {
+				Expect(admin.logLevels()).To(Equal(map[string]string{"router": "debug", "http": "debug", "upstream": "trace"}))
+				cancel()
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admin.logLevels()).To(Equal(map[string]string{"router": "info", "http": "info", "upstream": "warning"}))
+		})
+
+		It("rejects unknown loggers before changing any level", func() {
+			err := SetLogLevelsTemporarily(ctx, client, map[string]string{"router": "debug", "missing": "debug"}, time.Hour, func(map[string]string) {
+				Fail("the levels should not be set")
+			})
+			Expect(err).To(MatchError(UnknownLoggerError("missing", []string{"http", "router", "upstream"})))
+			Expect(admin.logLevels()["router"]).To(Equal("info"))
+		})
+
+		It("parses levels", func() {
+			levels, err := ParseLogLevels([]string{"router=debug", "all=info"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(levels).To(Equal(map[string]string{"router": "debug", "all": "info"}))
+
+			_, err = ParseLogLevels([]string{"router"})
+			Expect(err).To(MatchError(InvalidLogLevelFlagError("router")))
+		})
+	})
+
+	Context("tap", func() {
+
+		BeforeEach(func() {
+			admin.traces = []string{
+				`{"http_buffered_trace": {"request": {"headers": [{"key": ":path", "value": "/api/pets/1"}]}}}`,
+				`{"http_buffered_trace": {"request": {"headers": [{"key": ":path", "value": "/api/pets/2"}]}}}`,
+				`{"http_buffered_trace": {"request": {"headers": [{"key": ":path", "value": "/api/pets/3"}]}}}`,
+			}
+		})
+
+		It("streams the traces of the matching requests", func() {
+			headers, err := ParseTapHeaders([]string{"X-User: alice"})
+			Expect(err).NotTo(HaveOccurred())
+			request := NewTapRequest("glooctl", TapMatch{Host: "petstore.example.com", PathPrefix: "/api/pets", Headers: headers})
+
+			var traces []json.RawMessage
+			err = client.Tap(ctx, request, func(trace json.RawMessage) bool {
+				traces = append(traces, trace)
+				return len(traces) < 2
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(traces).To(HaveLen(2))
+			Expect(string(traces[1])).To(ContainSubstring("/api/pets/2"))
+
+			sent := tapRequest(admin.tapBody)
+			Expect(sent.GetConfigId()).To(Equal("glooctl"))
+			Expect(sent.GetTapConfig().GetOutputConfig().GetSinks()[0].GetStreamingAdmin()).NotTo(BeNil())
+			headerMatchers := sent.GetTapConfig().GetMatch().GetHttpRequestHeadersMatch().GetHeaders()
+			Expect(headerMatchers).To(HaveLen(3))
+			Expect(headerMatchers[0].GetName()).To(Equal(":authority"))
+			Expect(headerMatchers[0].GetStringMatch().GetExact()).To(Equal("petstore.example.com"))
+			Expect(headerMatchers[1].GetName()).To(Equal(":path"))
+			Expect(headerMatchers[1].GetStringMatch().GetPrefix()).To(Equal("/api/pets"))
+			Expect(headerMatchers[2].GetName()).To(Equal("x-user"))
+			Expect(headerMatchers[2].GetStringMatch().GetExact()).To(Equal("alice"))
+		})
+
+		It("taps every request without a match", func() {
+			err := client.Tap(ctx, NewTapRequest("glooctl", TapMatch{}), func(json.RawMessage) bool { return true })
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tapRequest(admin.tapBody).GetTapConfig().GetMatch().GetAnyMatch()).To(BeTrue())
+		})
+
+		It("returns the error of envoy when the tap filter is not configured", func() {
+			err := client.Tap(ctx, NewTapRequest("other", TapMatch{}), func(json.RawMessage) bool { return true })
+			Expect(err).To(MatchError(ContainSubstring("Unknown config id 'other'")))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package envoyadmin_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestEnvoyAdmin(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "EnvoyAdmin Suite")
+}
@@ -0,0 +1,98 @@
+package envoyadmin_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+)
+
+// fakeAdmin serves the parts of the Envoy admin API used by the client
+type fakeAdmin struct {
+	lock     sync.Mutex
+	clusters string
+	stats    string
+	levels   map[string]string
+	// tapBody is the last body posted to /tap, which responds with traces
+	tapBody []byte
+	traces  []string
+}
+
+func newFakeAdmin() (*fakeAdmin, *httptest.Server) {
+	admin := &fakeAdmin{levels: map[string]string{}}
+	return admin, httptest.NewServer(admin)
+}
+
+func (f *fakeAdmin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	switch r.URL.Path {
+	case "/clusters":
+		if r.URL.Query().Get("format") != "json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, f.clusters)
+	case "/stats":
+		fmt.Fprint(w, f.stats)
+	case "/logging":
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		for name, values := range r.URL.Query() {
+			if name == "level" {
+				for logger := range f.levels {
+					f.levels[logger] = values[0]
+				}
+				continue
+			}
+			if _, ok := f.levels[name]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, "error: unknown logger name\n")
+				return
+			}
+			f.levels[name] = values[0]
+		}
+		var loggers []string
+		for logger := range f.levels {
+			loggers = append(loggers, logger)
+		}
+		sort.Strings(loggers)
+		fmt.Fprintln(w, "active loggers:")
+		for _, logger := range loggers {
+			fmt.Fprintf(w, "  %s: %s\n", logger, f.levels[logger])
+		}
+	case "/tap":
+		body, _ := io.ReadAll(r.Body)
+		f.tapBody = body
+		var request struct {
+			ConfigId string `json:"configId"`
+		}
+		_ = json.Unmarshal(body, &request)
+		if request.ConfigId != "glooctl" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "Unknown config id '%s'. No extension has registered with this id.", request.ConfigId)
+			return
+		}
+		for _, trace := range f.traces {
+			fmt.Fprintln(w, trace)
+			w.(http.Flusher).Flush()
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeAdmin) logLevels() map[string]string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	levels := map[string]string{}
+	for logger, level := range f.levels {
+		levels[logger] = level
+	}
+	return levels
+}
@@ -0,0 +1,130 @@
+package envoyadmin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// ClusterHealth is the health of the hosts of an Envoy cluster.
+type ClusterHealth struct {
+	Cluster string
+	// Upstream the cluster was translated from, nil for clusters that do not belong to an Upstream
+	Upstream *core.ResourceRef
+	Hosts    []*HostHealth
+}
+
+// HealthyHosts returns the number of hosts of the cluster that can receive traffic.
+func (c *ClusterHealth) HealthyHosts() int {
+	healthy := 0
+	for _, host := range c.Hosts {
+		if host.Healthy() {
+			healthy++
+		}
+	}
+	return healthy
+}
+
+// HostHealth is the health of a single host of a cluster.
+type HostHealth struct {
+	Address string
+	// Reasons the host is unhealthy or degraded, empty for healthy hosts
+	Failures []string
+	Weight   uint32
+	// Requests counts the requests completed by the host, and Errors the ones that failed
+	Requests uint64
+	Errors   uint64
+}
+
+func (h *HostHealth) Healthy() bool {
+	return len(h.Failures) == 0
+}
+
+// ClustersHealth returns the health of the clusters of a proxy, naming the Upstream each cluster was translated from.
+// When filter is not empty, only the clusters of the Upstreams with the given name, or the given namespace.name, are
+// returned.
+func ClustersHealth(clusters *adminv3.Clusters, upstreams v1.UpstreamList, filter string) []*ClusterHealth {
+	upstreamsByCluster := map[string]*core.ResourceRef{}
+	for _, upstream := range upstreams {
+		ref := upstream.GetMetadata().Ref()
+		upstreamsByCluster[translator.UpstreamToClusterName(ref)] = ref
+	}
+
+	var result []*ClusterHealth
+	for _, status := range clusters.GetClusterStatuses() {
+		upstream := upstreamsByCluster[status.GetName()]
+		if filter != "" && !matchesUpstream(upstream, filter) {
+			continue
+		}
+		health := &ClusterHealth{
+			Cluster:  status.GetName(),
+			Upstream: upstream,
+		}
+		for _, host := range status.GetHostStatuses() {
+			health.Hosts = append(health.Hosts, hostHealth(host))
+		}
+		result = append(result, health)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Cluster < result[j].Cluster
+	})
+	return result
+}
+
+func matchesUpstream(upstream *core.ResourceRef, filter string) bool {
+	if upstream == nil {
+		return false
+	}
+	return upstream.GetName() == filter || upstream.GetNamespace()+"."+upstream.GetName() == filter
+}
+
+func hostHealth(host *adminv3.HostStatus) *HostHealth {
+	health := &HostHealth{
+		Address: formatAddress(host.GetAddress()),
+		Weight:  host.GetWeight(),
+	}
+	for _, stat := range host.GetStats() {
+		switch stat.GetName() {
+		case "rq_total":
+			health.Requests = stat.GetValue()
+		case "rq_error":
+			health.Errors = stat.GetValue()
+		}
+	}
+
+	status := host.GetHealthStatus()
+	for failure, failed := range map[string]bool{
+		"failed active health check":   status.GetFailedActiveHealthCheck(),
+		"active health check timeout":  status.GetActiveHcTimeout(),
+		"pending active health check":  status.GetPendingActiveHc(),
+		"degraded active health check": status.GetFailedActiveDegradedCheck(),
+		"ejected by outlier detection": status.GetFailedOutlierCheck(),
+		"excluded after health check":  status.GetExcludedViaImmediateHcFail(),
+		"pending removal":              status.GetPendingDynamicRemoval(),
+	} {
+		if failed {
+			health.Failures = append(health.Failures, failure)
+		}
+	}
+	switch edsStatus := status.GetEdsHealthStatus(); edsStatus {
+	case envoycore.HealthStatus_UNKNOWN, envoycore.HealthStatus_HEALTHY:
+	default:
+		health.Failures = append(health.Failures, "eds status "+strings.ToLower(edsStatus.String()))
+	}
+	sort.Strings(health.Failures)
+	return health
+}
+
+func formatAddress(address *envoycore.Address) string {
+	if pipe := address.GetPipe(); pipe != nil {
+		return pipe.GetPath()
+	}
+	socket := address.GetSocketAddress()
+	return fmt.Sprintf("%v:%v", socket.GetAddress(), socket.GetPortValue())
+}
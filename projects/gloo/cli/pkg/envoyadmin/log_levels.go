@@ -0,0 +1,118 @@
+package envoyadmin
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+)
+
+var (
+	InvalidLogLevelFlagError = func(flag string) error {
+		return eris.Errorf("log levels must be formatted as <logger>=<level>, got %s", flag)
+	}
+
+	UnknownLoggerError = func(logger string, known []string) error {
+		return eris.Errorf("envoy has no logger named %s, the loggers are: %s", logger, strings.Join(known, ", "))
+	}
+
+	RevertLogLevelsError = func(err error) error {
+		return eris.Wrap(err, "reverting the log levels, they must be reset with glooctl proxy log-level")
+	}
+)
+
+// revertTimeout bounds the requests reverting the log levels, which are sent after the caller's context is done
+const revertTimeout = 10 * time.Second
+
+// ParseLogLevels parses levels formatted as <logger>=<level>. The logger `all` sets the level of every logger.
+func ParseLogLevels(flags []string) (map[string]string, error) {
+	levels := map[string]string{}
+	for _, flag := range flags {
+		logger, level, ok := strings.Cut(flag, "=")
+		if !ok || logger == "" || level == "" {
+			return nil, InvalidLogLevelFlagError(flag)
+		}
+		levels[logger] = level
+	}
+	return levels, nil
+}
+
+// SetLogLevelsTemporarily sets the levels of the given loggers until the duration elapses or ctx is done, then reverts
+// them to their previous levels. The logger `all` sets the level of every logger. changed is called with the previous
+// level of each logger once the new levels are set.
+func SetLogLevelsTemporarily(
+	ctx context.Context,
+	client *Client,
+	levels map[string]string,
+	duration time.Duration,
+	changed func(previous map[string]string),
+) error {
+	current, err := client.LogLevels(ctx)
+	if err != nil {
+		return err
+	}
+
+	previous := map[string]string{}
+	for _, logger := range sortedKeys(levels) {
+		if logger == "all" {
+			for name, level := range current {
+				previous[name] = level
+			}
+			continue
+		}
+		level, ok := current[logger]
+		if !ok {
+			return UnknownLoggerError(logger, sortedKeys(current))
+		}
+		previous[logger] = level
+	}
+
+	// the level of `all` is set first, so that the level of single loggers overrides it
+	var setErr error
+	if level, ok := levels["all"]; ok {
+		setErr = client.SetLogLevel(ctx, "level", level)
+	}
+	for _, logger := range sortedKeys(levels) {
+		if setErr != nil {
+			break
+		}
+		if logger != "all" {
+			setErr = client.SetLogLevel(ctx, logger, levels[logger])
+		}
+	}
+	if setErr == nil {
+		changed(previous)
+		timer := time.NewTimer(duration)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+		}
+	}
+
+	// loggers that were set before a failure are reverted too
+	revertCtx, cancel := context.WithTimeout(context.Background(), revertTimeout)
+	defer cancel()
+	var revertErrs *multierror.Error
+	for _, logger := range sortedKeys(previous) {
+		if err := client.SetLogLevel(revertCtx, logger, previous[logger]); err != nil {
+			revertErrs = multierror.Append(revertErrs, err)
+		}
+	}
+	if err := revertErrs.ErrorOrNil(); err != nil {
+		return RevertLogLevelsError(err)
+	}
+	return setErr
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
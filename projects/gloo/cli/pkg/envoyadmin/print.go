@@ -0,0 +1,59 @@
+package envoyadmin
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// PrintClustersHealth prints one row per host, grouped by cluster.
+func PrintClustersHealth(clusters []*ClusterHealth, out io.Writer) {
+	if len(clusters) == 0 {
+		fmt.Fprintln(out, "no clusters found")
+		return
+	}
+	table := tablewriter.NewWriter(out)
+	table.SetHeader([]string{"Upstream", "Cluster", "Healthy", "Host", "Weight", "Requests", "Errors", "Status"})
+	table.SetAutoWrapText(false)
+	for _, cluster := range clusters {
+		upstream := "-"
+		if cluster.Upstream != nil {
+			upstream = cluster.Upstream.GetNamespace() + "." + cluster.Upstream.GetName()
+		}
+		healthy := fmt.Sprintf("%d/%d", cluster.HealthyHosts(), len(cluster.Hosts))
+		if len(cluster.Hosts) == 0 {
+			table.Append([]string{upstream, cluster.Cluster, healthy, "", "", "", "", ""})
+			continue
+		}
+		for i, host := range cluster.Hosts {
+			status := "healthy"
+			if !host.Healthy() {
+				status = strings.Join(host.Failures, ", ")
+			}
+			row := []string{"", "", "", host.Address, fmt.Sprint(host.Weight), fmt.Sprint(host.Requests), fmt.Sprint(host.Errors), status}
+			if i == 0 {
+				row[0], row[1], row[2] = upstream, cluster.Cluster, healthy
+			}
+			table.Append(row)
+		}
+	}
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+// PrintResponseFlags prints one row per response flag and source, with the number of responses in the window.
+func PrintResponseFlags(counts []*ResponseFlagCount, out io.Writer) {
+	if len(counts) == 0 {
+		fmt.Fprintln(out, "no responses with a response flag")
+		return
+	}
+	table := tablewriter.NewWriter(out)
+	table.SetHeader([]string{"Flag", "Description", "Source", "Count"})
+	for _, count := range counts {
+		table.Append([]string{count.Flag, count.Description, count.Source, fmt.Sprint(count.Count)})
+	}
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
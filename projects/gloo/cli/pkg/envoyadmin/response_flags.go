@@ -0,0 +1,100 @@
+package envoyadmin
+
+import (
+	"sort"
+	"strings"
+)
+
+// responseFlag is an Envoy response flag, along with the stat Envoy increments for the requests the flag is set on
+type responseFlag struct {
+	flag        string
+	description string
+	// scope is the prefix of the stat, either cluster or http for the stats of the http connection managers
+	scope  string
+	suffix string
+}
+
+// responseFlags are the response flags that can be derived from stats, so that they can be summarized without access logs
+var responseFlags = []responseFlag{
+	{flag: "UH", description: "no healthy upstream", scope: clusterScope, suffix: "upstream_cx_none_healthy"},
+	{flag: "UF", description: "upstream connection failure", scope: clusterScope, suffix: "upstream_cx_connect_fail"},
+	{flag: "UO", description: "upstream overflow", scope: clusterScope, suffix: "upstream_rq_pending_overflow"},
+	{flag: "UT", description: "upstream request timeout", scope: clusterScope, suffix: "upstream_rq_timeout"},
+	{flag: "URX", description: "upstream retry limit exceeded", scope: clusterScope, suffix: "upstream_rq_retry_limit_exceeded"},
+	{flag: "UC", description: "upstream connection termination", scope: clusterScope, suffix: "upstream_cx_destroy_remote_with_active_rq"},
+	{flag: "LR", description: "connection local reset", scope: clusterScope, suffix: "upstream_cx_destroy_local_with_active_rq"},
+	{flag: "RL", description: "rate limited", scope: clusterScope, suffix: "ratelimit.over_limit"},
+	{flag: "UAEX", description: "denied by external authorization", scope: clusterScope, suffix: "ext_authz.denied"},
+	{flag: "NR", description: "no route", scope: httpScope, suffix: "no_route"},
+	{flag: "NC", description: "no cluster", scope: httpScope, suffix: "no_cluster"},
+	{flag: "DC", description: "downstream connection termination", scope: httpScope, suffix: "downstream_cx_destroy_remote_active_rq"},
+	{flag: "DPE", description: "downstream protocol error", scope: httpScope, suffix: "downstream_cx_protocol_error"},
+}
+
+const (
+	clusterScope = "cluster"
+	httpScope    = "http"
+)
+
+// ResponseFlagCount is the number of responses with a response flag for a cluster or an http connection manager.
+type ResponseFlagCount struct {
+	Flag        string
+	Description string
+	// Source is the cluster or the stat prefix of the http connection manager the responses were counted for
+	Source string
+	Count  uint64
+}
+
+// SummarizeResponseFlags counts the responses of each response flag between two samples of the stats of a proxy,
+// from the most to the least frequent. Counters that were reset in between are counted from zero.
+func SummarizeResponseFlags(before, after map[string]uint64) []*ResponseFlagCount {
+	var result []*ResponseFlagCount
+	for name, value := range after {
+		flag, source, ok := responseFlagOf(name)
+		if !ok {
+			continue
+		}
+		count := value
+		if previous := before[name]; previous <= value {
+			count = value - previous
+		}
+		if count == 0 {
+			continue
+		}
+		result = append(result, &ResponseFlagCount{
+			Flag:        flag.flag,
+			Description: flag.description,
+			Source:      source,
+			Count:       count,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		if result[i].Flag != result[j].Flag {
+			return result[i].Flag < result[j].Flag
+		}
+		return result[i].Source < result[j].Source
+	})
+	return result
+}
+
+// responseFlagOf returns the response flag counted by a stat, and the cluster or http connection manager it was
+// counted for
+func responseFlagOf(stat string) (responseFlag, string, bool) {
+	for _, flag := range responseFlags {
+		prefix := flag.scope + "."
+		suffix := "." + flag.suffix
+		if !strings.HasPrefix(stat, prefix) || !strings.HasSuffix(stat, suffix) || len(stat) <= len(prefix)+len(suffix) {
+			continue
+		}
+		source := strings.TrimSuffix(strings.TrimPrefix(stat, prefix), suffix)
+		if flag.scope == clusterScope && strings.Contains(source, ".") {
+			// cluster names never contain dots, this is the stat of a filter scoped under the cluster
+			continue
+		}
+		return flag, source, true
+	}
+	return responseFlag{}, "", false
+}
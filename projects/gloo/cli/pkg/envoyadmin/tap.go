@@ -0,0 +1,96 @@
+package envoyadmin
+
+import (
+	"strings"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/config/common/matcher/v3"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	tapv3 "github.com/envoyproxy/go-control-plane/envoy/config/tap/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/rotisserie/eris"
+)
+
+var (
+	InvalidTapHeaderError = func(header string) error {
+		return eris.Errorf("headers must be formatted as <name>:<value>, got %s", header)
+	}
+)
+
+// TapMatch selects the requests of a route to tap. Empty fields match every request.
+type TapMatch struct {
+	// Host is matched exactly against the authority of the requests
+	Host string
+	// PathPrefix is matched against the path of the requests
+	PathPrefix string
+	// Headers are matched exactly against the headers of the requests
+	Headers map[string]string
+}
+
+// ParseTapHeaders parses headers formatted as <name>:<value>.
+func ParseTapHeaders(headers []string) (map[string]string, error) {
+	parsed := map[string]string{}
+	for _, header := range headers {
+		name, value, ok := strings.Cut(header, ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, InvalidTapHeaderError(header)
+		}
+		parsed[strings.ToLower(strings.TrimSpace(name))] = strings.TrimSpace(value)
+	}
+	return parsed, nil
+}
+
+// NewTapRequest returns the request streaming the traces of the requests that match, from the tap filter configured
+// with the admin config id.
+func NewTapRequest(configId string, match TapMatch) *adminv3.TapRequest {
+	var headers []*envoyroute.HeaderMatcher
+	if match.Host != "" {
+		headers = append(headers, exactHeaderMatcher(":authority", match.Host))
+	}
+	if match.PathPrefix != "" {
+		headers = append(headers, &envoyroute.HeaderMatcher{
+			Name: ":path",
+			HeaderMatchSpecifier: &envoyroute.HeaderMatcher_StringMatch{
+				StringMatch: &envoymatcher.StringMatcher{
+					MatchPattern: &envoymatcher.StringMatcher_Prefix{Prefix: match.PathPrefix},
+				},
+			},
+		})
+	}
+	for _, name := range sortedKeys(match.Headers) {
+		headers = append(headers, exactHeaderMatcher(name, match.Headers[name]))
+	}
+
+	predicate := &matcherv3.MatchPredicate{
+		Rule: &matcherv3.MatchPredicate_AnyMatch{AnyMatch: true},
+	}
+	if len(headers) > 0 {
+		predicate.Rule = &matcherv3.MatchPredicate_HttpRequestHeadersMatch{
+			HttpRequestHeadersMatch: &matcherv3.HttpHeadersMatch{Headers: headers},
+		}
+	}
+
+	return &adminv3.TapRequest{
+		ConfigId: configId,
+		TapConfig: &tapv3.TapConfig{
+			Match: predicate,
+			OutputConfig: &tapv3.OutputConfig{
+				Sinks: []*tapv3.OutputSink{{
+					Format:         tapv3.OutputSink_JSON_BODY_AS_STRING,
+					OutputSinkType: &tapv3.OutputSink_StreamingAdmin{StreamingAdmin: &tapv3.StreamingAdminSink{}},
+				}},
+			},
+		},
+	}
+}
+
+func exactHeaderMatcher(name, value string) *envoyroute.HeaderMatcher {
+	return &envoyroute.HeaderMatcher{
+		Name: name,
+		HeaderMatchSpecifier: &envoyroute.HeaderMatcher_StringMatch{
+			StringMatch: &envoymatcher.StringMatcher{
+				MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: value},
+			},
+		},
+	}
+}
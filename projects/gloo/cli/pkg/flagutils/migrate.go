@@ -0,0 +1,12 @@
+package flagutils
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/spf13/pflag"
+)
+
+func AddMigrateGatewayApiFlags(set *pflag.FlagSet, migrate *options.MigrateGatewayApi) {
+	set.StringSliceVarP(&migrate.Files, FileFlag, "f", []string{}, "files or directories containing the resources to convert (may be repeated). "+
+		"if empty, the resources are read from the cluster")
+	set.VarP(&migrate.Output, OutputFlag, "o", "output format: (yaml, json)")
+}
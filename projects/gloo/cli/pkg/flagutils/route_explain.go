@@ -0,0 +1,22 @@
+package flagutils
+
+import (
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/spf13/pflag"
+)
+
+func AddRouteExplainFlags(set *pflag.FlagSet, explain *options.RouteExplain) {
+	set.StringSliceVarP(&explain.Files, FileFlag, "f", []string{}, "files or directories containing the resources to translate (may be repeated). "+
+		"if empty, the proxy is read from the cluster")
+	set.StringVar(&explain.ProxyName, "proxy", defaults.GatewayProxyName, "name of the proxy receiving the request")
+	set.StringVarP(&explain.Method, "method", "X", "GET", "method of the request")
+	set.StringVar(&explain.Host, "host", "", "host (authority) header of the request")
+	set.StringVar(&explain.Path, "path", "/", "path of the request, may include a query string")
+	set.StringSliceVarP(&explain.Headers, "header", "H", []string{}, "headers of the request, as name:value (may be repeated)")
+	set.Uint32Var(&explain.Port, "port", 0, "port of the listener receiving the request. may be omitted if the proxy has a single listener")
+	set.BoolVar(&explain.Tls, "tls", false, "the request is sent over tls")
+	set.StringVar(&explain.Sni, "sni", "", "server name indication of the tls connection, implies --tls")
+	set.StringVar(&explain.SourceIp, "source-ip", "", "address of the client sending the request")
+	set.VarP(&explain.Output, OutputFlag, "o", "output format: (table, yaml, json)")
+}
@@ -0,0 +1,12 @@
+package flagutils
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/spf13/pflag"
+)
+
+func AddTranslateFlags(set *pflag.FlagSet, translate *options.Translate) {
+	set.StringSliceVarP(&translate.Files, FileFlag, "f", []string{}, "files or directories containing the resources to translate (may be repeated)")
+	set.StringVar(&translate.ProxyName, "proxy", "", "only print the output for the proxy with this name")
+	set.VarP(&translate.Output, OutputFlag, "o", "output format: (yaml, json)")
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/solo-io/gloo/pkg/utils/kubeutils"
+	"github.com/solo-io/gloo/projects/gateway2/controller/scheme"
 
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options/contextoptions"
 
@@ -22,6 +23,8 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	apiexts "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -40,6 +43,7 @@ import (
 var (
 	clientset         *kubernetes.Clientset
 	fakeKubeClientset *fake.Clientset
+	fakeGwClient      client.Client
 	memResourceClient *factory.MemoryResourceClientFactory
 	consulClient      *factory.ConsulResourceClientFactory
 	vaultClient       factory.ResourceClientFactory
@@ -82,6 +86,7 @@ func UseDefaultClients() {
 	lock.Lock()
 	defer lock.Unlock()
 	fakeKubeClientset = nil
+	fakeGwClient = nil
 	memResourceClient = nil
 	consulClient = nil
 	vaultClient = nil
@@ -94,6 +99,7 @@ func UseMemoryClients() {
 		Cache: memory.NewInMemoryResourceCache(),
 	}
 	fakeKubeClientset = fake.NewSimpleClientset()
+	fakeGwClient = crfake.NewClientBuilder().WithScheme(scheme.NewScheme()).Build()
 }
 
 // only applies to Config and Artifact clients
@@ -587,6 +593,18 @@ func getKubernetesConfig(timeout time.Duration, kubecontext string) (*rest.Confi
 	return config, nil
 }
 
+// GatewayApiClient returns a client for the Kubernetes Gateway API resources, and the GatewayParameters of Gloo Gateway
+func GatewayApiClient(kubecontext string) (client.Client, error) {
+	if fakeGwClient != nil {
+		return fakeGwClient, nil
+	}
+	cfg, err := kubeutils.GetRestConfigWithKubeContext(kubecontext)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting kube config")
+	}
+	return client.New(cfg, client.Options{Scheme: scheme.NewScheme()})
+}
+
 func MustApiExtsClient() apiexts.Interface {
 	client, err := ApiExtsClient()
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
 
 	"github.com/spf13/cobra"
@@ -35,6 +36,10 @@ func VersionMismatchWarning(opts *options.Options, cmd *cobra.Command) error {
 	if opts.Top.Consul.UseConsul {
 		return nil
 	}
+	// translate, and route explain and migrate given files, work on local files only, and must not require a cluster
+	if cmd.Name() == constants.TRANSLATE_COMMAND.Use || len(opts.Route.Explain.Files) > 0 || len(opts.Migrate.GatewayApi.Files) > 0 {
+		return nil
+	}
 	nsToCheck := opts.Metadata.GetNamespace()
 	// TODO: only use metadata namespace flag, install namespace can be populated from metadata namespace or refactored out of the opts
 	if nsToCheck == flagutils.DefaultNamespace && opts.Install.Gloo.Namespace != flagutils.DefaultNamespace {
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"strings"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/certcheck"
 )
 
 type CheckPrinters interface {
@@ -20,6 +22,8 @@ type CheckResult struct {
 	Resources []CheckStatus `json:"resources"`
 	Messages  []string      `json:"messages"`
 	Errors    []string      `json:"errors"`
+	// Certificates holds the inventory of the TLS secrets and their problems, from the certificates check
+	Certificates []*certcheck.Report `json:"certificates,omitempty"`
 }
 type CheckStatus struct {
 	Name   string `json:"name"`
@@ -71,6 +75,14 @@ func (p P) AppendError(err string) {
 	}
 }
 
+// AppendCertificates adds the reports of the certificates check, which are only printed with the json output, as the
+// table output prints their problems as errors and messages.
+func (p P) AppendCertificates(reports []*certcheck.Report) {
+	if p.OutputType.IsJSON() {
+		p.CheckResult.Certificates = append(p.CheckResult.Certificates, reports...)
+	}
+}
+
 func (p P) PrintChecks(w io.Writer) {
 
 	err := json.NewEncoder(w).Encode(p.CheckResult)
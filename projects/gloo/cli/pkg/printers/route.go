@@ -142,6 +142,8 @@ func Destinations(d *gloov1.Destination) string {
 		return "aws"
 	case *gloov1.DestinationSpec_Azure:
 		return "azure"
+	case *gloov1.DestinationSpec_Gcp:
+		return "gcp"
 	case *gloov1.DestinationSpec_Grpc:
 		return "grpc"
 	case *gloov1.DestinationSpec_Rest:
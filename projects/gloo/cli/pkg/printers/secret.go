@@ -51,6 +51,10 @@ func SecretTable(list v1.SecretList, w io.Writer) {
 			secretType = "ApiKey"
 		case *v1.Secret_Header:
 			secretType = "Header"
+		case *v1.Secret_Htpasswd:
+			secretType = "Htpasswd"
+		case *v1.Secret_Gcp:
+			secretType = "GCP"
 		default:
 			secretType = "unknown"
 		}
@@ -77,6 +77,10 @@ func upstreamType(up *v1.Upstream) string {
 		return "Consul"
 	case *v1.Upstream_AwsEc2:
 		return "AWS EC2"
+	case *v1.Upstream_Eureka:
+		return "Eureka"
+	case *v1.Upstream_Gcp:
+		return "GCP"
 	case *v1.Upstream_Kube:
 		return "Kubernetes"
 	case *v1.Upstream_Static:
@@ -119,6 +123,18 @@ func upstreamDetails(up *v1.Upstream, xdsDump *xdsinspection.XdsDump) []string {
 			fmt.Sprintf("port:           %v", usType.AwsEc2.GetPort()),
 		)
 		add(getEc2TagFiltersString(usType.AwsEc2.GetFilters())...)
+		if groupNames := usType.AwsEc2.GetAutoScalingGroupNames(); len(groupNames) > 0 {
+			add("auto scaling groups:")
+			for _, name := range groupNames {
+				add(fmt.Sprintf("- %v", name))
+			}
+		}
+		if ecsService := usType.AwsEc2.GetEcsService(); ecsService != nil {
+			add(
+				fmt.Sprintf("ecs cluster:    %v", ecsService.GetCluster()),
+				fmt.Sprintf("ecs service:    %v", ecsService.GetServiceName()),
+			)
+		}
 		instances := xdsDump.GetEc2InstancesForUpstream(up.GetMetadata().Ref())
 		add(
 			"EC2 Instance Ids:",
@@ -150,6 +166,25 @@ func upstreamDetails(up *v1.Upstream, xdsDump *xdsinspection.XdsDump) []string {
 		if usType.Consul.GetServiceSpec() != nil {
 			add(linesForServiceSpec(usType.Consul.GetServiceSpec())...)
 		}
+	case *v1.Upstream_Eureka:
+		add(
+			fmt.Sprintf("app name:    %v", usType.Eureka.GetAppName()),
+			fmt.Sprintf("secure port: %v", usType.Eureka.GetUseSecurePort()),
+		)
+		if usType.Eureka.GetServiceSpec() != nil {
+			add(linesForServiceSpec(usType.Eureka.GetServiceSpec())...)
+		}
+	case *v1.Upstream_Gcp:
+		add(
+			fmt.Sprintf("url:      %v", usType.Gcp.GetUrl()),
+			fmt.Sprintf("audience: %v", usType.Gcp.GetAudience()),
+		)
+		for i, fn := range usType.Gcp.GetFunctions() {
+			if i == 0 {
+				add("functions:")
+			}
+			add(fmt.Sprintf("- %v", fn.GetLogicalName()))
+		}
 	case *v1.Upstream_Kube:
 		add(
 			fmt.Sprintf("svc name:      %v", usType.Kube.GetServiceName()),
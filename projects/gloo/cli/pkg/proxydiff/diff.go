@@ -0,0 +1,284 @@
+package proxydiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	ChangeAdded    = "Added"
+	ChangeRemoved  = "Removed"
+	ChangeModified = "Modified"
+)
+
+// Result is the diff between the configuration of two snapshots.
+type Result struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+	// Resources lists the resources that changed, ordered by type and name
+	Resources []*ResourceDiff `json:"resources"`
+}
+
+// ResourceDiff describes a listener, route configuration, cluster or cluster load assignment that changed.
+type ResourceDiff struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Change string `json:"change"`
+	// Fields lists the fields of a modified resource that changed
+	Fields []*FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange describes a value that changed within a resource. Elements of lists that are matched by their name,
+// or another identifying field, are referenced by that key, such as virtualHosts[default].routes[0].
+// A nil Before is an added value, and a nil After a removed one.
+type FieldChange struct {
+	Path   string      `json:"path"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+type resourceType struct {
+	name      string
+	resources func(*Snapshot) []map[string]interface{}
+	// the field naming the resource
+	nameField string
+}
+
+var resourceTypes = []resourceType{
+	{"listener", func(s *Snapshot) []map[string]interface{} { return s.Listeners }, "name"},
+	{"route", func(s *Snapshot) []map[string]interface{} { return s.Routes }, "name"},
+	{"cluster", func(s *Snapshot) []map[string]interface{} { return s.Clusters }, "name"},
+	{"endpoint", func(s *Snapshot) []map[string]interface{} { return s.Endpoints }, "clusterName"},
+}
+
+func resourceName(typ resourceType, resource map[string]interface{}) string {
+	name, _ := resource[typ.nameField].(string)
+	return name
+}
+
+var (
+	// fields that change with every snapshot, without changing the configuration
+	ignoredFields = map[string]bool{
+		"versionInfo": true,
+		"lastUpdated": true,
+	}
+	// lists in which envoy evaluates the elements in order, so that reordering them changes the configuration.
+	// The order of any other list, such as the filter chains of a listener which are selected by their match,
+	// the virtual hosts of a route configuration or the endpoints of a cluster, is ignored.
+	orderedLists = map[string]bool{
+		"routes":      true,
+		"httpFilters": true,
+		"filters":     true,
+	}
+)
+
+// Diff compares the resources of two snapshots by name, and the fields of the resources present in both.
+func Diff(before, after *Snapshot) *Result {
+	result := &Result{Before: before.Source, After: after.Source, Resources: []*ResourceDiff{}}
+	for _, typ := range resourceTypes {
+		beforeResources := resourcesByName(typ, typ.resources(before))
+		afterResources := resourcesByName(typ, typ.resources(after))
+		for _, name := range unionKeys(beforeResources, afterResources) {
+			beforeResource, inBefore := beforeResources[name]
+			afterResource, inAfter := afterResources[name]
+			switch {
+			case !inBefore:
+				result.Resources = append(result.Resources, &ResourceDiff{Type: typ.name, Name: name, Change: ChangeAdded})
+			case !inAfter:
+				result.Resources = append(result.Resources, &ResourceDiff{Type: typ.name, Name: name, Change: ChangeRemoved})
+			default:
+				var changes []*FieldChange
+				compareValues("", "", beforeResource, afterResource, &changes)
+				if len(changes) > 0 {
+					result.Resources = append(result.Resources, &ResourceDiff{Type: typ.name, Name: name, Change: ChangeModified, Fields: changes})
+				}
+			}
+		}
+	}
+	return result
+}
+
+func resourcesByName(typ resourceType, resources []map[string]interface{}) map[string]interface{} {
+	byName := map[string]interface{}{}
+	for _, resource := range resources {
+		byName[resourceName(typ, resource)] = resource
+	}
+	return byName
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	var keys []string
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// compares two values decoded from json, field is the name of the field holding them
+func compareValues(path, field string, before, after interface{}, changes *[]*FieldChange) {
+	switch beforeValue := before.(type) {
+	case map[string]interface{}:
+		if afterValue, ok := after.(map[string]interface{}); ok {
+			for _, key := range unionKeys(beforeValue, afterValue) {
+				if ignoredFields[key] {
+					continue
+				}
+				compareValues(joinPath(path, key), key, beforeValue[key], afterValue[key], changes)
+			}
+			return
+		}
+	case []interface{}:
+		if afterValue, ok := after.([]interface{}); ok {
+			compareLists(path, field, beforeValue, afterValue, changes)
+			return
+		}
+	}
+	if !reflect.DeepEqual(before, after) {
+		*changes = append(*changes, &FieldChange{Path: path, Before: before, After: after})
+	}
+}
+
+func compareLists(path, field string, before, after []interface{}, changes *[]*FieldChange) {
+	beforeKeys, beforeKeyed := elementKeys(before)
+	afterKeys, afterKeyed := elementKeys(after)
+	if beforeKeyed && afterKeyed {
+		beforeElements, afterElements := map[string]interface{}{}, map[string]interface{}{}
+		for i, key := range beforeKeys {
+			beforeElements[key] = before[i]
+		}
+		for i, key := range afterKeys {
+			afterElements[key] = after[i]
+		}
+		for _, key := range unionKeys(beforeElements, afterElements) {
+			compareValues(fmt.Sprintf("%v[%v]", path, key), field, beforeElements[key], afterElements[key], changes)
+		}
+		if orderedLists[field] {
+			beforeOrder, afterOrder := commonKeys(beforeKeys, afterElements), commonKeys(afterKeys, beforeElements)
+			if !reflect.DeepEqual(beforeOrder, afterOrder) {
+				*changes = append(*changes, &FieldChange{Path: path + " (order)", Before: beforeOrder, After: afterOrder})
+			}
+		}
+		return
+	}
+
+	if !orderedLists[field] {
+		before, after = sortedByJson(before), sortedByJson(after)
+	}
+	for i := 0; i < len(before) || i < len(after); i++ {
+		var beforeElement, afterElement interface{}
+		if i < len(before) {
+			beforeElement = before[i]
+		}
+		if i < len(after) {
+			afterElement = after[i]
+		}
+		compareValues(fmt.Sprintf("%v[%v]", path, i), field, beforeElement, afterElement, changes)
+	}
+}
+
+// returns the identifying key of each element of a list, and false if any of the elements has none,
+// or if the keys are not unique
+func elementKeys(list []interface{}) ([]string, bool) {
+	var keys []string
+	seen := map[string]bool{}
+	for _, element := range list {
+		fields, ok := element.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		key := elementKey(fields)
+		if key == "" || seen[key] {
+			return nil, false
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	return keys, true
+}
+
+func elementKey(fields map[string]interface{}) string {
+	for _, field := range []string{"name", "clusterName"} {
+		if name, ok := fields[field].(string); ok && name != "" {
+			return name
+		}
+	}
+	// the lb endpoints of a cluster load assignment
+	if socketAddress, ok := lookup(fields, "endpoint", "address", "socketAddress").(map[string]interface{}); ok {
+		return fmt.Sprintf("%v:%v", socketAddress["address"], socketAddress["portValue"])
+	}
+	// the locality endpoints of a cluster load assignment
+	if locality, ok := fields["locality"].(map[string]interface{}); ok {
+		var parts []string
+		for _, part := range []string{"region", "zone", "subZone"} {
+			if value, ok := locality[part].(string); ok {
+				parts = append(parts, value)
+			}
+		}
+		return strings.Join(parts, "/")
+	}
+	return ""
+}
+
+func lookup(fields map[string]interface{}, path ...string) interface{} {
+	var value interface{} = fields
+	for _, field := range path {
+		nested, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = nested[field]
+	}
+	return value
+}
+
+// keys in the order of the list, limited to the keys present in the other list
+func commonKeys(keys []string, other map[string]interface{}) []string {
+	var common []string
+	for _, key := range keys {
+		if _, ok := other[key]; ok {
+			common = append(common, key)
+		}
+	}
+	return common
+}
+
+func sortedByJson(list []interface{}) []interface{} {
+	type encoded struct {
+		json    string
+		element interface{}
+	}
+	var elements []encoded
+	for _, element := range list {
+		b, _ := json.Marshal(element)
+		elements = append(elements, encoded{json: string(b), element: element})
+	}
+	sort.SliceStable(elements, func(i, j int) bool {
+		return elements[i].json < elements[j].json
+	})
+	sorted := make([]interface{}, 0, len(list))
+	for _, element := range elements {
+		sorted = append(sorted, element.element)
+	}
+	return sorted
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// Empty returns true if the snapshots have the same configuration.
+func (r *Result) Empty() bool {
+	return len(r.Resources) == 0
+}
@@ -0,0 +1,153 @@
+package proxydiff_test
+
+import (
+	"bytes"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gloo/cli/pkg/proxydiff"
+	"sigs.k8s.io/yaml"
+)
+
+const beforeSnapshot = `
+source: before
+proxy: gloo-system~gateway-proxy
+capturedAt: "2024-01-01T00:00:00Z"
+listeners:
+- name: listener-8080
+  filterChains:
+  - filters:
+    - name: envoy.filters.network.http_connection_manager
+routes:
+- name: listener-8080-routes
+  virtualHosts:
+  - name: petstore
+    domains: [petstore.example.com, www.petstore.example.com]
+    routes:
+    - name: pets
+      route: {cluster: petstore_apps}
+    - name: catch-all
+      directResponse: {status: 404}
+  - name: default
+    domains: ['*']
+clusters:
+- name: petstore_apps
+  connectTimeout: 5s
+- name: old_apps
+endpoints:
+- clusterName: petstore_apps
+  endpoints:
+  - lbEndpoints:
+    - endpoint: {address: {socketAddress: {address: 10.0.0.1, portValue: 8080}}}
+    - endpoint: {address: {socketAddress: {address: 10.0.0.2, portValue: 8080}}}
+`
+
+const afterSnapshot = `
+source: after
+proxy: gloo-system~gateway-proxy
+capturedAt: "2024-01-02T00:00:00Z"
+listeners:
+- name: listener-8080
+  filterChains:
+  - filters:
+    - name: envoy.filters.network.http_connection_manager
+routes:
+- name: listener-8080-routes
+  virtualHosts:
+  - name: default
+    domains: ['*']
+  - name: petstore
+    domains: [www.petstore.example.com, petstore.example.com]
+    routes:
+    - name: catch-all
+      directResponse: {status: 404}
+    - name: pets
+      route: {cluster: petstore_apps}
+clusters:
+- name: petstore_apps
+  connectTimeout: 1s
+- name: new_apps
+endpoints:
+- clusterName: petstore_apps
+  endpoints:
+  - lbEndpoints:
+    - endpoint: {address: {socketAddress: {address: 10.0.0.3, portValue: 8080}}}
+    - endpoint: {address: {socketAddress: {address: 10.0.0.1, portValue: 8080}}}
+`
+
+var _ = Describe("Diff", func() {
+
+	load := func(content string) *Snapshot {
+		var snap Snapshot
+		Expect(yaml.UnmarshalStrict([]byte(content), &snap)).To(Succeed())
+		return &snap
+	}
+
+	findResource := func(result *Result, typ, name string) *ResourceDiff {
+		for _, resource := range result.Resources {
+			if resource.Type == typ && resource.Name == name {
+				return resource
+			}
+		}
+		return nil
+	}
+
+	It("reports no differences for the same configuration", func() {
+		result := Diff(load(beforeSnapshot), load(beforeSnapshot))
+		Expect(result.Empty()).To(BeTrue())
+	})
+
+	It("reports added, removed and modified resources", func() {
+		result := Diff(load(beforeSnapshot), load(afterSnapshot))
+		Expect(findResource(result, "cluster", "new_apps").Change).To(Equal(ChangeAdded))
+		Expect(findResource(result, "cluster", "old_apps").Change).To(Equal(ChangeRemoved))
+
+		cluster := findResource(result, "cluster", "petstore_apps")
+		Expect(cluster.Change).To(Equal(ChangeModified))
+		Expect(cluster.Fields).To(ConsistOf(&FieldChange{Path: "connectTimeout", Before: "5s", After: "1s"}))
+
+		Expect(findResource(result, "listener", "listener-8080")).To(BeNil())
+	})
+
+	It("ignores the order of virtual hosts and domains, but not of routes", func() {
+		result := Diff(load(beforeSnapshot), load(afterSnapshot))
+		routes := findResource(result, "route", "listener-8080-routes")
+		Expect(routes.Fields).To(ConsistOf(&FieldChange{
+			Path:   "virtualHosts[petstore].routes (order)",
+			Before: []string{"pets", "catch-all"},
+			After:  []string{"catch-all", "pets"},
+		}))
+	})
+
+	It("matches endpoints by address", func() {
+		result := Diff(load(beforeSnapshot), load(afterSnapshot))
+		endpoints := findResource(result, "endpoint", "petstore_apps")
+		var paths []string
+		for _, field := range endpoints.Fields {
+			paths = append(paths, field.Path)
+		}
+		Expect(paths).To(ConsistOf(
+			"endpoints[0].lbEndpoints[10.0.0.2:8080]",
+			"endpoints[0].lbEndpoints[10.0.0.3:8080]",
+		))
+	})
+
+	It("saves and loads snapshots", func() {
+		snap := load(beforeSnapshot)
+		file := filepath.Join(GinkgoT().TempDir(), "snapshot.yaml")
+		Expect(snap.Save(file)).To(Succeed())
+		loaded, err := Load(file)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded.Source).To(Equal("before"))
+		Expect(Diff(snap, loaded).Empty()).To(BeTrue())
+	})
+
+	It("prints the diff as text", func() {
+		out := &bytes.Buffer{}
+		PrintText(Diff(load(beforeSnapshot), load(afterSnapshot)), out)
+		Expect(out.String()).To(ContainSubstring("--- before\n+++ after\n"))
+		Expect(out.String()).To(ContainSubstring("~ cluster petstore_apps\n    ~ connectTimeout: \"5s\" -> \"1s\"\n"))
+		Expect(out.String()).To(ContainSubstring("1 added, 1 removed, 3 modified"))
+	})
+})
@@ -0,0 +1,46 @@
+package proxydiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+var changeMarkers = map[string]string{
+	ChangeAdded:    "+",
+	ChangeRemoved:  "-",
+	ChangeModified: "~",
+}
+
+// PrintText prints the diff for a terminal, one line per resource and one line per changed field.
+func PrintText(result *Result, out io.Writer) {
+	fmt.Fprintf(out, "--- %v\n+++ %v\n", result.Before, result.After)
+	if result.Empty() {
+		fmt.Fprintln(out, "no differences")
+		return
+	}
+	counts := map[string]int{}
+	for _, resource := range result.Resources {
+		counts[resource.Change]++
+		fmt.Fprintf(out, "%v %v %v\n", changeMarkers[resource.Change], resource.Type, resource.Name)
+		for _, field := range resource.Fields {
+			switch {
+			case field.Before == nil:
+				fmt.Fprintf(out, "    + %v: %v\n", field.Path, formatValue(field.After))
+			case field.After == nil:
+				fmt.Fprintf(out, "    - %v: %v\n", field.Path, formatValue(field.Before))
+			default:
+				fmt.Fprintf(out, "    ~ %v: %v -> %v\n", field.Path, formatValue(field.Before), formatValue(field.After))
+			}
+		}
+	}
+	fmt.Fprintf(out, "%v added, %v removed, %v modified\n", counts[ChangeAdded], counts[ChangeRemoved], counts[ChangeModified])
+}
+
+func formatValue(value interface{}) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
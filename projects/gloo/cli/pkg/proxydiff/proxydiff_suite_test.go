@@ -0,0 +1,13 @@
+package proxydiff_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestProxyDiff(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ProxyDiff Suite")
+}
@@ -0,0 +1,142 @@
+package proxydiff
+
+import (
+	"os"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	skprotoutils "github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	InvalidSnapshotError = func(err error, path string) error {
+		return eris.Wrapf(err, "%v is not a proxy snapshot", path)
+	}
+)
+
+// Snapshot is the envoy configuration of a proxy, captured at a point in time.
+// Snapshots are saved as yaml, and the xds resources are kept in their json form so that any of them can be loaded
+// back without the go types of their typed configs.
+type Snapshot struct {
+	// Source describes where the configuration was captured from, such as cluster kind-gloo or files ./gloo-config
+	Source     string    `json:"source"`
+	Proxy      string    `json:"proxy"`
+	CapturedAt time.Time `json:"capturedAt"`
+
+	Listeners []map[string]interface{} `json:"listeners"`
+	Routes    []map[string]interface{} `json:"routes"`
+	Clusters  []map[string]interface{} `json:"clusters"`
+	Endpoints []map[string]interface{} `json:"endpoints"`
+}
+
+// FromXdsDump captures the configuration served to a proxy by the gloo xds server.
+func FromXdsDump(source string, dump *xdsinspection.XdsDump) (*Snapshot, error) {
+	snap := &Snapshot{Source: source, Proxy: dump.Role, CapturedAt: time.Now().UTC()}
+	for _, typed := range []struct {
+		resources []proto.Message
+		into      *[]map[string]interface{}
+	}{
+		{listenerMessages(dump), &snap.Listeners},
+		{routeMessages(dump), &snap.Routes},
+		{clusterMessages(dump), &snap.Clusters},
+		{endpointMessages(dump), &snap.Endpoints},
+	} {
+		for _, resource := range typed.resources {
+			resourceMap, err := skprotoutils.MarshalMapFromProto(resource)
+			if err != nil {
+				return nil, err
+			}
+			*typed.into = append(*typed.into, resourceMap)
+		}
+	}
+	snap.sort()
+	return snap, nil
+}
+
+func listenerMessages(dump *xdsinspection.XdsDump) []proto.Message {
+	var messages []proto.Message
+	for i := range dump.Listeners {
+		messages = append(messages, &dump.Listeners[i])
+	}
+	return messages
+}
+
+func routeMessages(dump *xdsinspection.XdsDump) []proto.Message {
+	var messages []proto.Message
+	for i := range dump.Routes {
+		messages = append(messages, &dump.Routes[i])
+	}
+	return messages
+}
+
+func clusterMessages(dump *xdsinspection.XdsDump) []proto.Message {
+	var messages []proto.Message
+	for i := range dump.Clusters {
+		messages = append(messages, &dump.Clusters[i])
+	}
+	return messages
+}
+
+func endpointMessages(dump *xdsinspection.XdsDump) []proto.Message {
+	var messages []proto.Message
+	for i := range dump.Endpoints {
+		messages = append(messages, &dump.Endpoints[i])
+	}
+	return messages
+}
+
+// FromXdsResources captures the configuration of a proxy translated offline by glooctl translate.
+func FromXdsResources(source, proxy string, listeners, routes, clusters, endpoints []map[string]interface{}) *Snapshot {
+	snap := &Snapshot{
+		Source:     source,
+		Proxy:      proxy,
+		CapturedAt: time.Now().UTC(),
+		Listeners:  listeners,
+		Routes:     routes,
+		Clusters:   clusters,
+		Endpoints:  endpoints,
+	}
+	snap.sort()
+	return snap
+}
+
+// sorts the resources by name, so that saved snapshots do not change with the order the xds server returns them in
+func (s *Snapshot) sort() {
+	for _, typ := range resourceTypes {
+		resources := typ.resources(s)
+		sort.SliceStable(resources, func(i, j int) bool {
+			return resourceName(typ, resources[i]) < resourceName(typ, resources[j])
+		})
+	}
+}
+
+// Save writes the snapshot to a file as yaml.
+func (s *Snapshot) Save(path string) error {
+	b, err := s.Marshal()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+// Marshal returns the snapshot as yaml.
+func (s *Snapshot) Marshal() ([]byte, error) {
+	return yaml.Marshal(s)
+}
+
+// Load reads a snapshot saved as yaml or json.
+func Load(path string) (*Snapshot, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var snap Snapshot
+	if err := yaml.UnmarshalStrict(b, &snap); err != nil {
+		return nil, InvalidSnapshotError(err, path)
+	}
+	return &snap, nil
+}
@@ -0,0 +1,368 @@
+package routeexplain
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/rotisserie/eris"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/types"
+)
+
+var (
+	InvalidRegexError = func(regex string, err error) error {
+		return eris.Wrapf(err, "invalid regex %v", regex)
+	}
+
+	InvalidFilterConfigError = func(filter string, err error) error {
+		return eris.Wrapf(err, "invalid config for filter %v", filter)
+	}
+)
+
+// Explanation describes how envoy routes a request, step by step.
+// If the request is not routed, NoMatch holds the reason and the steps after the last one that matched are empty.
+type Explanation struct {
+	Proxy              string          `json:"proxy"`
+	Listener           string          `json:"listener,omitempty"`
+	FilterChain        string          `json:"filterChain,omitempty"`
+	RouteConfiguration string          `json:"routeConfiguration,omitempty"`
+	VirtualHost        string          `json:"virtualHost,omitempty"`
+	Domain             string          `json:"domain,omitempty"`
+	SkippedRoutes      []*SkippedRoute `json:"skippedRoutes,omitempty"`
+	Route              *Route          `json:"route,omitempty"`
+	Destination        *Destination    `json:"destination,omitempty"`
+	NoMatch            string          `json:"noMatch,omitempty"`
+}
+
+type Route struct {
+	// Index is the position of the route in the virtual host of the route configuration
+	Index int    `json:"index"`
+	Name  string `json:"name,omitempty"`
+	// Sources are the resources the route was generated from, from the VirtualService down to the delegated RouteTables
+	Sources []string `json:"sources,omitempty"`
+}
+
+type SkippedRoute struct {
+	Route  `json:",inline"`
+	Reason string `json:"reason"`
+}
+
+type Destination struct {
+	Clusters       []*WeightedCluster `json:"clusters,omitempty"`
+	ClusterHeader  string             `json:"clusterHeader,omitempty"`
+	PrefixRewrite  string             `json:"prefixRewrite,omitempty"`
+	HostRewrite    string             `json:"hostRewrite,omitempty"`
+	Redirect       string             `json:"redirect,omitempty"`
+	DirectResponse uint32             `json:"directResponse,omitempty"`
+}
+
+type WeightedCluster struct {
+	Cluster string `json:"cluster"`
+	// Upstream is the namespace.name of the upstream the cluster was generated from
+	Upstream string `json:"upstream,omitempty"`
+	Weight   uint32 `json:"weight,omitempty"`
+}
+
+// Explain replays the matching envoy does for the request on the xds configuration translated from the proxy:
+// listener, filter chain, virtual host and route selection.
+// The proxy is only used to find the resources the routes were generated from.
+func Explain(proxy *gloov1.Proxy, snapshot envoycache.Snapshot, req *Request) (*Explanation, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	explanation := &Explanation{Proxy: proxy.GetMetadata().Ref().Key()}
+
+	listener, reason := selectListener(snapshotListeners(snapshot), req.Port)
+	if listener == nil {
+		explanation.NoMatch = reason
+		return explanation, nil
+	}
+	explanation.Listener = listener.GetName()
+
+	filterChain, reason := selectFilterChain(listener, req)
+	if filterChain == nil {
+		explanation.NoMatch = reason
+		return explanation, nil
+	}
+	explanation.FilterChain = describeFilterChain(filterChain)
+
+	for _, filter := range filterChain.filterChain.GetFilters() {
+		switch filter.GetName() {
+		case wellknown.HTTPConnectionManager:
+			var httpConnectionManager hcm.HttpConnectionManager
+			if err := filter.GetTypedConfig().UnmarshalTo(&httpConnectionManager); err != nil {
+				return nil, InvalidFilterConfigError(filter.GetName(), err)
+			}
+			return explanation, explainHttp(explanation, proxy, snapshot, listener, &httpConnectionManager, req)
+		case wellknown.TCPProxy:
+			var tcpProxy envoytcp.TcpProxy
+			if err := filter.GetTypedConfig().UnmarshalTo(&tcpProxy); err != nil {
+				return nil, InvalidFilterConfigError(filter.GetName(), err)
+			}
+			explanation.Destination = tcpDestination(&tcpProxy)
+			return explanation, nil
+		}
+	}
+	explanation.NoMatch = fmt.Sprintf("%v has neither an http connection manager nor a tcp proxy", explanation.FilterChain)
+	return explanation, nil
+}
+
+func explainHttp(
+	explanation *Explanation,
+	proxy *gloov1.Proxy,
+	snapshot envoycache.Snapshot,
+	listener *envoy_config_listener_v3.Listener,
+	httpConnectionManager *hcm.HttpConnectionManager,
+	req *Request,
+) error {
+	routeConfig := httpConnectionManager.GetRouteConfig()
+	if rds := httpConnectionManager.GetRds(); rds != nil {
+		explanation.RouteConfiguration = rds.GetRouteConfigName()
+		resource, ok := snapshot.GetResources(types.RouteTypeV3).Items[rds.GetRouteConfigName()]
+		if !ok {
+			explanation.NoMatch = fmt.Sprintf("route configuration %v not found", rds.GetRouteConfigName())
+			return nil
+		}
+		routeConfig = resource.ResourceProto().(*envoy_config_route_v3.RouteConfiguration)
+	}
+
+	host := strings.ToLower(req.Host)
+	if stripPort(httpConnectionManager, routeConfig, listener, host) {
+		host, _, _ = net.SplitHostPort(host)
+	}
+	virtualHost, domain := selectVirtualHost(routeConfig.GetVirtualHosts(), host)
+	if virtualHost == nil {
+		explanation.NoMatch = fmt.Sprintf("no virtual host matches host %q", req.Host)
+		return nil
+	}
+	explanation.VirtualHost = virtualHost.GetName()
+	explanation.Domain = domain
+
+	if !req.isTls() && virtualHost.GetRequireTls() != envoy_config_route_v3.VirtualHost_NONE {
+		explanation.Destination = &Destination{Redirect: "https, the virtual host requires tls"}
+		return nil
+	}
+
+	sources := newRouteSources(proxy)
+	for i, route := range virtualHost.GetRoutes() {
+		explained := Route{
+			Index:   i,
+			Name:    route.GetName(),
+			Sources: sources.find(virtualHost.GetName(), route.GetName()),
+		}
+		reason, err := matchRoute(route.GetMatch(), req)
+		if err != nil {
+			return eris.Wrapf(err, "matching route %v", route.GetName())
+		}
+		if reason != "" {
+			explanation.SkippedRoutes = append(explanation.SkippedRoutes, &SkippedRoute{Route: explained, Reason: reason})
+			continue
+		}
+		explanation.Route = &explained
+		explanation.Destination = routeDestination(route)
+		return nil
+	}
+	explanation.NoMatch = fmt.Sprintf("no route of virtual host %v matches the request", virtualHost.GetName())
+	return nil
+}
+
+// envoy matches the host header including its port, unless configured to strip it
+func stripPort(
+	httpConnectionManager *hcm.HttpConnectionManager,
+	routeConfig *envoy_config_route_v3.RouteConfiguration,
+	listener *envoy_config_listener_v3.Listener,
+	host string,
+) bool {
+	_, port, err := net.SplitHostPort(host)
+	if err != nil {
+		return false
+	}
+	if httpConnectionManager.GetStripAnyHostPort() || routeConfig.GetIgnorePortInHostMatching() {
+		return true
+	}
+	return httpConnectionManager.GetStripMatchingHostPort() && port == strconv.Itoa(int(listenerPort(listener)))
+}
+
+// selects the virtual host like envoy does: exact domains first, then suffix wildcards such as *.example.com and
+// prefix wildcards such as example.*, longest first, and finally the * domain.
+func selectVirtualHost(virtualHosts []*envoy_config_route_v3.VirtualHost, host string) (*envoy_config_route_v3.VirtualHost, string) {
+	type wildcard struct {
+		domain      string
+		virtualHost *envoy_config_route_v3.VirtualHost
+	}
+	var suffixes, prefixes []wildcard
+	var defaultVirtualHost *envoy_config_route_v3.VirtualHost
+	for _, virtualHost := range virtualHosts {
+		for _, domain := range virtualHost.GetDomains() {
+			domain = strings.ToLower(domain)
+			switch {
+			case domain == "*":
+				defaultVirtualHost = virtualHost
+			case domain == host:
+				return virtualHost, domain
+			case strings.HasPrefix(domain, "*"):
+				suffixes = append(suffixes, wildcard{domain, virtualHost})
+			case strings.HasSuffix(domain, "*"):
+				prefixes = append(prefixes, wildcard{domain, virtualHost})
+			}
+		}
+	}
+	for _, candidates := range [][]wildcard{suffixes, prefixes} {
+		sort.SliceStable(candidates, func(i, j int) bool { return len(candidates[i].domain) > len(candidates[j].domain) })
+		for _, candidate := range candidates {
+			// the wildcard must match at least one character
+			if len(host) < len(candidate.domain) {
+				continue
+			}
+			if strings.HasPrefix(candidate.domain, "*") && strings.HasSuffix(host, candidate.domain[1:]) ||
+				strings.HasSuffix(candidate.domain, "*") && strings.HasPrefix(host, strings.TrimSuffix(candidate.domain, "*")) {
+				return candidate.virtualHost, candidate.domain
+			}
+		}
+	}
+	if defaultVirtualHost != nil {
+		return defaultVirtualHost, "*"
+	}
+	return nil, ""
+}
+
+func routeDestination(route *envoy_config_route_v3.Route) *Destination {
+	switch action := route.GetAction().(type) {
+	case *envoy_config_route_v3.Route_Route:
+		destination := &Destination{
+			ClusterHeader: action.Route.GetClusterHeader(),
+			PrefixRewrite: action.Route.GetPrefixRewrite(),
+			HostRewrite:   action.Route.GetHostRewriteLiteral(),
+		}
+		if cluster := action.Route.GetCluster(); cluster != "" {
+			destination.Clusters = append(destination.Clusters, newWeightedCluster(cluster, 0))
+		}
+		for _, cluster := range action.Route.GetWeightedClusters().GetClusters() {
+			destination.Clusters = append(destination.Clusters, newWeightedCluster(cluster.GetName(), cluster.GetWeight().GetValue()))
+		}
+		return destination
+	case *envoy_config_route_v3.Route_Redirect:
+		return &Destination{Redirect: describeRedirect(action.Redirect)}
+	case *envoy_config_route_v3.Route_DirectResponse:
+		return &Destination{DirectResponse: action.DirectResponse.GetStatus()}
+	}
+	return &Destination{}
+}
+
+func tcpDestination(tcpProxy *envoytcp.TcpProxy) *Destination {
+	destination := &Destination{}
+	if cluster := tcpProxy.GetCluster(); cluster != "" {
+		destination.Clusters = append(destination.Clusters, newWeightedCluster(cluster, 0))
+	}
+	for _, cluster := range tcpProxy.GetWeightedClusters().GetClusters() {
+		destination.Clusters = append(destination.Clusters, newWeightedCluster(cluster.GetName(), cluster.GetWeight()))
+	}
+	return destination
+}
+
+func newWeightedCluster(cluster string, weight uint32) *WeightedCluster {
+	weighted := &WeightedCluster{Cluster: cluster, Weight: weight}
+	if ref, err := translator.ClusterToUpstreamRef(cluster); err == nil && ref.GetNamespace() != "" {
+		weighted.Upstream = ref.Key()
+	}
+	return weighted
+}
+
+func describeRedirect(redirect *envoy_config_route_v3.RedirectAction) string {
+	var parts []string
+	if scheme := redirect.GetSchemeRedirect(); scheme != "" {
+		parts = append(parts, "scheme "+scheme)
+	} else if redirect.GetHttpsRedirect() {
+		parts = append(parts, "scheme https")
+	}
+	if host := redirect.GetHostRedirect(); host != "" {
+		parts = append(parts, "host "+host)
+	}
+	if path := redirect.GetPathRedirect(); path != "" {
+		parts = append(parts, "path "+path)
+	}
+	if prefix := redirect.GetPrefixRewrite(); prefix != "" {
+		parts = append(parts, "prefix "+prefix)
+	}
+	if len(parts) == 0 {
+		parts = append(parts, "same url")
+	}
+	return fmt.Sprintf("%v (%v)", strings.Join(parts, ", "), redirect.GetResponseCode())
+}
+
+func snapshotListeners(snapshot envoycache.Snapshot) []*envoy_config_listener_v3.Listener {
+	var listeners []*envoy_config_listener_v3.Listener
+	for _, resource := range snapshot.GetResources(types.ListenerTypeV3).Items {
+		listeners = append(listeners, resource.ResourceProto().(*envoy_config_listener_v3.Listener))
+	}
+	sort.Slice(listeners, func(i, j int) bool { return listeners[i].GetName() < listeners[j].GetName() })
+	return listeners
+}
+
+// routeSources finds the resources the envoy routes were generated from, using the route names,
+// which gloo generates as <virtual host>-route-<index of the proxy route>[-<route name>]-matcher-<index>
+type routeSources struct {
+	virtualHosts map[string]*gloov1.VirtualHost
+}
+
+func newRouteSources(proxy *gloov1.Proxy) *routeSources {
+	sources := &routeSources{virtualHosts: map[string]*gloov1.VirtualHost{}}
+	addVirtualHost := func(virtualHost *gloov1.VirtualHost) {
+		// the translator replaces the dots in the names of the virtual hosts
+		sources.virtualHosts[utils.SanitizeForEnvoy(context.Background(), virtualHost.GetName(), "virtual host")] = virtualHost
+	}
+	addVirtualHosts := func(httpListener *gloov1.HttpListener) {
+		for _, virtualHost := range httpListener.GetVirtualHosts() {
+			addVirtualHost(virtualHost)
+		}
+	}
+	for _, listener := range proxy.GetListeners() {
+		addVirtualHosts(listener.GetHttpListener())
+		for _, matched := range listener.GetHybridListener().GetMatchedListeners() {
+			addVirtualHosts(matched.GetHttpListener())
+		}
+		for _, virtualHost := range listener.GetAggregateListener().GetHttpResources().GetVirtualHosts() {
+			addVirtualHost(virtualHost)
+		}
+	}
+	return sources
+}
+
+func (s *routeSources) find(virtualHostName, routeName string) []string {
+	virtualHost, ok := s.virtualHosts[virtualHostName]
+	if !ok {
+		return nil
+	}
+	suffix, ok := strings.CutPrefix(routeName, virtualHostName+"-route-")
+	if !ok {
+		return nil
+	}
+	index, _, _ := strings.Cut(suffix, "-")
+	i, err := strconv.Atoi(index)
+	if err != nil || i >= len(virtualHost.GetRoutes()) {
+		return nil
+	}
+	// the sources are listed from the innermost route table, reverse them to follow the delegation chain
+	sourceRefs := virtualHost.GetRoutes()[i].GetMetadataStatic().GetSources()
+	var out []string
+	for j := len(sourceRefs) - 1; j >= 0; j-- {
+		source := sourceRefs[j]
+		// the kind is the go type of the resource, such as *v1.VirtualService
+		kind := source.GetResourceKind()
+		kind = kind[strings.LastIndex(kind, ".")+1:]
+		out = append(out, fmt.Sprintf("%v %v", kind, source.GetResourceRef().Key()))
+	}
+	return out
+}
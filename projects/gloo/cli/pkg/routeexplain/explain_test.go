@@ -0,0 +1,267 @@
+package routeexplain_test
+
+import (
+	"fmt"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/routeexplain"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Explain", func() {
+
+	var (
+		proxy       *gloov1.Proxy
+		routeConfig *envoy_config_route_v3.RouteConfiguration
+		manager     *hcm.HttpConnectionManager
+	)
+
+	route := func(name string, match *envoy_config_route_v3.RouteMatch, cluster string) *envoy_config_route_v3.Route {
+		return &envoy_config_route_v3.Route{
+			Name:  name,
+			Match: match,
+			Action: &envoy_config_route_v3.Route_Route{Route: &envoy_config_route_v3.RouteAction{
+				ClusterSpecifier: &envoy_config_route_v3.RouteAction_Cluster{Cluster: cluster},
+			}},
+		}
+	}
+	prefix := func(prefix string) *envoy_config_route_v3.RouteMatch {
+		return &envoy_config_route_v3.RouteMatch{PathSpecifier: &envoy_config_route_v3.RouteMatch_Prefix{Prefix: prefix}}
+	}
+
+	filterChain := func(name string, serverNames ...string) *envoy_config_listener_v3.FilterChain {
+		typedConfig, err := utils.MessageToAny(manager)
+		Expect(err).NotTo(HaveOccurred())
+		return &envoy_config_listener_v3.FilterChain{
+			Name:             name,
+			FilterChainMatch: &envoy_config_listener_v3.FilterChainMatch{ServerNames: serverNames},
+			Filters: []*envoy_config_listener_v3.Filter{{
+				Name:       wellknown.HTTPConnectionManager,
+				ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{TypedConfig: typedConfig},
+			}},
+		}
+	}
+	listener := func(port uint32, filterChains ...*envoy_config_listener_v3.FilterChain) *envoy_config_listener_v3.Listener {
+		return &envoy_config_listener_v3.Listener{
+			Name: fmt.Sprintf("listener-%v", port),
+			Address: &envoy_config_core_v3.Address{Address: &envoy_config_core_v3.Address_SocketAddress{
+				SocketAddress: &envoy_config_core_v3.SocketAddress{
+					PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{PortValue: port},
+				},
+			}},
+			FilterChains: filterChains,
+		}
+	}
+	snapshot := func(listeners ...*envoy_config_listener_v3.Listener) envoycache.Snapshot {
+		var listenerResources []envoycache.Resource
+		for _, l := range listeners {
+			listenerResources = append(listenerResources, resource.NewEnvoyResource(l))
+		}
+		return xds.NewSnapshotFromResources(
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", []envoycache.Resource{resource.NewEnvoyResource(routeConfig)}),
+			envoycache.NewResources("", listenerResources),
+		)
+	}
+	explain := func(req *routeexplain.Request, listeners ...*envoy_config_listener_v3.Listener) *routeexplain.Explanation {
+		if len(listeners) == 0 {
+			listeners = append(listeners, listener(8080, filterChain("http")))
+		}
+		explanation, err := routeexplain.Explain(proxy, snapshot(listeners...), req)
+		Expect(err).NotTo(HaveOccurred())
+		return explanation
+	}
+	newRequest := func(host, path string, headers ...string) *routeexplain.Request {
+		req, err := routeexplain.NewRequest("GET", host, path, headers)
+		Expect(err).NotTo(HaveOccurred())
+		return req
+	}
+
+	BeforeEach(func() {
+		proxy = &gloov1.Proxy{Metadata: &core.Metadata{Name: "gateway-proxy", Namespace: "gloo-system"}}
+		routeConfig = &envoy_config_route_v3.RouteConfiguration{
+			Name: "listener-routes",
+			VirtualHosts: []*envoy_config_route_v3.VirtualHost{
+				{
+					Name:    "exact",
+					Domains: []string{"api.example.com"},
+					Routes:  []*envoy_config_route_v3.Route{route("exact-route", prefix("/"), "exact_apps")},
+				},
+				{
+					Name:    "suffix",
+					Domains: []string{"*.example.com"},
+					Routes:  []*envoy_config_route_v3.Route{route("suffix-route", prefix("/"), "suffix_apps")},
+				},
+				{
+					Name:    "longer-suffix",
+					Domains: []string{"*.eu.example.com"},
+					Routes:  []*envoy_config_route_v3.Route{route("longer-suffix-route", prefix("/"), "longer-suffix_apps")},
+				},
+				{
+					Name:    "prefix",
+					Domains: []string{"example.*"},
+					Routes:  []*envoy_config_route_v3.Route{route("prefix-route", prefix("/"), "prefix_apps")},
+				},
+				{
+					Name:    "default",
+					Domains: []string{"*"},
+					Routes: []*envoy_config_route_v3.Route{
+						route("header-route", &envoy_config_route_v3.RouteMatch{
+							PathSpecifier: &envoy_config_route_v3.RouteMatch_Prefix{Prefix: "/"},
+							Headers: []*envoy_config_route_v3.HeaderMatcher{{
+								Name:                 "x-version",
+								HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "v2"},
+							}},
+						}, "v2_apps"),
+						route("query-route", &envoy_config_route_v3.RouteMatch{
+							PathSpecifier: &envoy_config_route_v3.RouteMatch_SafeRegex{SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: "/pets/[0-9]+"}},
+							QueryParameters: []*envoy_config_route_v3.QueryParameterMatcher{{
+								Name: "debug",
+								QueryParameterMatchSpecifier: &envoy_config_route_v3.QueryParameterMatcher_StringMatch{
+									StringMatch: &envoy_type_matcher_v3.StringMatcher{
+										MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: "TRUE"},
+										IgnoreCase:   true,
+									},
+								},
+							}},
+						}, "debug_apps"),
+						route("not-canary-route", &envoy_config_route_v3.RouteMatch{
+							PathSpecifier: &envoy_config_route_v3.RouteMatch_Path{Path: "/pets"},
+							Headers: []*envoy_config_route_v3.HeaderMatcher{{
+								Name:                 "x-canary",
+								HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "true"},
+								InvertMatch:          true,
+							}},
+						}, "stable_apps"),
+					},
+				},
+			},
+		}
+		manager = &hcm.HttpConnectionManager{
+			RouteSpecifier: &hcm.HttpConnectionManager_Rds{Rds: &hcm.Rds{RouteConfigName: "listener-routes"}},
+		}
+	})
+
+	DescribeTable("selects the virtual host in the envoy order",
+		func(host, virtualHost, domain string) {
+			explanation := explain(newRequest(host, "/"))
+			Expect(explanation.VirtualHost).To(Equal(virtualHost))
+			Expect(explanation.Domain).To(Equal(domain))
+		},
+		Entry("exact domain", "API.example.com", "exact", "api.example.com"),
+		Entry("suffix wildcard", "www.example.com", "suffix", "*.example.com"),
+		Entry("longest suffix wildcard", "www.eu.example.com", "longer-suffix", "*.eu.example.com"),
+		Entry("prefix wildcard", "example.org", "prefix", "example.*"),
+		Entry("default", "other.org", "default", "*"),
+		Entry("host with a port", "api.example.com:8080", "default", "*"),
+	)
+
+	It("strips the port of the host when the connection manager is configured to", func() {
+		manager.StripPortMode = &hcm.HttpConnectionManager_StripAnyHostPort{StripAnyHostPort: true}
+		explanation := explain(newRequest("api.example.com:8080", "/"))
+		Expect(explanation.VirtualHost).To(Equal("exact"))
+	})
+
+	It("explains the skipped routes and maps the cluster to its upstream", func() {
+		explanation := explain(newRequest("other.org", "/pets?debug=true", "x-canary: false"))
+		Expect(explanation.Listener).To(Equal("listener-8080"))
+		Expect(explanation.FilterChain).To(Equal("http"))
+		Expect(explanation.RouteConfiguration).To(Equal("listener-routes"))
+		Expect(explanation.SkippedRoutes).To(HaveLen(2))
+		Expect(explanation.SkippedRoutes[0].Name).To(Equal("header-route"))
+		Expect(explanation.SkippedRoutes[0].Reason).To(Equal(`header x-version does not match exact "v2"`))
+		Expect(explanation.SkippedRoutes[1].Name).To(Equal("query-route"))
+		Expect(explanation.SkippedRoutes[1].Reason).To(Equal("path /pets does not match regex /pets/[0-9]+"))
+		Expect(explanation.Route.Name).To(Equal("not-canary-route"))
+		Expect(explanation.Destination.Clusters).To(ConsistOf(&routeexplain.WeightedCluster{Cluster: "stable_apps", Upstream: "apps.stable"}))
+	})
+
+	It("matches query parameters ignoring case, and headers case insensitively", func() {
+		explanation := explain(newRequest("other.org", "/pets/12?debug=True", "X-Version:v1"))
+		Expect(explanation.Route.Name).To(Equal("query-route"))
+	})
+
+	It("matches an inverted header matcher when the header is missing", func() {
+		explanation := explain(newRequest("other.org", "/pets"))
+		Expect(explanation.Route.Name).To(Equal("not-canary-route"))
+
+		explanation = explain(newRequest("other.org", "/pets", "x-canary:true"))
+		Expect(explanation.Route).To(BeNil())
+		Expect(explanation.NoMatch).To(Equal("no route of virtual host default matches the request"))
+		Expect(explanation.SkippedRoutes[2].Reason).To(Equal(`header x-canary does not match not exact "true"`))
+	})
+
+	It("redirects plain requests to virtual hosts that require tls", func() {
+		routeConfig.GetVirtualHosts()[0].RequireTls = envoy_config_route_v3.VirtualHost_ALL
+		explanation := explain(newRequest("api.example.com", "/"))
+		Expect(explanation.Route).To(BeNil())
+		Expect(explanation.Destination.Redirect).To(ContainSubstring("https"))
+	})
+
+	It("selects the filter chain with the most specific server name", func() {
+		http := listener(8443, filterChain("wildcard", "*.example.com"), filterChain("exact", "api.example.com"), filterChain("any"))
+
+		req := newRequest("api.example.com", "/")
+		req.Sni = "api.example.com"
+		Expect(explain(req, http).FilterChain).To(Equal("exact"))
+
+		req.Sni = "www.example.com"
+		Expect(explain(req, http).FilterChain).To(Equal("wildcard"))
+
+		req.Sni = ""
+		Expect(explain(req, http).FilterChain).To(Equal("any"))
+	})
+
+	It("requires the port of the request when the proxy has several listeners", func() {
+		req := newRequest("api.example.com", "/")
+		listeners := []*envoy_config_listener_v3.Listener{listener(8080, filterChain("http")), listener(8443, filterChain("https"))}
+		Expect(explain(req, listeners...).NoMatch).To(Equal("the proxy has 2 listeners, on ports [8080 8443], select one with the port of the request"))
+
+		req.Port = 8443
+		Expect(explain(req, listeners...).FilterChain).To(Equal("https"))
+
+		req.Port = 9090
+		Expect(explain(req, listeners...).NoMatch).To(Equal("no listener on port 9090, the proxy listens on ports [8080 8443]"))
+	})
+
+	It("reports that no filter chain matches", func() {
+		req := newRequest("api.example.com", "/")
+		req.Sni = "other.org"
+		explanation := explain(req, listener(8443, filterChain("wildcard", "*.example.com")))
+		Expect(explanation.NoMatch).To(Equal("no filter chain of listener listener-8443 matches the server name of the request"))
+	})
+
+	It("returns an error for invalid requests", func() {
+		_, err := routeexplain.NewRequest("GET", "api.example.com", "/", []string{"no-value"})
+		Expect(err).To(MatchError(`invalid header "no-value", expected name:value`))
+
+		req := newRequest("api.example.com", "/")
+		req.SourceIp = "not-an-ip"
+		_, err = routeexplain.Explain(proxy, snapshot(listener(8080, filterChain("http"))), req)
+		Expect(err).To(MatchError(`invalid source ip "not-an-ip"`))
+	})
+
+	It("returns an error for invalid regexes", func() {
+		routeConfig.GetVirtualHosts()[0].GetRoutes()[0].Match = &envoy_config_route_v3.RouteMatch{
+			PathSpecifier: &envoy_config_route_v3.RouteMatch_SafeRegex{SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: "("}},
+			CaseSensitive: wrapperspb.Bool(true),
+		}
+		_, err := routeexplain.Explain(proxy, snapshot(listener(8080, filterChain("http"))), newRequest("api.example.com", "/"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid regex ("))
+	})
+})
@@ -0,0 +1,182 @@
+package routeexplain
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+)
+
+// returns the listener the request is sent to, or the reason no listener was selected
+func selectListener(listeners []*envoy_config_listener_v3.Listener, port uint32) (*envoy_config_listener_v3.Listener, string) {
+	if port == 0 {
+		if len(listeners) == 1 {
+			return listeners[0], ""
+		}
+		return nil, fmt.Sprintf("the proxy has %v listeners, on ports %v, select one with the port of the request", len(listeners), listenerPorts(listeners))
+	}
+	for _, listener := range listeners {
+		if listenerPort(listener) == port {
+			return listener, ""
+		}
+	}
+	return nil, fmt.Sprintf("no listener on port %v, the proxy listens on ports %v", port, listenerPorts(listeners))
+}
+
+func listenerPort(listener *envoy_config_listener_v3.Listener) uint32 {
+	return listener.GetAddress().GetSocketAddress().GetPortValue()
+}
+
+func listenerPorts(listeners []*envoy_config_listener_v3.Listener) []uint32 {
+	var ports []uint32
+	for _, listener := range listeners {
+		ports = append(ports, listenerPort(listener))
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	return ports
+}
+
+// filterChainCandidate is a filter chain of the listener, with its index for filter chains without a name
+type filterChainCandidate struct {
+	index       int
+	filterChain *envoy_config_listener_v3.FilterChain
+}
+
+// selects the filter chain like envoy does: the criteria of the filter chain matches are applied one after the other,
+// and for each criterion only the candidates with the most specific match are kept, or the candidates that do not
+// set it if none match. Only the criteria a request can describe are evaluated: destination port, server name,
+// transport protocol and source address.
+func selectFilterChain(listener *envoy_config_listener_v3.Listener, req *Request) (*filterChainCandidate, string) {
+	var candidates []*filterChainCandidate
+	for i, filterChain := range listener.GetFilterChains() {
+		candidates = append(candidates, &filterChainCandidate{index: i, filterChain: filterChain})
+	}
+
+	criteria := []struct {
+		name string
+		// returns the specificity of the match, -1 if the candidate does not match and 0 if it does not set the criterion
+		specificity func(match *envoy_config_listener_v3.FilterChainMatch) int
+	}{
+		{"destination port", func(match *envoy_config_listener_v3.FilterChainMatch) int {
+			if match.GetDestinationPort() == nil {
+				return 0
+			}
+			if match.GetDestinationPort().GetValue() == listenerPort(listener) {
+				return 1
+			}
+			return -1
+		}},
+		{"server name", func(match *envoy_config_listener_v3.FilterChainMatch) int {
+			return serverNameSpecificity(match.GetServerNames(), req.Sni)
+		}},
+		{"transport protocol", func(match *envoy_config_listener_v3.FilterChainMatch) int {
+			switch match.GetTransportProtocol() {
+			case "":
+				return 0
+			case "tls":
+				if req.isTls() {
+					return 1
+				}
+			case "raw_buffer":
+				if !req.isTls() {
+					return 1
+				}
+			}
+			return -1
+		}},
+		{"source address", func(match *envoy_config_listener_v3.FilterChainMatch) int {
+			if req.SourceIp == "" {
+				return 0
+			}
+			return prefixRangeSpecificity(match.GetSourcePrefixRanges(), req.SourceIp)
+		}},
+	}
+
+	for _, criterion := range criteria {
+		best := 0
+		var matching []*filterChainCandidate
+		for _, candidate := range candidates {
+			specificity := criterion.specificity(candidate.filterChain.GetFilterChainMatch())
+			if specificity < 0 || specificity < best {
+				continue
+			}
+			if specificity > best {
+				best, matching = specificity, nil
+			}
+			matching = append(matching, candidate)
+		}
+		candidates = matching
+		if len(candidates) == 0 {
+			if defaultFilterChain := listener.GetDefaultFilterChain(); defaultFilterChain != nil {
+				return &filterChainCandidate{index: -1, filterChain: defaultFilterChain}, ""
+			}
+			return nil, fmt.Sprintf("no filter chain of listener %v matches the %v of the request", listener.GetName(), criterion.name)
+		}
+	}
+	return candidates[0], ""
+}
+
+// exact server names are more specific than wildcards, and longer wildcards more specific than shorter ones
+func serverNameSpecificity(serverNames []string, sni string) int {
+	if len(serverNames) == 0 {
+		return 0
+	}
+	sni = strings.ToLower(sni)
+	best := -1
+	for _, serverName := range serverNames {
+		serverName = strings.ToLower(serverName)
+		switch {
+		case serverName == sni && sni != "":
+			// longer than any wildcard
+			return 1 << 16
+		case strings.HasPrefix(serverName, "*.") && strings.HasSuffix(sni, serverName[1:]) && len(sni) > len(serverName)-1:
+			if specificity := len(serverName); specificity > best {
+				best = specificity
+			}
+		}
+	}
+	return best
+}
+
+func prefixRangeSpecificity(ranges []*envoy_config_core_v3.CidrRange, ip string) int {
+	if len(ranges) == 0 {
+		return 0
+	}
+	parsed := net.ParseIP(ip)
+	best := -1
+	for _, cidrRange := range ranges {
+		bits := 32
+		if parsed.To4() == nil {
+			bits = 128
+		}
+		if prefixLen := cidrRange.GetPrefixLen(); prefixLen != nil {
+			bits = int(prefixLen.GetValue())
+		}
+		_, network, err := net.ParseCIDR(fmt.Sprintf("%v/%v", cidrRange.GetAddressPrefix(), bits))
+		if err != nil || !network.Contains(parsed) {
+			continue
+		}
+		// a zero length prefix still sets the criterion, so it is more specific than not setting it
+		if bits+1 > best {
+			best = bits + 1
+		}
+	}
+	return best
+}
+
+func describeFilterChain(candidate *filterChainCandidate) string {
+	if name := candidate.filterChain.GetName(); name != "" {
+		return name
+	}
+	if candidate.index < 0 {
+		return "default filter chain"
+	}
+	description := fmt.Sprintf("filter chain #%v", candidate.index)
+	if serverNames := candidate.filterChain.GetFilterChainMatch().GetServerNames(); len(serverNames) > 0 {
+		description += fmt.Sprintf(" (server names %v)", strings.Join(serverNames, ", "))
+	}
+	return description
+}
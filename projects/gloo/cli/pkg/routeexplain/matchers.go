@@ -0,0 +1,236 @@
+package routeexplain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+)
+
+// The matchers follow the semantics of the envoy route matching in source/common/router/config_impl.cc and
+// source/common/http/header_utility.cc. Regexes are RE2 in both envoy and go, and must match the whole value.
+
+// returns an empty string if the route matches the request, otherwise the reason it does not
+func matchRoute(match *envoy_config_route_v3.RouteMatch, req *Request) (string, error) {
+	if reason, err := matchPath(match, req.pathOnly()); reason != "" || err != nil {
+		return reason, err
+	}
+	if match.GetGrpc() != nil {
+		if contentType, _ := req.header("content-type"); !strings.HasPrefix(contentType, "application/grpc") {
+			return "only matches grpc requests, and the content-type is not application/grpc", nil
+		}
+	}
+	for _, header := range match.GetHeaders() {
+		matches, err := matchHeader(header, req)
+		if err != nil {
+			return "", err
+		}
+		if !matches {
+			return fmt.Sprintf("header %v does not match %v", header.GetName(), describeHeaderMatcher(header)), nil
+		}
+	}
+	for _, param := range match.GetQueryParameters() {
+		matches, err := matchQueryParameter(param, req)
+		if err != nil {
+			return "", err
+		}
+		if !matches {
+			return fmt.Sprintf("query parameter %v does not match %v", param.GetName(), describeQueryParameterMatcher(param)), nil
+		}
+	}
+	if tlsContext := match.GetTlsContext(); tlsContext != nil {
+		if presented := tlsContext.GetPresented(); presented != nil && presented.GetValue() != req.isTls() {
+			return fmt.Sprintf("requires tls presented=%v", presented.GetValue()), nil
+		}
+	}
+	return "", nil
+}
+
+func matchPath(match *envoy_config_route_v3.RouteMatch, path string) (string, error) {
+	caseSensitive := match.GetCaseSensitive() == nil || match.GetCaseSensitive().GetValue()
+	fold := func(s string) string {
+		if caseSensitive {
+			return s
+		}
+		return strings.ToLower(s)
+	}
+
+	switch specifier := match.GetPathSpecifier().(type) {
+	case *envoy_config_route_v3.RouteMatch_Prefix:
+		if !strings.HasPrefix(fold(path), fold(specifier.Prefix)) {
+			return fmt.Sprintf("path %v does not start with prefix %v", path, specifier.Prefix), nil
+		}
+	case *envoy_config_route_v3.RouteMatch_Path:
+		if fold(path) != fold(specifier.Path) {
+			return fmt.Sprintf("path %v is not %v", path, specifier.Path), nil
+		}
+	case *envoy_config_route_v3.RouteMatch_PathSeparatedPrefix:
+		prefix := fold(specifier.PathSeparatedPrefix)
+		if folded := fold(path); folded != prefix && !strings.HasPrefix(folded, prefix+"/") {
+			return fmt.Sprintf("path %v is not %v or below it", path, specifier.PathSeparatedPrefix), nil
+		}
+	case *envoy_config_route_v3.RouteMatch_SafeRegex:
+		matches, err := fullMatch(specifier.SafeRegex.GetRegex(), path)
+		if err != nil {
+			return "", err
+		}
+		if !matches {
+			return fmt.Sprintf("path %v does not match regex %v", path, specifier.SafeRegex.GetRegex()), nil
+		}
+	case *envoy_config_route_v3.RouteMatch_ConnectMatcher_:
+		return "only matches CONNECT requests", nil
+	case nil:
+		return "route has no path matcher", nil
+	default:
+		return fmt.Sprintf("path matcher %T is not supported by route explain", specifier), nil
+	}
+	return "", nil
+}
+
+func matchHeader(matcher *envoy_config_route_v3.HeaderMatcher, req *Request) (bool, error) {
+	value, present := req.header(matcher.GetName())
+	if !present && !matcher.GetTreatMissingHeaderAsEmpty() {
+		if matcher.GetInvertMatch() {
+			return !isPresentMatcher(matcher), nil
+		}
+		return isPresentMatcher(matcher) && !matcher.GetPresentMatch(), nil
+	}
+
+	var matches bool
+	switch specifier := matcher.GetHeaderMatchSpecifier().(type) {
+	case *envoy_config_route_v3.HeaderMatcher_ExactMatch:
+		matches = value == specifier.ExactMatch
+	case *envoy_config_route_v3.HeaderMatcher_SafeRegexMatch:
+		var err error
+		if matches, err = fullMatch(specifier.SafeRegexMatch.GetRegex(), value); err != nil {
+			return false, err
+		}
+	case *envoy_config_route_v3.HeaderMatcher_RangeMatch:
+		number, err := strconv.ParseInt(value, 10, 64)
+		matches = err == nil && number >= specifier.RangeMatch.GetStart() && number < specifier.RangeMatch.GetEnd()
+	case *envoy_config_route_v3.HeaderMatcher_PresentMatch:
+		matches = specifier.PresentMatch
+	case *envoy_config_route_v3.HeaderMatcher_PrefixMatch:
+		matches = strings.HasPrefix(value, specifier.PrefixMatch)
+	case *envoy_config_route_v3.HeaderMatcher_SuffixMatch:
+		matches = strings.HasSuffix(value, specifier.SuffixMatch)
+	case *envoy_config_route_v3.HeaderMatcher_ContainsMatch:
+		matches = strings.Contains(value, specifier.ContainsMatch)
+	case *envoy_config_route_v3.HeaderMatcher_StringMatch:
+		var err error
+		if matches, err = matchString(specifier.StringMatch, value); err != nil {
+			return false, err
+		}
+	case nil:
+		// a header matcher without a specifier only requires the header to be present
+		matches = true
+	}
+	return matches != matcher.GetInvertMatch(), nil
+}
+
+func isPresentMatcher(matcher *envoy_config_route_v3.HeaderMatcher) bool {
+	_, ok := matcher.GetHeaderMatchSpecifier().(*envoy_config_route_v3.HeaderMatcher_PresentMatch)
+	return ok
+}
+
+func matchQueryParameter(matcher *envoy_config_route_v3.QueryParameterMatcher, req *Request) (bool, error) {
+	value, present := req.queryParameter(matcher.GetName())
+	switch specifier := matcher.GetQueryParameterMatchSpecifier().(type) {
+	case *envoy_config_route_v3.QueryParameterMatcher_PresentMatch:
+		return present == specifier.PresentMatch, nil
+	case *envoy_config_route_v3.QueryParameterMatcher_StringMatch:
+		if !present {
+			return false, nil
+		}
+		return matchString(specifier.StringMatch, value)
+	}
+	return present, nil
+}
+
+func matchString(matcher *envoy_type_matcher_v3.StringMatcher, value string) (bool, error) {
+	pattern := ""
+	compare := func(value, pattern string) bool { return value == pattern }
+	switch specifier := matcher.GetMatchPattern().(type) {
+	case *envoy_type_matcher_v3.StringMatcher_Exact:
+		pattern = specifier.Exact
+	case *envoy_type_matcher_v3.StringMatcher_Prefix:
+		pattern, compare = specifier.Prefix, strings.HasPrefix
+	case *envoy_type_matcher_v3.StringMatcher_Suffix:
+		pattern, compare = specifier.Suffix, strings.HasSuffix
+	case *envoy_type_matcher_v3.StringMatcher_Contains:
+		pattern, compare = specifier.Contains, strings.Contains
+	case *envoy_type_matcher_v3.StringMatcher_SafeRegex:
+		// ignore_case does not apply to regexes
+		return fullMatch(specifier.SafeRegex.GetRegex(), value)
+	}
+	if matcher.GetIgnoreCase() {
+		value, pattern = strings.ToLower(value), strings.ToLower(pattern)
+	}
+	return compare(value, pattern), nil
+}
+
+func fullMatch(regex, value string) (bool, error) {
+	re, err := regexp.Compile("^(?:" + regex + ")$")
+	if err != nil {
+		return false, InvalidRegexError(regex, err)
+	}
+	return re.MatchString(value), nil
+}
+
+func describeHeaderMatcher(matcher *envoy_config_route_v3.HeaderMatcher) string {
+	var description string
+	switch specifier := matcher.GetHeaderMatchSpecifier().(type) {
+	case *envoy_config_route_v3.HeaderMatcher_ExactMatch:
+		description = fmt.Sprintf("exact %q", specifier.ExactMatch)
+	case *envoy_config_route_v3.HeaderMatcher_SafeRegexMatch:
+		description = fmt.Sprintf("regex %q", specifier.SafeRegexMatch.GetRegex())
+	case *envoy_config_route_v3.HeaderMatcher_RangeMatch:
+		description = fmt.Sprintf("range [%v, %v)", specifier.RangeMatch.GetStart(), specifier.RangeMatch.GetEnd())
+	case *envoy_config_route_v3.HeaderMatcher_PresentMatch:
+		description = fmt.Sprintf("present=%v", specifier.PresentMatch)
+	case *envoy_config_route_v3.HeaderMatcher_PrefixMatch:
+		description = fmt.Sprintf("prefix %q", specifier.PrefixMatch)
+	case *envoy_config_route_v3.HeaderMatcher_SuffixMatch:
+		description = fmt.Sprintf("suffix %q", specifier.SuffixMatch)
+	case *envoy_config_route_v3.HeaderMatcher_ContainsMatch:
+		description = fmt.Sprintf("contains %q", specifier.ContainsMatch)
+	case *envoy_config_route_v3.HeaderMatcher_StringMatch:
+		description = describeStringMatcher(specifier.StringMatch)
+	default:
+		description = "present"
+	}
+	if matcher.GetInvertMatch() {
+		description = "not " + description
+	}
+	return description
+}
+
+func describeQueryParameterMatcher(matcher *envoy_config_route_v3.QueryParameterMatcher) string {
+	if stringMatch := matcher.GetStringMatch(); stringMatch != nil {
+		return describeStringMatcher(stringMatch)
+	}
+	return fmt.Sprintf("present=%v", matcher.GetPresentMatch())
+}
+
+func describeStringMatcher(matcher *envoy_type_matcher_v3.StringMatcher) string {
+	var description string
+	switch specifier := matcher.GetMatchPattern().(type) {
+	case *envoy_type_matcher_v3.StringMatcher_Exact:
+		description = fmt.Sprintf("exact %q", specifier.Exact)
+	case *envoy_type_matcher_v3.StringMatcher_Prefix:
+		description = fmt.Sprintf("prefix %q", specifier.Prefix)
+	case *envoy_type_matcher_v3.StringMatcher_Suffix:
+		description = fmt.Sprintf("suffix %q", specifier.Suffix)
+	case *envoy_type_matcher_v3.StringMatcher_Contains:
+		description = fmt.Sprintf("contains %q", specifier.Contains)
+	case *envoy_type_matcher_v3.StringMatcher_SafeRegex:
+		description = fmt.Sprintf("regex %q", specifier.SafeRegex.GetRegex())
+	}
+	if matcher.GetIgnoreCase() {
+		description += " (ignoring case)"
+	}
+	return description
+}
@@ -0,0 +1,71 @@
+package routeexplain
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// PrintText writes the explanation as the steps envoy takes to route the request
+func (e *Explanation) PrintText(w io.Writer) {
+	step := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(w, "%-22v%v\n", name+":", value)
+		}
+	}
+	step("proxy", e.Proxy)
+	step("listener", e.Listener)
+	step("filter chain", e.FilterChain)
+	step("route configuration", e.RouteConfiguration)
+	if e.VirtualHost != "" {
+		step("virtual host", fmt.Sprintf("%v (matched domain %q)", e.VirtualHost, e.Domain))
+	}
+
+	if len(e.SkippedRoutes) > 0 {
+		fmt.Fprintln(w, "skipped routes:")
+		for _, skipped := range e.SkippedRoutes {
+			fmt.Fprintf(w, "  #%v %v\n", skipped.Index, describeRoute(&skipped.Route))
+			fmt.Fprintf(w, "      %v\n", skipped.Reason)
+		}
+	}
+	if e.Route != nil {
+		step("matched route", fmt.Sprintf("#%v %v", e.Route.Index, describeRoute(e.Route)))
+	}
+	if e.NoMatch != "" {
+		step("no match", e.NoMatch)
+	}
+
+	destination := e.Destination
+	if destination == nil {
+		return
+	}
+	switch {
+	case destination.Redirect != "":
+		step("redirect", destination.Redirect)
+	case destination.DirectResponse != 0:
+		step("direct response", fmt.Sprintf("%v", destination.DirectResponse))
+	case destination.ClusterHeader != "":
+		step("cluster", fmt.Sprintf("from header %v", destination.ClusterHeader))
+	default:
+		for _, cluster := range destination.Clusters {
+			description := cluster.Cluster
+			if cluster.Upstream != "" {
+				description = fmt.Sprintf("%v (upstream %v)", description, cluster.Upstream)
+			}
+			if cluster.Weight != 0 {
+				description = fmt.Sprintf("%v weight %v", description, cluster.Weight)
+			}
+			step("cluster", description)
+		}
+	}
+	step("prefix rewrite", destination.PrefixRewrite)
+	step("host rewrite", destination.HostRewrite)
+}
+
+func describeRoute(route *Route) string {
+	description := route.Name
+	if len(route.Sources) > 0 {
+		description = fmt.Sprintf("%v from %v", description, strings.Join(route.Sources, " -> "))
+	}
+	return description
+}
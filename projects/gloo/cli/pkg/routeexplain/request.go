@@ -0,0 +1,129 @@
+package routeexplain
+
+import (
+	"net"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
+var (
+	InvalidHeaderError = func(header string) error {
+		return eris.Errorf("invalid header %q, expected name:value", header)
+	}
+
+	InvalidSourceIpError = func(ip string) error {
+		return eris.Errorf("invalid source ip %q", ip)
+	}
+)
+
+// Request is a synthetic request, described with the properties Envoy matches on.
+type Request struct {
+	Method string
+	// Host is the value of the host (:authority) header
+	Host string
+	// Path may include a query string
+	Path string
+	// Headers are keyed by their lowercase names
+	Headers map[string]string
+	// Port is the port of the listener the request is sent to, it may be left empty if the proxy has a single listener
+	Port uint32
+	// Tls is true if the connection uses tls, which is implied by a non-empty Sni
+	Tls bool
+	Sni string
+	// SourceIp is the address of the downstream client, if empty, filter chains are not selected by source address
+	SourceIp string
+}
+
+// NewRequest builds a request from the name:value headers given on the command line
+func NewRequest(method, host, path string, headers []string) (*Request, error) {
+	req := &Request{
+		Method:  strings.ToUpper(method),
+		Host:    host,
+		Path:    path,
+		Headers: map[string]string{},
+	}
+	if req.Method == "" {
+		req.Method = "GET"
+	}
+	if req.Path == "" {
+		req.Path = "/"
+	}
+	for _, header := range headers {
+		name, value, ok := strings.Cut(header, ":")
+		// pseudo headers such as :method start with a colon
+		if ok && name == "" {
+			var pseudoName string
+			pseudoName, value, ok = strings.Cut(value, ":")
+			name = ":" + pseudoName
+		}
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, InvalidHeaderError(header)
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		value = strings.TrimSpace(value)
+		// envoy joins the values of repeated headers with a comma before matching
+		if existing, ok := req.Headers[name]; ok {
+			value = existing + "," + value
+		}
+		req.Headers[name] = value
+	}
+	return req, nil
+}
+
+func (r *Request) validate() error {
+	if r.SourceIp != "" && net.ParseIP(r.SourceIp) == nil {
+		return InvalidSourceIpError(r.SourceIp)
+	}
+	return nil
+}
+
+func (r *Request) isTls() bool {
+	return r.Tls || r.Sni != ""
+}
+
+func (r *Request) scheme() string {
+	if r.isTls() {
+		return "https"
+	}
+	return "http"
+}
+
+// returns the path without the query string, which is what envoy matches paths on
+func (r *Request) pathOnly() string {
+	path, _, _ := strings.Cut(r.Path, "?")
+	path, _, _ = strings.Cut(path, "#")
+	return path
+}
+
+// returns the value of the header, including the pseudo headers derived from the request
+func (r *Request) header(name string) (string, bool) {
+	switch name {
+	case ":method":
+		return r.Method, true
+	case ":authority", "host":
+		return r.Host, r.Host != ""
+	case ":path":
+		return r.Path, true
+	case ":scheme":
+		return r.scheme(), true
+	}
+	value, ok := r.Headers[name]
+	return value, ok
+}
+
+// returns the value of the query parameter. Like envoy, the values are not url decoded.
+func (r *Request) queryParameter(name string) (string, bool) {
+	_, query, ok := strings.Cut(r.Path, "?")
+	if !ok {
+		return "", false
+	}
+	query, _, _ = strings.Cut(query, "#")
+	for _, param := range strings.Split(query, "&") {
+		key, value, _ := strings.Cut(param, "=")
+		if key == name {
+			return value, true
+		}
+	}
+	return "", false
+}
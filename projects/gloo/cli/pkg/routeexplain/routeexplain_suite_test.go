@@ -0,0 +1,13 @@
+package routeexplain_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRouteExplain(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Route Explain Suite")
+}
@@ -19,12 +19,14 @@ import (
 	envoy_service_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/service/endpoint/v3"
 	envoy_service_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/service/listener/v3"
 	envoy_service_route_v3 "github.com/envoyproxy/go-control-plane/envoy/service/route/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/rotisserie/eris"
 	_ "github.com/solo-io/gloo/projects/envoyinit/hack/filter_types"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options/contextoptions"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/go-utils/contextutils"
 	"go.uber.org/zap"
@@ -42,11 +44,11 @@ func GetGlooXdsDump(ctx context.Context, proxyName, namespace string, verboseErr
 
 	xdsPort := strconv.Itoa(defaults.GlooXdsPort)
 	// If gloo is in MTLS mode
-	glooMtlsCheck := exec.Command("kubectl", "get", "configmap", envoySidecarConfig, "-n", namespace)
+	glooMtlsCheck := kubectl(ctx, "get", "configmap", envoySidecarConfig, "-n", namespace)
 	if err := glooMtlsCheck.Run(); err == nil {
 		xdsPort = strconv.Itoa(defaults.GlooMtlsModeXdsPort)
 	}
-	portFwd := exec.Command("kubectl", "port-forward", "-n", namespace,
+	portFwd := kubectl(ctx, "port-forward", "-n", namespace,
 		"deployment/gloo", xdsPort)
 	mergedPortForwardOutput := bytes.NewBuffer([]byte{})
 	portFwd.Stdout = mergedPortForwardOutput
@@ -100,6 +102,14 @@ func GetGlooXdsDump(ctx context.Context, proxyName, namespace string, verboseErr
 
 }
 
+// runs kubectl against the kube context of the options in the go context, if one is set
+func kubectl(ctx context.Context, args ...string) *exec.Cmd {
+	if kubeContext := contextoptions.KubecontextFrom(ctx); kubeContext != "" {
+		args = append(args, "--context", kubeContext)
+	}
+	return exec.Command("kubectl", args...)
+}
+
 type XdsDump struct {
 	Role      string
 	Endpoints []envoyendpoint.ClusterLoadAssignment
@@ -142,7 +152,7 @@ func getXdsDump(ctx context.Context, xdsPort, proxyName, proxyNamespace string)
 	for _, l := range xdsDump.Listeners {
 		for _, fc := range l.GetFilterChains() {
 			for _, filter := range fc.GetFilters() {
-				if filter.GetName() == "envoy.http_connection_manager" {
+				if filter.GetName() == wellknown.HTTPConnectionManager || filter.GetName() == "envoy.http_connection_manager" {
 					var hcm envoyhttp.HttpConnectionManager
 					switch config := filter.GetConfigType().(type) {
 					case *envoylistener.Filter_TypedConfig:
@@ -157,7 +167,9 @@ func getXdsDump(ctx context.Context, xdsPort, proxyName, proxyNamespace string)
 
 	var routes []string
 	for _, hcm := range hcms {
-		routes = append(routes, hcm.GetRouteSpecifier().(*envoyhttp.HttpConnectionManager_Rds).Rds.GetRouteConfigName())
+		if routeConfigName := hcm.GetRds().GetRouteConfigName(); routeConfigName != "" {
+			routes = append(routes, routeConfigName)
+		}
 	}
 
 	xdsDump.Routes, err = listRoutes(ctx, conn, dr, routes)
@@ -0,0 +1,62 @@
+package kubeconverters
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kubesecret"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	GcpServiceAccountKeyDataKey                   = "service-account.json"
+	GcpServiceAccountSecretType corev1.SecretType = "gloo.solo.io/gcp-service-account"
+)
+
+// GcpSecretConverter processes secrets with type "gloo.solo.io/gcp-service-account"
+type GcpSecretConverter struct{}
+
+var _ kubesecret.SecretConverter = &GcpSecretConverter{}
+
+func (t *GcpSecretConverter) FromKubeSecret(ctx context.Context, _ *kubesecret.ResourceClient, secret *corev1.Secret) (resources.Resource, error) {
+	if secret == nil {
+		contextutils.LoggerFrom(ctx).Warn("unexpected nil secret")
+		return nil, nil
+	}
+
+	if secret.Type != GcpServiceAccountSecretType {
+		// any unmatched secrets will be handled by subsequent converters
+		return nil, nil
+	}
+
+	return &v1.Secret{
+		Metadata: kubeutils.FromKubeMeta(secret.ObjectMeta, true),
+		Kind: &v1.Secret_Gcp{
+			Gcp: &v1.GcpSecret{
+				ServiceAccountKey: string(secret.Data[GcpServiceAccountKeyDataKey]),
+			},
+		},
+	}, nil
+}
+
+func (t *GcpSecretConverter) ToKubeSecret(_ context.Context, _ *kubesecret.ResourceClient, resource resources.Resource) (*corev1.Secret, error) {
+	glooSecret, ok := resource.(*v1.Secret)
+	if !ok {
+		return nil, nil
+	}
+	gcpSecret, ok := glooSecret.GetKind().(*v1.Secret_Gcp)
+	if !ok {
+		return nil, nil
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: kubeutils.ToKubeMeta(glooSecret.GetMetadata()),
+		Type:       GcpServiceAccountSecretType,
+		Data: map[string][]byte{
+			GcpServiceAccountKeyDataKey: []byte(gcpSecret.Gcp.GetServiceAccountKey()),
+		},
+	}, nil
+}
@@ -0,0 +1,49 @@
+package kubeconverters_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/api/converters/kube"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+var _ = Describe("Gcp converters", func() {
+	const serviceAccountKey = `{"type":"service_account","client_email":"invoker@my-project.iam.gserviceaccount.com"}`
+
+	It("should convert secret to gcp secret and back preserving all information", func() {
+		kubeSecret := &corev1.Secret{
+			Type: GcpServiceAccountSecretType,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "s1",
+				Namespace:       "ns",
+				Labels:          map[string]string{},
+				OwnerReferences: []metav1.OwnerReference{},
+			},
+			Data: map[string][]byte{
+				GcpServiceAccountKeyDataKey: []byte(serviceAccountKey),
+			},
+		}
+		chainedConverter := NewSecretConverterChain(new(GcpSecretConverter))
+		resource, err := chainedConverter.FromKubeSecret(context.Background(), nil, kubeSecret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resource.GetMetadata().Name).To(Equal("s1"))
+		Expect(resource.(*v1.Secret).GetGcp().GetServiceAccountKey()).To(Equal(serviceAccountKey))
+		derivedSecret, err := chainedConverter.ToKubeSecret(context.Background(), nil, resource)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(derivedSecret).To(Equal(kubeSecret))
+	})
+
+	It("should ignore secrets of other types", func() {
+		resource, err := new(GcpSecretConverter).FromKubeSecret(context.Background(), nil, &corev1.Secret{
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{GcpServiceAccountKeyDataKey: []byte(serviceAccountKey)},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resource).To(BeNil())
+	})
+})
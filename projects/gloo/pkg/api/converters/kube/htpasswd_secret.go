@@ -0,0 +1,62 @@
+package kubeconverters
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kubesecret"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	HtpasswdDataKey                      = "htpasswd"
+	HtpasswdSecretType corev1.SecretType = "extauth.solo.io/htpasswd"
+)
+
+// HtpasswdSecretConverter processes secrets with type "extauth.solo.io/htpasswd"
+type HtpasswdSecretConverter struct{}
+
+var _ kubesecret.SecretConverter = &HtpasswdSecretConverter{}
+
+func (t *HtpasswdSecretConverter) FromKubeSecret(ctx context.Context, _ *kubesecret.ResourceClient, secret *corev1.Secret) (resources.Resource, error) {
+	if secret == nil {
+		contextutils.LoggerFrom(ctx).Warn("unexpected nil secret")
+		return nil, nil
+	}
+
+	if secret.Type != HtpasswdSecretType {
+		// any unmatched secrets will be handled by subsequent converters
+		return nil, nil
+	}
+
+	return &v1.Secret{
+		Metadata: kubeutils.FromKubeMeta(secret.ObjectMeta, true),
+		Kind: &v1.Secret_Htpasswd{
+			Htpasswd: &v1.HtpasswdSecret{
+				Htpasswd: string(secret.Data[HtpasswdDataKey]),
+			},
+		},
+	}, nil
+}
+
+func (t *HtpasswdSecretConverter) ToKubeSecret(_ context.Context, _ *kubesecret.ResourceClient, resource resources.Resource) (*corev1.Secret, error) {
+	glooSecret, ok := resource.(*v1.Secret)
+	if !ok {
+		return nil, nil
+	}
+	htpasswdSecret, ok := glooSecret.GetKind().(*v1.Secret_Htpasswd)
+	if !ok {
+		return nil, nil
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: kubeutils.ToKubeMeta(glooSecret.GetMetadata()),
+		Type:       HtpasswdSecretType,
+		Data: map[string][]byte{
+			HtpasswdDataKey: []byte(htpasswdSecret.Htpasswd.GetHtpasswd()),
+		},
+	}, nil
+}
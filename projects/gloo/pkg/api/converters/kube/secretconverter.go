@@ -25,6 +25,8 @@ var GlooSecretConverterChain = NewSecretConverterChain(
 	// added the encryption secret primarily for usersession cookie encryption. This enables us to
 	// create and update the key used for encryption.
 	new(EncryptionSecretConverter),
+	new(HtpasswdSecretConverter),
+	new(GcpSecretConverter),
 	// the header converter needs to run last because it has a fall-back to convert any opaque k8s secret with
 	// non-empty data into a gloo header secret
 	new(HeaderSecretConverter),
@@ -25,7 +25,7 @@ var _ = Describe("SecretConverter", func() {
 		convertersValue := reflect.ValueOf(*GlooSecretConverterChain).FieldByName(converterField.Name)
 		// NOTE: when adding a converter here, please add the glooctl command
 		// for the secret converter as well add to cli projects/gloo/cli/pkg/cmd/create/secret
-		Expect(convertersValue.Len()).To(Equal(8))
+		Expect(convertersValue.Len()).To(Equal(10))
 	})
 	It("should convert kube secret to gloo secret", func() {
 		secret := &corev1.Secret{
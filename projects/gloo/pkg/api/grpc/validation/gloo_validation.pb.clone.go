@@ -347,6 +347,18 @@ func (m *ListenerReport) Clone() proto.Message {
 			}
 		}
 
+	case *ListenerReport_UdpListenerReport:
+
+		if h, ok := interface{}(m.GetUdpListenerReport()).(clone.Cloner); ok {
+			target.ListenerTypeReport = &ListenerReport_UdpListenerReport{
+				UdpListenerReport: h.Clone().(*UdpListenerReport),
+			}
+		} else {
+			target.ListenerTypeReport = &ListenerReport_UdpListenerReport{
+				UdpListenerReport: proto.Clone(m.GetUdpListenerReport()).(*UdpListenerReport),
+			}
+		}
+
 	}
 
 	return target
@@ -537,6 +549,43 @@ func (m *TcpHostReport) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *UdpListenerReport) Clone() proto.Message {
+	var target *UdpListenerReport
+	if m == nil {
+		return target
+	}
+	target = &UdpListenerReport{}
+
+	if m.GetErrors() != nil {
+		target.Errors = make([]*UdpListenerReport_Error, len(m.GetErrors()))
+		for idx, v := range m.GetErrors() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Errors[idx] = h.Clone().(*UdpListenerReport_Error)
+			} else {
+				target.Errors[idx] = proto.Clone(v).(*UdpListenerReport_Error)
+			}
+
+		}
+	}
+
+	if m.GetWarnings() != nil {
+		target.Warnings = make([]*UdpListenerReport_Warning, len(m.GetWarnings()))
+		for idx, v := range m.GetWarnings() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Warnings[idx] = h.Clone().(*UdpListenerReport_Warning)
+			} else {
+				target.Warnings[idx] = proto.Clone(v).(*UdpListenerReport_Warning)
+			}
+
+		}
+	}
+
+	return target
+}
+
 // Clone function
 func (m *HybridListenerReport) Clone() proto.Message {
 	var target *HybridListenerReport
@@ -762,3 +811,33 @@ func (m *TcpHostReport_Warning) Clone() proto.Message {
 
 	return target
 }
+
+// Clone function
+func (m *UdpListenerReport_Error) Clone() proto.Message {
+	var target *UdpListenerReport_Error
+	if m == nil {
+		return target
+	}
+	target = &UdpListenerReport_Error{}
+
+	target.Type = m.GetType()
+
+	target.Reason = m.GetReason()
+
+	return target
+}
+
+// Clone function
+func (m *UdpListenerReport_Warning) Clone() proto.Message {
+	var target *UdpListenerReport_Warning
+	if m == nil {
+		return target
+	}
+	target = &UdpListenerReport_Warning{}
+
+	target.Type = m.GetType()
+
+	target.Reason = m.GetReason()
+
+	return target
+}
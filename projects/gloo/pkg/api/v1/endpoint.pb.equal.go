@@ -95,6 +95,20 @@ func (m *Endpoint) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocality()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocality()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocality(), target.GetLocality()) {
+			return false
+		}
+	}
+
+	if m.GetHealthStatus() != target.GetHealthStatus() {
+		return false
+	}
+
 	return true
 }
 
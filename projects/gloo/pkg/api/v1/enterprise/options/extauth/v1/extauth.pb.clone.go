@@ -329,6 +329,18 @@ func (m *BasicAuth) Clone() proto.Message {
 			}
 		}
 
+	case *BasicAuth_HtpasswdSecretRef:
+
+		if h, ok := interface{}(m.GetHtpasswdSecretRef()).(clone.Cloner); ok {
+			target.UserSource = &BasicAuth_HtpasswdSecretRef{
+				HtpasswdSecretRef: h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		} else {
+			target.UserSource = &BasicAuth_HtpasswdSecretRef{
+				HtpasswdSecretRef: proto.Clone(m.GetHtpasswdSecretRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		}
+
 	}
 
 	return target
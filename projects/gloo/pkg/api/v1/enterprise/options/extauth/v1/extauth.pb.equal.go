@@ -548,6 +548,21 @@ func (m *BasicAuth) Equal(that interface{}) bool {
 			}
 		}
 
+	case *BasicAuth_HtpasswdSecretRef:
+		if _, ok := target.UserSource.(*BasicAuth_HtpasswdSecretRef); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetHtpasswdSecretRef()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHtpasswdSecretRef()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetHtpasswdSecretRef(), target.GetHtpasswdSecretRef()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.UserSource != target.UserSource {
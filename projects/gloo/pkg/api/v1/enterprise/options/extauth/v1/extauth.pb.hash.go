@@ -616,6 +616,28 @@ func (m *BasicAuth) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *BasicAuth_HtpasswdSecretRef:
+
+		if h, ok := interface{}(m.GetHtpasswdSecretRef()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("HtpasswdSecretRef")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetHtpasswdSecretRef(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("HtpasswdSecretRef")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
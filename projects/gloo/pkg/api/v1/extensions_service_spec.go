@@ -50,3 +50,11 @@ func (us *Upstream_Consul) GetServiceSpec() *plugins.ServiceSpec {
 func (us *Upstream_Consul) SetServiceSpec(spec *plugins.ServiceSpec) {
 	us.Consul.ServiceSpec = spec
 }
+
+func (us *Upstream_Eureka) GetServiceSpec() *plugins.ServiceSpec {
+	return us.Eureka.GetServiceSpec()
+}
+
+func (us *Upstream_Eureka) SetServiceSpec(spec *plugins.ServiceSpec) {
+	us.Eureka.ServiceSpec = spec
+}
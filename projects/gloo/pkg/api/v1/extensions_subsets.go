@@ -31,6 +31,14 @@ func (us *Upstream_Kube) SetSubsetSpec(spec *plugins.SubsetSpec) {
 	us.Kube.SubsetSpec = spec
 }
 
+func (us *Upstream_Eureka) GetSubsetSpec() *plugins.SubsetSpec {
+	return us.Eureka.GetSubsetSpec()
+}
+
+func (us *Upstream_Eureka) SetSubsetSpec(spec *plugins.SubsetSpec) {
+	us.Eureka.SubsetSpec = spec
+}
+
 func (us *Upstream_Consul) GetSubsetSpec() *plugins.SubsetSpec {
 	subsets := &plugins.SubsetSpec{}
 
@@ -51,6 +51,12 @@ func (m *LoadBalancerConfig) Clone() proto.Message {
 		target.UpdateMergeWindow = proto.Clone(m.GetUpdateMergeWindow()).(*github_com_golang_protobuf_ptypes_duration.Duration)
 	}
 
+	if h, ok := interface{}(m.GetLocalityFailover()).(clone.Cloner); ok {
+		target.LocalityFailover = h.Clone().(*LoadBalancerConfig_LocalityFailover)
+	} else {
+		target.LocalityFailover = proto.Clone(m.GetLocalityFailover()).(*LoadBalancerConfig_LocalityFailover)
+	}
+
 	if h, ok := interface{}(m.GetUseHostnameForHashing()).(clone.Cloner); ok {
 		target.UseHostnameForHashing = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
 	} else {
@@ -135,6 +141,18 @@ func (m *LoadBalancerConfig) Clone() proto.Message {
 			}
 		}
 
+	case *LoadBalancerConfig_ZoneAwareLbConfig_:
+
+		if h, ok := interface{}(m.GetZoneAwareLbConfig()).(clone.Cloner); ok {
+			target.LocalityConfig = &LoadBalancerConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: h.Clone().(*LoadBalancerConfig_ZoneAwareLbConfig),
+			}
+		} else {
+			target.LocalityConfig = &LoadBalancerConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: proto.Clone(m.GetZoneAwareLbConfig()).(*LoadBalancerConfig_ZoneAwareLbConfig),
+			}
+		}
+
 	}
 
 	return target
@@ -230,6 +248,48 @@ func (m *LoadBalancerConfig_Maglev) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) Clone() proto.Message {
+	var target *LoadBalancerConfig_ZoneAwareLbConfig
+	if m == nil {
+		return target
+	}
+	target = &LoadBalancerConfig_ZoneAwareLbConfig{}
+
+	if h, ok := interface{}(m.GetRoutingEnabled()).(clone.Cloner); ok {
+		target.RoutingEnabled = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.RoutingEnabled = proto.Clone(m.GetRoutingEnabled()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	if h, ok := interface{}(m.GetMinClusterSize()).(clone.Cloner); ok {
+		target.MinClusterSize = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt64Value)
+	} else {
+		target.MinClusterSize = proto.Clone(m.GetMinClusterSize()).(*github_com_golang_protobuf_ptypes_wrappers.UInt64Value)
+	}
+
+	target.FailTrafficOnPanic = m.GetFailTrafficOnPanic()
+
+	return target
+}
+
+// Clone function
+func (m *LoadBalancerConfig_LocalityFailover) Clone() proto.Message {
+	var target *LoadBalancerConfig_LocalityFailover
+	if m == nil {
+		return target
+	}
+	target = &LoadBalancerConfig_LocalityFailover{}
+
+	if h, ok := interface{}(m.GetLocality()).(clone.Cloner); ok {
+		target.Locality = h.Clone().(*Locality)
+	} else {
+		target.Locality = proto.Clone(m.GetLocality()).(*Locality)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *LoadBalancerConfig_SlowStartConfig) Clone() proto.Message {
 	var target *LoadBalancerConfig_SlowStartConfig
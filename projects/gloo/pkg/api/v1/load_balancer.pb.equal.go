@@ -66,6 +66,16 @@ func (m *LoadBalancerConfig) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalityFailover()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocalityFailover()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocalityFailover(), target.GetLocalityFailover()) {
+			return false
+		}
+	}
+
 	if h, ok := interface{}(m.GetUseHostnameForHashing()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetUseHostnameForHashing()) {
 			return false
@@ -177,6 +187,21 @@ func (m *LoadBalancerConfig) Equal(that interface{}) bool {
 			}
 		}
 
+	case *LoadBalancerConfig_ZoneAwareLbConfig_:
+		if _, ok := target.LocalityConfig.(*LoadBalancerConfig_ZoneAwareLbConfig_); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetZoneAwareLbConfig()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetZoneAwareLbConfig()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetZoneAwareLbConfig(), target.GetZoneAwareLbConfig()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.LocalityConfig != target.LocalityConfig {
@@ -373,6 +398,88 @@ func (m *LoadBalancerConfig_Maglev) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LoadBalancerConfig_ZoneAwareLbConfig)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_ZoneAwareLbConfig)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetRoutingEnabled()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRoutingEnabled()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRoutingEnabled(), target.GetRoutingEnabled()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMinClusterSize()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMinClusterSize()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMinClusterSize(), target.GetMinClusterSize()) {
+			return false
+		}
+	}
+
+	if m.GetFailTrafficOnPanic() != target.GetFailTrafficOnPanic() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *LoadBalancerConfig_LocalityFailover) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LoadBalancerConfig_LocalityFailover)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_LocalityFailover)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetLocality()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocality()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocality(), target.GetLocality()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *LoadBalancerConfig_SlowStartConfig) Equal(that interface{}) bool {
 	if that == nil {
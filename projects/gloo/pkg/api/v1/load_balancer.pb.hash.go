@@ -78,6 +78,26 @@ func (m *LoadBalancerConfig) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalityFailover()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("LocalityFailover")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetLocalityFailover(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("LocalityFailover")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	if h, ok := interface{}(m.GetUseHostnameForHashing()).(safe_hasher.SafeHasher); ok {
 		if _, err = hasher.Write([]byte("UseHostnameForHashing")); err != nil {
 			return 0, err
@@ -236,6 +256,28 @@ func (m *LoadBalancerConfig) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *LoadBalancerConfig_ZoneAwareLbConfig_:
+
+		if h, ok := interface{}(m.GetZoneAwareLbConfig()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("ZoneAwareLbConfig")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetZoneAwareLbConfig(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("ZoneAwareLbConfig")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
@@ -412,6 +454,103 @@ func (m *LoadBalancerConfig_Maglev) Hash(hasher hash.Hash64) (uint64, error) {
 	return hasher.Sum64(), nil
 }
 
+// Hash function
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.LoadBalancerConfig_ZoneAwareLbConfig")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetRoutingEnabled()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("RoutingEnabled")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetRoutingEnabled(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("RoutingEnabled")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetMinClusterSize()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("MinClusterSize")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMinClusterSize(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("MinClusterSize")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetFailTrafficOnPanic())
+	if err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *LoadBalancerConfig_LocalityFailover) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.LoadBalancerConfig_LocalityFailover")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetLocality()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Locality")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetLocality(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Locality")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // Hash function
 func (m *LoadBalancerConfig_SlowStartConfig) Hash(hasher hash.Hash64) (uint64, error) {
 	if m == nil {
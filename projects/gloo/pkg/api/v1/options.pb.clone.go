@@ -49,6 +49,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_aws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_aws_request_signing "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws_request_signing"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_azure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_connection_limit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/connection_limit"
@@ -59,6 +61,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_faultinjection "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/faultinjection"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_gcp "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/gcp"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_grpc_json "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
@@ -71,6 +75,8 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_healthcheck "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/healthcheck"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_http3 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/http3"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_lbhash "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
@@ -170,6 +176,12 @@ func (m *ListenerOptions) Clone() proto.Message {
 		target.ListenerAccessLoggingService = proto.Clone(m.GetListenerAccessLoggingService()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_als.AccessLoggingService)
 	}
 
+	if h, ok := interface{}(m.GetHttp3()).(clone.Cloner); ok {
+		target.Http3 = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_http3.Http3)
+	} else {
+		target.Http3 = proto.Clone(m.GetHttp3()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_http3.Http3)
+	}
+
 	return target
 }
 
@@ -822,6 +834,12 @@ func (m *RouteOptions) Clone() proto.Message {
 		target.ExtProc = proto.Clone(m.GetExtProc()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_extproc.RouteSettings)
 	}
 
+	if h, ok := interface{}(m.GetAwsRequestSigning()).(clone.Cloner); ok {
+		target.AwsRequestSigning = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_aws_request_signing.AwsRequestSigning)
+	} else {
+		target.AwsRequestSigning = proto.Clone(m.GetAwsRequestSigning()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_aws_request_signing.AwsRequestSigning)
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
@@ -1029,6 +1047,18 @@ func (m *DestinationSpec) Clone() proto.Message {
 			}
 		}
 
+	case *DestinationSpec_Gcp:
+
+		if h, ok := interface{}(m.GetGcp()).(clone.Cloner); ok {
+			target.DestinationType = &DestinationSpec_Gcp{
+				Gcp: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_gcp.DestinationSpec),
+			}
+		} else {
+			target.DestinationType = &DestinationSpec_Gcp{
+				Gcp: proto.Clone(m.GetGcp()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_gcp.DestinationSpec),
+			}
+		}
+
 	}
 
 	return target
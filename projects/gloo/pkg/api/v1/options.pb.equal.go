@@ -123,6 +123,16 @@ func (m *ListenerOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetHttp3()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHttp3()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHttp3(), target.GetHttp3()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -1196,6 +1206,16 @@ func (m *RouteOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetAwsRequestSigning()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAwsRequestSigning()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAwsRequestSigning(), target.GetAwsRequestSigning()) {
+			return false
+		}
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
@@ -1486,6 +1506,21 @@ func (m *DestinationSpec) Equal(that interface{}) bool {
 			}
 		}
 
+	case *DestinationSpec_Gcp:
+		if _, ok := target.DestinationType.(*DestinationSpec_Gcp); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetGcp()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetGcp()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetGcp(), target.GetGcp()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.DestinationType != target.DestinationType {
@@ -182,6 +182,26 @@ func (m *ListenerOptions) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetHttp3()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Http3")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetHttp3(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Http3")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -1981,6 +2001,26 @@ func (m *RouteOptions) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetAwsRequestSigning()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("AwsRequestSigning")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetAwsRequestSigning(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("AwsRequestSigning")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
@@ -2333,6 +2373,28 @@ func (m *DestinationSpec) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *DestinationSpec_Gcp:
+
+		if h, ok := interface{}(m.GetGcp()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Gcp")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetGcp(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Gcp")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
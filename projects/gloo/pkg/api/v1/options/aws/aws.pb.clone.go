@@ -68,6 +68,36 @@ func (m *UpstreamSpec) Clone() proto.Message {
 		target.DestinationOverrides = proto.Clone(m.GetDestinationOverrides()).(*DestinationSpec)
 	}
 
+	if h, ok := interface{}(m.GetLambdaDiscovery()).(clone.Cloner); ok {
+		target.LambdaDiscovery = h.Clone().(*LambdaDiscovery)
+	} else {
+		target.LambdaDiscovery = proto.Clone(m.GetLambdaDiscovery()).(*LambdaDiscovery)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *LambdaDiscovery) Clone() proto.Message {
+	var target *LambdaDiscovery
+	if m == nil {
+		return target
+	}
+	target = &LambdaDiscovery{}
+
+	if m.GetTagSelector() != nil {
+		target.TagSelector = make(map[string]string, len(m.GetTagSelector()))
+		for k, v := range m.GetTagSelector() {
+
+			target.TagSelector[k] = v
+
+		}
+	}
+
+	target.DiscoverAliases = m.GetDiscoverAliases()
+
+	target.DiscoverFunctionUrls = m.GetDiscoverFunctionUrls()
+
 	return target
 }
 
@@ -85,6 +115,8 @@ func (m *LambdaFunctionSpec) Clone() proto.Message {
 
 	target.Qualifier = m.GetQualifier()
 
+	target.FunctionUrl = m.GetFunctionUrl()
+
 	return target
 }
 
@@ -110,5 +142,7 @@ func (m *DestinationSpec) Clone() proto.Message {
 
 	target.WrapAsApiGateway = m.GetWrapAsApiGateway()
 
+	target.InvokeFunctionUrl = m.GetInvokeFunctionUrl()
+
 	return target
 }
@@ -99,6 +99,59 @@ func (m *UpstreamSpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLambdaDiscovery()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLambdaDiscovery()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLambdaDiscovery(), target.GetLambdaDiscovery()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *LambdaDiscovery) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LambdaDiscovery)
+	if !ok {
+		that2, ok := that.(LambdaDiscovery)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetTagSelector()) != len(target.GetTagSelector()) {
+		return false
+	}
+	for k, v := range m.GetTagSelector() {
+
+		if strings.Compare(v, target.GetTagSelector()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	if m.GetDiscoverAliases() != target.GetDiscoverAliases() {
+		return false
+	}
+
+	if m.GetDiscoverFunctionUrls() != target.GetDiscoverFunctionUrls() {
+		return false
+	}
+
 	return true
 }
 
@@ -135,6 +188,10 @@ func (m *LambdaFunctionSpec) Equal(that interface{}) bool {
 		return false
 	}
 
+	if strings.Compare(m.GetFunctionUrl(), target.GetFunctionUrl()) != 0 {
+		return false
+	}
+
 	return true
 }
 
@@ -187,5 +244,9 @@ func (m *DestinationSpec) Equal(that interface{}) bool {
 		return false
 	}
 
+	if m.GetInvokeFunctionUrl() != target.GetInvokeFunctionUrl() {
+		return false
+	}
+
 	return true
 }
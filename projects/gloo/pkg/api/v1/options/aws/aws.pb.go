@@ -66,7 +66,7 @@ func (x DestinationSpec_InvocationStyle) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DestinationSpec_InvocationStyle.Descriptor instead.
 func (DestinationSpec_InvocationStyle) EnumDescriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_rawDescGZIP(), []int{2, 0}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_rawDescGZIP(), []int{3, 0}
 }
 
 // Upstream Spec for AWS Lambda Upstreams
@@ -104,6 +104,9 @@ type UpstreamSpec struct {
 	// Specifies AWS DestinationSpec configuration overrides for any route targeting this upstream.
 	// Note that the route in question must have an AWS DestinationSpec to be affected and this will only set things that are non-falsey as overrides.
 	DestinationOverrides *DestinationSpec `protobuf:"bytes,7,opt,name=destination_overrides,json=destinationOverrides,proto3" json:"destination_overrides,omitempty"`
+	// Options for the discovery of the Lambda Functions of this upstream.
+	// Only used if discovery is enabled for AWS Lambda Functions.
+	LambdaDiscovery *LambdaDiscovery `protobuf:"bytes,8,opt,name=lambda_discovery,json=lambdaDiscovery,proto3" json:"lambda_discovery,omitempty"`
 }
 
 func (x *UpstreamSpec) Reset() {
@@ -187,6 +190,82 @@ func (x *UpstreamSpec) GetDestinationOverrides() *DestinationSpec {
 	return nil
 }
 
+func (x *UpstreamSpec) GetLambdaDiscovery() *LambdaDiscovery {
+	if x != nil {
+		return x.LambdaDiscovery
+	}
+	return nil
+}
+
+// Options for the discovery of Lambda Functions.
+// Published versions of each function are always discovered.
+// Functions which no longer exist in AWS (or no longer match the `tagSelector`) are removed from the upstream.
+type LambdaDiscovery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only discover functions which have all of these tags.
+	TagSelector map[string]string `protobuf:"bytes,1,rep,name=tag_selector,json=tagSelector,proto3" json:"tag_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Discover the aliases of each function in addition to its published versions.
+	DiscoverAliases bool `protobuf:"varint,2,opt,name=discover_aliases,json=discoverAliases,proto3" json:"discover_aliases,omitempty"`
+	// Discover the Lambda Function URLs of each function and of its aliases.
+	DiscoverFunctionUrls bool `protobuf:"varint,3,opt,name=discover_function_urls,json=discoverFunctionUrls,proto3" json:"discover_function_urls,omitempty"`
+}
+
+func (x *LambdaDiscovery) Reset() {
+	*x = LambdaDiscovery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LambdaDiscovery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LambdaDiscovery) ProtoMessage() {}
+
+func (x *LambdaDiscovery) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LambdaDiscovery.ProtoReflect.Descriptor instead.
+func (*LambdaDiscovery) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LambdaDiscovery) GetTagSelector() map[string]string {
+	if x != nil {
+		return x.TagSelector
+	}
+	return nil
+}
+
+func (x *LambdaDiscovery) GetDiscoverAliases() bool {
+	if x != nil {
+		return x.DiscoverAliases
+	}
+	return false
+}
+
+func (x *LambdaDiscovery) GetDiscoverFunctionUrls() bool {
+	if x != nil {
+		return x.DiscoverFunctionUrls
+	}
+	return false
+}
+
 // Each Lambda Function Spec contains data necessary for Gloo to invoke Lambda functions:
 // - name of the function
 // - qualifier for the function
@@ -203,12 +282,17 @@ type LambdaFunctionSpec struct {
 	// The Qualifier for the Lambda Function. Qualifiers act as a kind of version
 	// for Lambda Functions. See https://docs.aws.amazon.com/lambda/latest/dg/API_Invoke.html for more info.
 	Qualifier string `protobuf:"bytes,3,opt,name=qualifier,proto3" json:"qualifier,omitempty"`
+	// The Lambda Function URL of this function and qualifier, if it has one.
+	// This is populated by discovery if `discoverFunctionUrls` is set on the upstream.
+	// Routes invoke the function through this url rather than the Lambda Invoke API if `invokeFunctionUrl` is set
+	// on their destination spec.
+	FunctionUrl string `protobuf:"bytes,4,opt,name=function_url,json=functionUrl,proto3" json:"function_url,omitempty"`
 }
 
 func (x *LambdaFunctionSpec) Reset() {
 	*x = LambdaFunctionSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[1]
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -221,7 +305,7 @@ func (x *LambdaFunctionSpec) String() string {
 func (*LambdaFunctionSpec) ProtoMessage() {}
 
 func (x *LambdaFunctionSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[1]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,7 +318,7 @@ func (x *LambdaFunctionSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LambdaFunctionSpec.ProtoReflect.Descriptor instead.
 func (*LambdaFunctionSpec) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_rawDescGZIP(), []int{1}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *LambdaFunctionSpec) GetLogicalName() string {
@@ -258,8 +342,15 @@ func (x *LambdaFunctionSpec) GetQualifier() string {
 	return ""
 }
 
+func (x *LambdaFunctionSpec) GetFunctionUrl() string {
+	if x != nil {
+		return x.FunctionUrl
+	}
+	return ""
+}
+
 // Each Lambda Function Spec contains data necessary for Gloo to invoke Lambda functions
-// [#next-free-field: 8]
+// [#next-free-field: 11]
 type DestinationSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -291,12 +382,18 @@ type DestinationSpec struct {
 	// Intended to ease migration when previously using API Gateway to invoke Lambdas.
 	// Only one of `requestTransformation` or `wrapAsApiGateway` should be provided.
 	WrapAsApiGateway bool `protobuf:"varint,9,opt,name=wrap_as_api_gateway,json=wrapAsApiGateway,proto3" json:"wrap_as_api_gateway,omitempty"`
+	// Invoke the function through its Lambda Function URL (`functionUrl`) rather than the Lambda Invoke API.
+	// The request is proxied to the url as is, so the other options of this destination spec do not apply.
+	// Function urls using `AWS_IAM` auth also require requests to be signed for the `lambda` service with `awsRequestSigning`
+	// on the route or the upstream.
+	// Only supported on routes to a single destination.
+	InvokeFunctionUrl bool `protobuf:"varint,10,opt,name=invoke_function_url,json=invokeFunctionUrl,proto3" json:"invoke_function_url,omitempty"`
 }
 
 func (x *DestinationSpec) Reset() {
 	*x = DestinationSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[2]
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -309,7 +406,7 @@ func (x *DestinationSpec) String() string {
 func (*DestinationSpec) ProtoMessage() {}
 
 func (x *DestinationSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[2]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,7 +419,7 @@ func (x *DestinationSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DestinationSpec.ProtoReflect.Descriptor instead.
 func (*DestinationSpec) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_rawDescGZIP(), []int{2}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DestinationSpec) GetLogicalName() string {
@@ -375,6 +472,13 @@ func (x *DestinationSpec) GetWrapAsApiGateway() bool {
 	return false
 }
 
+func (x *DestinationSpec) GetInvokeFunctionUrl() bool {
+	if x != nil {
+		return x.InvokeFunctionUrl
+	}
+	return false
+}
+
 var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_rawDesc = []byte{
@@ -388,7 +492,7 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_rawD
 	0x74, 0x6f, 0x1a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
 	0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x6b, 0x69, 0x74, 0x2f,
 	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x8e, 0x03, 0x0a, 0x0c, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65,
+	0x22, 0xe4, 0x03, 0x0a, 0x0c, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65,
 	0x63, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x73, 0x65, 0x63,
 	0x72, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
@@ -413,48 +517,76 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_rawD
 	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74,
 	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x52, 0x14, 0x64, 0x65, 0x73,
 	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
-	0x73, 0x22, 0x87, 0x01, 0x0a, 0x12, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x46, 0x75, 0x6e, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x69,
-	0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6c,
-	0x61, 0x6d, 0x62, 0x64, 0x61, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x61, 0x6d, 0x62, 0x64,
-	0x61, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xbc, 0x03, 0x0a, 0x0f,
-	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x61,
-	0x77, 0x73, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x3b, 0x0a, 0x17, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x02, 0x18, 0x01, 0x52, 0x16, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d,
-	0x75, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x5f, 0x61, 0x73, 0x5f, 0x61, 0x6c, 0x62, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x41, 0x73, 0x41, 0x6c, 0x62,
-	0x12, 0x31, 0x0a, 0x15, 0x75, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x5f, 0x61, 0x73, 0x5f, 0x61, 0x70,
-	0x69, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x12, 0x75, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x41, 0x73, 0x41, 0x70, 0x69, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x77, 0x72, 0x61, 0x70, 0x5f, 0x61, 0x73, 0x5f, 0x61,
-	0x70, 0x69, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x10, 0x77, 0x72, 0x61, 0x70, 0x41, 0x73, 0x41, 0x70, 0x69, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x22, 0x26, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x00, 0x12,
-	0x09, 0x0a, 0x05, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x01, 0x42, 0x4a, 0xb8, 0xf5, 0x04, 0x01,
-	0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f,
-	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x61, 0x77, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x12, 0x54, 0x0a, 0x10, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x5f, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x77,
+	0x73, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0f, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x22, 0x91, 0x02, 0x0a, 0x0f, 0x4c, 0x61, 0x6d, 0x62,
+	0x64, 0x61, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x5d, 0x0a, 0x0c, 0x74,
+	0x61, 0x67, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4c, 0x61, 0x6d,
+	0x62, 0x64, 0x61, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x54, 0x61, 0x67,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x74,
+	0x61, 0x67, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x46,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x54,
+	0x61, 0x67, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x12,
+	0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x5f,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75, 0x61, 0x6c, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x22, 0xec, 0x03, 0x0a, 0x0f, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x64, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x79, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x61, 0x77, 0x73, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x70, 0x65, 0x63, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x79, 0x6c, 0x65, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x17,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x16, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x75, 0x6e, 0x77,
+	0x72, 0x61, 0x70, 0x5f, 0x61, 0x73, 0x5f, 0x61, 0x6c, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x75, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x41, 0x73, 0x41, 0x6c, 0x62, 0x12, 0x31, 0x0a,
+	0x15, 0x75, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x5f, 0x61, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x75, 0x6e,
+	0x77, 0x72, 0x61, 0x70, 0x41, 0x73, 0x41, 0x70, 0x69, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x12, 0x2d, 0x0a, 0x13, 0x77, 0x72, 0x61, 0x70, 0x5f, 0x61, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x5f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x77,
+	0x72, 0x61, 0x70, 0x41, 0x73, 0x41, 0x70, 0x69, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12,
+	0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e,
+	0x76, 0x6f, 0x6b, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x22,
+	0x26, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x79,
+	0x6c, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x41, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x01, 0x42, 0x4a, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04,
+	0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x61, 0x77, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -470,24 +602,28 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_raw
 }
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_goTypes = []interface{}{
 	(DestinationSpec_InvocationStyle)(0), // 0: aws.options.gloo.solo.io.DestinationSpec.InvocationStyle
 	(*UpstreamSpec)(nil),                 // 1: aws.options.gloo.solo.io.UpstreamSpec
-	(*LambdaFunctionSpec)(nil),           // 2: aws.options.gloo.solo.io.LambdaFunctionSpec
-	(*DestinationSpec)(nil),              // 3: aws.options.gloo.solo.io.DestinationSpec
-	(*core.ResourceRef)(nil),             // 4: core.solo.io.ResourceRef
+	(*LambdaDiscovery)(nil),              // 2: aws.options.gloo.solo.io.LambdaDiscovery
+	(*LambdaFunctionSpec)(nil),           // 3: aws.options.gloo.solo.io.LambdaFunctionSpec
+	(*DestinationSpec)(nil),              // 4: aws.options.gloo.solo.io.DestinationSpec
+	nil,                                  // 5: aws.options.gloo.solo.io.LambdaDiscovery.TagSelectorEntry
+	(*core.ResourceRef)(nil),             // 6: core.solo.io.ResourceRef
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_depIdxs = []int32{
-	4, // 0: aws.options.gloo.solo.io.UpstreamSpec.secret_ref:type_name -> core.solo.io.ResourceRef
-	2, // 1: aws.options.gloo.solo.io.UpstreamSpec.lambda_functions:type_name -> aws.options.gloo.solo.io.LambdaFunctionSpec
-	3, // 2: aws.options.gloo.solo.io.UpstreamSpec.destination_overrides:type_name -> aws.options.gloo.solo.io.DestinationSpec
-	0, // 3: aws.options.gloo.solo.io.DestinationSpec.invocation_style:type_name -> aws.options.gloo.solo.io.DestinationSpec.InvocationStyle
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6, // 0: aws.options.gloo.solo.io.UpstreamSpec.secret_ref:type_name -> core.solo.io.ResourceRef
+	3, // 1: aws.options.gloo.solo.io.UpstreamSpec.lambda_functions:type_name -> aws.options.gloo.solo.io.LambdaFunctionSpec
+	4, // 2: aws.options.gloo.solo.io.UpstreamSpec.destination_overrides:type_name -> aws.options.gloo.solo.io.DestinationSpec
+	2, // 3: aws.options.gloo.solo.io.UpstreamSpec.lambda_discovery:type_name -> aws.options.gloo.solo.io.LambdaDiscovery
+	5, // 4: aws.options.gloo.solo.io.LambdaDiscovery.tag_selector:type_name -> aws.options.gloo.solo.io.LambdaDiscovery.TagSelectorEntry
+	0, // 5: aws.options.gloo.solo.io.DestinationSpec.invocation_style:type_name -> aws.options.gloo.solo.io.DestinationSpec.InvocationStyle
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_init() }
@@ -509,7 +645,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_ini
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LambdaFunctionSpec); i {
+			switch v := v.(*LambdaDiscovery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -521,6 +657,18 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_ini
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LambdaFunctionSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DestinationSpec); i {
 			case 0:
 				return &v.state
@@ -539,7 +687,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_ini
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_aws_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -62,6 +62,42 @@ func (m *UpstreamSpec) Clone() proto.Message {
 
 	target.Port = m.GetPort()
 
+	if m.GetAutoScalingGroupNames() != nil {
+		target.AutoScalingGroupNames = make([]string, len(m.GetAutoScalingGroupNames()))
+		for idx, v := range m.GetAutoScalingGroupNames() {
+
+			target.AutoScalingGroupNames[idx] = v
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetEcsService()).(clone.Cloner); ok {
+		target.EcsService = h.Clone().(*EcsServiceSelector)
+	} else {
+		target.EcsService = proto.Clone(m.GetEcsService()).(*EcsServiceSelector)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *EcsServiceSelector) Clone() proto.Message {
+	var target *EcsServiceSelector
+	if m == nil {
+		return target
+	}
+	target = &EcsServiceSelector{}
+
+	target.Cluster = m.GetCluster()
+
+	target.ServiceName = m.GetServiceName()
+
+	target.ContainerName = m.GetContainerName()
+
+	target.ContainerPort = m.GetContainerPort()
+
+	target.HealthyOnly = m.GetHealthyOnly()
+
 	return target
 }
 
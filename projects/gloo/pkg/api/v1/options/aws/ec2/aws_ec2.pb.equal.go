@@ -89,6 +89,71 @@ func (m *UpstreamSpec) Equal(that interface{}) bool {
 		return false
 	}
 
+	if len(m.GetAutoScalingGroupNames()) != len(target.GetAutoScalingGroupNames()) {
+		return false
+	}
+	for idx, v := range m.GetAutoScalingGroupNames() {
+
+		if strings.Compare(v, target.GetAutoScalingGroupNames()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetEcsService()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetEcsService()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetEcsService(), target.GetEcsService()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *EcsServiceSelector) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*EcsServiceSelector)
+	if !ok {
+		that2, ok := that.(EcsServiceSelector)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetCluster(), target.GetCluster()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetServiceName(), target.GetServiceName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetContainerName(), target.GetContainerName()) != 0 {
+		return false
+	}
+
+	if m.GetContainerPort() != target.GetContainerPort() {
+		return false
+	}
+
+	if m.GetHealthyOnly() != target.GetHealthyOnly() {
+		return false
+	}
+
 	return true
 }
 
@@ -63,6 +63,14 @@ type UpstreamSpec struct {
 	PublicIp bool `protobuf:"varint,4,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
 	// If set, will use this port on EC2 instances. Defaults to 0.
 	Port uint32 `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	// List of Auto Scaling Group names for selecting instances
+	// If provided, an instance must be a member of one of the groups in order to be selected. Only instances that are
+	// in service and reported healthy by their Auto Scaling Group are selected.
+	// Can be combined with tag filters, in which case an instance must match both.
+	AutoScalingGroupNames []string `protobuf:"bytes,8,rep,name=auto_scaling_group_names,json=autoScalingGroupNames,proto3" json:"auto_scaling_group_names,omitempty"`
+	// If set, the endpoints of this upstream are the running tasks of the given ECS service rather than EC2 instances.
+	// Tag filters and Auto Scaling Group names are ignored when this is set.
+	EcsService *EcsServiceSelector `protobuf:"bytes,9,opt,name=ecs_service,json=ecsService,proto3" json:"ecs_service,omitempty"`
 }
 
 func (x *UpstreamSpec) Reset() {
@@ -139,6 +147,112 @@ func (x *UpstreamSpec) GetPort() uint32 {
 	return 0
 }
 
+func (x *UpstreamSpec) GetAutoScalingGroupNames() []string {
+	if x != nil {
+		return x.AutoScalingGroupNames
+	}
+	return nil
+}
+
+func (x *UpstreamSpec) GetEcsService() *EcsServiceSelector {
+	if x != nil {
+		return x.EcsService
+	}
+	return nil
+}
+
+// Selects the tasks of an ECS service
+type EcsServiceSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name or ARN of the ECS cluster the service runs in
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// The name of the ECS service
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// Optional, the name of the container to route to.
+	// If not set, the first container of each task that exposes the container port is used.
+	ContainerName string `protobuf:"bytes,3,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	// The port the container listens on. Defaults to the `port` of the upstream.
+	// For tasks that use the `awsvpc` network mode (including all Fargate tasks), endpoints use the IP address of the
+	// task's elastic network interface and this port.
+	// For tasks that use the `bridge` or `host` network mode, endpoints use the IP address of the container instance
+	// and the host port bound to this container port. The `public_ip` setting of the upstream applies to these tasks.
+	ContainerPort uint32 `protobuf:"varint,4,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"`
+	// By default, tasks reported UNHEALTHY by ECS are excluded, while tasks with an UNKNOWN health status
+	// (i.e. without a container health check) are included.
+	// If set, only tasks reported HEALTHY are included.
+	HealthyOnly bool `protobuf:"varint,5,opt,name=healthy_only,json=healthyOnly,proto3" json:"healthy_only,omitempty"`
+}
+
+func (x *EcsServiceSelector) Reset() {
+	*x = EcsServiceSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EcsServiceSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EcsServiceSelector) ProtoMessage() {}
+
+func (x *EcsServiceSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EcsServiceSelector.ProtoReflect.Descriptor instead.
+func (*EcsServiceSelector) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EcsServiceSelector) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *EcsServiceSelector) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *EcsServiceSelector) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *EcsServiceSelector) GetContainerPort() uint32 {
+	if x != nil {
+		return x.ContainerPort
+	}
+	return 0
+}
+
+func (x *EcsServiceSelector) GetHealthyOnly() bool {
+	if x != nil {
+		return x.HealthyOnly
+	}
+	return false
+}
+
 type TagFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -154,7 +268,7 @@ type TagFilter struct {
 func (x *TagFilter) Reset() {
 	*x = TagFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[1]
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -167,7 +281,7 @@ func (x *TagFilter) String() string {
 func (*TagFilter) ProtoMessage() {}
 
 func (x *TagFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[1]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -180,7 +294,7 @@ func (x *TagFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagFilter.ProtoReflect.Descriptor instead.
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_rawDescGZIP(), []int{1}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_rawDescGZIP(), []int{2}
 }
 
 func (m *TagFilter) GetSpec() isTagFilter_Spec {
@@ -237,7 +351,7 @@ type TagFilter_KvPair struct {
 func (x *TagFilter_KvPair) Reset() {
 	*x = TagFilter_KvPair{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[2]
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -250,7 +364,7 @@ func (x *TagFilter_KvPair) String() string {
 func (*TagFilter_KvPair) ProtoMessage() {}
 
 func (x *TagFilter_KvPair) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[2]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,7 +377,7 @@ func (x *TagFilter_KvPair) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagFilter_KvPair.ProtoReflect.Descriptor instead.
 func (*TagFilter_KvPair) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_rawDescGZIP(), []int{1, 0}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *TagFilter_KvPair) GetKey() string {
@@ -293,7 +407,7 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_pr
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c,
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d,
 	0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x6b, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x01, 0x0a,
+	0x76, 0x31, 0x2f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfb, 0x02, 0x0a,
 	0x0c, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x16, 0x0a,
 	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
 	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f,
@@ -308,23 +422,44 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_pr
 	0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a,
 	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
 	0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
-	0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xa4,
-	0x01, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x49, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x77, 0x73, 0x5f, 0x65, 0x63, 0x32, 0x2e, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
-	0x2e, 0x54, 0x61, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4b, 0x76, 0x50, 0x61, 0x69,
-	0x72, 0x48, 0x00, 0x52, 0x06, 0x6b, 0x76, 0x50, 0x61, 0x69, 0x72, 0x1a, 0x30, 0x0a, 0x06, 0x4b,
-	0x76, 0x50, 0x61, 0x69, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x06, 0x0a,
-	0x04, 0x73, 0x70, 0x65, 0x63, 0x42, 0x4e, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0,
-	0xf5, 0x04, 0x01, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x77,
-	0x73, 0x2f, 0x65, 0x63, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x37,
+	0x0a, 0x18, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x15, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0b, 0x65, 0x63, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61,
+	0x77, 0x73, 0x5f, 0x65, 0x63, 0x32, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x63, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0a,
+	0x65, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xc2, 0x01, 0x0a, 0x12, 0x45,
+	0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x4f, 0x6e, 0x6c, 0x79, 0x22,
+	0xa4, 0x01, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x49, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x77, 0x73, 0x5f, 0x65, 0x63, 0x32, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x54, 0x61, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4b, 0x76, 0x50, 0x61,
+	0x69, 0x72, 0x48, 0x00, 0x52, 0x06, 0x6b, 0x76, 0x50, 0x61, 0x69, 0x72, 0x1a, 0x30, 0x0a, 0x06,
+	0x4b, 0x76, 0x50, 0x61, 0x69, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x06,
+	0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x42, 0x4e, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01,
+	0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x77, 0x73, 0x2f, 0x65, 0x63, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -339,22 +474,24 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_p
 	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_goTypes = []interface{}{
-	(*UpstreamSpec)(nil),     // 0: aws_ec2.options.gloo.solo.io.UpstreamSpec
-	(*TagFilter)(nil),        // 1: aws_ec2.options.gloo.solo.io.TagFilter
-	(*TagFilter_KvPair)(nil), // 2: aws_ec2.options.gloo.solo.io.TagFilter.KvPair
-	(*core.ResourceRef)(nil), // 3: core.solo.io.ResourceRef
+	(*UpstreamSpec)(nil),       // 0: aws_ec2.options.gloo.solo.io.UpstreamSpec
+	(*EcsServiceSelector)(nil), // 1: aws_ec2.options.gloo.solo.io.EcsServiceSelector
+	(*TagFilter)(nil),          // 2: aws_ec2.options.gloo.solo.io.TagFilter
+	(*TagFilter_KvPair)(nil),   // 3: aws_ec2.options.gloo.solo.io.TagFilter.KvPair
+	(*core.ResourceRef)(nil),   // 4: core.solo.io.ResourceRef
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_depIdxs = []int32{
-	3, // 0: aws_ec2.options.gloo.solo.io.UpstreamSpec.secret_ref:type_name -> core.solo.io.ResourceRef
-	1, // 1: aws_ec2.options.gloo.solo.io.UpstreamSpec.filters:type_name -> aws_ec2.options.gloo.solo.io.TagFilter
-	2, // 2: aws_ec2.options.gloo.solo.io.TagFilter.kv_pair:type_name -> aws_ec2.options.gloo.solo.io.TagFilter.KvPair
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 0: aws_ec2.options.gloo.solo.io.UpstreamSpec.secret_ref:type_name -> core.solo.io.ResourceRef
+	2, // 1: aws_ec2.options.gloo.solo.io.UpstreamSpec.filters:type_name -> aws_ec2.options.gloo.solo.io.TagFilter
+	1, // 2: aws_ec2.options.gloo.solo.io.UpstreamSpec.ecs_service:type_name -> aws_ec2.options.gloo.solo.io.EcsServiceSelector
+	3, // 3: aws_ec2.options.gloo.solo.io.TagFilter.kv_pair:type_name -> aws_ec2.options.gloo.solo.io.TagFilter.KvPair
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_init() }
@@ -376,7 +513,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_p
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TagFilter); i {
+			switch v := v.(*EcsServiceSelector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -388,6 +525,18 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_p
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TagFilter_KvPair); i {
 			case 0:
 				return &v.state
@@ -400,7 +549,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_p
 			}
 		}
 	}
-	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*TagFilter_Key)(nil),
 		(*TagFilter_KvPair_)(nil),
 	}
@@ -410,7 +559,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_p
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_ec2_aws_ec2_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,56 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/aws_request_signing/aws_request_signing.proto
+
+package aws_request_signing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *AwsRequestSigning) Clone() proto.Message {
+	var target *AwsRequestSigning
+	if m == nil {
+		return target
+	}
+	target = &AwsRequestSigning{}
+
+	target.ServiceName = m.GetServiceName()
+
+	target.Region = m.GetRegion()
+
+	target.HostRewrite = m.GetHostRewrite()
+
+	target.UseUnsignedPayload = m.GetUseUnsignedPayload()
+
+	if m.GetExcludedHeaders() != nil {
+		target.ExcludedHeaders = make([]string, len(m.GetExcludedHeaders()))
+		for idx, v := range m.GetExcludedHeaders() {
+
+			target.ExcludedHeaders[idx] = v
+
+		}
+	}
+
+	target.SigningAlgorithm = m.GetSigningAlgorithm()
+
+	return target
+}
@@ -0,0 +1,81 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/aws_request_signing/aws_request_signing.proto
+
+package aws_request_signing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *AwsRequestSigning) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AwsRequestSigning)
+	if !ok {
+		that2, ok := that.(AwsRequestSigning)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetServiceName(), target.GetServiceName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetRegion(), target.GetRegion()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetHostRewrite(), target.GetHostRewrite()) != 0 {
+		return false
+	}
+
+	if m.GetUseUnsignedPayload() != target.GetUseUnsignedPayload() {
+		return false
+	}
+
+	if len(m.GetExcludedHeaders()) != len(target.GetExcludedHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetExcludedHeaders() {
+
+		if strings.Compare(v, target.GetExcludedHeaders()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if m.GetSigningAlgorithm() != target.GetSigningAlgorithm() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/aws_request_signing/aws_request_signing.proto
+
+package aws_request_signing
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AwsRequestSigning_SigningAlgorithm int32
+
+const (
+	// AWS Signature Version 4, signing for a single region.
+	AwsRequestSigning_AWS_SIGV4 AwsRequestSigning_SigningAlgorithm = 0
+	// AWS Signature Version 4A, signing for a set of regions, as multi-region access points of S3 require.
+	AwsRequestSigning_AWS_SIGV4A AwsRequestSigning_SigningAlgorithm = 1
+)
+
+// Enum value maps for AwsRequestSigning_SigningAlgorithm.
+var (
+	AwsRequestSigning_SigningAlgorithm_name = map[int32]string{
+		0: "AWS_SIGV4",
+		1: "AWS_SIGV4A",
+	}
+	AwsRequestSigning_SigningAlgorithm_value = map[string]int32{
+		"AWS_SIGV4":  0,
+		"AWS_SIGV4A": 1,
+	}
+)
+
+func (x AwsRequestSigning_SigningAlgorithm) Enum() *AwsRequestSigning_SigningAlgorithm {
+	p := new(AwsRequestSigning_SigningAlgorithm)
+	*p = x
+	return p
+}
+
+func (x AwsRequestSigning_SigningAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AwsRequestSigning_SigningAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_enumTypes[0].Descriptor()
+}
+
+func (AwsRequestSigning_SigningAlgorithm) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_enumTypes[0]
+}
+
+func (x AwsRequestSigning_SigningAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AwsRequestSigning_SigningAlgorithm.Descriptor instead.
+func (AwsRequestSigning_SigningAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Signs requests with AWS Signature Version 4, to route to AWS services such as S3, OpenSearch or private API Gateway
+// endpoints.
+// Ref. https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/aws_request_signing_filter
+//
+// Requests are signed with the credentials of the proxy, found by Envoy's default AWS credentials chain: the
+// `AWS_ACCESS_KEY_ID` and `AWS_SECRET_ACCESS_KEY` environment variables, the web identity token of the proxy's service
+// account as set up for `settings.gloo.awsOptions.serviceAccountCredentials`, or the container and instance metadata
+// endpoints. Both credential fetchers of `settings.gloo.awsOptions` resolve to these sources.
+type AwsRequestSigning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the AWS service the requests are sent to, for example `s3`, `es` or `execute-api`. Required.
+	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// The AWS region of the service, for example `us-east-1`. Required.
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	// The host to sign the requests for and to send them to, when it differs from the host of the requests, for
+	// example the host of the endpoint of the AWS service.
+	HostRewrite string `protobuf:"bytes,3,opt,name=host_rewrite,json=hostRewrite,proto3" json:"host_rewrite,omitempty"`
+	// Signs the requests without hashing their payload, so that request bodies are not buffered. The AWS service must
+	// accept unsigned payloads, as S3 does.
+	UseUnsignedPayload bool `protobuf:"varint,4,opt,name=use_unsigned_payload,json=useUnsignedPayload,proto3" json:"use_unsigned_payload,omitempty"`
+	// The names of the request headers that are not signed, for example headers that proxies between Envoy and the
+	// AWS service may change.
+	ExcludedHeaders []string `protobuf:"bytes,5,rep,name=excluded_headers,json=excludedHeaders,proto3" json:"excluded_headers,omitempty"`
+	// The algorithm the requests are signed with. Defaults to AWS_SIGV4.
+	SigningAlgorithm AwsRequestSigning_SigningAlgorithm `protobuf:"varint,6,opt,name=signing_algorithm,json=signingAlgorithm,proto3,enum=aws_request_signing.options.gloo.solo.io.AwsRequestSigning_SigningAlgorithm" json:"signing_algorithm,omitempty"`
+}
+
+func (x *AwsRequestSigning) Reset() {
+	*x = AwsRequestSigning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AwsRequestSigning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AwsRequestSigning) ProtoMessage() {}
+
+func (x *AwsRequestSigning) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AwsRequestSigning.ProtoReflect.Descriptor instead.
+func (*AwsRequestSigning) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AwsRequestSigning) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *AwsRequestSigning) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *AwsRequestSigning) GetHostRewrite() string {
+	if x != nil {
+		return x.HostRewrite
+	}
+	return ""
+}
+
+func (x *AwsRequestSigning) GetUseUnsignedPayload() bool {
+	if x != nil {
+		return x.UseUnsignedPayload
+	}
+	return false
+}
+
+func (x *AwsRequestSigning) GetExcludedHeaders() []string {
+	if x != nil {
+		return x.ExcludedHeaders
+	}
+	return nil
+}
+
+func (x *AwsRequestSigning) GetSigningAlgorithm() AwsRequestSigning_SigningAlgorithm {
+	if x != nil {
+		return x.SigningAlgorithm
+	}
+	return AwsRequestSigning_AWS_SIGV4
+}
+
+var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDesc = []byte{
+	0x0a, 0x62, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x77, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x77, 0x73, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x28, 0x61, 0x77, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x12,
+	0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xfc, 0x02, 0x0a, 0x11, 0x41, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x5f, 0x75, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x75, 0x73, 0x65, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x79, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4c,
+	0x2e, 0x61, 0x77, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x77, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x10, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x31,
+	0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x57, 0x53, 0x5f, 0x53, 0x49, 0x47, 0x56, 0x34, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x57, 0x53, 0x5f, 0x53, 0x49, 0x47, 0x56, 0x34, 0x41, 0x10,
+	0x01, 0x42, 0x5a, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a,
+	0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x77, 0x73, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDescData = file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_goTypes = []interface{}{
+	(AwsRequestSigning_SigningAlgorithm)(0), // 0: aws_request_signing.options.gloo.solo.io.AwsRequestSigning.SigningAlgorithm
+	(*AwsRequestSigning)(nil),               // 1: aws_request_signing.options.gloo.solo.io.AwsRequestSigning
+}
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_depIdxs = []int32{
+	0, // 0: aws_request_signing.options.gloo.solo.io.AwsRequestSigning.signing_algorithm:type_name -> aws_request_signing.options.gloo.solo.io.AwsRequestSigning.SigningAlgorithm
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_init()
+}
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_init() {
+	if File_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AwsRequestSigning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_enumTypes,
+		MessageInfos:      file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto = out.File
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_goTypes = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_aws_request_signing_aws_request_signing_proto_depIdxs = nil
+}
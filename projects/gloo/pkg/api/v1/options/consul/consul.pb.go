@@ -60,6 +60,11 @@ type UpstreamSpec struct {
 	// QueryOptions are the query options to use for all Consul queries.
 	QueryOptions *QueryOptions `protobuf:"bytes,10,opt,name=query_options,json=queryOptions,proto3" json:"query_options,omitempty"`
 	// Is this consul service connect enabled.
+	// If set, and Connect is configured in the settings (`consulDiscovery.connect`), Gloo routes to the Connect
+	// sidecar proxies (or Connect-native instances) of the service and originates mutual TLS using its Connect leaf
+	// certificate, verifying the SPIFFE identity of the service in the data center of each endpoint.
+	// Discovery sets it for the services that have a Connect sidecar proxy registered (`<service>-sidecar-proxy`)
+	// when Connect is configured.
 	ConnectEnabled bool `protobuf:"varint,4,opt,name=connect_enabled,json=connectEnabled,proto3" json:"connect_enabled,omitempty"`
 	// The data centers in which the service instance represented by this upstream is registered.
 	DataCenters []string `protobuf:"bytes,5,rep,name=data_centers,json=dataCenters,proto3" json:"data_centers,omitempty"`
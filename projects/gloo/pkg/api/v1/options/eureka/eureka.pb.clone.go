@@ -0,0 +1,55 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/eureka/eureka.proto
+
+package eureka
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *UpstreamSpec) Clone() proto.Message {
+	var target *UpstreamSpec
+	if m == nil {
+		return target
+	}
+	target = &UpstreamSpec{}
+
+	target.AppName = m.GetAppName()
+
+	target.UseSecurePort = m.GetUseSecurePort()
+
+	if h, ok := interface{}(m.GetServiceSpec()).(clone.Cloner); ok {
+		target.ServiceSpec = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options.ServiceSpec)
+	} else {
+		target.ServiceSpec = proto.Clone(m.GetServiceSpec()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options.ServiceSpec)
+	}
+
+	if h, ok := interface{}(m.GetSubsetSpec()).(clone.Cloner); ok {
+		target.SubsetSpec = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options.SubsetSpec)
+	} else {
+		target.SubsetSpec = proto.Clone(m.GetSubsetSpec()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options.SubsetSpec)
+	}
+
+	return target
+}
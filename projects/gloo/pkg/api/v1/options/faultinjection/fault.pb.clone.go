@@ -14,6 +14,12 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+
+	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 // ensure the imports are used
@@ -39,6 +45,14 @@ func (m *RouteAbort) Clone() proto.Message {
 
 	target.HttpStatus = m.GetHttpStatus()
 
+	if h, ok := interface{}(m.GetGrpcStatus()).(clone.Cloner); ok {
+		target.GrpcStatus = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.GrpcStatus = proto.Clone(m.GetGrpcStatus()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	target.HeaderControlled = m.GetHeaderControlled()
+
 	return target
 }
 
@@ -58,6 +72,25 @@ func (m *RouteDelay) Clone() proto.Message {
 		target.FixedDelay = proto.Clone(m.GetFixedDelay()).(*github_com_golang_protobuf_ptypes_duration.Duration)
 	}
 
+	target.HeaderControlled = m.GetHeaderControlled()
+
+	return target
+}
+
+// Clone function
+func (m *RouteResponseRateLimit) Clone() proto.Message {
+	var target *RouteResponseRateLimit
+	if m == nil {
+		return target
+	}
+	target = &RouteResponseRateLimit{}
+
+	target.Percentage = m.GetPercentage()
+
+	target.FixedLimitKbps = m.GetFixedLimitKbps()
+
+	target.HeaderControlled = m.GetHeaderControlled()
+
 	return target
 }
 
@@ -81,5 +114,36 @@ func (m *RouteFaults) Clone() proto.Message {
 		target.Delay = proto.Clone(m.GetDelay()).(*RouteDelay)
 	}
 
+	if h, ok := interface{}(m.GetResponseRateLimit()).(clone.Cloner); ok {
+		target.ResponseRateLimit = h.Clone().(*RouteResponseRateLimit)
+	} else {
+		target.ResponseRateLimit = proto.Clone(m.GetResponseRateLimit()).(*RouteResponseRateLimit)
+	}
+
+	if m.GetHeaders() != nil {
+		target.Headers = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetHeaders()))
+		for idx, v := range m.GetHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Headers[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.Headers[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetUpstream()).(clone.Cloner); ok {
+		target.Upstream = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.Upstream = proto.Clone(m.GetUpstream()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	if h, ok := interface{}(m.GetMaxActiveFaults()).(clone.Cloner); ok {
+		target.MaxActiveFaults = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.MaxActiveFaults = proto.Clone(m.GetMaxActiveFaults()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
 	return target
 }
@@ -0,0 +1,82 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/gcp/gcp.proto
+
+package gcp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *UpstreamSpec) Clone() proto.Message {
+	var target *UpstreamSpec
+	if m == nil {
+		return target
+	}
+	target = &UpstreamSpec{}
+
+	target.Url = m.GetUrl()
+
+	target.Audience = m.GetAudience()
+
+	if m.GetFunctions() != nil {
+		target.Functions = make([]*FunctionSpec, len(m.GetFunctions()))
+		for idx, v := range m.GetFunctions() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Functions[idx] = h.Clone().(*FunctionSpec)
+			} else {
+				target.Functions[idx] = proto.Clone(v).(*FunctionSpec)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *FunctionSpec) Clone() proto.Message {
+	var target *FunctionSpec
+	if m == nil {
+		return target
+	}
+	target = &FunctionSpec{}
+
+	target.LogicalName = m.GetLogicalName()
+
+	target.Path = m.GetPath()
+
+	return target
+}
+
+// Clone function
+func (m *DestinationSpec) Clone() proto.Message {
+	var target *DestinationSpec
+	if m == nil {
+		return target
+	}
+	target = &DestinationSpec{}
+
+	target.LogicalName = m.GetLogicalName()
+
+	return target
+}
@@ -62,5 +62,7 @@ func (m *UpstreamSpec) Clone() proto.Message {
 		target.SubsetSpec = proto.Clone(m.GetSubsetSpec()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options.SubsetSpec)
 	}
 
+	target.DrainTerminatingEndpoints = m.GetDrainTerminatingEndpoints()
+
 	return target
 }
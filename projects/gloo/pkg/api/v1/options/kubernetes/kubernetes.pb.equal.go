@@ -89,5 +89,9 @@ func (m *UpstreamSpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if m.GetDrainTerminatingEndpoints() != target.GetDrainTerminatingEndpoints() {
+		return false
+	}
+
 	return true
 }
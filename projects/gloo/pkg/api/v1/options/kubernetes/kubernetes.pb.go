@@ -49,6 +49,14 @@ type UpstreamSpec struct {
 	// configuration allows you to partition the upstream to a set of subsets.
 	// for each unique set of keys and values, a subset will be created.
 	SubsetSpec *options.SubsetSpec `protobuf:"bytes,6,opt,name=subset_spec,json=subsetSpec,proto3" json:"subset_spec,omitempty"`
+	// Keep the endpoints of terminating pods which are still serving, with a DRAINING health status, so that Envoy
+	// sends them no new requests but lets the requests in flight finish rather than resetting them.
+	// The endpoints are removed once Kubernetes removes them, i.e. when the pods have terminated. Unless the upstream
+	// sets `ignoreHealthOnHostRemoval`, it is enabled so that they are removed even if they pass active health checks.
+	// Whether a terminating pod is serving is only known with `settings.kubernetes.useEndpointSlices`; otherwise all
+	// terminating pods are drained.
+	// This setting is preserved when the upstream is updated by discovery.
+	DrainTerminatingEndpoints bool `protobuf:"varint,7,opt,name=drain_terminating_endpoints,json=drainTerminatingEndpoints,proto3" json:"drain_terminating_endpoints,omitempty"`
 }
 
 func (x *UpstreamSpec) Reset() {
@@ -125,6 +133,13 @@ func (x *UpstreamSpec) GetSubsetSpec() *options.SubsetSpec {
 	return nil
 }
 
+func (x *UpstreamSpec) GetDrainTerminatingEndpoints() bool {
+	if x != nil {
+		return x.DrainTerminatingEndpoints
+	}
+	return false
+}
+
 var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_rawDesc = []byte{
@@ -145,7 +160,7 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kuberne
 	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
 	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
 	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x70,
-	0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x03, 0x0a, 0x0c, 0x55, 0x70, 0x73,
+	0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x03, 0x0a, 0x0c, 0x55, 0x70, 0x73,
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11,
@@ -167,7 +182,11 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kuberne
 	0x75, 0x62, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x20, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
 	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x53, 0x70,
-	0x65, 0x63, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x1a, 0x3b,
+	0x65, 0x63, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x3e,
+	0x0a, 0x1b, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x19, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x3b,
 	0x0a, 0x0d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
 	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
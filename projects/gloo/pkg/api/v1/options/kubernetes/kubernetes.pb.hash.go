@@ -114,5 +114,10 @@ func (m *UpstreamSpec) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	err = binary.Write(hasher, binary.LittleEndian, m.GetDrainTerminatingEndpoints())
+	if err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
@@ -13,6 +13,8 @@ import (
 	"github.com/solo-io/protoc-gen-ext/pkg/clone"
 	"google.golang.org/protobuf/proto"
 
+	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
 	github_com_golang_protobuf_ptypes_empty "github.com/golang/protobuf/ptypes/empty"
 
 	github_com_golang_protobuf_ptypes_struct "github.com/golang/protobuf/ptypes/struct"
@@ -176,6 +178,18 @@ func (m *Listener) Clone() proto.Message {
 			}
 		}
 
+	case *Listener_UdpListener:
+
+		if h, ok := interface{}(m.GetUdpListener()).(clone.Cloner); ok {
+			target.ListenerType = &Listener_UdpListener{
+				UdpListener: h.Clone().(*UdpListener),
+			}
+		} else {
+			target.ListenerType = &Listener_UdpListener{
+				UdpListener: proto.Clone(m.GetUdpListener()).(*UdpListener),
+			}
+		}
+
 	}
 
 	switch m.OpaqueMetadata.(type) {
@@ -266,6 +280,37 @@ func (m *TcpHost) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *UdpListener) Clone() proto.Message {
+	var target *UdpListener
+	if m == nil {
+		return target
+	}
+	target = &UdpListener{}
+
+	if h, ok := interface{}(m.GetUpstream()).(clone.Cloner); ok {
+		target.Upstream = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.Upstream = proto.Clone(m.GetUpstream()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	target.StatPrefix = m.GetStatPrefix()
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(clone.Cloner); ok {
+		target.IdleTimeout = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.IdleTimeout = proto.Clone(m.GetIdleTimeout()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if h, ok := interface{}(m.GetHashPolicy()).(clone.Cloner); ok {
+		target.HashPolicy = h.Clone().(*UdpListener_HashPolicy)
+	} else {
+		target.HashPolicy = proto.Clone(m.GetHashPolicy()).(*UdpListener_HashPolicy)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *HttpListener) Clone() proto.Message {
 	var target *HttpListener
@@ -1092,6 +1137,33 @@ func (m *TcpHost_TcpAction) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *UdpListener_HashPolicy) Clone() proto.Message {
+	var target *UdpListener_HashPolicy
+	if m == nil {
+		return target
+	}
+	target = &UdpListener_HashPolicy{}
+
+	switch m.PolicySpecifier.(type) {
+
+	case *UdpListener_HashPolicy_SourceIp:
+
+		target.PolicySpecifier = &UdpListener_HashPolicy_SourceIp{
+			SourceIp: m.GetSourceIp(),
+		}
+
+	case *UdpListener_HashPolicy_Key:
+
+		target.PolicySpecifier = &UdpListener_HashPolicy_Key{
+			Key: m.GetKey(),
+		}
+
+	}
+
+	return target
+}
+
 // Clone function
 func (m *AggregateListener_HttpResources) Clone() proto.Message {
 	var target *AggregateListener_HttpResources
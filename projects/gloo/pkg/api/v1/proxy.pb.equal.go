@@ -232,6 +232,21 @@ func (m *Listener) Equal(that interface{}) bool {
 			}
 		}
 
+	case *Listener_UdpListener:
+		if _, ok := target.ListenerType.(*Listener_UdpListener); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetUdpListener()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUdpListener()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetUdpListener(), target.GetUdpListener()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.ListenerType != target.ListenerType {
@@ -384,6 +399,64 @@ func (m *TcpHost) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *UdpListener) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpListener)
+	if !ok {
+		that2, ok := that.(UdpListener)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUpstream()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUpstream()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUpstream(), target.GetUpstream()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetStatPrefix(), target.GetStatPrefix()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetIdleTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetIdleTimeout(), target.GetIdleTimeout()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetHashPolicy()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHashPolicy()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHashPolicy(), target.GetHashPolicy()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *HttpListener) Equal(that interface{}) bool {
 	if that == nil {
@@ -1714,6 +1787,57 @@ func (m *TcpHost_TcpAction) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *UdpListener_HashPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpListener_HashPolicy)
+	if !ok {
+		that2, ok := that.(UdpListener_HashPolicy)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	switch m.PolicySpecifier.(type) {
+
+	case *UdpListener_HashPolicy_SourceIp:
+		if _, ok := target.PolicySpecifier.(*UdpListener_HashPolicy_SourceIp); !ok {
+			return false
+		}
+
+		if m.GetSourceIp() != target.GetSourceIp() {
+			return false
+		}
+
+	case *UdpListener_HashPolicy_Key:
+		if _, ok := target.PolicySpecifier.(*UdpListener_HashPolicy_Key); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetKey(), target.GetKey()) != 0 {
+			return false
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.PolicySpecifier != target.PolicySpecifier {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *AggregateListener_HttpResources) Equal(that interface{}) bool {
 	if that == nil {
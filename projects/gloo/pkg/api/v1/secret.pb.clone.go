@@ -141,6 +141,30 @@ func (m *Secret) Clone() proto.Message {
 			}
 		}
 
+	case *Secret_Htpasswd:
+
+		if h, ok := interface{}(m.GetHtpasswd()).(clone.Cloner); ok {
+			target.Kind = &Secret_Htpasswd{
+				Htpasswd: h.Clone().(*HtpasswdSecret),
+			}
+		} else {
+			target.Kind = &Secret_Htpasswd{
+				Htpasswd: proto.Clone(m.GetHtpasswd()).(*HtpasswdSecret),
+			}
+		}
+
+	case *Secret_Gcp:
+
+		if h, ok := interface{}(m.GetGcp()).(clone.Cloner); ok {
+			target.Kind = &Secret_Gcp{
+				Gcp: h.Clone().(*GcpSecret),
+			}
+		} else {
+			target.Kind = &Secret_Gcp{
+				Gcp: proto.Clone(m.GetGcp()).(*GcpSecret),
+			}
+		}
+
 	case *Secret_Extensions:
 
 		if h, ok := interface{}(m.GetExtensions()).(clone.Cloner); ok {
@@ -264,3 +288,29 @@ func (m *EncryptionKeySecret) Clone() proto.Message {
 
 	return target
 }
+
+// Clone function
+func (m *HtpasswdSecret) Clone() proto.Message {
+	var target *HtpasswdSecret
+	if m == nil {
+		return target
+	}
+	target = &HtpasswdSecret{}
+
+	target.Htpasswd = m.GetHtpasswd()
+
+	return target
+}
+
+// Clone function
+func (m *GcpSecret) Clone() proto.Message {
+	var target *GcpSecret
+	if m == nil {
+		return target
+	}
+	target = &GcpSecret{}
+
+	target.ServiceAccountKey = m.GetServiceAccountKey()
+
+	return target
+}
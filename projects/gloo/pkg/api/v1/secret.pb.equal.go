@@ -178,6 +178,36 @@ func (m *Secret) Equal(that interface{}) bool {
 			}
 		}
 
+	case *Secret_Htpasswd:
+		if _, ok := target.Kind.(*Secret_Htpasswd); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetHtpasswd()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHtpasswd()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetHtpasswd(), target.GetHtpasswd()) {
+				return false
+			}
+		}
+
+	case *Secret_Gcp:
+		if _, ok := target.Kind.(*Secret_Gcp); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetGcp()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetGcp()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetGcp(), target.GetGcp()) {
+				return false
+			}
+		}
+
 	case *Secret_Extensions:
 		if _, ok := target.Kind.(*Secret_Extensions); !ok {
 			return false
@@ -408,3 +438,59 @@ func (m *EncryptionKeySecret) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *HtpasswdSecret) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*HtpasswdSecret)
+	if !ok {
+		that2, ok := that.(HtpasswdSecret)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetHtpasswd(), target.GetHtpasswd()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *GcpSecret) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GcpSecret)
+	if !ok {
+		that2, ok := that.(GcpSecret)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetServiceAccountKey(), target.GetServiceAccountKey()) != 0 {
+		return false
+	}
+
+	return true
+}
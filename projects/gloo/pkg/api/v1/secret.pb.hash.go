@@ -236,6 +236,50 @@ func (m *Secret) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *Secret_Htpasswd:
+
+		if h, ok := interface{}(m.GetHtpasswd()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Htpasswd")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetHtpasswd(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Htpasswd")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	case *Secret_Gcp:
+
+		if h, ok := interface{}(m.GetGcp()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Gcp")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetGcp(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Gcp")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	case *Secret_Extensions:
 
 		if h, ok := interface{}(m.GetExtensions()).(safe_hasher.SafeHasher); ok {
@@ -444,3 +488,43 @@ func (m *EncryptionKeySecret) Hash(hasher hash.Hash64) (uint64, error) {
 
 	return hasher.Sum64(), nil
 }
+
+// Hash function
+func (m *HtpasswdSecret) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.HtpasswdSecret")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetHtpasswd())); err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *GcpSecret) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.GcpSecret")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetServiceAccountKey())); err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
@@ -118,6 +118,12 @@ func (m *Settings) Clone() proto.Message {
 		target.ConsulDiscovery = proto.Clone(m.GetConsulDiscovery()).(*Settings_ConsulUpstreamDiscoveryConfiguration)
 	}
 
+	if h, ok := interface{}(m.GetEureka()).(clone.Cloner); ok {
+		target.Eureka = h.Clone().(*Settings_EurekaConfiguration)
+	} else {
+		target.Eureka = proto.Clone(m.GetEureka()).(*Settings_EurekaConfiguration)
+	}
+
 	if h, ok := interface{}(m.GetKubernetes()).(clone.Cloner); ok {
 		target.Kubernetes = h.Clone().(*Settings_KubernetesConfiguration)
 	} else {
@@ -394,6 +400,12 @@ func (m *GlooOptions) Clone() proto.Message {
 		target.AwsOptions = proto.Clone(m.GetAwsOptions()).(*GlooOptions_AWSOptions)
 	}
 
+	if h, ok := interface{}(m.GetGcpOptions()).(clone.Cloner); ok {
+		target.GcpOptions = h.Clone().(*GlooOptions_GCPOptions)
+	} else {
+		target.GcpOptions = proto.Clone(m.GetGcpOptions()).(*GlooOptions_GCPOptions)
+	}
+
 	if h, ok := interface{}(m.GetInvalidConfigPolicy()).(clone.Cloner); ok {
 		target.InvalidConfigPolicy = h.Clone().(*GlooOptions_InvalidConfigPolicy)
 	} else {
@@ -532,6 +544,12 @@ func (m *GatewayOptions) Clone() proto.Message {
 		target.TranslateEmptyGateways = proto.Clone(m.GetTranslateEmptyGateways()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
 	}
 
+	if h, ok := interface{}(m.GetCanary()).(clone.Cloner); ok {
+		target.Canary = h.Clone().(*GatewayOptions_CanaryOptions)
+	} else {
+		target.Canary = proto.Clone(m.GetCanary()).(*GatewayOptions_CanaryOptions)
+	}
+
 	return target
 }
 
@@ -917,6 +935,48 @@ func (m *Settings_ConsulUpstreamDiscoveryConfiguration) Clone() proto.Message {
 		target.EdsBlockingQueries = proto.Clone(m.GetEdsBlockingQueries()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
 	}
 
+	if h, ok := interface{}(m.GetConnect()).(clone.Cloner); ok {
+		target.Connect = h.Clone().(*Settings_ConsulUpstreamDiscoveryConfiguration_ConnectConfiguration)
+	} else {
+		target.Connect = proto.Clone(m.GetConnect()).(*Settings_ConsulUpstreamDiscoveryConfiguration_ConnectConfiguration)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *Settings_EurekaConfiguration) Clone() proto.Message {
+	var target *Settings_EurekaConfiguration
+	if m == nil {
+		return target
+	}
+	target = &Settings_EurekaConfiguration{}
+
+	if m.GetServiceUrls() != nil {
+		target.ServiceUrls = make([]string, len(m.GetServiceUrls()))
+		for idx, v := range m.GetServiceUrls() {
+
+			target.ServiceUrls[idx] = v
+
+		}
+	}
+
+	target.Username = m.GetUsername()
+
+	target.Password = m.GetPassword()
+
+	if h, ok := interface{}(m.GetPollingInterval()).(clone.Cloner); ok {
+		target.PollingInterval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.PollingInterval = proto.Clone(m.GetPollingInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if h, ok := interface{}(m.GetRequestTimeout()).(clone.Cloner); ok {
+		target.RequestTimeout = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.RequestTimeout = proto.Clone(m.GetRequestTimeout()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
 	return target
 }
 
@@ -934,6 +994,10 @@ func (m *Settings_KubernetesConfiguration) Clone() proto.Message {
 		target.RateLimits = proto.Clone(m.GetRateLimits()).(*Settings_KubernetesConfiguration_RateLimits)
 	}
 
+	target.EnableEndpointLocality = m.GetEnableEndpointLocality()
+
+	target.UseEndpointSlices = m.GetUseEndpointSlices()
+
 	return target
 }
 
@@ -1081,6 +1145,25 @@ func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) Clone() proto.Mes
 	return target
 }
 
+// Clone function
+func (m *Settings_ConsulUpstreamDiscoveryConfiguration_ConnectConfiguration) Clone() proto.Message {
+	var target *Settings_ConsulUpstreamDiscoveryConfiguration_ConnectConfiguration
+	if m == nil {
+		return target
+	}
+	target = &Settings_ConsulUpstreamDiscoveryConfiguration_ConnectConfiguration{}
+
+	target.ServiceName = m.GetServiceName()
+
+	if h, ok := interface{}(m.GetSecretRef()).(clone.Cloner); ok {
+		target.SecretRef = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.SecretRef = proto.Clone(m.GetSecretRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *Settings_KubernetesConfiguration_RateLimits) Clone() proto.Message {
 	var target *Settings_KubernetesConfiguration_RateLimits
@@ -1188,6 +1271,31 @@ func (m *GlooOptions_AWSOptions) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *GlooOptions_GCPOptions) Clone() proto.Message {
+	var target *GlooOptions_GCPOptions
+	if m == nil {
+		return target
+	}
+	target = &GlooOptions_GCPOptions{}
+
+	target.MetadataServerUrl = m.GetMetadataServerUrl()
+
+	if h, ok := interface{}(m.GetTokenCacheSize()).(clone.Cloner); ok {
+		target.TokenCacheSize = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt64Value)
+	} else {
+		target.TokenCacheSize = proto.Clone(m.GetTokenCacheSize()).(*github_com_golang_protobuf_ptypes_wrappers.UInt64Value)
+	}
+
+	if h, ok := interface{}(m.GetServiceAccountSecretRef()).(clone.Cloner); ok {
+		target.ServiceAccountSecretRef = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.ServiceAccountSecretRef = proto.Clone(m.GetServiceAccountSecretRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *GlooOptions_InvalidConfigPolicy) Clone() proto.Message {
 	var target *GlooOptions_InvalidConfigPolicy
@@ -1283,6 +1391,33 @@ func (m *GatewayOptions_ValidationOptions) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *GatewayOptions_CanaryOptions) Clone() proto.Message {
+	var target *GatewayOptions_CanaryOptions
+	if m == nil {
+		return target
+	}
+	target = &GatewayOptions_CanaryOptions{}
+
+	if h, ok := interface{}(m.GetEnabled()).(clone.Cloner); ok {
+		target.Enabled = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.Enabled = proto.Clone(m.GetEnabled()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	target.PrometheusUrl = m.GetPrometheusUrl()
+
+	target.EnvoyStatsUrl = m.GetEnvoyStatsUrl()
+
+	if h, ok := interface{}(m.GetSyncPeriod()).(clone.Cloner); ok {
+		target.SyncPeriod = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.SyncPeriod = proto.Clone(m.GetSyncPeriod()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *GraphqlOptions_SchemaChangeValidationOptions) Clone() proto.Message {
 	var target *GraphqlOptions_SchemaChangeValidationOptions
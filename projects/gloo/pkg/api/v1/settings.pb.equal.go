@@ -153,6 +153,16 @@ func (m *Settings) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetEureka()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetEureka()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetEureka(), target.GetEureka()) {
+			return false
+		}
+	}
+
 	if h, ok := interface{}(m.GetKubernetes()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetKubernetes()) {
 			return false
@@ -579,6 +589,16 @@ func (m *GlooOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetGcpOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetGcpOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetGcpOptions(), target.GetGcpOptions()) {
+			return false
+		}
+	}
+
 	if h, ok := interface{}(m.GetInvalidConfigPolicy()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetInvalidConfigPolicy()) {
 			return false
@@ -825,6 +845,16 @@ func (m *GatewayOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetCanary()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCanary()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCanary(), target.GetCanary()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -1568,6 +1598,79 @@ func (m *Settings_ConsulUpstreamDiscoveryConfiguration) Equal(that interface{})
 		}
 	}
 
+	if h, ok := interface{}(m.GetConnect()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetConnect()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetConnect(), target.GetConnect()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Settings_EurekaConfiguration) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Settings_EurekaConfiguration)
+	if !ok {
+		that2, ok := that.(Settings_EurekaConfiguration)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetServiceUrls()) != len(target.GetServiceUrls()) {
+		return false
+	}
+	for idx, v := range m.GetServiceUrls() {
+
+		if strings.Compare(v, target.GetServiceUrls()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if strings.Compare(m.GetUsername(), target.GetUsername()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetPassword(), target.GetPassword()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetPollingInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPollingInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPollingInterval(), target.GetPollingInterval()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetRequestTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRequestTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRequestTimeout(), target.GetRequestTimeout()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -1602,6 +1705,14 @@ func (m *Settings_KubernetesConfiguration) Equal(that interface{}) bool {
 		}
 	}
 
+	if m.GetEnableEndpointLocality() != target.GetEnableEndpointLocality() {
+		return false
+	}
+
+	if m.GetUseEndpointSlices() != target.GetUseEndpointSlices() {
+		return false
+	}
+
 	return true
 }
 
@@ -1848,6 +1959,44 @@ func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) Equal(that interf
 	return true
 }
 
+// Equal function
+func (m *Settings_ConsulUpstreamDiscoveryConfiguration_ConnectConfiguration) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Settings_ConsulUpstreamDiscoveryConfiguration_ConnectConfiguration)
+	if !ok {
+		that2, ok := that.(Settings_ConsulUpstreamDiscoveryConfiguration_ConnectConfiguration)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetServiceName(), target.GetServiceName()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetSecretRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSecretRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSecretRef(), target.GetSecretRef()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *Settings_KubernetesConfiguration_RateLimits) Equal(that interface{}) bool {
 	if that == nil {
@@ -2044,6 +2193,54 @@ func (m *GlooOptions_AWSOptions) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *GlooOptions_GCPOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GlooOptions_GCPOptions)
+	if !ok {
+		that2, ok := that.(GlooOptions_GCPOptions)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetMetadataServerUrl(), target.GetMetadataServerUrl()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTokenCacheSize()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTokenCacheSize()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTokenCacheSize(), target.GetTokenCacheSize()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetServiceAccountSecretRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetServiceAccountSecretRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetServiceAccountSecretRef(), target.GetServiceAccountSecretRef()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *GlooOptions_InvalidConfigPolicy) Equal(that interface{}) bool {
 	if that == nil {
@@ -2224,6 +2421,58 @@ func (m *GatewayOptions_ValidationOptions) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *GatewayOptions_CanaryOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GatewayOptions_CanaryOptions)
+	if !ok {
+		that2, ok := that.(GatewayOptions_CanaryOptions)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetEnabled()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetEnabled()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetEnabled(), target.GetEnabled()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetPrometheusUrl(), target.GetPrometheusUrl()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetEnvoyStatsUrl(), target.GetEnvoyStatsUrl()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetSyncPeriod()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSyncPeriod()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSyncPeriod(), target.GetSyncPeriod()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *GraphqlOptions_SchemaChangeValidationOptions) Equal(that interface{}) bool {
 	if that == nil {
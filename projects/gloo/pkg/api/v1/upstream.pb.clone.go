@@ -25,10 +25,16 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_aws_ec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_aws_request_signing "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws_request_signing"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_azure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_consul "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_eureka "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/eureka"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_gcp "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/gcp"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_pipe "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/pipe"
@@ -219,6 +225,12 @@ func (m *Upstream) Clone() proto.Message {
 		target.DisableIstioAutoMtls = proto.Clone(m.GetDisableIstioAutoMtls()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
 	}
 
+	if h, ok := interface{}(m.GetAwsRequestSigning()).(clone.Cloner); ok {
+		target.AwsRequestSigning = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_aws_request_signing.AwsRequestSigning)
+	} else {
+		target.AwsRequestSigning = proto.Clone(m.GetAwsRequestSigning()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_aws_request_signing.AwsRequestSigning)
+	}
+
 	switch m.UpstreamType.(type) {
 
 	case *Upstream_Kube:
@@ -305,6 +317,30 @@ func (m *Upstream) Clone() proto.Message {
 			}
 		}
 
+	case *Upstream_Eureka:
+
+		if h, ok := interface{}(m.GetEureka()).(clone.Cloner); ok {
+			target.UpstreamType = &Upstream_Eureka{
+				Eureka: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_eureka.UpstreamSpec),
+			}
+		} else {
+			target.UpstreamType = &Upstream_Eureka{
+				Eureka: proto.Clone(m.GetEureka()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_eureka.UpstreamSpec),
+			}
+		}
+
+	case *Upstream_Gcp:
+
+		if h, ok := interface{}(m.GetGcp()).(clone.Cloner); ok {
+			target.UpstreamType = &Upstream_Gcp{
+				Gcp: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_gcp.UpstreamSpec),
+			}
+		} else {
+			target.UpstreamType = &Upstream_Gcp{
+				Gcp: proto.Clone(m.GetGcp()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_gcp.UpstreamSpec),
+			}
+		}
+
 	}
 
 	return target
@@ -304,6 +304,16 @@ func (m *Upstream) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetAwsRequestSigning()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAwsRequestSigning()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAwsRequestSigning(), target.GetAwsRequestSigning()) {
+			return false
+		}
+	}
+
 	switch m.UpstreamType.(type) {
 
 	case *Upstream_Kube:
@@ -411,6 +421,36 @@ func (m *Upstream) Equal(that interface{}) bool {
 			}
 		}
 
+	case *Upstream_Eureka:
+		if _, ok := target.UpstreamType.(*Upstream_Eureka); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetEureka()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetEureka()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetEureka(), target.GetEureka()) {
+				return false
+			}
+		}
+
+	case *Upstream_Gcp:
+		if _, ok := target.UpstreamType.(*Upstream_Gcp); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetGcp()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetGcp()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetGcp(), target.GetGcp()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.UpstreamType != target.UpstreamType {
@@ -511,6 +511,26 @@ func (m *Upstream) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetAwsRequestSigning()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("AwsRequestSigning")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetAwsRequestSigning(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("AwsRequestSigning")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	switch m.UpstreamType.(type) {
 
 	case *Upstream_Kube:
@@ -667,6 +687,50 @@ func (m *Upstream) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *Upstream_Eureka:
+
+		if h, ok := interface{}(m.GetEureka()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Eureka")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetEureka(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Eureka")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	case *Upstream_Gcp:
+
+		if h, ok := interface{}(m.GetGcp()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Gcp")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetGcp(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Gcp")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
@@ -19,6 +19,7 @@ import (
 	gwtranslator "github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/debug"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gcp/tokenserver"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/validation"
 )
@@ -43,6 +44,7 @@ type Opts struct {
 	MatchableTcpGateways         factory.ResourceClientFactory
 	VirtualHostOptions           factory.ResourceClientFactory
 	RouteOptions                 factory.ResourceClientFactory
+	Canaries                     factory.ResourceClientFactory
 	KubeClient                   kubernetes.Interface
 	Consul                       Consul
 	WatchOpts                    clients.WatchOpts
@@ -50,6 +52,7 @@ type Opts struct {
 	ControlPlane                 ControlPlane
 	ValidationServer             ValidationServer
 	ProxyDebugServer             ProxyDebugServer
+	GcpTokenServer               *tokenserver.Server
 	Settings                     *v1.Settings
 	KubeCoreCache                corecache.KubeCoreCache
 	ValidationOpts               *gwtranslator.ValidationOpts
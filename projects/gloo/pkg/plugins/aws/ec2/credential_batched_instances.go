@@ -8,6 +8,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	glooec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
@@ -28,6 +29,7 @@ func getLatestEndpoints(ctx context.Context, lister Ec2InstanceLister, secrets v
 	credGroups := getCredGroupsFromUpstreams(upstreamList)
 
 	// call the EC2 DescribeInstances once for each set of credentials and apply the output to the credential groups
+	// the Auto Scaling Groups and ECS services referenced by the upstreams are described once per set of credentials as well
 	if err := getInstancesForCredentialGroups(ctx, lister, secrets, credGroups); err != nil {
 		return nil, err
 	}
@@ -35,6 +37,10 @@ func getLatestEndpoints(ctx context.Context, lister Ec2InstanceLister, secrets v
 	var allEndpoints v1.EndpointList
 	for _, credGroup := range credGroups {
 		for _, upstream := range credGroup.upstreams {
+			if upstream.GetAwsEc2().GetEcsService() != nil {
+				allEndpoints = append(allEndpoints, ecsServiceToEndpoints(ctx, writeNamespace, upstream, credGroup)...)
+				continue
+			}
 			instancesForUpstream := filterInstancesForUpstream(ctx, upstream, credGroup)
 			for _, instance := range instancesForUpstream {
 				if endpoint := upstreamInstanceToEndpoint(ctx, writeNamespace, upstream, instance); endpoint != nil {
@@ -57,6 +63,12 @@ type credentialGroup struct {
 	instances []*ec2.Instance
 	// one filter map exists for each instance in order to support client-side filtering
 	filterMaps []FilterMap
+	// all the instances visible to the given credentials, keyed by instance id
+	instancesById map[string]*ec2.Instance
+	// the ids of the in service, healthy members of the Auto Scaling Groups referenced by the upstreams, keyed by group name
+	autoScalingGroupInstances map[string]map[string]bool
+	// the running tasks of the ECS services referenced by the upstreams
+	ecsTasks map[EcsService]*EcsServiceTasks
 }
 
 // Initializes the credentialGroups
@@ -84,18 +96,103 @@ func getCredGroupsFromUpstreams(upstreams v1.UpstreamList) map[CredentialKey]*cr
 // - adds the instances for each credentialGroup's credential
 // - adds tag filters for each instance for later use when refining the list of instances that an upstream has
 // permission to describe to the list of instances that the upstream should route to
+// - adds the members of the Auto Scaling Groups referenced by the credentialGroup's upstreams
+// - adds the tasks of the ECS services referenced by the credentialGroup's upstreams
 func getInstancesForCredentialGroups(ctx context.Context, lister Ec2InstanceLister, secrets v1.SecretList, credGroups map[CredentialKey]*credentialGroup) error {
 	for _, credGroup := range credGroups {
-		instances, err := lister.ListForCredentials(ctx, credGroup.credentialSpec, secrets)
-		if err != nil {
-			return err
+		groupNames, ecsServices, needsInstances := getResourcesForUpstreams(credGroup.upstreams)
+
+		if len(ecsServices) > 0 {
+			tasks, err := lister.ListEcsTasksForCredentials(ctx, credGroup.credentialSpec, secrets, ecsServices)
+			if err != nil {
+				return err
+			}
+			credGroup.ecsTasks = tasks
+			// tasks that are not running on Fargate are reached through the address of their container instance
+			for _, serviceTasks := range tasks {
+				if len(serviceTasks.ContainerInstances) > 0 {
+					needsInstances = true
+				}
+			}
+		}
+
+		if needsInstances {
+			instances, err := lister.ListForCredentials(ctx, credGroup.credentialSpec, secrets)
+			if err != nil {
+				return err
+			}
+			credGroup.instances = instances
+			credGroup.filterMaps = generateFilterMaps(instances)
+			credGroup.instancesById = make(map[string]*ec2.Instance, len(instances))
+			for _, instance := range instances {
+				credGroup.instancesById[aws.StringValue(instance.InstanceId)] = instance
+			}
+		}
+
+		if len(groupNames) > 0 {
+			groups, err := lister.ListAutoScalingGroupsForCredentials(ctx, credGroup.credentialSpec, secrets, groupNames)
+			if err != nil {
+				return err
+			}
+			credGroup.autoScalingGroupInstances = getHealthyAutoScalingGroupInstances(groups)
 		}
-		credGroup.instances = instances
-		credGroup.filterMaps = generateFilterMaps(instances)
 	}
 	return nil
 }
 
+// returns the Auto Scaling Group names and ECS services referenced by the given upstreams, and whether any of the
+// upstreams selects EC2 instances
+// NOTE: assumes that upstreams are EC2 upstreams
+func getResourcesForUpstreams(upstreams v1.UpstreamList) ([]string, []EcsService, bool) {
+	var (
+		groupNames     []string
+		ecsServices    []EcsService
+		needsInstances bool
+	)
+	seenGroupNames := make(map[string]bool)
+	seenEcsServices := make(map[EcsService]bool)
+	for _, upstream := range upstreams {
+		if selector := upstream.GetAwsEc2().GetEcsService(); selector != nil {
+			service := ecsServiceFromSelector(selector)
+			if !seenEcsServices[service] {
+				seenEcsServices[service] = true
+				ecsServices = append(ecsServices, service)
+			}
+			continue
+		}
+		needsInstances = true
+		for _, name := range upstream.GetAwsEc2().GetAutoScalingGroupNames() {
+			if !seenGroupNames[name] {
+				seenGroupNames[name] = true
+				groupNames = append(groupNames, name)
+			}
+		}
+	}
+	return groupNames, ecsServices, needsInstances
+}
+
+// instances that are being launched, terminated or are otherwise out of service, or that have failed their health
+// checks, should not receive traffic
+func getHealthyAutoScalingGroupInstances(groups []*autoscaling.Group) map[string]map[string]bool {
+	groupInstances := make(map[string]map[string]bool, len(groups))
+	for _, group := range groups {
+		instanceIds := make(map[string]bool)
+		for _, instance := range group.Instances {
+			if aws.StringValue(instance.LifecycleState) != autoscaling.LifecycleStateInService {
+				continue
+			}
+			if aws.StringValue(instance.HealthStatus) != autoScalingHealthStatusHealthy {
+				continue
+			}
+			instanceIds[aws.StringValue(instance.InstanceId)] = true
+		}
+		groupInstances[aws.StringValue(group.AutoScalingGroupName)] = instanceIds
+	}
+	return groupInstances
+}
+
+const autoScalingHealthStatusHealthy = "Healthy"
+
 // applies filter logic equivalent to the tag filter logic used in AWS's DescribeInstances API
 // NOTE: assumes that upstreams are EC2 upstreams
 func filterInstancesForUpstream(ctx context.Context, upstream *v1.Upstream, credGroup *credentialGroup) []*ec2.Instance {
@@ -121,6 +218,9 @@ func filterInstancesForUpstream(ctx context.Context, upstream *v1.Upstream, cred
 				}
 			}
 		}
+		if matchesAll {
+			matchesAll = inAutoScalingGroups(aws.StringValue(candidateInstance.InstanceId), upstream.GetAwsEc2().GetAutoScalingGroupNames(), credGroup)
+		}
 		if matchesAll {
 			instances = append(instances, candidateInstance)
 			logger.Debugw("instance for upstream accepted", "upstream", upstream.GetMetadata().Ref().Key(), "instance-tags", candidateInstance.Tags, "instance-id", candidateInstance.InstanceId)
@@ -131,6 +231,20 @@ func filterInstancesForUpstream(ctx context.Context, upstream *v1.Upstream, cred
 	return instances
 }
 
+// an instance is selected by an upstream's Auto Scaling Group names if it is a healthy member of any of the groups
+// all instances are selected if the upstream does not reference any Auto Scaling Groups
+func inAutoScalingGroups(instanceId string, groupNames []string, credGroup *credentialGroup) bool {
+	if len(groupNames) == 0 {
+		return true
+	}
+	for _, name := range groupNames {
+		if credGroup.autoScalingGroupInstances[name][instanceId] {
+			return true
+		}
+	}
+	return false
+}
+
 // NOTE: assumes that upstreams are EC2 upstreams
 func upstreamInstanceToEndpoint(ctx context.Context, writeNamespace string, upstream *v1.Upstream, instance *ec2.Instance) *v1.Endpoint {
 	ipAddr := instance.PrivateIpAddress
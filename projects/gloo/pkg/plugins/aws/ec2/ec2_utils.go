@@ -8,28 +8,55 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	glooec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
 	aws2 "github.com/solo-io/gloo/projects/gloo/pkg/utils/aws"
 )
 
 func GetEc2Client(cred *CredentialSpec, secrets v1.SecretList) (*ec2.EC2, error) {
+	sess, configs, err := getSessionForCredentials(cred, secrets)
+	if err != nil {
+		return nil, err
+	}
+	return ec2.New(sess, configs...), nil
+}
+
+func GetAutoScalingClient(cred *CredentialSpec, secrets v1.SecretList) (*autoscaling.AutoScaling, error) {
+	sess, configs, err := getSessionForCredentials(cred, secrets)
+	if err != nil {
+		return nil, err
+	}
+	return autoscaling.New(sess, configs...), nil
+}
+
+func GetEcsClient(cred *CredentialSpec, secrets v1.SecretList) (*ecs.ECS, error) {
+	sess, configs, err := getSessionForCredentials(cred, secrets)
+	if err != nil {
+		return nil, err
+	}
+	return ecs.New(sess, configs...), nil
+}
+
+// returns the session and the additional client configs (if any) needed to create an AWS API client for the given credentials
+func getSessionForCredentials(cred *CredentialSpec, secrets v1.SecretList) (*session.Session, []*aws.Config, error) {
 	regionConfig := &aws.Config{Region: aws.String(cred.Region())}
 	secretRef := cred.SecretRef()
 	sess, err := aws2.GetAwsSession(secretRef, secrets, regionConfig)
 	if err != nil {
 		if secretRef == nil {
-			return nil, CreateSessionFromEnvError(err)
+			return nil, nil, CreateSessionFromEnvError(err)
 		}
-		return nil, CreateSessionFromSecretError(err)
+		return nil, nil, CreateSessionFromSecretError(err)
 	}
 	if cred.Arn() != "" {
 		cred := stscreds.NewCredentials(sess, cred.Arn())
-		config := &aws.Config{Credentials: cred}
-		return ec2.New(sess, config), nil
+		return sess, []*aws.Config{{Credentials: cred}}, nil
 	}
-	return ec2.New(sess), nil
+	return sess, nil, nil
 }
 
 func GetInstancesFromDescription(desc *ec2.DescribeInstancesOutput) []*ec2.Instance {
@@ -0,0 +1,206 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/k8s-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.uber.org/zap"
+)
+
+const TaskArnAnnotationKey = "taskArn"
+
+const ecsEndpointNamePrefix = "ecs"
+
+// ECS reports the network interface of tasks using the awsvpc network mode as an attachment of this type
+const (
+	eniAttachmentType            = "ElasticNetworkInterface"
+	eniAttachmentPrivateIpv4Name = "privateIPv4Address"
+)
+
+func ecsServiceFromSelector(selector *glooec2.EcsServiceSelector) EcsService {
+	return EcsService{
+		Cluster: selector.GetCluster(),
+		Service: selector.GetServiceName(),
+	}
+}
+
+// NOTE: assumes that the upstream is an EC2 upstream with an ECS service selector
+func ecsServiceToEndpoints(ctx context.Context, writeNamespace string, upstream *v1.Upstream, credGroup *credentialGroup) v1.EndpointList {
+	logger := contextutils.LoggerFrom(ctx)
+	spec := upstream.GetAwsEc2()
+	selector := spec.GetEcsService()
+	serviceTasks, ok := credGroup.ecsTasks[ecsServiceFromSelector(selector)]
+	if !ok {
+		return nil
+	}
+
+	containerPort := selector.GetContainerPort()
+	if containerPort == 0 {
+		containerPort = spec.GetPort()
+	}
+	if containerPort == 0 {
+		containerPort = DefaultPort
+	}
+
+	var endpoints v1.EndpointList
+	for _, task := range serviceTasks.Tasks {
+		taskArn := aws.StringValue(task.TaskArn)
+		container := selectContainer(task, selector.GetContainerName(), containerPort)
+		if selector.GetContainerName() != "" && container == nil {
+			logger.Warnw("container not found in ecs task",
+				zap.Any("upstreamRef", upstream.GetMetadata().Ref()),
+				zap.String("taskArn", taskArn),
+				zap.String("containerName", selector.GetContainerName()))
+			continue
+		}
+		if !taskIsReady(task, container, selector.GetHealthyOnly()) {
+			logger.Debugw("ecs task for upstream filtered out", "upstream", upstream.GetMetadata().Ref().Key(), "task-arn", taskArn, "last-status", aws.StringValue(task.LastStatus), "health-status", aws.StringValue(task.HealthStatus))
+			continue
+		}
+
+		annotations := map[string]string{TaskArnAnnotationKey: taskArn}
+		address, port := getTaskEniAddress(task), containerPort
+		if address == "" {
+			// bridge and host network modes: route to the host port on the container instance
+			var instanceId string
+			address, port, instanceId = getTaskContainerInstanceAddress(spec, task, container, containerPort, serviceTasks, credGroup)
+			if instanceId != "" {
+				annotations[InstanceIdAnnotationKey] = instanceId
+			}
+		}
+		if address == "" {
+			logger.Warnw("no address found for ecs task",
+				zap.Any("upstreamRef", upstream.GetMetadata().Ref()),
+				zap.String("taskArn", taskArn),
+				zap.Uint32("containerPort", containerPort))
+			continue
+		}
+
+		ref := upstream.GetMetadata().Ref()
+		endpoints = append(endpoints, &v1.Endpoint{
+			Upstreams: []*core.ResourceRef{ref},
+			Address:   address,
+			Port:      port,
+			Metadata: &core.Metadata{
+				Name:        generateEcsName(ref, address, port),
+				Namespace:   writeNamespace,
+				Annotations: annotations,
+			},
+		})
+	}
+	return endpoints
+}
+
+// returns the container with the given name, or, if no name is given, the first container that binds the container port
+func selectContainer(task *ecs.Task, containerName string, containerPort uint32) *ecs.Container {
+	for _, container := range task.Containers {
+		if containerName != "" {
+			if aws.StringValue(container.Name) == containerName {
+				return container
+			}
+			continue
+		}
+		if getNetworkBinding(container, containerPort) != nil {
+			return container
+		}
+	}
+	return nil
+}
+
+func getNetworkBinding(container *ecs.Container, containerPort uint32) *ecs.NetworkBinding {
+	if container == nil {
+		return nil
+	}
+	for _, binding := range container.NetworkBindings {
+		if uint32(aws.Int64Value(binding.ContainerPort)) == containerPort {
+			return binding
+		}
+	}
+	return nil
+}
+
+// tasks (and their selected container) that are reported UNHEALTHY never receive traffic
+// tasks without a health check are reported UNKNOWN and receive traffic unless healthyOnly is set
+// the health status of a task is derived from its essential containers, so healthyOnly only applies to the task status
+func taskIsReady(task *ecs.Task, container *ecs.Container, healthyOnly bool) bool {
+	if aws.StringValue(task.LastStatus) != ecs.DesiredStatusRunning {
+		return false
+	}
+	if container != nil && aws.StringValue(container.HealthStatus) == ecs.HealthStatusUnhealthy {
+		return false
+	}
+	switch aws.StringValue(task.HealthStatus) {
+	case ecs.HealthStatusHealthy:
+		return true
+	case ecs.HealthStatusUnhealthy:
+		return false
+	default:
+		return !healthyOnly
+	}
+}
+
+// returns the private ip of the task's elastic network interface, if the task uses the awsvpc network mode
+func getTaskEniAddress(task *ecs.Task) string {
+	for _, attachment := range task.Attachments {
+		if aws.StringValue(attachment.Type) != eniAttachmentType {
+			continue
+		}
+		for _, detail := range attachment.Details {
+			if aws.StringValue(detail.Name) == eniAttachmentPrivateIpv4Name {
+				return aws.StringValue(detail.Value)
+			}
+		}
+	}
+	for _, container := range task.Containers {
+		for _, eni := range container.NetworkInterfaces {
+			if ip := aws.StringValue(eni.PrivateIpv4Address); ip != "" {
+				return ip
+			}
+		}
+	}
+	return ""
+}
+
+// returns the address and host port of the container instance hosting the task, along with the container instance's EC2 instance id
+func getTaskContainerInstanceAddress(
+	spec *glooec2.UpstreamSpec,
+	task *ecs.Task,
+	container *ecs.Container,
+	containerPort uint32,
+	serviceTasks *EcsServiceTasks,
+	credGroup *credentialGroup,
+) (string, uint32, string) {
+	binding := getNetworkBinding(container, containerPort)
+	if binding == nil {
+		return "", 0, ""
+	}
+	instanceId := serviceTasks.ContainerInstances[aws.StringValue(task.ContainerInstanceArn)]
+	instance, ok := credGroup.instancesById[instanceId]
+	if !ok {
+		return "", 0, instanceId
+	}
+	ipAddr := instance.PrivateIpAddress
+	if spec.GetPublicIp() {
+		ipAddr = instance.PublicIpAddress
+	}
+	return aws.StringValue(ipAddr), uint32(aws.Int64Value(binding.HostPort)), instanceId
+}
+
+// several tasks may share the address of their container instance, so the port is part of the name
+func generateEcsName(upstreamRef *core.ResourceRef, address string, port uint32) string {
+	return kubeutils.SanitizeNameV2(fmt.Sprintf(
+		"%v-name-%s-namespace-%s-%v-%v",
+		ecsEndpointNamePrefix,
+		upstreamRef.GetName(),
+		upstreamRef.GetNamespace(),
+		address,
+		port,
+	))
+}
@@ -0,0 +1,241 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	. "github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Auto Scaling Groups and ECS services", func() {
+
+	const writeNamespace = "default"
+
+	var (
+		ctx    context.Context
+		cred   CredentialKey
+		lister *mockEc2InstanceLister
+	)
+
+	upstream := func(name string, spec *glooec2.UpstreamSpec) *v1.Upstream {
+		spec.Region = "us-east-1"
+		spec.SecretRef = testSecretRef1
+		return &v1.Upstream{
+			UpstreamType: &v1.Upstream_AwsEc2{AwsEc2: spec},
+			Metadata:     &core.Metadata{Name: name, Namespace: writeNamespace},
+		}
+	}
+
+	instance := func(id, privateIp string) *ec2.Instance {
+		return &ec2.Instance{
+			InstanceId:       aws.String(id),
+			PrivateIpAddress: aws.String(privateIp),
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cred = NewCredentialSpecFromEc2UpstreamSpec(upstream("any", &glooec2.UpstreamSpec{}).GetAwsEc2()).GetKey()
+		lister = newMockEc2InstanceLister(mockListerResponses{
+			cred: {
+				instance("i-1", "10.0.0.1"),
+				instance("i-2", "10.0.0.2"),
+				instance("i-3", "10.0.0.3"),
+			},
+		})
+		lister.autoScalingGroups = map[CredentialKey][]*autoscaling.Group{
+			cred: {
+				{
+					AutoScalingGroupName: aws.String("asg-a"),
+					Instances: []*autoscaling.Instance{
+						{InstanceId: aws.String("i-1"), LifecycleState: aws.String(autoscaling.LifecycleStateInService), HealthStatus: aws.String("Healthy")},
+						{InstanceId: aws.String("i-2"), LifecycleState: aws.String(autoscaling.LifecycleStateInService), HealthStatus: aws.String("Unhealthy")},
+					},
+				},
+				{
+					AutoScalingGroupName: aws.String("asg-b"),
+					Instances: []*autoscaling.Instance{
+						{InstanceId: aws.String("i-3"), LifecycleState: aws.String(autoscaling.LifecycleStatePending), HealthStatus: aws.String("Healthy")},
+					},
+				},
+			},
+		}
+	})
+
+	endpointAddresses := func(endpoints v1.EndpointList) []string {
+		var addresses []string
+		for _, ep := range endpoints {
+			addresses = append(addresses, ep.GetAddress())
+		}
+		return addresses
+	}
+
+	Context("auto scaling groups", func() {
+
+		It("selects the healthy, in service members of the groups", func() {
+			upstreams := v1.UpstreamList{
+				upstream("asg-a", &glooec2.UpstreamSpec{AutoScalingGroupNames: []string{"asg-a"}}),
+				upstream("asg-b", &glooec2.UpstreamSpec{AutoScalingGroupNames: []string{"asg-b"}}),
+				upstream("all", &glooec2.UpstreamSpec{}),
+			}
+			endpoints, err := getLatestEndpoints(ctx, lister, nil, writeNamespace, upstreams)
+			Expect(err).NotTo(HaveOccurred())
+
+			byUpstream := map[string][]string{}
+			for _, ep := range endpoints {
+				name := ep.GetUpstreams()[0].GetName()
+				byUpstream[name] = append(byUpstream[name], ep.GetAddress())
+			}
+			Expect(byUpstream).To(Equal(map[string][]string{
+				"asg-a": {"10.0.0.1"},
+				"all":   {"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+			}))
+		})
+
+		It("requires instances to match both the tag filters and the groups", func() {
+			lister.responses[cred][0].Tags = []*ec2.Tag{{Key: aws.String("team"), Value: aws.String("a")}}
+			upstreams := v1.UpstreamList{
+				upstream("tagged", &glooec2.UpstreamSpec{
+					AutoScalingGroupNames: []string{"asg-a", "asg-b"},
+					Filters:               []*glooec2.TagFilter{{Spec: &glooec2.TagFilter_Key{Key: "team"}}},
+				}),
+			}
+			endpoints, err := getLatestEndpoints(ctx, lister, nil, writeNamespace, upstreams)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpointAddresses(endpoints)).To(ConsistOf("10.0.0.1"))
+		})
+	})
+
+	Context("ecs services", func() {
+
+		var service EcsService
+
+		eniTask := func(arn, ip, health string) *ecs.Task {
+			return &ecs.Task{
+				TaskArn:      aws.String(arn),
+				LastStatus:   aws.String(ecs.DesiredStatusRunning),
+				HealthStatus: aws.String(health),
+				Attachments: []*ecs.Attachment{{
+					Type: aws.String(eniAttachmentType),
+					Details: []*ecs.KeyValuePair{
+						{Name: aws.String("subnetId"), Value: aws.String("subnet-1")},
+						{Name: aws.String(eniAttachmentPrivateIpv4Name), Value: aws.String(ip)},
+					},
+				}},
+				Containers: []*ecs.Container{{Name: aws.String("app")}},
+			}
+		}
+
+		BeforeEach(func() {
+			service = EcsService{Cluster: "cluster", Service: "web"}
+		})
+
+		ecsUpstream := func(selector *glooec2.EcsServiceSelector) *v1.Upstream {
+			selector.Cluster = service.Cluster
+			selector.ServiceName = service.Service
+			return upstream("web", &glooec2.UpstreamSpec{EcsService: selector})
+		}
+
+		It("uses the task network interface for awsvpc tasks", func() {
+			lister.ecsTasks = map[CredentialKey]map[EcsService]*EcsServiceTasks{
+				cred: {service: {Tasks: []*ecs.Task{
+					eniTask("arn:task/1", "10.1.0.1", ecs.HealthStatusHealthy),
+					eniTask("arn:task/2", "10.1.0.2", ecs.HealthStatusUnknown),
+					eniTask("arn:task/3", "10.1.0.3", ecs.HealthStatusUnhealthy),
+				}}},
+			}
+			us := ecsUpstream(&glooec2.EcsServiceSelector{ContainerPort: 8080})
+			endpoints, err := getLatestEndpoints(ctx, lister, nil, writeNamespace, v1.UpstreamList{us})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(2))
+			Expect(endpoints[0]).To(MatchProto(&v1.Endpoint{
+				Upstreams: []*core.ResourceRef{us.GetMetadata().Ref()},
+				Address:   "10.1.0.1",
+				Port:      8080,
+				Metadata: &core.Metadata{
+					Name:        "ecs-name-web-namespace-default-10-1-0-1-8080",
+					Namespace:   writeNamespace,
+					Annotations: map[string]string{TaskArnAnnotationKey: "arn:task/1"},
+				},
+			}))
+			Expect(endpoints[1].GetAddress()).To(Equal("10.1.0.2"))
+
+			// fargate only services do not need to describe instances
+			Expect(lister.instanceListCalled).To(BeFalse())
+		})
+
+		It("excludes tasks that are not reported healthy when healthy_only is set", func() {
+			unhealthyContainer := eniTask("arn:task/2", "10.1.0.2", ecs.HealthStatusHealthy)
+			unhealthyContainer.Containers[0].HealthStatus = aws.String(ecs.HealthStatusUnhealthy)
+			stopping := eniTask("arn:task/4", "10.1.0.4", ecs.HealthStatusHealthy)
+			stopping.LastStatus = aws.String(ecs.DesiredStatusStopped)
+			lister.ecsTasks = map[CredentialKey]map[EcsService]*EcsServiceTasks{
+				cred: {service: {Tasks: []*ecs.Task{
+					eniTask("arn:task/1", "10.1.0.1", ecs.HealthStatusHealthy),
+					unhealthyContainer,
+					eniTask("arn:task/3", "10.1.0.3", ecs.HealthStatusUnknown),
+					stopping,
+				}}},
+			}
+			us := ecsUpstream(&glooec2.EcsServiceSelector{ContainerName: "app", HealthyOnly: true})
+			endpoints, err := getLatestEndpoints(ctx, lister, nil, writeNamespace, v1.UpstreamList{us})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpointAddresses(endpoints)).To(ConsistOf("10.1.0.1"))
+			Expect(endpoints[0].GetPort()).To(Equal(uint32(DefaultPort)))
+		})
+
+		It("uses the container instance address and host port for bridge tasks", func() {
+			bridgeTask := func(arn, containerInstanceArn string, hostPort int64) *ecs.Task {
+				return &ecs.Task{
+					TaskArn:              aws.String(arn),
+					LastStatus:           aws.String(ecs.DesiredStatusRunning),
+					ContainerInstanceArn: aws.String(containerInstanceArn),
+					Containers: []*ecs.Container{
+						{Name: aws.String("sidecar"), NetworkBindings: []*ecs.NetworkBinding{{ContainerPort: aws.Int64(9901), HostPort: aws.Int64(hostPort + 1)}}},
+						{Name: aws.String("app"), NetworkBindings: []*ecs.NetworkBinding{{ContainerPort: aws.Int64(8080), HostPort: aws.Int64(hostPort)}}},
+					},
+				}
+			}
+			lister.ecsTasks = map[CredentialKey]map[EcsService]*EcsServiceTasks{
+				cred: {service: {
+					Tasks: []*ecs.Task{
+						bridgeTask("arn:task/1", "arn:container-instance/1", 32768),
+						bridgeTask("arn:task/2", "arn:container-instance/1", 32770),
+						bridgeTask("arn:task/3", "arn:container-instance/unknown", 32768),
+					},
+					ContainerInstances: map[string]string{
+						"arn:container-instance/1":       "i-2",
+						"arn:container-instance/unknown": "i-9",
+					},
+				}},
+			}
+			us := ecsUpstream(&glooec2.EcsServiceSelector{ContainerPort: 8080})
+			endpoints, err := getLatestEndpoints(ctx, lister, nil, writeNamespace, v1.UpstreamList{us})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(2))
+			Expect(endpoints[0]).To(MatchProto(&v1.Endpoint{
+				Upstreams: []*core.ResourceRef{us.GetMetadata().Ref()},
+				Address:   "10.0.0.2",
+				Port:      32768,
+				Metadata: &core.Metadata{
+					Name:      "ecs-name-web-namespace-default-10-0-0-2-32768",
+					Namespace: writeNamespace,
+					Annotations: map[string]string{
+						TaskArnAnnotationKey:    "arn:task/1",
+						InstanceIdAnnotationKey: "i-2",
+					},
+				},
+			}))
+			Expect(endpoints[1].GetPort()).To(Equal(uint32(32770)))
+			Expect(lister.instanceListCalled).To(BeTrue())
+		})
+	})
+})
@@ -6,7 +6,9 @@ import (
 	"github.com/rotisserie/eris"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/go-utils/contextutils"
 	"go.uber.org/zap"
@@ -16,6 +18,22 @@ import (
 // This allows us to easily mock the API in our tests.
 type Ec2InstanceLister interface {
 	ListForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) ([]*ec2.Instance, error)
+	ListAutoScalingGroupsForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList, groupNames []string) ([]*autoscaling.Group, error)
+	ListEcsTasksForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList, services []EcsService) (map[EcsService]*EcsServiceTasks, error)
+}
+
+// EcsService identifies an ECS service within a cluster
+type EcsService struct {
+	Cluster string
+	Service string
+}
+
+type EcsServiceTasks struct {
+	// the running tasks of the service
+	Tasks []*ecs.Task
+	// maps the ARNs of the container instances that host the tasks to their EC2 instance ids
+	// tasks running on Fargate are not hosted on container instances
+	ContainerInstances map[string]string
 }
 
 type ec2InstanceLister struct {
@@ -62,6 +80,110 @@ func (c *ec2InstanceLister) ListWithClient(ctx context.Context, svc *ec2.EC2) ([
 	return result, nil
 }
 
+// the DescribeAutoScalingGroups API accepts at most 50 group names per call
+const maxAutoScalingGroupNamesPerCall = 50
+
+func (c *ec2InstanceLister) ListAutoScalingGroupsForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList, groupNames []string) ([]*autoscaling.Group, error) {
+	svc, err := GetAutoScalingClient(cred, secrets)
+	if err != nil {
+		return nil, GetClientError(err)
+	}
+
+	var result []*autoscaling.Group
+	for _, names := range chunkStrings(groupNames, maxAutoScalingGroupNamesPerCall) {
+		input := &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice(names),
+		}
+		err := svc.DescribeAutoScalingGroupsPagesWithContext(ctx, input, func(r *autoscaling.DescribeAutoScalingGroupsOutput, more bool) bool {
+			result = append(result, r.AutoScalingGroups...)
+			return true
+		})
+		if err != nil {
+			return nil, DescribeAutoScalingGroupsError(err)
+		}
+	}
+
+	contextutils.LoggerFrom(ctx).Debugw("ec2Upstream auto scaling groups result", zap.Any("value", result))
+	return result, nil
+}
+
+// the DescribeTasks and DescribeContainerInstances APIs accept at most 100 ARNs per call
+const maxEcsArnsPerCall = 100
+
+func (c *ec2InstanceLister) ListEcsTasksForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList, services []EcsService) (map[EcsService]*EcsServiceTasks, error) {
+	svc, err := GetEcsClient(cred, secrets)
+	if err != nil {
+		return nil, GetClientError(err)
+	}
+
+	result := make(map[EcsService]*EcsServiceTasks, len(services))
+	for _, service := range services {
+		var taskArns []string
+		input := &ecs.ListTasksInput{
+			Cluster:       aws.String(service.Cluster),
+			ServiceName:   aws.String(service.Service),
+			DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+		}
+		err := svc.ListTasksPagesWithContext(ctx, input, func(r *ecs.ListTasksOutput, more bool) bool {
+			taskArns = append(taskArns, aws.StringValueSlice(r.TaskArns)...)
+			return true
+		})
+		if err != nil {
+			return nil, ListEcsTasksError(err, service)
+		}
+
+		serviceTasks := &EcsServiceTasks{ContainerInstances: map[string]string{}}
+		var containerInstanceArns []string
+		for _, arns := range chunkStrings(taskArns, maxEcsArnsPerCall) {
+			out, err := svc.DescribeTasksWithContext(ctx, &ecs.DescribeTasksInput{
+				Cluster: aws.String(service.Cluster),
+				Tasks:   aws.StringSlice(arns),
+			})
+			if err != nil {
+				return nil, ListEcsTasksError(err, service)
+			}
+			for _, task := range out.Tasks {
+				serviceTasks.Tasks = append(serviceTasks.Tasks, task)
+				if arn := aws.StringValue(task.ContainerInstanceArn); arn != "" {
+					if _, ok := serviceTasks.ContainerInstances[arn]; !ok {
+						serviceTasks.ContainerInstances[arn] = ""
+						containerInstanceArns = append(containerInstanceArns, arn)
+					}
+				}
+			}
+		}
+
+		for _, arns := range chunkStrings(containerInstanceArns, maxEcsArnsPerCall) {
+			out, err := svc.DescribeContainerInstancesWithContext(ctx, &ecs.DescribeContainerInstancesInput{
+				Cluster:            aws.String(service.Cluster),
+				ContainerInstances: aws.StringSlice(arns),
+			})
+			if err != nil {
+				return nil, ListEcsTasksError(err, service)
+			}
+			for _, containerInstance := range out.ContainerInstances {
+				serviceTasks.ContainerInstances[aws.StringValue(containerInstance.ContainerInstanceArn)] = aws.StringValue(containerInstance.Ec2InstanceId)
+			}
+		}
+		result[service] = serviceTasks
+	}
+
+	contextutils.LoggerFrom(ctx).Debugw("ec2Upstream ecs tasks result", zap.Any("value", result))
+	return result, nil
+}
+
+func chunkStrings(values []string, size int) [][]string {
+	var chunks [][]string
+	for len(values) > size {
+		chunks = append(chunks, values[:size])
+		values = values[size:]
+	}
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+	return chunks
+}
+
 var (
 	GetClientError = func(err error) error {
 		return eris.Wrapf(err, "unable to get aws client")
@@ -70,4 +192,12 @@ var (
 	DescribeInstancesError = func(err error) error {
 		return eris.Wrapf(err, "unable to describe instances")
 	}
+
+	DescribeAutoScalingGroupsError = func(err error) error {
+		return eris.Wrapf(err, "unable to describe auto scaling groups")
+	}
+
+	ListEcsTasksError = func(err error, service EcsService) error {
+		return eris.Wrapf(err, "unable to list tasks of ecs service %v in cluster %v", service.Service, service.Cluster)
+	}
 )
@@ -8,6 +8,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/protobuf/proto"
 	. "github.com/onsi/ginkgo/v2"
@@ -130,7 +131,10 @@ func testEndpointsWatcher(
 
 type mockListerResponses map[CredentialKey][]*ec2.Instance
 type mockEc2InstanceLister struct {
-	responses mockListerResponses
+	responses          mockListerResponses
+	autoScalingGroups  map[CredentialKey][]*autoscaling.Group
+	ecsTasks           map[CredentialKey]map[EcsService]*EcsServiceTasks
+	instanceListCalled bool
 }
 
 func newMockEc2InstanceLister(responses mockListerResponses) *mockEc2InstanceLister {
@@ -141,6 +145,7 @@ func newMockEc2InstanceLister(responses mockListerResponses) *mockEc2InstanceLis
 }
 
 func (m *mockEc2InstanceLister) ListForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) ([]*ec2.Instance, error) {
+	m.instanceListCalled = true
 	v, ok := m.responses[cred.GetKey()]
 	if !ok {
 		return nil, fmt.Errorf("invalid input, no test responses available")
@@ -148,6 +153,32 @@ func (m *mockEc2InstanceLister) ListForCredentials(ctx context.Context, cred *Cr
 	return v, nil
 }
 
+func (m *mockEc2InstanceLister) ListAutoScalingGroupsForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList, groupNames []string) ([]*autoscaling.Group, error) {
+	var groups []*autoscaling.Group
+	for _, group := range m.autoScalingGroups[cred.GetKey()] {
+		for _, name := range groupNames {
+			if aws.StringValue(group.AutoScalingGroupName) == name {
+				groups = append(groups, group)
+			}
+		}
+	}
+	return groups, nil
+}
+
+func (m *mockEc2InstanceLister) ListEcsTasksForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList, services []EcsService) (map[EcsService]*EcsServiceTasks, error) {
+	v, ok := m.ecsTasks[cred.GetKey()]
+	if !ok {
+		return nil, fmt.Errorf("invalid input, no test responses available")
+	}
+	result := make(map[EcsService]*EcsServiceTasks)
+	for _, service := range services {
+		if tasks, ok := v[service]; ok {
+			result[service] = tasks
+		}
+	}
+	return result, nil
+}
+
 func getSecretClient(ctx context.Context) v1.SecretClient {
 	config := &rest.Config{}
 	mc := memory.NewInMemoryResourceCache()
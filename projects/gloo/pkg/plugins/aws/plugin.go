@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"sort"
 	"unicode/utf8"
 
 	"github.com/hashicorp/go-multierror"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
@@ -32,6 +35,7 @@ import (
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 var (
@@ -39,6 +43,8 @@ var (
 	_ plugins.UpstreamPlugin   = new(Plugin)
 	_ plugins.RoutePlugin      = new(Plugin)
 	_ plugins.HttpFilterPlugin = new(Plugin)
+
+	_ plugins.ResourceGeneratorPlugin = new(Plugin)
 )
 
 const (
@@ -46,6 +52,9 @@ const (
 	FilterName                    = "io.solo.aws_lambda"
 	ResponseTransformationName    = "io.solo.api_gateway.api_gateway_transformer"
 	ResponseTransformationTypeUrl = "type.googleapis.com/envoy.config.transformer.aws_lambda.v2.ApiGatewayTransformation"
+
+	// FunctionUrlClusterPrefix prefixes the name of the clusters generated for the hosts of Lambda Function URLs
+	FunctionUrlClusterPrefix = "aws_lambda_function_url_"
 )
 
 // PerRouteConfigGenerator defines how to build the Per Route Configuration for a Lambda upstream
@@ -64,6 +73,8 @@ type Plugin struct {
 	settings                     *v1.GlooOptions_AWSOptions
 	upstreamOptions              *v1.UpstreamOptions
 	requiresTransformationFilter bool
+	// clusters of the function urls invoked by routes, by cluster name
+	functionUrlClusters map[string]*envoy_config_cluster_v3.Cluster
 }
 
 // NewPlugin creates an instance of the aws plugin and sets the non-per run
@@ -86,6 +97,7 @@ func (p *Plugin) Init(params plugins.InitParams) {
 	p.settings = params.Settings.GetGloo().GetAwsOptions()
 	p.upstreamOptions = params.Settings.GetUpstreamOptions()
 	p.requiresTransformationFilter = false
+	p.functionUrlClusters = make(map[string]*envoy_config_cluster_v3.Cluster)
 }
 
 func getLambdaHostname(s *aws.UpstreamSpec) string {
@@ -112,23 +124,11 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	out.DnsLookupFamily = envoy_config_cluster_v3.Cluster_V4_ONLY
 	pluginutils.EnvoySingleEndpointLoadAssignment(out, lambdaHostname, 443)
 
-	commonTlsContext, err := utils.GetCommonTlsContextFromUpstreamOptions(p.upstreamOptions)
-	if err != nil {
-		return err
-	}
-	tlsContext := &envoyauth.UpstreamTlsContext{
-		CommonTlsContext: commonTlsContext,
-		// TODO(yuval-k): Add verification context
-		Sni: lambdaHostname,
-	}
-	typedConfig, err := utils.MessageToAny(tlsContext)
+	transportSocket, err := p.tlsTransportSocket(lambdaHostname)
 	if err != nil {
 		return err
 	}
-	out.TransportSocket = &envoy_config_core_v3.TransportSocket{
-		Name:       wellknown.TransportSocketTls,
-		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
-	}
+	out.TransportSocket = transportSocket
 
 	// To utilize the aws lambda plugin much of the power comes via its secret management
 	// Check that one of the supported auth paradigms in enabled.
@@ -165,8 +165,34 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	return nil
 }
 
+func (p *Plugin) tlsTransportSocket(sni string) (*envoy_config_core_v3.TransportSocket, error) {
+	commonTlsContext, err := utils.GetCommonTlsContextFromUpstreamOptions(p.upstreamOptions)
+	if err != nil {
+		return nil, err
+	}
+	tlsContext := &envoyauth.UpstreamTlsContext{
+		CommonTlsContext: commonTlsContext,
+		// TODO(yuval-k): Add verification context
+		Sni: sni,
+	}
+	typedConfig, err := utils.MessageToAny(tlsContext)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_core_v3.TransportSocket{
+		Name:       wellknown.TransportSocketTls,
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}, nil
+}
+
 func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
-	err := pluginutils.MarkPerFilterConfig(params.Ctx, params.Snapshot, in, out, FilterName,
+	// routes to function urls are plain https routes, which need neither the lambda filter nor its transformations
+	routedToFunctionUrl, err := p.routeToFunctionUrl(in, out)
+	if err != nil || routedToFunctionUrl {
+		return err
+	}
+
+	err = pluginutils.MarkPerFilterConfig(params.Ctx, params.Snapshot, in, out, FilterName,
 		func(spec *v1.Destination) (proto.Message, error) {
 			logger := contextutils.LoggerFrom(params.Ctx)
 			// local variable to avoid side effects for calls that are not to aws upstreams
@@ -304,6 +330,110 @@ func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	)
 }
 
+// routeToFunctionUrl routes the requests of a route to the Lambda Function URL of its destination function,
+// if the destination invokes the function through its url. Returns whether the route was routed to a function url.
+func (p *Plugin) routeToFunctionUrl(in *v1.Route, out *envoy_config_route_v3.Route) (bool, error) {
+	for _, weightedDestination := range in.GetRouteAction().GetMulti().GetDestinations() {
+		if _, invokesFunctionUrl := p.functionUrlDestination(weightedDestination.GetDestination()); invokesFunctionUrl {
+			return false, errors.Errorf("lambda function urls can only be invoked by routes to a single destination")
+		}
+	}
+
+	destination := in.GetRouteAction().GetSingle()
+	lambdaSpec, invokesFunctionUrl := p.functionUrlDestination(destination)
+	if !invokesFunctionUrl {
+		return false, nil
+	}
+
+	logicalName := destination.GetDestinationSpec().GetAws().GetLogicalName()
+	lambdaFunc, err := findLambdaFunction(p.settings, lambdaSpec, logicalName)
+	if err != nil {
+		return false, err
+	}
+	if lambdaFunc.GetFunctionUrl() == "" {
+		return false, errors.Errorf("lambda function %v has no function url", lambdaFunc.GetLogicalName())
+	}
+	functionUrl, err := url.Parse(lambdaFunc.GetFunctionUrl())
+	if err != nil || functionUrl.Hostname() == "" {
+		return false, errors.Errorf("invalid function url %v of lambda function %v", lambdaFunc.GetFunctionUrl(), lambdaFunc.GetLogicalName())
+	}
+
+	host := functionUrl.Hostname()
+	clusterName := FunctionUrlClusterPrefix + host
+	if _, ok := p.functionUrlClusters[clusterName]; !ok {
+		cluster, err := p.functionUrlCluster(clusterName, host)
+		if err != nil {
+			return false, err
+		}
+		p.functionUrlClusters[clusterName] = cluster
+	}
+
+	routeAction := out.GetRoute()
+	if routeAction == nil {
+		return false, nil
+	}
+	routeAction.ClusterSpecifier = &envoy_config_route_v3.RouteAction_Cluster{Cluster: clusterName}
+	routeAction.HostRewriteSpecifier = &envoy_config_route_v3.RouteAction_HostRewriteLiteral{HostRewriteLiteral: host}
+	return true, nil
+}
+
+// functionUrlDestination returns the aws upstream of a destination which invokes a function through its url,
+// either by its destination spec or by the destination overrides of the upstream.
+func (p *Plugin) functionUrlDestination(destination *v1.Destination) (*aws.UpstreamSpec, bool) {
+	awsDestination := destination.GetDestinationSpec().GetAws()
+	if awsDestination == nil {
+		return nil, false
+	}
+	upstreamRef, err := upstreams.DestinationToUpstreamRef(destination)
+	if err != nil {
+		return nil, false
+	}
+	lambdaSpec, ok := p.recordedUpstreams[translator.UpstreamToClusterName(upstreamRef)]
+	if !ok {
+		return nil, false
+	}
+	return lambdaSpec, awsDestination.GetInvokeFunctionUrl() || lambdaSpec.GetDestinationOverrides().GetInvokeFunctionUrl()
+}
+
+func (p *Plugin) functionUrlCluster(name, host string) (*envoy_config_cluster_v3.Cluster, error) {
+	out := &envoy_config_cluster_v3.Cluster{
+		Name:           name,
+		ConnectTimeout: durationpb.New(translator.ClusterConnectionTimeout),
+		ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{
+			Type: envoy_config_cluster_v3.Cluster_LOGICAL_DNS,
+		},
+		DnsLookupFamily: envoy_config_cluster_v3.Cluster_V4_ONLY,
+	}
+	pluginutils.EnvoySingleEndpointLoadAssignment(out, host, 443)
+
+	transportSocket, err := p.tlsTransportSocket(host)
+	if err != nil {
+		return nil, err
+	}
+	out.TransportSocket = transportSocket
+	return out, nil
+}
+
+// GeneratedResources adds the clusters of the function urls invoked by routes.
+func (p *Plugin) GeneratedResources(_ plugins.Params,
+	_ []*envoy_config_cluster_v3.Cluster,
+	_ []*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	_ []*envoy_config_route_v3.RouteConfiguration,
+	_ []*envoy_config_listener_v3.Listener) (
+	[]*envoy_config_cluster_v3.Cluster,
+	[]*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	[]*envoy_config_route_v3.RouteConfiguration,
+	[]*envoy_config_listener_v3.Listener, error) {
+	var generatedClusters []*envoy_config_cluster_v3.Cluster
+	for _, cluster := range p.functionUrlClusters {
+		generatedClusters = append(generatedClusters, cluster)
+	}
+	sort.Slice(generatedClusters, func(i, j int) bool {
+		return generatedClusters[i].GetName() < generatedClusters[j].GetName()
+	})
+	return generatedClusters, nil, nil, nil, nil
+}
+
 func (p *Plugin) HttpFilters(_ plugins.Params, _ *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
 	if len(p.recordedUpstreams) == 0 {
 		// no upstreams no filter
@@ -356,29 +486,9 @@ func GenerateAWSLambdaRouteConfig(options *v1.GlooOptions_AWSOptions, destinatio
 		mergo.Merge(destination, upstream.GetDestinationOverrides())
 	}
 
-	logicalName := destination.GetLogicalName()
-	if len(upstream.GetLambdaFunctions()) == 0 {
-		return nil, errors.Errorf("lambda points to upstream with no functions %v", logicalName)
-	}
-
-	// Validate whether there is a function that conforms to our request
-	var lambdaFunc *aws.LambdaFunctionSpec
-	for _, candidateLambdaFunc := range upstream.GetLambdaFunctions() {
-		if candidateLambdaFunc.GetLogicalName() == logicalName {
-			lambdaFunc = candidateLambdaFunc
-			break
-		}
-	}
-
-	if lambdaFunc == nil {
-		// pull from options to see if we allow not setting the function on a route
-		// this is dangerous due to name ordering when discovery is on https://github.com/solo-io/gloo/tree/main/projects/discovery/pkg/fds/discoveries/aws/aws.go#L75
-		tryFallback := options.GetFallbackToFirstFunction().GetValue()
-		if !tryFallback {
-			return nil, errors.Errorf("unknown lambda function %v", logicalName)
-		}
-		// Check at the start of the function to make sure that there exists at least one function.
-		lambdaFunc = upstream.GetLambdaFunctions()[0]
+	lambdaFunc, err := findLambdaFunction(options, upstream, destination.GetLogicalName())
+	if err != nil {
+		return nil, err
 	}
 
 	functionName := lambdaFunc.GetLambdaFunctionName()
@@ -430,6 +540,30 @@ func GenerateAWSLambdaRouteConfig(options *v1.GlooOptions_AWSOptions, destinatio
 
 }
 
+// findLambdaFunction returns the function of an upstream with the given logical name,
+// or its first function if there is none and fallbackToFirstFunction is enabled.
+func findLambdaFunction(options *v1.GlooOptions_AWSOptions, upstream *aws.UpstreamSpec, logicalName string) (*aws.LambdaFunctionSpec, error) {
+	if len(upstream.GetLambdaFunctions()) == 0 {
+		return nil, errors.Errorf("lambda points to upstream with no functions %v", logicalName)
+	}
+
+	// Validate whether there is a function that conforms to our request
+	for _, candidateLambdaFunc := range upstream.GetLambdaFunctions() {
+		if candidateLambdaFunc.GetLogicalName() == logicalName {
+			return candidateLambdaFunc, nil
+		}
+	}
+
+	// pull from options to see if we allow not setting the function on a route
+	// this is dangerous due to name ordering when discovery is on https://github.com/solo-io/gloo/tree/main/projects/discovery/pkg/fds/discoveries/aws/aws.go#L75
+	tryFallback := options.GetFallbackToFirstFunction().GetValue()
+	if !tryFallback {
+		return nil, errors.Errorf("unknown lambda function %v", logicalName)
+	}
+	// Check at the start of the function to make sure that there exists at least one function.
+	return upstream.GetLambdaFunctions()[0], nil
+}
+
 type staticSecretDerivation struct {
 	access, session, secret string
 }
@@ -610,6 +610,78 @@ var _ = Describe("Plugin", func() {
 		})
 	})
 
+	Context("function urls", func() {
+
+		var awsDestination *aws.DestinationSpec
+
+		BeforeEach(func() {
+			upstream.GetAws().GetLambdaFunctions()[0].FunctionUrl = "https://abcdefg.lambda-url.us-east-1.on.aws/"
+		})
+
+		JustBeforeEach(func() {
+			err := awsPlugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			awsDestination = route.GetRouteAction().GetSingle().GetDestinationSpec().GetAws()
+			awsDestination.InvokeFunctionUrl = true
+		})
+
+		generatedClusters := func() []*envoy_config_cluster_v3.Cluster {
+			clusters, _, _, _, err := awsPlugin.(plugins.ResourceGeneratorPlugin).GeneratedResources(params, nil, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			return clusters
+		}
+
+		It("routes to the cluster of the function url instead of invoking the function", func() {
+			err := awsPlugin.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{VirtualHostParams: vhostParams}, route, outroute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outroute.TypedPerFilterConfig).NotTo(HaveKey(FilterName))
+			Expect(outroute.GetRoute().GetCluster()).To(Equal(FunctionUrlClusterPrefix + "abcdefg.lambda-url.us-east-1.on.aws"))
+			Expect(outroute.GetRoute().GetHostRewriteLiteral()).To(Equal("abcdefg.lambda-url.us-east-1.on.aws"))
+
+			clusters := generatedClusters()
+			Expect(clusters).To(HaveLen(1))
+			Expect(clusters[0].GetName()).To(Equal(outroute.GetRoute().GetCluster()))
+			Expect(clusters[0].GetType()).To(Equal(envoy_config_cluster_v3.Cluster_LOGICAL_DNS))
+			Expect(clusters[0].GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetSocketAddress().GetAddress()).
+				To(Equal("abcdefg.lambda-url.us-east-1.on.aws"))
+			Expect(getClusterTlsContext(clusters[0]).GetSni()).To(Equal("abcdefg.lambda-url.us-east-1.on.aws"))
+		})
+
+		It("routes to the function url if the upstream overrides the destination spec", func() {
+			upstream.GetAws().DestinationOverrides = &aws.DestinationSpec{InvokeFunctionUrl: true}
+			awsPlugin.Init(initParams)
+			err := awsPlugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			awsDestination.InvokeFunctionUrl = false
+
+			err = awsPlugin.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{VirtualHostParams: vhostParams}, route, outroute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outroute.GetRoute().GetCluster()).To(Equal(FunctionUrlClusterPrefix + "abcdefg.lambda-url.us-east-1.on.aws"))
+		})
+
+		It("should error when the function has no function url", func() {
+			upstream.GetAws().GetLambdaFunctions()[0].FunctionUrl = ""
+
+			err := awsPlugin.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{VirtualHostParams: vhostParams}, route, outroute)
+			Expect(err).To(MatchError("lambda function foo has no function url"))
+			Expect(generatedClusters()).To(BeEmpty())
+		})
+
+		It("should error on routes to multiple destinations", func() {
+			route.GetRouteAction().Destination = &v1.RouteAction_Multi{
+				Multi: &v1.MultiDestination{
+					Destinations: []*v1.WeightedDestination{{
+						Destination: route.GetRouteAction().GetSingle(),
+						Weight:      &wrapperspb.UInt32Value{Value: 1},
+					}},
+				},
+			}
+
+			err := awsPlugin.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{VirtualHostParams: vhostParams}, route, outroute)
+			Expect(err).To(MatchError("lambda function urls can only be invoked by routes to a single destination"))
+		})
+	})
+
 	Context("filters", func() {
 		It("should produce filters when upstream is present", func() {
 			// process upstream
@@ -0,0 +1,13 @@
+package aws_request_signing_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAwsRequestSigning(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AWS Request Signing Suite")
+}
@@ -0,0 +1,147 @@
+package aws_request_signing
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_config_aws_request_signing_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/aws_request_signing/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws_request_signing"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.UpstreamPlugin   = new(plugin)
+	_ plugins.RoutePlugin      = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+)
+
+// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/aws_request_signing_filter
+const (
+	ExtensionName = "aws_request_signing"
+	FilterName    = "envoy.filters.http.aws_request_signing"
+	StatPrefix    = "aws_request_signing"
+)
+
+// Sign in the same stage as the other upstream auth (i.e. aws λ) so that the signature
+// covers the headers added by earlier filters.
+var pluginStage = plugins.DuringStage(plugins.OutAuthStage)
+
+var (
+	MissingServiceNameError = eris.New("aws request signing requires a service name")
+	MissingRegionError      = eris.New("aws request signing requires a region")
+)
+
+type plugin struct {
+	filterRequiredForListener map[*v1.HttpListener]struct{}
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.filterRequiredForListener = make(map[*v1.HttpListener]struct{})
+}
+
+// ProcessUpstream only validates the signing options so that errors are reported on the upstream.
+// The options are applied on the routes which send traffic to the upstream.
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	_, err := translateSigning(in.GetAwsRequestSigning())
+	return err
+}
+
+// ProcessRoute signs the requests of a route with the route's signing options if it has any,
+// otherwise with the signing options of each destination upstream.
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	if in.GetRouteAction() == nil {
+		return nil
+	}
+
+	if routeSigning := in.GetOptions().GetAwsRequestSigning(); routeSigning != nil {
+		perRoute, err := translateSigning(routeSigning)
+		if err != nil {
+			return err
+		}
+		p.filterRequiredForListener[params.HttpListener] = struct{}{}
+		return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRoute)
+	}
+
+	return pluginutils.MarkPerFilterConfig(params.Ctx, params.Snapshot, in, out, FilterName, func(spec *v1.Destination) (proto.Message, error) {
+		ref, err := upstreams.DestinationToUpstreamRef(spec)
+		if err != nil {
+			return nil, nil
+		}
+		// missing upstreams are reported by the route validation
+		upstream, err := params.Snapshot.Upstreams.Find(ref.Strings())
+		if err != nil || upstream.GetAwsRequestSigning() == nil {
+			return nil, nil
+		}
+		perRoute, err := translateSigning(upstream.GetAwsRequestSigning())
+		if err != nil {
+			return nil, err
+		}
+		p.filterRequiredForListener[params.HttpListener] = struct{}{}
+		return perRoute, nil
+	})
+}
+
+// HttpFilters adds a disabled signing filter to listeners with signed routes. The filter is
+// enabled by the per-route configuration, so unsigned routes pass through untouched.
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if _, ok := p.filterRequiredForListener[listener]; !ok {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+
+	// the filter validates its base configuration even when disabled, so give it a placeholder
+	// which is always overridden by the per-route configuration
+	stagedFilter, err := plugins.NewStagedFilter(FilterName, &envoy_config_aws_request_signing_v3.AwsRequestSigning{
+		ServiceName: "disabled",
+		Region:      "disabled",
+	}, pluginStage)
+	if err != nil {
+		return nil, err
+	}
+	stagedFilter.HttpFilter.Disabled = true
+	return []plugins.StagedHttpFilter{stagedFilter}, nil
+}
+
+func translateSigning(in *aws_request_signing.AwsRequestSigning) (*envoy_config_aws_request_signing_v3.AwsRequestSigningPerRoute, error) {
+	if in == nil {
+		return nil, nil
+	}
+	if in.GetServiceName() == "" {
+		return nil, MissingServiceNameError
+	}
+	if in.GetRegion() == "" {
+		return nil, MissingRegionError
+	}
+
+	var excludedHeaders []*envoy_type_matcher_v3.StringMatcher
+	for _, header := range in.GetExcludedHeaders() {
+		excludedHeaders = append(excludedHeaders, &envoy_type_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: header},
+		})
+	}
+
+	return &envoy_config_aws_request_signing_v3.AwsRequestSigningPerRoute{
+		AwsRequestSigning: &envoy_config_aws_request_signing_v3.AwsRequestSigning{
+			ServiceName:          in.GetServiceName(),
+			Region:               in.GetRegion(),
+			HostRewrite:          in.GetHostRewrite(),
+			UseUnsignedPayload:   in.GetUseUnsignedPayload(),
+			MatchExcludedHeaders: excludedHeaders,
+			SigningAlgorithm:     envoy_config_aws_request_signing_v3.AwsRequestSigning_SigningAlgorithm(in.GetSigningAlgorithm()),
+		},
+		StatPrefix: StatPrefix,
+	}, nil
+}
@@ -0,0 +1,159 @@
+package aws_request_signing_test
+
+import (
+	"context"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_config_aws_request_signing_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/aws_request_signing/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws_request_signing"
+	static_plugin_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws_request_signing"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p            plugins.Plugin
+		params       plugins.RouteParams
+		httpListener *v1.HttpListener
+		upstream     *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		p.Init(plugins.InitParams{})
+
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "opensearch", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static_plugin_gloo.UpstreamSpec{
+					Hosts: []*static_plugin_gloo.Host{{Addr: "search.us-east-1.es.amazonaws.com", Port: 443}},
+				},
+			},
+			AwsRequestSigning: &aws_request_signing.AwsRequestSigning{
+				ServiceName:     "es",
+				Region:          "us-east-1",
+				ExcludedHeaders: []string{"x-trace-id"},
+			},
+		}
+		httpListener = &v1.HttpListener{}
+		params = plugins.RouteParams{
+			VirtualHostParams: plugins.VirtualHostParams{
+				Params: plugins.Params{
+					Ctx:      context.Background(),
+					Snapshot: &gloosnapshot.ApiSnapshot{Upstreams: v1.UpstreamList{upstream}},
+				},
+				HttpListener: httpListener,
+			},
+		}
+	})
+
+	routeTo := func(ref *core.ResourceRef, options *v1.RouteOptions) *v1.Route {
+		return &v1.Route{
+			Action: &v1.Route_RouteAction{
+				RouteAction: &v1.RouteAction{
+					Destination: &v1.RouteAction_Single{
+						Single: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{Upstream: ref},
+						},
+					},
+				},
+			},
+			Options: options,
+		}
+	}
+
+	newOut := func() *envoy_config_route_v3.Route {
+		return &envoy_config_route_v3.Route{
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: &envoy_config_route_v3.RouteAction{},
+			},
+		}
+	}
+
+	expectPerRoute := func(out *envoy_config_route_v3.Route, expected *envoy_config_aws_request_signing_v3.AwsRequestSigning) {
+		typedConfig, err := utils.MessageToAny(&envoy_config_aws_request_signing_v3.AwsRequestSigningPerRoute{
+			AwsRequestSigning: expected,
+			StatPrefix:        StatPrefix,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()[FilterName]).To(matchers.MatchProto(typedConfig))
+	}
+
+	It("signs requests to upstreams with signing options", func() {
+		out := newOut()
+		err := p.(plugins.RoutePlugin).ProcessRoute(params, routeTo(upstream.GetMetadata().Ref(), nil), out)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectPerRoute(out, &envoy_config_aws_request_signing_v3.AwsRequestSigning{
+			ServiceName: "es",
+			Region:      "us-east-1",
+			MatchExcludedHeaders: []*envoy_type_matcher_v3.StringMatcher{{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: "x-trace-id"},
+			}},
+		})
+
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params.Params, httpListener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+		Expect(filters[0].HttpFilter.GetDisabled()).To(BeTrue())
+		Expect(filters[0].Stage).To(Equal(plugins.DuringStage(plugins.OutAuthStage)))
+	})
+
+	It("prefers the route's signing options over the upstream's", func() {
+		out := newOut()
+		err := p.(plugins.RoutePlugin).ProcessRoute(params, routeTo(upstream.GetMetadata().Ref(), &v1.RouteOptions{
+			AwsRequestSigning: &aws_request_signing.AwsRequestSigning{
+				ServiceName:        "s3",
+				Region:             "us-west-2",
+				HostRewrite:        "bucket.s3.us-west-2.amazonaws.com",
+				UseUnsignedPayload: true,
+				SigningAlgorithm:   aws_request_signing.AwsRequestSigning_AWS_SIGV4A,
+			},
+		}), out)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectPerRoute(out, &envoy_config_aws_request_signing_v3.AwsRequestSigning{
+			ServiceName:        "s3",
+			Region:             "us-west-2",
+			HostRewrite:        "bucket.s3.us-west-2.amazonaws.com",
+			UseUnsignedPayload: true,
+			SigningAlgorithm:   envoy_config_aws_request_signing_v3.AwsRequestSigning_AWS_SIGV4A,
+		})
+	})
+
+	It("does not add the filter when no route is signed", func() {
+		upstream.AwsRequestSigning = nil
+
+		out := newOut()
+		err := p.(plugins.RoutePlugin).ProcessRoute(params, routeTo(upstream.GetMetadata().Ref(), nil), out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()).NotTo(HaveKey(FilterName))
+
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(params.Params, httpListener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("rejects signing options without a service name or region", func() {
+		upstream.AwsRequestSigning = &aws_request_signing.AwsRequestSigning{Region: "us-east-1"}
+		err := p.(plugins.UpstreamPlugin).ProcessUpstream(params.Params, upstream, nil)
+		Expect(err).To(MatchError(MissingServiceNameError))
+
+		out := newOut()
+		err = p.(plugins.RoutePlugin).ProcessRoute(params, routeTo(upstream.GetMetadata().Ref(), &v1.RouteOptions{
+			AwsRequestSigning: &aws_request_signing.AwsRequestSigning{ServiceName: "execute-api"},
+		}), out)
+		Expect(err).To(MatchError(MissingRegionError))
+	})
+})
@@ -5,13 +5,17 @@ import (
 	"fmt"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_previous_hosts_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
+	envoy_previous_priorities_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/priority/previous_priorities/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/solo-io/gloo/pkg/utils/regexutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/upgradeconfig"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/errors"
 )
 
@@ -22,6 +26,16 @@ var (
 
 const (
 	ExtensionName = "basic_route"
+
+	// PreviousHostsPredicateName is the name of the Envoy retry host predicate rejecting the hosts already attempted
+	PreviousHostsPredicateName = "envoy.retry_host_predicates.previous_hosts"
+	// PreviousPrioritiesName is the name of the Envoy retry priority excluding the priority levels already attempted
+	PreviousPrioritiesName = "envoy.retry_priorities.previous_priorities"
+	// RetryAfterHeader is the header rate-limited retries back off by when no reset header is configured
+	RetryAfterHeader = "Retry-After"
+
+	defaultHostSelectionRetryMaxAttempts     = 3
+	defaultPreviousPrioritiesUpdateFrequency = 2
 )
 
 // Handles a RoutePlugin APIs which map directly to basic Envoy config
@@ -46,7 +60,7 @@ func (p *plugin) ProcessVirtualHost(
 	if in.GetOptions() == nil {
 		return nil
 	}
-	return applyRetriesVhost(in, out)
+	return applyRetriesVhost(params.Ctx, in, out)
 }
 
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
@@ -74,7 +88,7 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	if err := applyMaxStreamDuration(in, out); err != nil {
 		return err
 	}
-	if err := applyRetries(in, out); err != nil {
+	if err := applyRetries(params.Ctx, in, out); err != nil {
 		return err
 	}
 	if err := applyHostRewrite(params.Ctx, in, out); err != nil {
@@ -178,7 +192,7 @@ func applyMaxStreamDuration(in *v1.Route, out *envoy_config_route_v3.Route) erro
 	return nil
 }
 
-func applyRetries(in *v1.Route, out *envoy_config_route_v3.Route) error {
+func applyRetries(ctx context.Context, in *v1.Route, out *envoy_config_route_v3.Route) error {
 	policy := in.GetOptions().GetRetries()
 	if policy == nil {
 		return nil
@@ -193,7 +207,7 @@ func applyRetries(in *v1.Route, out *envoy_config_route_v3.Route) error {
 	}
 
 	var err error
-	routeAction.Route.RetryPolicy, err = convertPolicy(policy)
+	routeAction.Route.RetryPolicy, err = convertPolicy(ctx, policy)
 	if err != nil {
 		return err
 	}
@@ -285,16 +299,16 @@ func applyUpgrades(in *v1.Route, out *envoy_config_route_v3.Route) error {
 	return upgradeconfig.ValidateRouteUpgradeConfigs(routeAction.Route.GetUpgradeConfigs())
 }
 
-func applyRetriesVhost(in *v1.VirtualHost, out *envoy_config_route_v3.VirtualHost) error {
+func applyRetriesVhost(ctx context.Context, in *v1.VirtualHost, out *envoy_config_route_v3.VirtualHost) error {
 	var err error
-	out.RetryPolicy, err = convertPolicy(in.GetOptions().GetRetries())
+	out.RetryPolicy, err = convertPolicy(ctx, in.GetOptions().GetRetries())
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func convertPolicy(policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPolicy, error) {
+func convertPolicy(ctx context.Context, policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPolicy, error) {
 	if policy == nil {
 		return nil, nil
 	}
@@ -304,10 +318,15 @@ func convertPolicy(policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPol
 		numRetries = 1
 	}
 
-	v3RetryPolicyBackOff := &envoy_config_route_v3.RetryPolicy_RetryBackOff{}
+	out := &envoy_config_route_v3.RetryPolicy{
+		RetryOn:       policy.GetRetryOn(),
+		NumRetries:    &wrappers.UInt32Value{Value: numRetries},
+		PerTryTimeout: policy.GetPerTryTimeout(),
+	}
 
 	// Let's make some checks
 	if retryPolicyInterval := policy.GetRetryBackOff(); retryPolicyInterval != nil {
+		v3RetryPolicyBackOff := &envoy_config_route_v3.RetryPolicy_RetryBackOff{}
 
 		baseInterval := retryPolicyInterval.GetBaseInterval()
 		maxInterval := retryPolicyInterval.GetMaxInterval()
@@ -351,18 +370,97 @@ func convertPolicy(policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPol
 			}
 		}
 
-		// If max and/or/both base intervals are defined, return a RetryPolicy object that contains them
-		return &envoy_config_route_v3.RetryPolicy{
-			RetryOn:       policy.GetRetryOn(),
-			NumRetries:    &wrappers.UInt32Value{Value: numRetries},
-			PerTryTimeout: policy.GetPerTryTimeout(),
-			RetryBackOff:  v3RetryPolicyBackOff,
-		}, nil
+		// If max and/or/both base intervals are defined, the RetryPolicy contains them
+		out.RetryBackOff = v3RetryPolicyBackOff
 	}
 
-	return &envoy_config_route_v3.RetryPolicy{
-		RetryOn:       policy.GetRetryOn(),
-		NumRetries:    &wrappers.UInt32Value{Value: numRetries},
-		PerTryTimeout: policy.GetPerTryTimeout(),
-	}, nil
+	for _, code := range policy.GetRetriableStatusCodes() {
+		if code < 100 || code > 599 {
+			return nil, errors.Errorf("retriable status code %d is not a valid HTTP status code", code)
+		}
+	}
+	out.RetriableStatusCodes = policy.GetRetriableStatusCodes()
+	out.RetriableHeaders = pluginutils.EnvoyHeaderMatchers(ctx, policy.GetRetriableHeaders())
+
+	if err := applyRetryPredicates(policy, out); err != nil {
+		return nil, err
+	}
+
+	rateLimitedRetryBackOff, err := convertRateLimitedRetryBackOff(policy.GetRateLimitedRetryBackOff())
+	if err != nil {
+		return nil, err
+	}
+	out.RateLimitedRetryBackOff = rateLimitedRetryBackOff
+
+	return out, nil
+}
+
+// applyRetryPredicates makes the retries avoid the hosts and priority levels that were already attempted
+func applyRetryPredicates(policy *retries.RetryPolicy, out *envoy_config_route_v3.RetryPolicy) error {
+	if previousHosts := policy.GetPreviousHosts(); previousHosts != nil {
+		predicate, err := utils.MessageToAny(&envoy_previous_hosts_v3.PreviousHostsPredicate{})
+		if err != nil {
+			return err
+		}
+		out.RetryHostPredicate = []*envoy_config_route_v3.RetryPolicy_RetryHostPredicate{{
+			Name:       PreviousHostsPredicateName,
+			ConfigType: &envoy_config_route_v3.RetryPolicy_RetryHostPredicate_TypedConfig{TypedConfig: predicate},
+		}}
+		out.HostSelectionRetryMaxAttempts = defaultHostSelectionRetryMaxAttempts
+		if maxAttempts := previousHosts.GetHostSelectionRetryMaxAttempts(); maxAttempts != nil {
+			out.HostSelectionRetryMaxAttempts = int64(maxAttempts.GetValue())
+		}
+	}
+
+	if previousPriorities := policy.GetPreviousPriorities(); previousPriorities != nil {
+		updateFrequency := uint32(defaultPreviousPrioritiesUpdateFrequency)
+		if frequency := previousPriorities.GetUpdateFrequency(); frequency != nil {
+			if frequency.GetValue() == 0 {
+				return errors.Errorf("update frequency of the previous priorities retry predicate must be greater than 0")
+			}
+			updateFrequency = frequency.GetValue()
+		}
+		priority, err := utils.MessageToAny(&envoy_previous_priorities_v3.PreviousPrioritiesConfig{
+			UpdateFrequency: int32(updateFrequency),
+		})
+		if err != nil {
+			return err
+		}
+		out.RetryPriority = &envoy_config_route_v3.RetryPolicy_RetryPriority{
+			Name:       PreviousPrioritiesName,
+			ConfigType: &envoy_config_route_v3.RetryPolicy_RetryPriority_TypedConfig{TypedConfig: priority},
+		}
+	}
+	return nil
+}
+
+func convertRateLimitedRetryBackOff(in *retries.RateLimitedRetryBackOff) (*envoy_config_route_v3.RetryPolicy_RateLimitedRetryBackOff, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &envoy_config_route_v3.RetryPolicy_RateLimitedRetryBackOff{
+		MaxInterval: in.GetMaxInterval(),
+	}
+	if maxInterval := in.GetMaxInterval(); maxInterval != nil && maxInterval.AsDuration().Milliseconds() <= 0 {
+		return nil, errors.Errorf("max interval for rate limited retry backoff was <= than 0 | you provided: %d",
+			maxInterval.AsDuration().Milliseconds())
+	}
+
+	for _, header := range in.GetResetHeaders() {
+		if header.GetName() == "" {
+			return nil, errors.Errorf("reset headers of the rate limited retry backoff must have a name")
+		}
+		out.ResetHeaders = append(out.GetResetHeaders(), &envoy_config_route_v3.RetryPolicy_ResetHeader{
+			Name:   header.GetName(),
+			Format: envoy_config_route_v3.RetryPolicy_ResetHeaderFormat(header.GetFormat()),
+		})
+	}
+	if len(out.GetResetHeaders()) == 0 {
+		out.ResetHeaders = []*envoy_config_route_v3.RetryPolicy_ResetHeader{{
+			Name:   RetryAfterHeader,
+			Format: envoy_config_route_v3.RetryPolicy_SECONDS,
+		}}
+	}
+	return out, nil
 }
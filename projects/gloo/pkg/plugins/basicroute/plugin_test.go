@@ -12,11 +12,13 @@ import (
 	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_previous_priorities_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/priority/previous_priorities/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	v32 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -490,6 +492,109 @@ var _ = Describe("retries with both intervals", func() {
 		Expect(out.RetryPolicy).To(Equal(expectedRetryPolicy))
 	})
 })
+var _ = Describe("retries with predicates and retriable responses", func() {
+	var (
+		retryPolicy *retries.RetryPolicy
+	)
+
+	BeforeEach(func() {
+		retryPolicy = &retries.RetryPolicy{
+			RetryOn:              "retriable-status-codes,retriable-headers",
+			NumRetries:           2,
+			RetriableStatusCodes: []uint32{429, 503},
+			RetriableHeaders: []*matchers.HeaderMatcher{
+				{Name: "x-upstream-retry", Value: "true"},
+				{Name: "x-overloaded"},
+			},
+			PreviousHosts:      &retries.PreviousHosts{},
+			PreviousPriorities: &retries.PreviousPriorities{UpdateFrequency: &wrappers.UInt32Value{Value: 3}},
+			RateLimitedRetryBackOff: &retries.RateLimitedRetryBackOff{
+				MaxInterval: durationpb.New(time.Minute),
+			},
+		}
+	})
+
+	processRoute := func() (*envoy_config_route_v3.RetryPolicy, error) {
+		routeAction := &envoy_config_route_v3.RouteAction{}
+		out := &envoy_config_route_v3.Route{
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: routeAction,
+			},
+		}
+		err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				Retries: retryPolicy,
+			},
+			Action: &v1.Route_RouteAction{},
+		}, out)
+		return routeAction.GetRetryPolicy(), err
+	}
+
+	It("works", func() {
+		retryPolicy, err := processRoute()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(retryPolicy.GetRetriableStatusCodes()).To(Equal([]uint32{429, 503}))
+		Expect(retryPolicy.GetRetriableHeaders()).To(HaveLen(2))
+		Expect(retryPolicy.GetRetriableHeaders()[0].GetExactMatch()).To(Equal("true"))
+		Expect(retryPolicy.GetRetriableHeaders()[1].GetPresentMatch()).To(BeTrue())
+
+		Expect(retryPolicy.GetRetryHostPredicate()).To(HaveLen(1))
+		Expect(retryPolicy.GetRetryHostPredicate()[0].GetName()).To(Equal(PreviousHostsPredicateName))
+		Expect(retryPolicy.GetHostSelectionRetryMaxAttempts()).To(BeEquivalentTo(3))
+
+		Expect(retryPolicy.GetRetryPriority().GetName()).To(Equal(PreviousPrioritiesName))
+		var previousPriorities envoy_previous_priorities_v3.PreviousPrioritiesConfig
+		Expect(retryPolicy.GetRetryPriority().GetTypedConfig().UnmarshalTo(&previousPriorities)).NotTo(HaveOccurred())
+		Expect(previousPriorities.GetUpdateFrequency()).To(BeEquivalentTo(3))
+
+		Expect(retryPolicy.GetRateLimitedRetryBackOff()).To(Equal(&envoy_config_route_v3.RetryPolicy_RateLimitedRetryBackOff{
+			ResetHeaders: []*envoy_config_route_v3.RetryPolicy_ResetHeader{{
+				Name:   RetryAfterHeader,
+				Format: envoy_config_route_v3.RetryPolicy_SECONDS,
+			}},
+			MaxInterval: durationpb.New(time.Minute),
+		}))
+	})
+
+	It("translates the reset headers of the rate limited back-off", func() {
+		retryPolicy.RateLimitedRetryBackOff.ResetHeaders = []*retries.RateLimitedRetryBackOff_ResetHeader{{
+			Name:   "X-RateLimit-Reset",
+			Format: retries.RateLimitedRetryBackOff_UNIX_TIMESTAMP,
+		}}
+		retryPolicy, err := processRoute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retryPolicy.GetRateLimitedRetryBackOff().GetResetHeaders()).To(Equal([]*envoy_config_route_v3.RetryPolicy_ResetHeader{{
+			Name:   "X-RateLimit-Reset",
+			Format: envoy_config_route_v3.RetryPolicy_UNIX_TIMESTAMP,
+		}}))
+	})
+
+	It("rejects invalid status codes", func() {
+		retryPolicy.RetriableStatusCodes = []uint32{503, 42}
+		_, err := processRoute()
+		Expect(err).To(MatchError("retriable status code 42 is not a valid HTTP status code"))
+	})
+
+	It("rejects a zero update frequency of the previous priorities", func() {
+		retryPolicy.PreviousPriorities.UpdateFrequency = &wrappers.UInt32Value{Value: 0}
+		_, err := processRoute()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("works on vhost", func() {
+		out := &envoy_config_route_v3.VirtualHost{}
+		err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				Retries: retryPolicy,
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetRetryPolicy().GetRetryHostPredicate()).To(HaveLen(1))
+		Expect(out.GetRetryPolicy().GetRetriableStatusCodes()).To(Equal([]uint32{429, 503}))
+	})
+})
+
 var _ = Describe("host rewrite", func() {
 	It("rewrites using provided string", func() {
 
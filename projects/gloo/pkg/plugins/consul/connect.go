@@ -0,0 +1,410 @@
+package consul
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/avast/retry-go"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/constants"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooConsul "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	skerrors "github.com/solo-io/solo-kit/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	DefaultConnectServiceName = "gloo"
+
+	// annotations on the Connect secret which record the identity that Consul issued the leaf certificate for
+	ConnectTrustDomainAnnotation = "consul.solo.io/connect-trust-domain"
+	ConnectDataCenterAnnotation  = "consul.solo.io/connect-datacenter"
+
+	// Consul only supports the default namespace outside of Consul Enterprise
+	connectNamespace = "default"
+
+	// the key of the transport socket match metadata of an endpoint that holds its data center
+	connectDataCenterMatchKey = "consul_connect_dc"
+
+	// the filter metadata that holds the labels of an endpoint
+	envoyLbMetadataKey = "envoy.lb"
+)
+
+var (
+	ConnectSecretNotFoundError = func(ref *core.ResourceRef, err error) error {
+		return eris.Wrapf(err, "consul connect certificates not found in secret %v, they are written once the consul agent has issued them", ref.Key())
+	}
+
+	ConnectTrustDomainMissingError = func(ref *core.ResourceRef) error {
+		return eris.Errorf("consul connect secret %v is missing the %v annotation", ref.Key(), ConnectTrustDomainAnnotation)
+	}
+
+	ConnectDataCenterUnknownError = func(ref *core.ResourceRef, service string) error {
+		return eris.Errorf("the data center of consul service %v is unknown, as its upstream lists no data centers and consul connect secret %v is missing the %v annotation",
+			service, ref.Key(), ConnectDataCenterAnnotation)
+	}
+)
+
+// ConnectServiceName returns the name of the Connect service Gloo identifies as
+func ConnectServiceName(settings *v1.Settings) string {
+	if name := settings.GetConsulDiscovery().GetConnect().GetServiceName(); name != "" {
+		return name
+	}
+	return DefaultConnectServiceName
+}
+
+// ConnectSecretRef returns the ref to the secret that holds Gloo's Connect leaf certificate and CA roots
+func ConnectSecretRef(settings *v1.Settings) *core.ResourceRef {
+	ref := &core.ResourceRef{
+		Name:      "consul-connect-" + ConnectServiceName(settings),
+		Namespace: settings.GetDiscoveryNamespace(),
+	}
+	if configured := settings.GetConsulDiscovery().GetConnect().GetSecretRef(); configured != nil {
+		if configured.GetName() != "" {
+			ref.Name = configured.GetName()
+		}
+		if configured.GetNamespace() != "" {
+			ref.Namespace = configured.GetNamespace()
+		}
+	}
+	if ref.GetNamespace() == "" {
+		ref.Namespace = defaults.GlooSystem
+	}
+	return ref
+}
+
+func connectEnabled(settings *v1.Settings, spec *glooConsul.UpstreamSpec) bool {
+	return spec.GetConnectEnabled() && settings.GetConsulDiscovery().GetConnect() != nil
+}
+
+// SyncConnectCertificates watches the Connect CA roots and Gloo's Connect leaf certificate on the local Consul agent
+// and writes them to the Connect secret whenever Consul issues or rotates them.
+// Blocks until the context is cancelled.
+func SyncConnectCertificates(ctx context.Context, client consul.ClientWrapper, secretClient v1.SecretClient, settings *v1.Settings) error {
+	logger := contextutils.LoggerFrom(ctx)
+	ref := ConnectSecretRef(settings)
+	serviceName := ConnectServiceName(settings)
+
+	rootsChan, rootsErrs := watchConnect(ctx, func(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+		return client.ConnectCARoots(q)
+	})
+	leafChan, leafErrs := watchConnect(ctx, func(q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+		return client.ConnectCALeaf(serviceName, q)
+	})
+
+	var (
+		roots *consulapi.CARootList
+		leaf  *consulapi.LeafCert
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-rootsChan:
+			if !ok {
+				return nil
+			}
+			roots = update
+		case update, ok := <-leafChan:
+			if !ok {
+				return nil
+			}
+			leaf = update
+		case err, ok := <-rootsErrs:
+			if !ok {
+				rootsErrs = nil
+				continue
+			}
+			logger.Warnw("failed to fetch consul connect CA roots", zap.Error(err))
+			continue
+		case err, ok := <-leafErrs:
+			if !ok {
+				leafErrs = nil
+				continue
+			}
+			logger.Warnw("failed to fetch consul connect leaf certificate", zap.String("service", serviceName), zap.Error(err))
+			continue
+		}
+
+		if roots == nil || leaf == nil {
+			continue
+		}
+		secret := connectSecret(ref, roots, leaf)
+		err := retry.Do(
+			func() error {
+				return writeConnectSecret(ctx, secretClient, secret)
+			},
+			retry.Attempts(5),
+			retry.Delay(100*time.Millisecond),
+			retry.DelayType(retry.BackOffDelay),
+		)
+		if err != nil {
+			logger.Errorw("failed to write consul connect secret", zap.String("secret", ref.Key()), zap.Error(err))
+			continue
+		}
+		logger.Debugw("wrote consul connect secret", zap.String("secret", ref.Key()), zap.String("serial", leaf.SerialNumber), zap.Time("validBefore", leaf.ValidBefore))
+	}
+}
+
+// Runs a blocking query (see [here](https://www.consul.io/api/features/blocking.html) for more info) against the
+// agent's Connect endpoints, sending the result every time it changes.
+// The agent itself renews the leaf certificate before it expires, which unblocks the query.
+func watchConnect[T any](ctx context.Context, query func(q *consulapi.QueryOptions) (T, *consulapi.QueryMeta, error)) (<-chan T, <-chan error) {
+	resultChan := make(chan T)
+	errsChan := make(chan error)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errsChan)
+
+		lastIndex := uint64(0)
+		for ctx.Err() == nil {
+			var (
+				result    T
+				queryMeta *consulapi.QueryMeta
+			)
+			err := retry.Do(
+				func() error {
+					if ctx.Err() != nil {
+						return nil
+					}
+					var err error
+					result, queryMeta, err = query((&consulapi.QueryOptions{WaitIndex: lastIndex}).WithContext(ctx))
+					return err
+				},
+				retry.Attempts(6),
+				//  Last delay is 2^6 * 100ms = 3.2s
+				retry.Delay(100*time.Millisecond),
+				retry.DelayType(retry.BackOffDelay),
+			)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				select {
+				case errsChan <- err:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+
+			// If index is the same, there have been no changes since last query
+			if queryMeta.LastIndex == lastIndex {
+				continue
+			}
+			if queryMeta.LastIndex < lastIndex {
+				// reset if index goes backwards per consul blocking query docs
+				lastIndex = 0
+			} else {
+				lastIndex = queryMeta.LastIndex
+			}
+
+			select {
+			case resultChan <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return resultChan, errsChan
+}
+
+// all the roots are trusted, so that certificates signed by a new root are accepted while Consul rotates its CA
+func connectSecret(ref *core.ResourceRef, roots *consulapi.CARootList, leaf *consulapi.LeafCert) *v1.Secret {
+	var rootCas []string
+	for _, root := range roots.Roots {
+		rootCas = append(rootCas, strings.TrimSpace(root.RootCertPEM))
+	}
+	return &v1.Secret{
+		Metadata: &core.Metadata{
+			Name:      ref.GetName(),
+			Namespace: ref.GetNamespace(),
+			Annotations: map[string]string{
+				ConnectTrustDomainAnnotation: roots.TrustDomain,
+				ConnectDataCenterAnnotation:  dataCenterFromSpiffeId(leaf.ServiceURI),
+			},
+		},
+		Kind: &v1.Secret_Tls{
+			Tls: &v1.TlsSecret{
+				CertChain:  leaf.CertPEM,
+				PrivateKey: leaf.PrivateKeyPEM,
+				RootCa:     strings.Join(rootCas, "\n") + "\n",
+			},
+		},
+	}
+}
+
+func writeConnectSecret(ctx context.Context, secretClient v1.SecretClient, secret *v1.Secret) error {
+	existing, err := secretClient.Read(secret.GetMetadata().GetNamespace(), secret.GetMetadata().GetName(), clients.ReadOpts{Ctx: ctx})
+	if err != nil && !skerrors.IsNotExist(err) {
+		return err
+	}
+	if err == nil {
+		if existing.GetTls().Equal(secret.GetTls()) && maps.Equal(existing.GetMetadata().GetAnnotations(), secret.GetMetadata().GetAnnotations()) {
+			return nil
+		}
+		secret.GetMetadata().ResourceVersion = existing.GetMetadata().GetResourceVersion()
+	}
+	_, err = secretClient.Write(secret, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+	return err
+}
+
+// Connect service identities have the form spiffe://<trust domain>/ns/<namespace>/dc/<datacenter>/svc/<service>
+func connectSpiffeId(trustDomain, dataCenter, service string) string {
+	return fmt.Sprintf("spiffe://%s/ns/%s/dc/%s/svc/%s", trustDomain, connectNamespace, dataCenter, service)
+}
+
+func dataCenterFromSpiffeId(spiffeId string) string {
+	u, err := url.Parse(spiffeId)
+	if err != nil {
+		return ""
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := 0; i+1 < len(segments); i += 2 {
+		if segments[i] == "dc" {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
+
+// Builds the transport sockets Gloo uses to originate mutual TLS to the Connect proxies of the given service, presenting
+// Gloo's leaf certificate. Each data center of the service gets a socket, selected by the data center of the endpoints,
+// that sends the SNI mesh gateways route on to that data center and verifies the SPIFFE identity of the service in it.
+// The default socket, used for endpoints without a data center, uses the data center of the local agent and accepts
+// the identity of the service in any of its data centers.
+func connectTransportSockets(settings *v1.Settings, secrets v1.SecretList, spec *glooConsul.UpstreamSpec) (*envoy_config_core_v3.TransportSocket, []*envoy_config_cluster_v3.Cluster_TransportSocketMatch, error) {
+	ref := ConnectSecretRef(settings)
+	secret, err := secrets.Find(ref.GetNamespace(), ref.GetName())
+	if err != nil {
+		return nil, nil, ConnectSecretNotFoundError(ref, err)
+	}
+	trustDomain := secret.GetMetadata().GetAnnotations()[ConnectTrustDomainAnnotation]
+	if trustDomain == "" {
+		return nil, nil, ConnectTrustDomainMissingError(ref)
+	}
+
+	agentDataCenter := secret.GetMetadata().GetAnnotations()[ConnectDataCenterAnnotation]
+	var dataCenters []string
+	for _, dc := range spec.GetDataCenters() {
+		if dc != "" {
+			dataCenters = append(dataCenters, dc)
+		}
+	}
+	if len(dataCenters) == 0 {
+		if agentDataCenter == "" {
+			return nil, nil, ConnectDataCenterUnknownError(ref, spec.GetServiceName())
+		}
+		dataCenters = []string{agentDataCenter}
+	}
+	defaultDataCenter := dataCenters[0]
+	if slices.Contains(dataCenters, agentDataCenter) {
+		defaultDataCenter = agentDataCenter
+	}
+
+	defaultSocket, err := connectTransportSocket(secrets, ref, trustDomain, spec.GetServiceName(), defaultDataCenter, dataCenters)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(dataCenters) == 1 {
+		return defaultSocket, nil, nil
+	}
+	var matches []*envoy_config_cluster_v3.Cluster_TransportSocketMatch
+	for _, dc := range dataCenters {
+		socket, err := connectTransportSocket(secrets, ref, trustDomain, spec.GetServiceName(), dc, []string{dc})
+		if err != nil {
+			return nil, nil, err
+		}
+		matches = append(matches, &envoy_config_cluster_v3.Cluster_TransportSocketMatch{
+			Name:            "consul-connect-" + dc,
+			Match:           connectDataCenterMatch(dc),
+			TransportSocket: socket,
+		})
+	}
+	return defaultSocket, matches, nil
+}
+
+// Builds the transport socket for the Connect proxies of the service in the sni data center, which accepts the SPIFFE
+// identity of the service in any of the given data centers
+func connectTransportSocket(secrets v1.SecretList, ref *core.ResourceRef, trustDomain, service, sniDataCenter string, dataCenters []string) (*envoy_config_core_v3.TransportSocket, error) {
+	var subjectAltNames []string
+	for _, dc := range dataCenters {
+		subjectAltNames = append(subjectAltNames, connectSpiffeId(trustDomain, dc, service))
+	}
+
+	sslConfig := &ssl.UpstreamSslConfig{
+		SslSecrets: &ssl.UpstreamSslConfig_SecretRef{
+			SecretRef: ref,
+		},
+		// the SNI that Consul mesh gateways route on
+		Sni:                  fmt.Sprintf("%s.%s.%s.internal.%s", service, connectNamespace, sniDataCenter, trustDomain),
+		VerifySubjectAltName: subjectAltNames,
+	}
+	tlsContext, err := utils.NewSslConfigTranslator().ResolveUpstreamSslConfig(secrets, sslConfig)
+	if err != nil {
+		return nil, err
+	}
+	typedConfig, err := utils.MessageToAny(tlsContext)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_core_v3.TransportSocket{
+		Name:       wellknown.TransportSocketTls,
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}, nil
+}
+
+func connectDataCenterMatch(dataCenter string) *structpb.Struct {
+	return &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			connectDataCenterMatchKey: structpb.NewStringValue(dataCenter),
+		},
+	}
+}
+
+// Sets the transport socket match metadata of the endpoints of a Connect-enabled service to their data center, which
+// is the data center label they were created with
+func setConnectDataCenterMatches(loadAssignment *envoy_config_endpoint_v3.ClusterLoadAssignment) {
+	for _, localityEndpoints := range loadAssignment.GetEndpoints() {
+		for _, lbEndpoint := range localityEndpoints.GetLbEndpoints() {
+			var dataCenter string
+			for key, value := range lbEndpoint.GetMetadata().GetFilterMetadata()[envoyLbMetadataKey].GetFields() {
+				if strings.HasPrefix(key, constants.ConsulDataCenterKeyPrefix) && value.GetStringValue() == constants.ConsulEndpointMetadataMatchTrue {
+					dataCenter = strings.TrimPrefix(key, constants.ConsulDataCenterKeyPrefix)
+					break
+				}
+			}
+			if dataCenter == "" {
+				continue
+			}
+			filterMetadata := lbEndpoint.GetMetadata().GetFilterMetadata()
+			match := filterMetadata[static.TransportSocketMatchKey]
+			if match == nil {
+				match = &structpb.Struct{Fields: map[string]*structpb.Value{}}
+				filterMetadata[static.TransportSocketMatchKey] = match
+			}
+			match.GetFields()[connectDataCenterMatchKey] = structpb.NewStringValue(dataCenter)
+		}
+	}
+}
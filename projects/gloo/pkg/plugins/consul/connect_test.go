@@ -0,0 +1,325 @@
+package consul
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/golang/mock/gomock"
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	mock_consul "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
+	"github.com/solo-io/gloo/test/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	. "github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = Describe("Connect", func() {
+
+	var (
+		ctrl              *gomock.Controller
+		consulWatcherMock *mock_consul.MockConsulWatcher
+		settings          *v1.Settings
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		consulWatcherMock = mock_consul.NewMockConsulWatcher(ctrl)
+		settings = &v1.Settings{
+			DiscoveryNamespace: "gloo-system",
+			ConsulDiscovery: &v1.Settings_ConsulUpstreamDiscoveryConfiguration{
+				Connect: &v1.Settings_ConsulUpstreamDiscoveryConfiguration_ConnectConfiguration{},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("defaults the service name and secret ref", func() {
+		Expect(ConnectServiceName(settings)).To(Equal("gloo"))
+		Expect(ConnectSecretRef(settings)).To(Equal(&core.ResourceRef{Name: "consul-connect-gloo", Namespace: "gloo-system"}))
+
+		settings.GetConsulDiscovery().GetConnect().ServiceName = "edge"
+		settings.GetConsulDiscovery().GetConnect().SecretRef = &core.ResourceRef{Namespace: "consul"}
+		Expect(ConnectSecretRef(settings)).To(Equal(&core.ResourceRef{Name: "consul-connect-edge", Namespace: "consul"}))
+	})
+
+	It("turns connect off when discovery no longer finds a connect proxy for the service", func() {
+		original := createTestFilteredUpstream("web", "web", nil, nil, []string{"dc1"})
+		original.GetConsul().ConnectEnabled = true
+		desired := createTestFilteredUpstream("web", "web", nil, nil, []string{"dc1"})
+
+		updated, err := NewPlugin(consulWatcherMock, nil, nil).UpdateUpstream(original, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).To(BeTrue())
+		Expect(desired.GetConsul().GetConnectEnabled()).To(BeFalse())
+	})
+
+	Context("certificate sync", func() {
+
+		var (
+			ctx          context.Context
+			cancel       context.CancelFunc
+			secretClient v1.SecretClient
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			var err error
+			secretClient, err = v1.NewSecretClient(ctx, &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		// serves the given responses to successive blocking queries, then blocks until the query is cancelled
+		serveSequence := func(indexes ...uint64) func(q *consulapi.QueryOptions) (int, *consulapi.QueryMeta, error) {
+			var lock sync.Mutex
+			calls := 0
+			return func(q *consulapi.QueryOptions) (int, *consulapi.QueryMeta, error) {
+				lock.Lock()
+				call := calls
+				calls++
+				lock.Unlock()
+				if call < len(indexes) {
+					return call, &consulapi.QueryMeta{LastIndex: indexes[call]}, nil
+				}
+				<-q.Context().Done()
+				return 0, nil, q.Context().Err()
+			}
+		}
+
+		It("writes the leaf certificate and roots, and rotates them", func() {
+			roots := serveSequence(10)
+			consulWatcherMock.EXPECT().ConnectCARoots(gomock.Any()).DoAndReturn(func(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+				_, meta, err := roots(q)
+				if err != nil {
+					return nil, nil, err
+				}
+				return &consulapi.CARootList{
+					TrustDomain: "11111111-2222.consul",
+					Roots: []*consulapi.CARoot{
+						{RootCertPEM: "root-1\n"},
+						{RootCertPEM: "root-2\n"},
+					},
+				}, meta, nil
+			}).AnyTimes()
+
+			leaves := serveSequence(20, 21)
+			consulWatcherMock.EXPECT().ConnectCALeaf("gloo", gomock.Any()).DoAndReturn(func(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+				call, meta, err := leaves(q)
+				if err != nil {
+					return nil, nil, err
+				}
+				certs := []string{"leaf-1", "leaf-2"}
+				return &consulapi.LeafCert{
+					CertPEM:       certs[call],
+					PrivateKeyPEM: "key",
+					ServiceURI:    "spiffe://11111111-2222.consul/ns/default/dc/dc1/svc/gloo",
+				}, meta, nil
+			}).AnyTimes()
+
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				Expect(SyncConnectCertificates(ctx, consulWatcherMock, secretClient, settings)).To(Succeed())
+			}()
+
+			Eventually(func() (*v1.Secret, error) {
+				return secretClient.Read("gloo-system", "consul-connect-gloo", clients.ReadOpts{Ctx: ctx})
+			}, 5*time.Second).Should(WithTransform(func(secret *v1.Secret) *v1.TlsSecret { return secret.GetTls() }, Equal(&v1.TlsSecret{
+				CertChain:  "leaf-2",
+				PrivateKey: "key",
+				RootCa:     "root-1\nroot-2\n",
+			})))
+
+			secret, err := secretClient.Read("gloo-system", "consul-connect-gloo", clients.ReadOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.GetMetadata().GetAnnotations()).To(Equal(map[string]string{
+				ConnectTrustDomainAnnotation: "11111111-2222.consul",
+				ConnectDataCenterAnnotation:  "dc1",
+			}))
+
+			cancel()
+			Eventually(done, 5*time.Second).Should(BeClosed())
+		})
+	})
+
+	Context("upstream tls", func() {
+
+		var (
+			p        *plugin
+			upstream *v1.Upstream
+			snapshot *v1snap.ApiSnapshot
+		)
+
+		BeforeEach(func() {
+			p = NewPlugin(consulWatcherMock, nil, nil)
+			p.Init(plugins.InitParams{Ctx: context.Background(), Settings: settings})
+
+			upstream = createTestFilteredUpstream("web", "web", nil, nil, []string{"dc1", "dc2"})
+			upstream.GetConsul().ConnectEnabled = true
+
+			snapshot = &v1snap.ApiSnapshot{
+				Secrets: v1.SecretList{{
+					Metadata: &core.Metadata{
+						Name:      "consul-connect-gloo",
+						Namespace: "gloo-system",
+						Annotations: map[string]string{
+							ConnectTrustDomainAnnotation: "11111111-2222.consul",
+							ConnectDataCenterAnnotation:  "dc1",
+						},
+					},
+					Kind: &v1.Secret_Tls{Tls: &v1.TlsSecret{
+						CertChain:  helpers.Certificate(),
+						PrivateKey: helpers.PrivateKey(),
+						RootCa:     helpers.Certificate(),
+					}},
+				}},
+			}
+		})
+
+		processUpstream := func() (*envoy_config_cluster_v3.Cluster, error) {
+			out := &envoy_config_cluster_v3.Cluster{}
+			err := p.ProcessUpstream(plugins.Params{Ctx: context.Background(), Snapshot: snapshot}, upstream, out)
+			return out, err
+		}
+
+		It("originates mutual tls verifying the spiffe identity of the service", func() {
+			out, err := processUpstream()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTransportSocket()).NotTo(BeNil())
+
+			var tlsContext envoyauth.UpstreamTlsContext
+			Expect(out.GetTransportSocket().GetTypedConfig().UnmarshalTo(&tlsContext)).To(Succeed())
+			Expect(tlsContext.GetSni()).To(Equal("web.default.dc1.internal.11111111-2222.consul"))
+			Expect(tlsContext.GetCommonTlsContext().GetTlsCertificates()).To(HaveLen(1))
+
+			var sans []string
+			for _, matcher := range tlsContext.GetCommonTlsContext().GetValidationContext().GetMatchSubjectAltNames() {
+				sans = append(sans, matcher.GetExact())
+			}
+			Expect(sans).To(Equal([]string{
+				"spiffe://11111111-2222.consul/ns/default/dc/dc1/svc/web",
+				"spiffe://11111111-2222.consul/ns/default/dc/dc2/svc/web",
+			}))
+		})
+
+		It("selects the transport socket of the data center of each endpoint", func() {
+			out, err := processUpstream()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTransportSocketMatches()).To(HaveLen(2))
+
+			for i, dc := range []string{"dc1", "dc2"} {
+				match := out.GetTransportSocketMatches()[i]
+				Expect(match.GetName()).To(Equal("consul-connect-" + dc))
+				Expect(match.GetMatch().GetFields()).To(HaveKeyWithValue("consul_connect_dc", MatchProto(structpb.NewStringValue(dc))))
+
+				var tlsContext envoyauth.UpstreamTlsContext
+				Expect(match.GetTransportSocket().GetTypedConfig().UnmarshalTo(&tlsContext)).To(Succeed())
+				Expect(tlsContext.GetSni()).To(Equal("web.default." + dc + ".internal.11111111-2222.consul"))
+				matchers := tlsContext.GetCommonTlsContext().GetValidationContext().GetMatchSubjectAltNames()
+				Expect(matchers).To(HaveLen(1))
+				Expect(matchers[0].GetExact()).To(Equal("spiffe://11111111-2222.consul/ns/default/dc/" + dc + "/svc/web"))
+			}
+
+			lbEndpoint := &envoy_config_endpoint_v3.LbEndpoint{
+				Metadata: &envoy_config_core_v3.Metadata{
+					FilterMetadata: map[string]*structpb.Struct{
+						"envoy.lb": {Fields: map[string]*structpb.Value{
+							"dc_dc1": structpb.NewStringValue("0"),
+							"dc_dc2": structpb.NewStringValue("1"),
+						}},
+					},
+				},
+			}
+			cla := &envoy_config_endpoint_v3.ClusterLoadAssignment{
+				Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
+					LbEndpoints: []*envoy_config_endpoint_v3.LbEndpoint{lbEndpoint},
+				}},
+			}
+			Expect(p.ProcessEndpoints(plugins.Params{Ctx: context.Background(), Snapshot: snapshot}, upstream, cla)).To(Succeed())
+			Expect(lbEndpoint.GetMetadata().GetFilterMetadata()).To(HaveKeyWithValue("envoy.transport_socket_match",
+				MatchProto(&structpb.Struct{Fields: map[string]*structpb.Value{"consul_connect_dc": structpb.NewStringValue("dc2")}})))
+		})
+
+		It("uses the data center of the agent when the upstream lists none", func() {
+			upstream.GetConsul().DataCenters = nil
+			out, err := processUpstream()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTransportSocketMatches()).To(BeEmpty())
+
+			var tlsContext envoyauth.UpstreamTlsContext
+			Expect(out.GetTransportSocket().GetTypedConfig().UnmarshalTo(&tlsContext)).To(Succeed())
+			Expect(tlsContext.GetSni()).To(Equal("web.default.dc1.internal.11111111-2222.consul"))
+
+			delete(snapshot.Secrets[0].GetMetadata().GetAnnotations(), ConnectDataCenterAnnotation)
+			_, err = processUpstream()
+			Expect(err).To(MatchError(ContainSubstring("the data center of consul service web is unknown")))
+		})
+
+		It("errors until the certificates have been written", func() {
+			snapshot.Secrets = nil
+			_, err := processUpstream()
+			Expect(err).To(MatchError(ContainSubstring("consul connect certificates not found in secret gloo-system.consul-connect-gloo")))
+		})
+
+		It("does not override the ssl config of the upstream", func() {
+			upstream.SslConfig = &ssl.UpstreamSslConfig{Sni: "custom"}
+			out, err := processUpstream()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTransportSocket()).To(BeNil())
+		})
+
+		It("does nothing unless connect is configured in the settings", func() {
+			settings.GetConsulDiscovery().Connect = nil
+			p.Init(plugins.InitParams{Ctx: context.Background(), Settings: settings})
+			out, err := processUpstream()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTransportSocket()).To(BeNil())
+		})
+	})
+
+	It("resolves connect-enabled services to their proxies", func() {
+		q := &consulapi.QueryOptions{Datacenter: "dc1"}
+		consulWatcherMock.EXPECT().Connect("web", "", q).Return([]*consulapi.CatalogService{
+			{
+				ServiceName:    "web-sidecar-proxy",
+				ServiceAddress: "10.0.0.1",
+				ServicePort:    21000,
+				ServiceProxy:   &consulapi.AgentServiceConnectProxyConfig{DestinationServiceName: "web"},
+			},
+			{
+				ServiceName:    "web",
+				ServiceAddress: "10.0.0.2",
+				ServicePort:    8443,
+			},
+		}, &consulapi.QueryMeta{}, nil)
+
+		instances, _, err := getServiceInstances(consulWatcherMock, "web", true, q)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instances).To(HaveLen(2))
+		for _, instance := range instances {
+			Expect(instance.ServiceName).To(Equal("web"))
+		}
+		Expect(instances[0].ServicePort).To(Equal(21000))
+	})
+})
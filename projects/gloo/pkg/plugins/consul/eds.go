@@ -52,11 +52,16 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 
 	// Filter out non-consul upstreams
 	trackedServiceToUpstreams := make(map[string][]*v1.Upstream)
+	// the services that are reached through their Connect proxies
+	connectServices := make(map[string]bool)
 	for _, us := range upstreamsToTrack {
 		if consulUsSpec := us.GetConsul(); consulUsSpec != nil {
 			// discovery generates one upstream for every Consul service name;
 			// this should only happen if users define duplicate upstreams for a consul service name.
 			trackedServiceToUpstreams[consulUsSpec.GetServiceName()] = append(trackedServiceToUpstreams[consulUsSpec.GetServiceName()], us)
+			if connectEnabled(p.settings, consulUsSpec) {
+				connectServices[consulUsSpec.GetServiceName()] = true
+			}
 		}
 	}
 
@@ -134,7 +139,7 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 					// service is updated is tested in "fires service watch even if catalog service is the only update"
 					//
 					// i.e., even an update to a single catalog service will cause a full refresh of all services
-					specs := refreshSpecs(opts.Ctx, p.client, serviceMeta, errChan, trackedServiceToUpstreams)
+					specs := refreshSpecs(opts.Ctx, p.client, serviceMeta, errChan, trackedServiceToUpstreams, connectServices)
 					previousSpecs = specs
 
 					// Build new endpoints from specs and publish if ctx is not cancelled
@@ -182,7 +187,7 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 						dcName := dc
 						svcName := meta.Name
 
-						endpointsChan, epErrChan := p.watchEndpointsInDataCenter(ctx, dcName, svcName, p.consulUpstreamDiscoverySettings.GetConsistencyMode(), p.consulUpstreamDiscoverySettings.GetQueryOptions())
+						endpointsChan, epErrChan := p.watchEndpointsInDataCenter(ctx, dcName, svcName, connectServices[svcName], p.consulUpstreamDiscoverySettings.GetConsistencyMode(), p.consulUpstreamDiscoverySettings.GetQueryOptions())
 
 						// Collect endpoints
 						eg.Go(func() error {
@@ -304,7 +309,7 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 }
 
 // Honors the contract of Watch functions to open with an initial read.
-func (p *plugin) watchEndpointsInDataCenter(ctx context.Context, dataCenter, svcName string, cm glooConsul.ConsulConsistencyModes, queryOpts *glooConsul.QueryOptions) (<-chan *dataCenterServiceEndpointsTuple, <-chan error) {
+func (p *plugin) watchEndpointsInDataCenter(ctx context.Context, dataCenter, svcName string, connect bool, cm glooConsul.ConsulConsistencyModes, queryOpts *glooConsul.QueryOptions) (<-chan *dataCenterServiceEndpointsTuple, <-chan error) {
 	endpointsChan := make(chan *dataCenterServiceEndpointsTuple)
 	errsChan := make(chan error)
 
@@ -343,7 +348,7 @@ func (p *plugin) watchEndpointsInDataCenter(ctx context.Context, dataCenter, svc
 							return nil
 						}
 
-						endpoints, queryMeta, err = p.client.Service(svcName, "", queryOpts.WithContext(ctx))
+						endpoints, queryMeta, err = getServiceInstances(p.client, svcName, connect, queryOpts.WithContext(ctx))
 						return err
 					},
 					retry.Attempts(6),
@@ -390,6 +395,29 @@ func (p *plugin) watchEndpointsInDataCenter(ctx context.Context, dataCenter, svc
 	return endpointsChan, errsChan
 }
 
+// Returns the instances of the given service, or, for Connect-enabled services, the Connect proxies (and
+// Connect-native instances) of the service. Proxies are registered as separate services in the catalog, so they are
+// renamed after the service they are a proxy for in order to be matched to the upstreams of that service.
+func getServiceInstances(client consul.ClientWrapper, svcName string, connect bool, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
+	if !connect {
+		return client.Service(svcName, "", q)
+	}
+	instances, queryMeta, err := client.Connect(svcName, "", q)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, instance := range instances {
+		if instance.ServiceProxy == nil || instance.ServiceProxy.DestinationServiceName == "" {
+			// connect-native instance
+			continue
+		}
+		proxy := *instance
+		proxy.ServiceName = instance.ServiceProxy.DestinationServiceName
+		instances[i] = &proxy
+	}
+	return instances, queryMeta, nil
+}
+
 func aggregateEndpoints(ctx context.Context, dest chan *dataCenterServiceEndpointsTuple, src <-chan *dataCenterServiceEndpointsTuple) {
 	for {
 		select {
@@ -410,7 +438,7 @@ func aggregateEndpoints(ctx context.Context, dest chan *dataCenterServiceEndpoin
 
 // For each service AND data center combination, return a CatalogService that contains a list of all service instances
 // belonging to that service within that datacenter.
-func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta []*consul.ServiceMeta, errChan chan error, serviceToUpstream map[string][]*v1.Upstream) []*consulapi.CatalogService {
+func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta []*consul.ServiceMeta, errChan chan error, serviceToUpstream map[string][]*v1.Upstream, connectServices map[string]bool) []*consulapi.CatalogService {
 	logger := contextutils.LoggerFrom(contextutils.WithLogger(ctx, "consul_eds"))
 	specs := newThreadSafeSpecCollector()
 
@@ -458,7 +486,7 @@ func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta
 					// we create a lot of requests; by the time we get here ctx may be done
 					return ctx.Err()
 				}
-				services, _, err := client.Service(svc.Name, "", queryOpts.WithContext(ctx))
+				services, _, err := getServiceInstances(client, svc.Name, connectServices[svc.Name], queryOpts.WithContext(ctx))
 				if err != nil {
 					return err
 				}
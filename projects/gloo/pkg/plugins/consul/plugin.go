@@ -13,12 +13,14 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	"github.com/rotisserie/eris"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	upstream_proxy_protocol "github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/upstreamproxyprotocol"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 )
 
@@ -26,6 +28,7 @@ var (
 	_ discovery.DiscoveryPlugin = new(plugin)
 	_ plugins.UpstreamPlugin    = new(plugin)
 	_ plugins.RouteActionPlugin = new(plugin)
+	_ plugins.EndpointPlugin    = new(plugin)
 )
 
 const (
@@ -148,7 +151,7 @@ func (p *plugin) Init(params plugins.InitParams) {
 }
 
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
-	_, ok := in.GetUpstreamType().(*v1.Upstream_Consul)
+	consulSpec, ok := in.GetUpstreamType().(*v1.Upstream_Consul)
 	if !ok {
 		return nil
 	}
@@ -156,6 +159,40 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	// consul upstreams use EDS
 	xds.SetEdsOnCluster(out, p.settings)
 
+	// an explicit ssl config on the upstream takes precedence over the connect certificates
+	if connectEnabled(p.settings, consulSpec.Consul) && in.GetSslConfig() == nil {
+		transportSocket, transportSocketMatches, err := connectTransportSockets(p.settings, params.Snapshot.Secrets, consulSpec.Consul)
+		if err != nil {
+			return err
+		}
+		if in.GetProxyProtocolVersion() != nil {
+			transportSocket, err = upstream_proxy_protocol.WrapWithPProtocol(transportSocket, in.GetProxyProtocolVersion().GetValue())
+			if err != nil {
+				return err
+			}
+			for _, match := range transportSocketMatches {
+				match.TransportSocket, err = upstream_proxy_protocol.WrapWithPProtocol(match.GetTransportSocket(), in.GetProxyProtocolVersion().GetValue())
+				if err != nil {
+					return err
+				}
+			}
+		}
+		out.TransportSocket = transportSocket
+		out.TransportSocketMatches = append(out.GetTransportSocketMatches(), transportSocketMatches...)
+	}
+
+	return nil
+}
+
+func (p *plugin) ProcessEndpoints(params plugins.Params, in *v1.Upstream, out *envoy_config_endpoint_v3.ClusterLoadAssignment) error {
+	consulSpec, ok := in.GetUpstreamType().(*v1.Upstream_Consul)
+	if !ok {
+		return nil
+	}
+	// select the connect transport socket of the data center of each endpoint
+	if connectEnabled(p.settings, consulSpec.Consul) && in.GetSslConfig() == nil {
+		setConnectDataCenterMatches(out)
+	}
 	return nil
 }
 
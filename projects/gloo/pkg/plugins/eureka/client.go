@@ -0,0 +1,216 @@
+package eureka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+)
+
+const (
+	DefaultRequestTimeout = 5 * time.Second
+
+	applicationsPath = "/apps"
+	deltaPath        = "/apps/delta"
+)
+
+var (
+	NoServiceUrlsError = eris.New("eureka settings must specify at least one service url")
+
+	UnexpectedStatusError = func(url string, statusCode int) error {
+		return eris.Errorf("unexpected status code %d from eureka registry at %s", statusCode, url)
+	}
+)
+
+// Client talks to the REST API of a Eureka-compatible service registry.
+type Client interface {
+	// Applications returns the full registry.
+	Applications(ctx context.Context) (*Applications, error)
+	// Delta returns the instances that changed recently, together with the hash code of the full registry.
+	Delta(ctx context.Context) (*Applications, error)
+}
+
+type client struct {
+	serviceUrls []string
+	username    string
+	password    string
+	httpClient  *http.Client
+}
+
+func NewClient(settings *v1.Settings_EurekaConfiguration) (Client, error) {
+	if len(settings.GetServiceUrls()) == 0 {
+		return nil, NoServiceUrlsError
+	}
+
+	timeout := DefaultRequestTimeout
+	if settings.GetRequestTimeout() != nil {
+		timeout = prototime.DurationFromProto(settings.GetRequestTimeout())
+	}
+
+	var serviceUrls []string
+	for _, serviceUrl := range settings.GetServiceUrls() {
+		serviceUrls = append(serviceUrls, strings.TrimSuffix(serviceUrl, "/"))
+	}
+
+	return &client{
+		serviceUrls: serviceUrls,
+		username:    settings.GetUsername(),
+		password:    settings.GetPassword(),
+		httpClient:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (c *client) Applications(ctx context.Context) (*Applications, error) {
+	return c.getApplications(ctx, applicationsPath)
+}
+
+func (c *client) Delta(ctx context.Context) (*Applications, error) {
+	return c.getApplications(ctx, deltaPath)
+}
+
+// query the service urls in order, returning the first successful response
+func (c *client) getApplications(ctx context.Context, path string) (*Applications, error) {
+	var errs error
+	for _, serviceUrl := range c.serviceUrls {
+		apps, err := c.getApplicationsFrom(ctx, serviceUrl+path)
+		if err == nil {
+			return apps, nil
+		}
+		errs = multierror.Append(errs, err)
+	}
+	return nil, errs
+}
+
+func (c *client) getApplicationsFrom(ctx context.Context, url string) (*Applications, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	// the registry responds with XML unless asked otherwise
+	req.Header.Set("Accept", "application/json")
+	if c.username != "" || c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, eris.Wrapf(err, "querying eureka registry at %s", url)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, UnexpectedStatusError(url, resp.StatusCode)
+	}
+
+	var body struct {
+		Applications *Applications `json:"applications"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, eris.Wrapf(err, "decoding response from eureka registry at %s", url)
+	}
+	if body.Applications == nil {
+		return nil, eris.Errorf("response from eureka registry at %s did not contain applications", url)
+	}
+	return body.Applications, nil
+}
+
+// Applications is the registry document returned by the `/apps` and `/apps/delta` endpoints.
+type Applications struct {
+	VersionsDelta string          `json:"versions__delta"`
+	AppsHashCode  string          `json:"apps__hashcode"`
+	Applications  applicationList `json:"application"`
+}
+
+type Application struct {
+	Name      string       `json:"name"`
+	Instances instanceList `json:"instance"`
+}
+
+type Instance struct {
+	InstanceId string            `json:"instanceId"`
+	HostName   string            `json:"hostName"`
+	App        string            `json:"app"`
+	IpAddr     string            `json:"ipAddr"`
+	Status     string            `json:"status"`
+	Port       Port              `json:"port"`
+	SecurePort Port              `json:"securePort"`
+	Metadata   map[string]string `json:"metadata"`
+	// only set on instances returned by the delta endpoint
+	ActionType string `json:"actionType,omitempty"`
+}
+
+const (
+	StatusUp = "UP"
+
+	ActionAdded    = "ADDED"
+	ActionModified = "MODIFIED"
+	ActionDeleted  = "DELETED"
+)
+
+// Port is serialized by the registry as `{"$": 8080, "@enabled": "true"}`.
+type Port struct {
+	Number  uint32
+	Enabled bool
+}
+
+func (p *Port) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Number  json.Number `json:"$"`
+		Enabled interface{} `json:"@enabled"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Number != "" {
+		number, err := raw.Number.Int64()
+		if err != nil {
+			return eris.Wrapf(err, "invalid port %s", raw.Number)
+		}
+		p.Number = uint32(number)
+	}
+	p.Enabled = fmt.Sprint(raw.Enabled) == "true"
+	return nil
+}
+
+func (p Port) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"$":        p.Number,
+		"@enabled": fmt.Sprint(p.Enabled),
+	})
+}
+
+// Some registries serialize single-element lists as a bare object, so both forms are accepted.
+type applicationList []*Application
+
+func (l *applicationList) UnmarshalJSON(data []byte) error {
+	return unmarshalObjectOrList(data, (*[]*Application)(l))
+}
+
+type instanceList []*Instance
+
+func (l *instanceList) UnmarshalJSON(data []byte) error {
+	return unmarshalObjectOrList(data, (*[]*Instance)(l))
+}
+
+func unmarshalObjectOrList[T any](data []byte, out *[]*T) error {
+	trimmed := strings.TrimSpace(string(data))
+	if strings.HasPrefix(trimmed, "[") {
+		return json.Unmarshal(data, out)
+	}
+	if trimmed == "null" {
+		return nil
+	}
+	var single T
+	if err := json.Unmarshal(data, &single); err != nil {
+		return err
+	}
+	*out = []*T{&single}
+	return nil
+}
@@ -0,0 +1,148 @@
+package eureka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/k8s-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Starts polling the registry for the applications associated with the tracked upstreams.
+// Whenever the instances of those applications change, the complete list of endpoints is sent on the returned channel.
+func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+
+	// Filter out non-eureka upstreams
+	trackedAppToUpstreams := make(map[string][]*v1.Upstream)
+	for _, us := range upstreamsToTrack {
+		if eurekaSpec := us.GetEureka(); eurekaSpec != nil {
+			appName := strings.ToUpper(eurekaSpec.GetAppName())
+			trackedAppToUpstreams[appName] = append(trackedAppToUpstreams[appName], us)
+		}
+	}
+
+	applicationsChan, errs := watchApplications(opts.Ctx, p.client, p.pollingInterval)
+
+	endpointsChan := make(chan v1.EndpointList)
+	go func() {
+		defer close(endpointsChan)
+
+		var (
+			previousHash uint64
+			published    bool
+		)
+		for {
+			select {
+			case applications, ok := <-applicationsChan:
+				if !ok {
+					return
+				}
+				endpoints := buildEndpoints(opts.Ctx, writeNamespace, applications, trackedAppToUpstreams)
+				currentHash := hashutils.MustHash(endpoints)
+				if published && currentHash == previousHash {
+					continue
+				}
+				previousHash = currentHash
+				published = true
+				select {
+				case endpointsChan <- endpoints:
+				case <-opts.Ctx.Done():
+					return
+				}
+			case <-opts.Ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return endpointsChan, errs, nil
+}
+
+func buildEndpoints(
+	ctx context.Context,
+	writeNamespace string,
+	applications []*Application,
+	trackedAppToUpstreams map[string][]*v1.Upstream,
+) v1.EndpointList {
+	var endpoints v1.EndpointList
+	for _, app := range applications {
+		upstreams, ok := trackedAppToUpstreams[strings.ToUpper(app.Name)]
+		if !ok {
+			continue
+		}
+		for _, instance := range app.Instances {
+			endpoints = append(endpoints, buildEndpointsForInstance(ctx, writeNamespace, app.Name, instance, upstreams)...)
+		}
+	}
+
+	// Sort by name in ascending order for idempotency
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		return endpoints[i].GetMetadata().GetName() < endpoints[j].GetMetadata().GetName()
+	})
+	return endpoints
+}
+
+// An instance yields one endpoint for each of its ports that is selected by an upstream,
+// i.e. at most one for the plain-text port and one for the secure port.
+func buildEndpointsForInstance(ctx context.Context, writeNamespace, appName string, instance *Instance, upstreams []*v1.Upstream) v1.EndpointList {
+	// the registry only reports the effective status, which already takes status overrides into account
+	if instance.Status != StatusUp {
+		return nil
+	}
+	if instance.IpAddr == "" {
+		contextutils.LoggerFrom(ctx).Warnf("eureka instance %s of application %s has no ip address, skipping", instance.InstanceId, appName)
+		return nil
+	}
+
+	upstreamsByPort := map[uint32][]*core.ResourceRef{}
+	for _, us := range upstreams {
+		port := instance.Port
+		if us.GetEureka().GetUseSecurePort() {
+			port = instance.SecurePort
+		}
+		if !port.Enabled || port.Number == 0 {
+			continue
+		}
+		upstreamsByPort[port.Number] = append(upstreamsByPort[port.Number], us.GetMetadata().Ref())
+	}
+
+	var endpoints v1.EndpointList
+	for port, upstreamRefs := range upstreamsByPort {
+		endpoints = append(endpoints, &v1.Endpoint{
+			Metadata: &core.Metadata{
+				Namespace: writeNamespace,
+				Name:      buildEndpointName(appName, instance, port),
+				Labels:    buildLabels(instance.Metadata),
+			},
+			Upstreams: upstreamRefs,
+			Address:   instance.IpAddr,
+			Port:      port,
+			Hostname:  instance.HostName,
+		})
+	}
+	return endpoints
+}
+
+func buildEndpointName(appName string, instance *Instance, port uint32) string {
+	unsanitizedName := fmt.Sprintf("%s-%s-%s-%d", upstreamNamePrefix, appName, instanceKey(instance), port)
+	return kubeutils.SanitizeNameV2(strings.ToLower(unsanitizedName))
+}
+
+// The labels will be used to match the endpoint to the subsets of the cluster represented by the upstream.
+// Eureka adds bookkeeping entries such as `@class` to the metadata of some instances; these are dropped.
+func buildLabels(metadata map[string]string) map[string]string {
+	labels := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if strings.HasPrefix(k, "@") {
+			continue
+		}
+		labels[k] = v
+	}
+	return labels
+}
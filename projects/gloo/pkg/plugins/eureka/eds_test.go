@@ -0,0 +1,180 @@
+package eureka
+
+import (
+	"context"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/eureka"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ = Describe("Eureka discovery", func() {
+
+	const writeNamespace = "gloo-system"
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		fake   *fakeRegistry
+		server *httptest.Server
+		p      *plugin
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		fake = newFakeRegistry()
+		server = httptest.NewServer(fake)
+
+		settings := &v1.Settings{
+			Eureka: &v1.Settings_EurekaConfiguration{
+				ServiceUrls:     []string{server.URL + "/eureka"},
+				PollingInterval: durationpb.New(50 * time.Millisecond),
+			},
+		}
+		client, err := NewClient(settings.GetEureka())
+		Expect(err).NotTo(HaveOccurred())
+		p = NewPlugin(client)
+		p.Init(plugins.InitParams{Ctx: ctx, Settings: settings})
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	eurekaUpstream := func(name, appName string, useSecurePort bool) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: &core.Metadata{Name: name, Namespace: writeNamespace},
+			UpstreamType: &v1.Upstream_Eureka{
+				Eureka: &eureka.UpstreamSpec{AppName: appName, UseSecurePort: useSecurePort},
+			},
+		}
+	}
+
+	Context("UDS", func() {
+
+		It("creates an upstream for every application", func() {
+			secureOnly := testInstance("PAYMENTS", "p1", "10.0.0.3", "UP", 8080)
+			secureOnly.Port.Enabled = false
+			secureOnly.SecurePort.Enabled = true
+			fake.setInstances(
+				testInstance("ORDERS", "o1", "10.0.0.1", "UP", 8080),
+				testInstance("ORDERS", "o2", "10.0.0.2", "DOWN", 8080),
+				secureOnly,
+			)
+
+			upstreams, errs, err := p.DiscoverUpstreams(nil, writeNamespace, clients.WatchOpts{Ctx: ctx}, discovery.Opts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			var discovered v1.UpstreamList
+			Eventually(upstreams, time.Second).Should(Receive(&discovered))
+			Consistently(errs).ShouldNot(Receive())
+			Expect(discovered).To(ConsistOf(
+				eurekaUpstream("eureka-orders", "ORDERS", false),
+				eurekaUpstream("eureka-payments", "PAYMENTS", true),
+			))
+		})
+
+		It("only sends upstreams when they change", func() {
+			fake.setInstances(testInstance("ORDERS", "o1", "10.0.0.1", "UP", 8080))
+
+			upstreams, _, err := p.DiscoverUpstreams(nil, writeNamespace, clients.WatchOpts{Ctx: ctx}, discovery.Opts{})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(upstreams, time.Second).Should(Receive(HaveLen(1)))
+			Consistently(upstreams, 200*time.Millisecond).ShouldNot(Receive())
+
+			fake.setInstances(
+				testInstance("ORDERS", "o1", "10.0.0.1", "UP", 8080),
+				testInstance("USERS", "u1", "10.0.0.2", "UP", 8080),
+			)
+			Eventually(upstreams, time.Second).Should(Receive(HaveLen(2)))
+		})
+
+		It("preserves the service spec of the existing upstream", func() {
+			original := eurekaUpstream("eureka-orders", "ORDERS", false)
+			original.GetEureka().ServiceSpec = &options.ServiceSpec{}
+			desired := eurekaUpstream("eureka-orders", "ORDERS", false)
+
+			changed, err := p.UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeFalse())
+			Expect(desired.GetEureka().GetServiceSpec()).To(Equal(original.GetEureka().GetServiceSpec()))
+		})
+	})
+
+	Context("EDS", func() {
+
+		It("creates endpoints for the instances that are up", func() {
+			o1 := testInstance("ORDERS", "o1", "10.0.0.1", "UP", 8080)
+			o1.Metadata = map[string]string{"version": "v1", "@class": "java.util.Collections$EmptyMap"}
+			o1.SecurePort.Enabled = true
+			fake.setInstances(
+				o1,
+				testInstance("ORDERS", "o2", "10.0.0.2", "DOWN", 8080),
+				testInstance("ORDERS", "o3", "10.0.0.3", "OUT_OF_SERVICE", 8080),
+				testInstance("USERS", "u1", "10.0.0.4", "UP", 8080),
+			)
+
+			plain := eurekaUpstream("orders", "orders", false)
+			secure := eurekaUpstream("orders-tls", "ORDERS", true)
+			endpoints, errs, err := p.WatchEndpoints(writeNamespace, v1.UpstreamList{plain, secure}, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+
+			var eps v1.EndpointList
+			Eventually(endpoints, time.Second).Should(Receive(&eps))
+			Consistently(errs).ShouldNot(Receive())
+			Expect(eps).To(Equal(v1.EndpointList{
+				{
+					Metadata: &core.Metadata{
+						Namespace: writeNamespace,
+						Name:      "eureka-orders-o1-443",
+						Labels:    map[string]string{"version": "v1"},
+					},
+					Upstreams: []*core.ResourceRef{secure.GetMetadata().Ref()},
+					Address:   "10.0.0.1",
+					Port:      443,
+					Hostname:  "o1.local",
+				},
+				{
+					Metadata: &core.Metadata{
+						Namespace: writeNamespace,
+						Name:      "eureka-orders-o1-8080",
+						Labels:    map[string]string{"version": "v1"},
+					},
+					Upstreams: []*core.ResourceRef{plain.GetMetadata().Ref()},
+					Address:   "10.0.0.1",
+					Port:      8080,
+					Hostname:  "o1.local",
+				},
+			}))
+		})
+
+		It("updates endpoints when instances change status", func() {
+			fake.setInstances(testInstance("ORDERS", "o1", "10.0.0.1", "STARTING", 8080))
+
+			us := eurekaUpstream("orders", "ORDERS", false)
+			endpoints, _, err := p.WatchEndpoints(writeNamespace, v1.UpstreamList{us}, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(endpoints, time.Second).Should(Receive(BeEmpty()))
+
+			modified := testInstance("ORDERS", "o1", "10.0.0.1", "UP", 8080)
+			fake.setInstances(modified)
+			delta := *modified
+			delta.ActionType = ActionModified
+			fake.setDelta(&delta)
+
+			Eventually(endpoints, time.Second).Should(Receive(HaveLen(1)))
+			_, deltas := fake.counts()
+			Expect(deltas).To(BeNumerically(">", 0))
+		})
+	})
+})
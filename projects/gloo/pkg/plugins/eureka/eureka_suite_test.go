@@ -0,0 +1,13 @@
+package eureka
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestEureka(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Eureka Plugin Suite")
+}
@@ -0,0 +1,73 @@
+package eureka
+
+import (
+	"reflect"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.UpstreamPlugin    = new(plugin)
+	_ discovery.DiscoveryPlugin = new(plugin)
+)
+
+const (
+	ExtensionName          = "eureka"
+	DefaultPollingInterval = 30 * time.Second
+)
+
+var (
+	WrongUpstreamTypeError = func(upstream *v1.Upstream) error {
+		return eris.Errorf("internal error: expected *v1.Upstream_Eureka, got %v", reflect.TypeOf(upstream.GetUpstreamType()).Name())
+	}
+)
+
+/*
+Steps:
+- UDS polls the registry and creates an upstream for each registered application
+- EDS polls the registry and creates an endpoint for each instance with status UP, labelled with the instance metadata
+- Gloo plugin configures the clusters for Eureka upstreams to use EDS
+*/
+
+type plugin struct {
+	client          Client
+	pollingInterval time.Duration
+
+	settings *v1.Settings
+}
+
+func NewPlugin(client Client) *plugin {
+	return &plugin{client: client}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.settings = params.Settings
+	p.pollingInterval = DefaultPollingInterval
+	if interval := params.Settings.GetEureka().GetPollingInterval(); interval != nil {
+		p.pollingInterval = prototime.DurationFromProto(interval)
+	}
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	_, ok := in.GetUpstreamType().(*v1.Upstream_Eureka)
+	if !ok {
+		return nil
+	}
+
+	// eureka upstreams use EDS
+	xds.SetEdsOnCluster(out, p.settings)
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package eureka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+// registry is a local copy of the remote registry.
+// After the first full fetch it is kept up to date by applying deltas, the same way the Eureka client does:
+// if the hash code of the reconciled copy does not match the one advertised by the server, the
+// full registry is fetched again.
+type registry struct {
+	client Client
+
+	// application name (upper case) -> instance id -> instance
+	apps        map[string]map[string]*Instance
+	initialized bool
+}
+
+func newRegistry(client Client) *registry {
+	return &registry{client: client}
+}
+
+// refresh brings the local copy up to date with the remote registry
+func (r *registry) refresh(ctx context.Context) error {
+	if !r.initialized {
+		return r.fetchFull(ctx)
+	}
+
+	delta, err := r.client.Delta(ctx)
+	if err != nil {
+		// delta fetches may be disabled on the server; fall back to fetching everything
+		contextutils.LoggerFrom(ctx).Debugw("failed to fetch eureka registry delta, fetching full registry", "error", err)
+		return r.fetchFull(ctx)
+	}
+
+	r.applyDelta(delta)
+	if hashCode := r.hashCode(); hashCode != delta.AppsHashCode {
+		contextutils.LoggerFrom(ctx).Debugw("eureka registry hash code mismatch after applying delta, fetching full registry",
+			"local", hashCode, "remote", delta.AppsHashCode)
+		return r.fetchFull(ctx)
+	}
+	return nil
+}
+
+func (r *registry) fetchFull(ctx context.Context) error {
+	apps, err := r.client.Applications(ctx)
+	if err != nil {
+		return eris.Wrapf(err, "fetching eureka registry")
+	}
+	r.apps = map[string]map[string]*Instance{}
+	for _, app := range apps.Applications {
+		for _, instance := range app.Instances {
+			r.put(app.Name, instance)
+		}
+	}
+	r.initialized = true
+	return nil
+}
+
+func (r *registry) applyDelta(delta *Applications) {
+	for _, app := range delta.Applications {
+		for _, instance := range app.Instances {
+			switch instance.ActionType {
+			case ActionDeleted:
+				r.remove(app.Name, instance)
+			default:
+				// ADDED and MODIFIED both carry the complete instance
+				r.put(app.Name, instance)
+			}
+		}
+	}
+}
+
+func (r *registry) put(appName string, instance *Instance) {
+	appName = strings.ToUpper(appName)
+	if r.apps[appName] == nil {
+		r.apps[appName] = map[string]*Instance{}
+	}
+	stored := *instance
+	stored.ActionType = ""
+	r.apps[appName][instanceKey(instance)] = &stored
+}
+
+func (r *registry) remove(appName string, instance *Instance) {
+	appName = strings.ToUpper(appName)
+	delete(r.apps[appName], instanceKey(instance))
+	if len(r.apps[appName]) == 0 {
+		delete(r.apps, appName)
+	}
+}
+
+// hashCode computes the registry hash code in the same format as the server, i.e. `<STATUS>_<count>_` for each
+// instance status in alphabetical order, e.g. `DOWN_1_UP_3_`
+func (r *registry) hashCode() string {
+	countsByStatus := map[string]int{}
+	for _, instances := range r.apps {
+		for _, instance := range instances {
+			countsByStatus[instance.Status]++
+		}
+	}
+	var statuses []string
+	for status := range countsByStatus {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	var sb strings.Builder
+	for _, status := range statuses {
+		sb.WriteString(fmt.Sprintf("%s_%d_", status, countsByStatus[status]))
+	}
+	return sb.String()
+}
+
+// applications returns a copy of the registry, sorted by application name and instance id
+func (r *registry) applications() []*Application {
+	var apps []*Application
+	for appName, instances := range r.apps {
+		app := &Application{Name: appName}
+		for _, instance := range instances {
+			copied := *instance
+			app.Instances = append(app.Instances, &copied)
+		}
+		sort.SliceStable(app.Instances, func(i, j int) bool {
+			return instanceKey(app.Instances[i]) < instanceKey(app.Instances[j])
+		})
+		apps = append(apps, app)
+	}
+	sort.SliceStable(apps, func(i, j int) bool {
+		return apps[i].Name < apps[j].Name
+	})
+	return apps
+}
+
+// instances without an id are identified by their address
+func instanceKey(instance *Instance) string {
+	if instance.InstanceId != "" {
+		return instance.InstanceId
+	}
+	return fmt.Sprintf("%s:%s:%d", instance.HostName, instance.IpAddr, instance.Port.Number)
+}
+
+// watchApplications polls the registry at the given interval and sends its content on the returned channel
+// after every successful refresh. Both channels are closed when the context is cancelled.
+func watchApplications(ctx context.Context, client Client, pollingInterval time.Duration) (<-chan []*Application, chan error) {
+	applicationsChan := make(chan []*Application)
+	errs := make(chan error)
+	reg := newRegistry(client)
+
+	go func() {
+		defer close(applicationsChan)
+		defer close(errs)
+
+		ticker := time.NewTicker(pollingInterval)
+		defer ticker.Stop()
+
+		for {
+			if err := reg.refresh(ctx); err != nil {
+				select {
+				case errs <- err:
+				case <-ctx.Done():
+					return
+				}
+			} else {
+				select {
+				case applicationsChan <- reg.applications():
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return applicationsChan, errs
+}
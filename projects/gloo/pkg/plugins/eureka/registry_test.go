@@ -0,0 +1,250 @@
+package eureka
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+// fakeRegistry serves the subset of the Eureka REST API used by Gloo
+type fakeRegistry struct {
+	lock sync.Mutex
+
+	apps          map[string][]*Instance
+	delta         []*Instance
+	deltaDisabled bool
+	fullFetches   int
+	deltaFetches  int
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{apps: map[string][]*Instance{}}
+}
+
+func (f *fakeRegistry) setInstances(instances ...*Instance) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.apps = map[string][]*Instance{}
+	for _, instance := range instances {
+		f.apps[instance.App] = append(f.apps[instance.App], instance)
+	}
+}
+
+func (f *fakeRegistry) setDelta(instances ...*Instance) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.delta = instances
+}
+
+func (f *fakeRegistry) counts() (int, int) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.fullFetches, f.deltaFetches
+}
+
+func (f *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if r.Header.Get("Accept") != "application/json" {
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
+
+	full := newRegistry(nil)
+	full.apps = map[string]map[string]*Instance{}
+	for appName, instances := range f.apps {
+		for _, instance := range instances {
+			full.put(appName, instance)
+		}
+	}
+	apps := &Applications{AppsHashCode: full.hashCode()}
+
+	switch r.URL.Path {
+	case "/eureka/apps":
+		f.fullFetches++
+		apps.Applications = full.applications()
+	case "/eureka/apps/delta":
+		if f.deltaDisabled {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		f.deltaFetches++
+		byApp := map[string]*Application{}
+		for _, instance := range f.delta {
+			if byApp[instance.App] == nil {
+				byApp[instance.App] = &Application{Name: instance.App}
+				apps.Applications = append(apps.Applications, byApp[instance.App])
+			}
+			byApp[instance.App].Instances = append(byApp[instance.App].Instances, instance)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"applications": apps})
+}
+
+func testInstance(app, id, ip, status string, port uint32) *Instance {
+	return &Instance{
+		InstanceId: id,
+		App:        app,
+		HostName:   id + ".local",
+		IpAddr:     ip,
+		Status:     status,
+		Port:       Port{Number: port, Enabled: true},
+		SecurePort: Port{Number: 443},
+	}
+}
+
+var _ = Describe("Registry", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		fake   *fakeRegistry
+		server *httptest.Server
+		client Client
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		fake = newFakeRegistry()
+		server = httptest.NewServer(fake)
+
+		var err error
+		client, err = NewClient(&v1.Settings_EurekaConfiguration{ServiceUrls: []string{server.URL + "/eureka/"}})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		cancel()
+	})
+
+	It("requires a service url", func() {
+		_, err := NewClient(&v1.Settings_EurekaConfiguration{})
+		Expect(err).To(MatchError(NoServiceUrlsError))
+	})
+
+	It("computes the hash code in the same format as the server", func() {
+		reg := newRegistry(nil)
+		reg.apps = map[string]map[string]*Instance{}
+		reg.put("a", testInstance("A", "a1", "10.0.0.1", "UP", 8080))
+		reg.put("a", testInstance("A", "a2", "10.0.0.2", "UP", 8080))
+		reg.put("b", testInstance("B", "b1", "10.0.0.3", "DOWN", 8080))
+		Expect(reg.hashCode()).To(Equal("DOWN_1_UP_2_"))
+	})
+
+	It("fetches the full registry first and applies deltas afterwards", func() {
+		fake.setInstances(
+			testInstance("A", "a1", "10.0.0.1", "UP", 8080),
+			testInstance("B", "b1", "10.0.0.2", "UP", 8080),
+		)
+		reg := newRegistry(client)
+		Expect(reg.refresh(ctx)).To(Succeed())
+		Expect(reg.applications()).To(HaveLen(2))
+
+		// a1 goes down, b1 is deregistered, c1 registers
+		a1Down := testInstance("A", "a1", "10.0.0.1", "DOWN", 8080)
+		c1 := testInstance("C", "c1", "10.0.0.3", "UP", 8080)
+		fake.setInstances(a1Down, c1)
+
+		a1Modified := *a1Down
+		a1Modified.ActionType = ActionModified
+		b1Deleted := testInstance("B", "b1", "10.0.0.2", "UP", 8080)
+		b1Deleted.ActionType = ActionDeleted
+		c1Added := *c1
+		c1Added.ActionType = ActionAdded
+		fake.setDelta(&a1Modified, b1Deleted, &c1Added)
+
+		Expect(reg.refresh(ctx)).To(Succeed())
+		full, deltas := fake.counts()
+		Expect(full).To(Equal(1))
+		Expect(deltas).To(Equal(1))
+
+		apps := reg.applications()
+		Expect(apps).To(HaveLen(2))
+		Expect(apps[0].Name).To(Equal("A"))
+		Expect(apps[0].Instances).To(ConsistOf(a1Down))
+		Expect(apps[1].Name).To(Equal("C"))
+		Expect(apps[1].Instances).To(ConsistOf(c1))
+	})
+
+	It("falls back to a full fetch when the hash code does not match", func() {
+		fake.setInstances(testInstance("A", "a1", "10.0.0.1", "UP", 8080))
+		reg := newRegistry(client)
+		Expect(reg.refresh(ctx)).To(Succeed())
+
+		// the delta misses a registration
+		fake.setInstances(
+			testInstance("A", "a1", "10.0.0.1", "UP", 8080),
+			testInstance("A", "a2", "10.0.0.2", "UP", 8080),
+		)
+		Expect(reg.refresh(ctx)).To(Succeed())
+		full, deltas := fake.counts()
+		Expect(full).To(Equal(2))
+		Expect(deltas).To(Equal(1))
+		Expect(reg.applications()[0].Instances).To(HaveLen(2))
+	})
+
+	It("falls back to a full fetch when deltas are disabled", func() {
+		fake.setInstances(testInstance("A", "a1", "10.0.0.1", "UP", 8080))
+		fake.deltaDisabled = true
+		reg := newRegistry(client)
+		Expect(reg.refresh(ctx)).To(Succeed())
+		Expect(reg.refresh(ctx)).To(Succeed())
+		full, deltas := fake.counts()
+		Expect(full).To(Equal(2))
+		Expect(deltas).To(Equal(0))
+	})
+
+	It("fails over to the next service url", func() {
+		fake.setInstances(testInstance("A", "a1", "10.0.0.1", "UP", 8080))
+		unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer unavailable.Close()
+
+		failoverClient, err := NewClient(&v1.Settings_EurekaConfiguration{
+			ServiceUrls: []string{unavailable.URL + "/eureka", server.URL + "/eureka"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		apps, err := failoverClient.Applications(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apps.Applications).To(HaveLen(1))
+	})
+
+	It("accepts single applications and instances serialized as objects", func() {
+		var apps Applications
+		err := json.Unmarshal([]byte(`{
+			"versions__delta": "1",
+			"apps__hashcode": "UP_1_",
+			"application": {
+				"name": "A",
+				"instance": {
+					"instanceId": "a1",
+					"ipAddr": "10.0.0.1",
+					"status": "UP",
+					"port": {"$": 8080, "@enabled": "true"},
+					"securePort": {"$": "443", "@enabled": false},
+					"metadata": {"@class": "java.util.Collections$EmptyMap"}
+				}
+			}
+		}`), &apps)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apps.Applications).To(HaveLen(1))
+		Expect(apps.Applications[0].Instances).To(HaveLen(1))
+		instance := apps.Applications[0].Instances[0]
+		Expect(instance.Port).To(Equal(Port{Number: 8080, Enabled: true}))
+		Expect(instance.SecurePort).To(Equal(Port{Number: 443, Enabled: false}))
+	})
+})
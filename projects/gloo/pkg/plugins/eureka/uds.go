@@ -0,0 +1,115 @@
+package eureka
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/eureka"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils"
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/k8s-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const upstreamNamePrefix = "eureka"
+
+func (p *plugin) DiscoverUpstreams(_ []string, writeNamespace string, opts clients.WatchOpts, _ discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	applicationsChan, errs := watchApplications(opts.Ctx, p.client, p.pollingInterval)
+
+	upstreamsChan := make(chan v1.UpstreamList)
+	go func() {
+		defer close(upstreamsChan)
+
+		var (
+			previousHash uint64
+			published    bool
+		)
+		for {
+			select {
+			case applications, ok := <-applicationsChan:
+				if !ok {
+					return
+				}
+				upstreams := upstreamsForApplications(writeNamespace, applications)
+				currentHash := hashutils.MustHash(upstreams)
+				if published && currentHash == previousHash {
+					continue
+				}
+				previousHash = currentHash
+				published = true
+				select {
+				case upstreamsChan <- upstreams:
+				case <-opts.Ctx.Done():
+					return
+				}
+			case <-opts.Ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return upstreamsChan, errs, nil
+}
+
+// create one upstream for every application with at least one instance
+func upstreamsForApplications(writeNamespace string, applications []*Application) v1.UpstreamList {
+	var upstreams v1.UpstreamList
+	for _, app := range applications {
+		if len(app.Instances) == 0 {
+			continue
+		}
+		upstreams = append(upstreams, &v1.Upstream{
+			Metadata: &core.Metadata{
+				Name:      UpstreamName(app.Name),
+				Namespace: writeNamespace,
+			},
+			UpstreamType: &v1.Upstream_Eureka{
+				Eureka: &eureka.UpstreamSpec{
+					AppName:       app.Name,
+					UseSecurePort: onlySecurePortsEnabled(app.Instances),
+				},
+			},
+		})
+	}
+	return upstreams
+}
+
+// UpstreamName returns the name of the upstream discovered for the given application
+func UpstreamName(appName string) string {
+	return kubeutils.SanitizeNameV2(fmt.Sprintf("%s-%s", upstreamNamePrefix, strings.ToLower(appName)))
+}
+
+// applications that only expose their secure port are discovered with use_secure_port set
+func onlySecurePortsEnabled(instances []*Instance) bool {
+	for _, instance := range instances {
+		if instance.Port.Enabled || !instance.SecurePort.Enabled {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	originalSpec, ok := original.GetUpstreamType().(*v1.Upstream_Eureka)
+	if !ok {
+		return false, WrongUpstreamTypeError(original)
+	}
+	desiredSpec, ok := desired.GetUpstreamType().(*v1.Upstream_Eureka)
+	if !ok {
+		return false, WrongUpstreamTypeError(desired)
+	}
+
+	// copy service spec, we don't want to overwrite that
+	desiredSpec.Eureka.ServiceSpec = originalSpec.Eureka.GetServiceSpec()
+
+	utils.UpdateUpstream(original, desired)
+
+	if originalSpec.Eureka.Equal(desiredSpec.Eureka) {
+		return false, nil
+	}
+
+	return true, nil
+}
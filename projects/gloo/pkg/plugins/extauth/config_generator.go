@@ -30,6 +30,9 @@ var (
 	ServerNotFound = func(usRef *core.ResourceRef) error {
 		return eris.Errorf("extauth server upstream not found %s", usRef.String())
 	}
+	AuthConfigNotFound = func(configRef *core.ResourceRef) error {
+		return eris.Errorf("could not find AuthConfig resource with name [%s] in namespace [%s]", configRef.GetName(), configRef.GetNamespace())
+	}
 	InvalidStatusOnErrorErr = func(code uint32) error {
 		return eris.Errorf("invalid statusOnError code: %d", code)
 	}
@@ -39,8 +42,8 @@ type ExtAuthzConfigGenerator interface {
 	IsMulti() bool
 	GenerateListenerExtAuthzConfig(listener *v1.HttpListener, upstreams v1.UpstreamList) ([]*envoyauth.ExtAuthz, error)
 	GenerateVirtualHostExtAuthzConfig(virtualHost *v1.VirtualHost, params plugins.VirtualHostParams) (*envoyauth.ExtAuthzPerRoute, error)
-	GenerateRouteExtAuthzConfig(route *v1.Route) (*envoyauth.ExtAuthzPerRoute, error)
-	GenerateWeightedDestinationExtAuthzConfig(weightedDestination *v1.WeightedDestination) (*envoyauth.ExtAuthzPerRoute, error)
+	GenerateRouteExtAuthzConfig(route *v1.Route, params plugins.RouteParams) (*envoyauth.ExtAuthzPerRoute, error)
+	GenerateWeightedDestinationExtAuthzConfig(weightedDestination *v1.WeightedDestination, params plugins.RouteParams) (*envoyauth.ExtAuthzPerRoute, error)
 }
 
 func getOpenSourceConfigGenerator(defaultSettings *extauthv1.Settings, namedSettings map[string]*extauthv1.Settings) ExtAuthzConfigGenerator {
@@ -97,6 +100,10 @@ func (d *DefaultConfigGenerator) GenerateVirtualHostExtAuthzConfig(virtualHost *
 		return GetDisabledAuth(), nil
 	}
 
+	if configRef := extension.GetConfigRef(); configRef != nil {
+		return configRefExtAuthzConfig(configRef, params.Snapshot.AuthConfigs)
+	}
+
 	customAuthConfig := extension.GetCustomAuth()
 
 	// No extauth config on this virtual host, disable it
@@ -114,7 +121,7 @@ func (d *DefaultConfigGenerator) GenerateVirtualHostExtAuthzConfig(virtualHost *
 	return config, nil
 }
 
-func (d *DefaultConfigGenerator) GenerateRouteExtAuthzConfig(route *v1.Route) (*envoyauth.ExtAuthzPerRoute, error) {
+func (d *DefaultConfigGenerator) GenerateRouteExtAuthzConfig(route *v1.Route, params plugins.RouteParams) (*envoyauth.ExtAuthzPerRoute, error) {
 	extension := route.GetOptions().GetExtauth()
 	if extension == nil {
 		return nil, nil
@@ -125,6 +132,10 @@ func (d *DefaultConfigGenerator) GenerateRouteExtAuthzConfig(route *v1.Route) (*
 		return GetDisabledAuth(), nil
 	}
 
+	if configRef := extension.GetConfigRef(); configRef != nil {
+		return configRefExtAuthzConfig(configRef, params.Snapshot.AuthConfigs)
+	}
+
 	customAuthConfig := extension.GetCustomAuth()
 
 	// No custom config, do nothing
@@ -142,7 +153,7 @@ func (d *DefaultConfigGenerator) GenerateRouteExtAuthzConfig(route *v1.Route) (*
 	return config, nil
 }
 
-func (d *DefaultConfigGenerator) GenerateWeightedDestinationExtAuthzConfig(weightedDestination *v1.WeightedDestination) (*envoyauth.ExtAuthzPerRoute, error) {
+func (d *DefaultConfigGenerator) GenerateWeightedDestinationExtAuthzConfig(weightedDestination *v1.WeightedDestination, params plugins.RouteParams) (*envoyauth.ExtAuthzPerRoute, error) {
 	extension := weightedDestination.GetOptions().GetExtauth()
 	if extension == nil {
 		return nil, nil
@@ -153,6 +164,10 @@ func (d *DefaultConfigGenerator) GenerateWeightedDestinationExtAuthzConfig(weigh
 		return GetDisabledAuth(), nil
 	}
 
+	if configRef := extension.GetConfigRef(); configRef != nil {
+		return configRefExtAuthzConfig(configRef, params.Snapshot.AuthConfigs)
+	}
+
 	customAuthConfig := extension.GetCustomAuth()
 
 	// No custom config, do nothing
@@ -170,6 +185,22 @@ func (d *DefaultConfigGenerator) GenerateWeightedDestinationExtAuthzConfig(weigh
 	return config, nil
 }
 
+// configRefExtAuthzConfig tells the open source extauth server which AuthConfig to apply to requests on a resource
+func configRefExtAuthzConfig(configRef *core.ResourceRef, authConfigs extauthv1.AuthConfigList) (*envoyauth.ExtAuthzPerRoute, error) {
+	if _, err := authConfigs.Find(configRef.GetNamespace(), configRef.GetName()); err != nil {
+		return nil, AuthConfigNotFound(configRef)
+	}
+	return &envoyauth.ExtAuthzPerRoute{
+		Override: &envoyauth.ExtAuthzPerRoute_CheckSettings{
+			CheckSettings: &envoyauth.CheckSettings{
+				ContextExtensions: map[string]string{
+					extauth.ConfigIdContextExtension: extauth.ConfigId(configRef.GetNamespace(), configRef.GetName()),
+				},
+			},
+		},
+	}, nil
+}
+
 func GetDisabledAuth() *envoyauth.ExtAuthzPerRoute {
 	return &envoyauth.ExtAuthzPerRoute{
 		Override: &envoyauth.ExtAuthzPerRoute_Disabled{
@@ -77,7 +77,7 @@ func (p *plugin) ProcessVirtualHost(
 }
 
 // This function generates the ext_authz TypedPerFilterConfig for this route:
-// - if the route defines custom auth configuration, set the filter correspondingly;
+// - if the route references an AuthConfig or defines custom auth configuration, set the filter correspondingly;
 // - if auth is explicitly disabled, disable the filter (will apply by default also to WeightedDestinations);
 // - else, do nothing (will inherit config from parent virtual host).
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
@@ -87,7 +87,7 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 		return nil
 	}
 
-	extAuthPerRouteConfig, err := p.extAuthzConfigGenerator.GenerateRouteExtAuthzConfig(in)
+	extAuthPerRouteConfig, err := p.extAuthzConfigGenerator.GenerateRouteExtAuthzConfig(in, params)
 	if err != nil {
 		return err
 	}
@@ -99,7 +99,7 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 }
 
 // This function generates the ext_authz TypedPerFilterConfig for this weightedDestination:
-// - if the weightedDestination defines custom auth configuration, set the filter correspondingly;
+// - if the weightedDestination references an AuthConfig or defines custom auth configuration, set the filter correspondingly;
 // - if auth is explicitly disabled, disable the filter;
 // - else, do nothing (will inherit config from parent virtual host and/or route).
 func (p *plugin) ProcessWeightedDestination(
@@ -113,7 +113,7 @@ func (p *plugin) ProcessWeightedDestination(
 		return nil
 	}
 
-	extAuthPerRouteConfig, err := p.extAuthzConfigGenerator.GenerateWeightedDestinationExtAuthzConfig(in)
+	extAuthPerRouteConfig, err := p.extAuthzConfigGenerator.GenerateWeightedDestinationExtAuthzConfig(in, params)
 	if err != nil {
 		return err
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/extauth"
+	extauthsyncer "github.com/solo-io/gloo/projects/gloo/pkg/syncer/extauth"
 	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
@@ -110,6 +111,47 @@ var _ = Describe("Process Custom Extauth configuration", func() {
 	})
 })
 
+var _ = Describe("Process AuthConfig references", func() {
+
+	var (
+		pluginContext *pluginContext
+		authConfigRef *core.ResourceRef
+	)
+
+	BeforeEach(func() {
+		pluginContext = getPluginContext(true, Undefined, Undefined, Undefined)
+		authConfig := &extauthv1.AuthConfig{
+			Metadata: &core.Metadata{Name: "basic-auth", Namespace: "default"},
+		}
+		authConfigRef = authConfig.GetMetadata().Ref()
+		pluginContext.RouteParams.Snapshot.AuthConfigs = extauthv1.AuthConfigList{authConfig}
+		pluginContext.Route.Options.Extauth = &extauthv1.ExtAuthExtension{
+			Spec: &extauthv1.ExtAuthExtension_ConfigRef{ConfigRef: authConfigRef},
+		}
+	})
+
+	It("passes the config id of the referenced AuthConfig to the extauth server", func() {
+		var out envoy_config_route_v3.Route
+		err := pluginContext.PluginInstance.(plugins.RoutePlugin).ProcessRoute(pluginContext.RouteParams, pluginContext.Route, &out)
+		Expect(err).NotTo(HaveOccurred())
+
+		msg, err := glooutils.AnyToMessage(out.GetTypedPerFilterConfig()[wellknown.HTTPExternalAuthorization])
+		Expect(err).NotTo(HaveOccurred())
+		ctxExtensions := msg.(*envoyauth.ExtAuthzPerRoute).GetCheckSettings().GetContextExtensions()
+		Expect(ctxExtensions).To(Equal(map[string]string{
+			extauthsyncer.ConfigIdContextExtension: extauthsyncer.ConfigId("default", "basic-auth"),
+		}))
+	})
+
+	It("returns an error when the referenced AuthConfig does not exist", func() {
+		pluginContext.RouteParams.Snapshot.AuthConfigs = nil
+
+		var out envoy_config_route_v3.Route
+		err := pluginContext.PluginInstance.(plugins.RoutePlugin).ProcessRoute(pluginContext.RouteParams, pluginContext.Route, &out)
+		Expect(err).To(MatchError(ContainSubstring(AuthConfigNotFound(authConfigRef).Error())))
+	})
+})
+
 type pluginContext struct {
 	PluginInstance      plugins.Plugin
 	VirtualHost         *gloov1.VirtualHost
@@ -13,6 +13,7 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 )
 
 var (
@@ -78,17 +79,30 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 		if err != nil {
 			return nil, err
 		}
+		envoyRateLimit, err := toEnvoyResponseRateLimit(routeFaults.GetResponseRateLimit())
+		if err != nil {
+			return nil, err
+		}
 
-		// neither were configured on the route so return without error
-		if envoyAbort == nil && envoyDelay == nil {
+		// none were configured on the route so return without error
+		if envoyAbort == nil && envoyDelay == nil && envoyRateLimit == nil {
 			return nil, nil
 		}
 
+		var upstreamCluster string
+		if upstream := routeFaults.GetUpstream(); upstream != nil {
+			upstreamCluster = translator.UpstreamToClusterName(upstream)
+		}
+
 		// mark configured and return the wrapped envoy configuration
 		p.filterRequiredForListener[params.HttpListener] = struct{}{}
 		return &envoyhttpfault.HTTPFault{
-			Abort: envoyAbort,
-			Delay: envoyDelay,
+			Abort:             envoyAbort,
+			Delay:             envoyDelay,
+			ResponseRateLimit: envoyRateLimit,
+			Headers:           pluginutils.EnvoyHeaderMatchers(params.Ctx, routeFaults.GetHeaders()),
+			UpstreamCluster:   upstreamCluster,
+			MaxActiveFaults:   routeFaults.GetMaxActiveFaults(),
 		}, nil
 	}
 	return pluginutils.MarkPerFilterConfig(params.Ctx, params.Snapshot, in, out, wellknown.Fault, markFilterConfigFunc)
@@ -100,12 +114,28 @@ func toEnvoyAbort(abort *fault.RouteAbort) (*envoyhttpfault.FaultAbort, error) {
 	if abort == nil {
 		return nil, nil
 	}
+	percentage := common.ToEnvoyPercentage(abort.GetPercentage())
+	switch {
+	case abort.GetHeaderControlled():
+		return &envoyhttpfault.FaultAbort{
+			Percentage: percentage,
+			ErrorType:  &envoyhttpfault.FaultAbort_HeaderAbort_{HeaderAbort: &envoyhttpfault.FaultAbort_HeaderAbort{}},
+		}, nil
+	case abort.GetGrpcStatus() != nil:
+		// https://github.com/grpc/grpc/blob/master/doc/statuscodes.md
+		if abort.GetGrpcStatus().GetValue() == 0 || abort.GetGrpcStatus().GetValue() > 16 {
+			return nil, errors.Errorf("invalid abort grpc status '%v', must be in range of [1,16]", abort.GetGrpcStatus().GetValue())
+		}
+		return &envoyhttpfault.FaultAbort{
+			Percentage: percentage,
+			ErrorType:  &envoyhttpfault.FaultAbort_GrpcStatus{GrpcStatus: abort.GetGrpcStatus().GetValue()},
+		}, nil
+	}
 	// Validation really should catch this at proto level but sometimes things can sneak by
 	// https://github.com/envoyproxy/envoy/blob/bc8f0cd19f991a56269f1ea30b5b8d8d331da0dc/api/envoy/config/filter/http/fault/v2/fault.proto#L39
 	if abort.GetHttpStatus() >= 600 || abort.GetHttpStatus() < 200 {
 		return nil, errors.Errorf("invalid abort status code '%v', must be in range of [200,600)", abort.GetHttpStatus())
 	}
-	percentage := common.ToEnvoyPercentage(abort.GetPercentage())
 	errorType := &envoyhttpfault.FaultAbort_HttpStatus{
 		HttpStatus: abort.GetHttpStatus(),
 	}
@@ -121,6 +151,12 @@ func toEnvoyDelay(delay *fault.RouteDelay) (*envoyfault.FaultDelay, error) {
 	if delay == nil {
 		return nil, nil
 	}
+	if delay.GetHeaderControlled() {
+		return &envoyfault.FaultDelay{
+			Percentage:         common.ToEnvoyPercentage(delay.GetPercentage()),
+			FaultDelaySecifier: &envoyfault.FaultDelay_HeaderDelay_{HeaderDelay: &envoyfault.FaultDelay_HeaderDelay{}},
+		}, nil
+	}
 	// Validation really should catch this at proto level but sometimes things can sneak by
 	// https://github.com/envoyproxy/envoy/blob/bc8f0cd19f991a56269f1ea30b5b8d8d331da0dc/api/envoy/extensions/filters/common/fault/v3/fault.proto#L53
 	if delay.GetFixedDelay().GetSeconds() <= 0 {
@@ -135,3 +171,27 @@ func toEnvoyDelay(delay *fault.RouteDelay) (*envoyfault.FaultDelay, error) {
 		FaultDelaySecifier: delaySpec,
 	}, nil
 }
+
+// toEnvoyResponseRateLimit converts the response rate limit config from the gloo api to the envoy api.
+// Will error if there is config present but it is invalid.
+func toEnvoyResponseRateLimit(rateLimit *fault.RouteResponseRateLimit) (*envoyfault.FaultRateLimit, error) {
+	if rateLimit == nil {
+		return nil, nil
+	}
+	percentage := common.ToEnvoyPercentage(rateLimit.GetPercentage())
+	if rateLimit.GetHeaderControlled() {
+		return &envoyfault.FaultRateLimit{
+			Percentage: percentage,
+			LimitType:  &envoyfault.FaultRateLimit_HeaderLimit_{HeaderLimit: &envoyfault.FaultRateLimit_HeaderLimit{}},
+		}, nil
+	}
+	if rateLimit.GetFixedLimitKbps() < 1 {
+		return nil, errors.Errorf("invalid response rate limit '%v' KiB/s, must be at least 1", rateLimit.GetFixedLimitKbps())
+	}
+	return &envoyfault.FaultRateLimit{
+		Percentage: percentage,
+		LimitType: &envoyfault.FaultRateLimit_FixedLimit_{
+			FixedLimit: &envoyfault.FaultRateLimit_FixedLimit{LimitKbps: rateLimit.GetFixedLimitKbps()},
+		},
+	}, nil
+}
@@ -6,13 +6,17 @@ import (
 	"testing"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhttpfault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
 	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/faultinjection"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/internal/common"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 func TestToEnvoyPercentage(t *testing.T) {
@@ -67,6 +71,10 @@ func TestProcessRoute(t *testing.T) {
 		{"delay non-zero but short", faultinjection.RouteFaults{Delay: &faultinjection.RouteDelay{FixedDelay: &duration.Duration{Nanos: 1}}}, "invalid delay duration 'nanos:1'"},
 
 		{"empty abort", faultinjection.RouteFaults{Abort: &faultinjection.RouteAbort{}}, "status code '0', must be in range of [200,600)"},
+		{"grpc abort", faultinjection.RouteFaults{Abort: &faultinjection.RouteAbort{GrpcStatus: &wrappers.UInt32Value{Value: 14}}}, ""},
+		{"invalid grpc abort", faultinjection.RouteFaults{Abort: &faultinjection.RouteAbort{GrpcStatus: &wrappers.UInt32Value{Value: 0}}}, "grpc status '0', must be in range of [1,16]"},
+		{"header controlled delay", faultinjection.RouteFaults{Delay: &faultinjection.RouteDelay{HeaderControlled: true}}, ""},
+		{"empty response rate limit", faultinjection.RouteFaults{ResponseRateLimit: &faultinjection.RouteResponseRateLimit{}}, "response rate limit '0' KiB/s, must be at least 1"},
 	}
 	for _, tc := range tests {
 		// not safe to run parallel but no big deal still make local copy
@@ -97,3 +105,63 @@ func TestProcessRoute(t *testing.T) {
 	}
 
 }
+
+// TestProcessRouteFaults checks the translation of the header-controlled faults and of the fault scoping.
+func TestProcessRouteFaults(t *testing.T) {
+	p := NewPlugin()
+	p.Init(plugins.InitParams{})
+
+	out := &envoy_config_route_v3.Route{
+		Action: &envoy_config_route_v3.Route_Route{
+			Route: &envoy_config_route_v3.RouteAction{},
+		},
+	}
+	err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+		Options: &v1.RouteOptions{
+			Faults: &faultinjection.RouteFaults{
+				Abort: &faultinjection.RouteAbort{Percentage: 100, HeaderControlled: true},
+				Delay: &faultinjection.RouteDelay{Percentage: 50, HeaderControlled: true},
+				ResponseRateLimit: &faultinjection.RouteResponseRateLimit{
+					Percentage:     100,
+					FixedLimitKbps: 64,
+				},
+				Headers:         []*matchers.HeaderMatcher{{Name: "x-chaos-client", Value: "true"}},
+				Upstream:        &core.ResourceRef{Name: "petstore", Namespace: "gloo-system"},
+				MaxActiveFaults: &wrappers.UInt32Value{Value: 10},
+			},
+		},
+		Action: &v1.Route_RouteAction{
+			RouteAction: &v1.RouteAction{
+				Destination: &v1.RouteAction_Single{
+					Single: &v1.Destination{},
+				},
+			},
+		},
+	}, out)
+	if err != nil {
+		t.Fatalf("Expected no error but got %v.", err)
+	}
+
+	var cfg envoyhttpfault.HTTPFault
+	if err := out.GetTypedPerFilterConfig()[wellknown.Fault].UnmarshalTo(&cfg); err != nil {
+		t.Fatalf("Expected the fault filter config but got %v.", err)
+	}
+	if cfg.GetAbort().GetHeaderAbort() == nil {
+		t.Errorf("Expected a header controlled abort but got %v.", cfg.GetAbort())
+	}
+	if cfg.GetDelay().GetHeaderDelay() == nil {
+		t.Errorf("Expected a header controlled delay but got %v.", cfg.GetDelay())
+	}
+	if limit := cfg.GetResponseRateLimit().GetFixedLimit().GetLimitKbps(); limit != 64 {
+		t.Errorf("Expected a response rate limit of 64 KiB/s but got %v.", limit)
+	}
+	if len(cfg.GetHeaders()) != 1 || cfg.GetHeaders()[0].GetExactMatch() != "true" {
+		t.Errorf("Expected the x-chaos-client header matcher but got %v.", cfg.GetHeaders())
+	}
+	if cfg.GetUpstreamCluster() != "petstore_gloo-system" {
+		t.Errorf("Expected the faults to be scoped to petstore_gloo-system but got %v.", cfg.GetUpstreamCluster())
+	}
+	if cfg.GetMaxActiveFaults().GetValue() != 10 {
+		t.Errorf("Expected 10 max active faults but got %v.", cfg.GetMaxActiveFaults())
+	}
+}
@@ -0,0 +1,358 @@
+package gcp
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoygcpauthn "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/gcp_authn/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/rotisserie/eris"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/gcp"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gcp/tokenserver"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	_ plugins.Plugin                  = new(plugin)
+	_ plugins.UpstreamPlugin          = new(plugin)
+	_ plugins.RoutePlugin             = new(plugin)
+	_ plugins.HttpFilterPlugin        = new(plugin)
+	_ plugins.ResourceGeneratorPlugin = new(plugin)
+)
+
+// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/gcp_authn_filter
+const (
+	ExtensionName             = "gcp"
+	FilterName                = "envoy.filters.http.gcp_authn"
+	MetadataServerClusterName = "gcp_metadata_server"
+	DefaultMetadataServerUrl  = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/identity?audience=[AUDIENCE]"
+	DefaultTokenCacheSize     = 100
+
+	tokenFetchTimeout = 10 * time.Second
+)
+
+// ID tokens are upstream auth, like the aws λ signature
+var pluginStage = plugins.DuringStage(plugins.OutAuthStage)
+
+var (
+	InvalidUrlError = func(rawUrl string, err error) error {
+		return eris.Wrapf(err, "invalid gcp url %s", rawUrl)
+	}
+	UnsupportedSchemeError = func(rawUrl string) error {
+		return eris.Errorf("gcp url %s must use https", rawUrl)
+	}
+	NotGcpUpstreamError = func(ref *core.ResourceRef) error {
+		return eris.Errorf("%v is not a GCP upstream", ref.Key())
+	}
+	UnknownFunctionError = func(logicalName string) error {
+		return eris.Errorf("unknown gcp function %v", logicalName)
+	}
+	ServiceAccountSecretNotFoundError = func(ref *core.ResourceRef, err error) error {
+		return eris.Wrapf(err, "gcp service account secret %v not found", ref.Key())
+	}
+	NotGcpSecretError = func(ref *core.ResourceRef) error {
+		return eris.Errorf("secret %v is not a gcp service account secret", ref.Key())
+	}
+)
+
+type plugin struct {
+	settings          *v1.GlooOptions_GCPOptions
+	upstreamOptions   *v1.UpstreamOptions
+	recordedUpstreams map[string]*gcp.UpstreamSpec
+}
+
+func NewPlugin() plugins.Plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.settings = params.Settings.GetGloo().GetGcpOptions()
+	p.upstreamOptions = params.Settings.GetUpstreamOptions()
+	p.recordedUpstreams = make(map[string]*gcp.UpstreamSpec)
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	upstreamSpec, ok := in.GetUpstreamType().(*v1.Upstream_Gcp)
+	if !ok {
+		// not ours
+		return nil
+	}
+	gcpUpstream := upstreamSpec.Gcp
+	// even if it failed, route should still be valid
+	p.recordedUpstreams[translator.UpstreamToClusterName(in.GetMetadata().Ref())] = gcpUpstream
+
+	serviceUrl, err := parseServiceUrl(gcpUpstream.GetUrl())
+	if err != nil {
+		return err
+	}
+	hostname := serviceUrl.Hostname()
+
+	// configure Envoy cluster routing info
+	out.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{
+		Type: envoy_config_cluster_v3.Cluster_LOGICAL_DNS,
+	}
+	pluginutils.EnvoySingleEndpointLoadAssignment(out, hostname, urlPort(serviceUrl))
+
+	commonTlsContext, err := utils.GetCommonTlsContextFromUpstreamOptions(p.upstreamOptions)
+	if err != nil {
+		return err
+	}
+	tlsContext := &envoyauth.UpstreamTlsContext{
+		CommonTlsContext: commonTlsContext,
+		Sni:              hostname,
+	}
+	typedConfig, err := utils.MessageToAny(tlsContext)
+	if err != nil {
+		return err
+	}
+	out.TransportSocket = &envoy_config_core_v3.TransportSocket{
+		Name:       wellknown.TransportSocketTls,
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}
+
+	// the gcp authn filter looks up the audience of the token in the metadata of the cluster
+	audience, err := utils.MessageToAny(&envoygcpauthn.Audience{Url: getAudience(gcpUpstream, serviceUrl)})
+	if err != nil {
+		return err
+	}
+	if out.GetMetadata() == nil {
+		out.Metadata = &envoy_config_core_v3.Metadata{}
+	}
+	if out.GetMetadata().GetTypedFilterMetadata() == nil {
+		out.GetMetadata().TypedFilterMetadata = make(map[string]*any.Any)
+	}
+	out.GetMetadata().GetTypedFilterMetadata()[FilterName] = audience
+
+	return nil
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	return pluginutils.MarkPerFilterConfig(params.Ctx, params.Snapshot, in, out, transformation.FilterName,
+		func(spec *v1.Destination) (proto.Message, error) {
+			// check if it's gcp upstream destination
+			gcpDestinationSpec, ok := spec.GetDestinationSpec().GetDestinationType().(*v1.DestinationSpec_Gcp)
+			if !ok {
+				return nil, nil
+			}
+
+			upstreamRef, err := upstreams.DestinationToUpstreamRef(spec)
+			if err != nil {
+				return nil, err
+			}
+			upstreamSpec, ok := p.recordedUpstreams[translator.UpstreamToClusterName(upstreamRef)]
+			if !ok {
+				return nil, NotGcpUpstreamError(upstreamRef)
+			}
+			serviceUrl, err := parseServiceUrl(upstreamSpec.GetUrl())
+			if err != nil {
+				return nil, err
+			}
+
+			headers := map[string]*transformationapi.InjaTemplate{
+				":authority": {
+					Text: serviceUrl.Host,
+				},
+			}
+			if logicalName := gcpDestinationSpec.Gcp.GetLogicalName(); logicalName != "" {
+				function := findFunction(upstreamSpec, logicalName)
+				if function == nil {
+					return nil, UnknownFunctionError(logicalName)
+				}
+				headers[":path"] = &transformationapi.InjaTemplate{Text: serviceUrl.EscapedPath() + function.GetPath()}
+			} else if serviceUrl.EscapedPath() != "" {
+				headers[":path"] = &transformationapi.InjaTemplate{Text: serviceUrl.EscapedPath() + `{{ header(":path") }}`}
+			}
+
+			return &transformationapi.RouteTransformations{
+				RequestTransformation: &transformationapi.Transformation{
+					TransformationType: &transformationapi.Transformation_TransformationTemplate{
+						TransformationTemplate: &transformationapi.TransformationTemplate{
+							Headers: headers,
+							BodyTransformation: &transformationapi.TransformationTemplate_Passthrough{
+								Passthrough: &transformationapi.Passthrough{},
+							},
+						},
+					},
+				},
+			}, nil
+		},
+	)
+}
+
+// HttpFilters adds the gcp authn filter if there are gcp upstreams. The filter only attaches
+// tokens to requests for clusters with an audience, i.e. the clusters of gcp upstreams.
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if !hasGcpUpstreams(params) {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+
+	tokenCacheSize := uint64(DefaultTokenCacheSize)
+	if p.settings.GetTokenCacheSize() != nil {
+		tokenCacheSize = p.settings.GetTokenCacheSize().GetValue()
+	}
+
+	httpUri := &envoy_config_core_v3.HttpUri{
+		Uri: p.metadataServerUrl(),
+		HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+			Cluster: MetadataServerClusterName,
+		},
+		Timeout: durationpb.New(tokenFetchTimeout),
+	}
+	if secretRef := p.settings.GetServiceAccountSecretRef(); secretRef != nil {
+		// the token server is given the key by the syncer of NewTokenServerSyncer, which only syncs accepted resources,
+		// so the key is only used here to authenticate to the server
+		key, err := serviceAccountKey(params.Snapshot.Secrets, secretRef)
+		if err != nil {
+			return nil, err
+		}
+		// fetch the tokens from the token server, which is served on the rest xds server of gloo
+		httpUri.Uri = "http://" + defaults.GlooRestXdsName + tokenserver.IdentityPath + "?audience=[AUDIENCE]&" +
+			tokenserver.AccessTokenParam + "=" + tokenserver.AccessToken(key)
+		httpUri.HttpUpstreamType = &envoy_config_core_v3.HttpUri_Cluster{
+			Cluster: defaults.GlooRestXdsName,
+		}
+	}
+
+	stagedFilter, err := plugins.NewStagedFilter(FilterName, &envoygcpauthn.GcpAuthnFilterConfig{
+		HttpUri: httpUri,
+		CacheConfig: &envoygcpauthn.TokenCacheConfig{
+			CacheSize: wrapperspb.UInt64(tokenCacheSize),
+		},
+	}, pluginStage)
+	if err != nil {
+		return nil, err
+	}
+	return []plugins.StagedHttpFilter{stagedFilter}, nil
+}
+
+// GeneratedResources adds the cluster of the metadata server from which the gcp authn filter fetches tokens,
+// unless they are fetched from the token server.
+func (p *plugin) GeneratedResources(params plugins.Params,
+	_ []*envoy_config_cluster_v3.Cluster,
+	_ []*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	_ []*envoy_config_route_v3.RouteConfiguration,
+	_ []*envoy_config_listener_v3.Listener) (
+	[]*envoy_config_cluster_v3.Cluster,
+	[]*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	[]*envoy_config_route_v3.RouteConfiguration,
+	[]*envoy_config_listener_v3.Listener, error) {
+	if !hasGcpUpstreams(params) || p.settings.GetServiceAccountSecretRef() != nil {
+		return nil, nil, nil, nil, nil
+	}
+
+	cluster, err := p.generateMetadataServerCluster()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return []*envoy_config_cluster_v3.Cluster{cluster}, nil, nil, nil, nil
+}
+
+func (p *plugin) generateMetadataServerCluster() (*envoy_config_cluster_v3.Cluster, error) {
+	metadataServerUrl := p.metadataServerUrl()
+	parsedUrl, err := url.Parse(metadataServerUrl)
+	if err != nil {
+		return nil, InvalidUrlError(metadataServerUrl, err)
+	}
+
+	out := &envoy_config_cluster_v3.Cluster{
+		Name:           MetadataServerClusterName,
+		ConnectTimeout: durationpb.New(5 * time.Second),
+		ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{
+			Type: envoy_config_cluster_v3.Cluster_LOGICAL_DNS,
+		},
+	}
+	pluginutils.EnvoySingleEndpointLoadAssignment(out, parsedUrl.Hostname(), urlPort(parsedUrl))
+
+	if parsedUrl.Scheme == "https" {
+		typedConfig, err := utils.MessageToAny(&envoyauth.UpstreamTlsContext{Sni: parsedUrl.Hostname()})
+		if err != nil {
+			return nil, err
+		}
+		out.TransportSocket = &envoy_config_core_v3.TransportSocket{
+			Name:       wellknown.TransportSocketTls,
+			ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+		}
+	}
+	return out, nil
+}
+
+func (p *plugin) metadataServerUrl() string {
+	if metadataServerUrl := p.settings.GetMetadataServerUrl(); metadataServerUrl != "" {
+		return metadataServerUrl
+	}
+	return DefaultMetadataServerUrl
+}
+
+func hasGcpUpstreams(params plugins.Params) bool {
+	for _, us := range params.Snapshot.Upstreams {
+		if _, ok := us.GetUpstreamType().(*v1.Upstream_Gcp); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func findFunction(spec *gcp.UpstreamSpec, logicalName string) *gcp.FunctionSpec {
+	for _, function := range spec.GetFunctions() {
+		if function.GetLogicalName() == logicalName {
+			return function
+		}
+	}
+	return nil
+}
+
+func parseServiceUrl(rawUrl string) (*url.URL, error) {
+	serviceUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, InvalidUrlError(rawUrl, err)
+	}
+	if serviceUrl.Scheme != "https" || serviceUrl.Hostname() == "" {
+		return nil, UnsupportedSchemeError(rawUrl)
+	}
+	// the request path is appended to the path of the url
+	serviceUrl.Path = strings.TrimRight(serviceUrl.Path, "/")
+	serviceUrl.RawPath = strings.TrimRight(serviceUrl.RawPath, "/")
+	return serviceUrl, nil
+}
+
+func getAudience(spec *gcp.UpstreamSpec, serviceUrl *url.URL) string {
+	if spec.GetAudience() != "" {
+		return spec.GetAudience()
+	}
+	return serviceUrl.Scheme + "://" + serviceUrl.Host
+}
+
+func urlPort(parsedUrl *url.URL) uint32 {
+	if port, err := strconv.ParseUint(parsedUrl.Port(), 10, 32); err == nil {
+		return uint32(port)
+	}
+	if parsedUrl.Scheme == "https" {
+		return 443
+	}
+	return 80
+}
@@ -0,0 +1,73 @@
+package gcp
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gcp/tokenserver"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ v1snap.ApiSyncer = new(tokenServerSyncer)
+
+// tokenServerSyncer passes the key of the service account secret and the audiences of the gcp upstreams to the
+// token server. It syncs the snapshots of the api event loop rather than being part of the plugin, so that the
+// resources proposed to the validation webhook, which are translated by the same plugins, never change the tokens
+// which the server issues.
+type tokenServerSyncer struct {
+	tokenServer *tokenserver.Server
+	settings    *v1.GlooOptions_GCPOptions
+}
+
+func NewTokenServerSyncer(tokenServer *tokenserver.Server, settings *v1.Settings) v1snap.ApiSyncer {
+	return &tokenServerSyncer{
+		tokenServer: tokenServer,
+		settings:    settings.GetGloo().GetGcpOptions(),
+	}
+}
+
+func (s *tokenServerSyncer) Sync(_ context.Context, snap *v1snap.ApiSnapshot) error {
+	secretRef := s.settings.GetServiceAccountSecretRef()
+	audiences := gcpAudiences(snap.Upstreams)
+	if secretRef == nil || len(audiences) == 0 {
+		s.tokenServer.SetCredentials(nil, nil)
+		return nil
+	}
+
+	key, err := serviceAccountKey(snap.Secrets, secretRef)
+	if err != nil {
+		// stop issuing tokens with the key of a secret which was deleted or became invalid
+		s.tokenServer.SetCredentials(nil, nil)
+		return err
+	}
+	s.tokenServer.SetCredentials(key, audiences)
+	return nil
+}
+
+func serviceAccountKey(secrets v1.SecretList, secretRef *core.ResourceRef) (*tokenserver.ServiceAccountKey, error) {
+	secret, err := secrets.Find(secretRef.Strings())
+	if err != nil {
+		return nil, ServiceAccountSecretNotFoundError(secretRef, err)
+	}
+	gcpSecret, ok := secret.GetKind().(*v1.Secret_Gcp)
+	if !ok {
+		return nil, NotGcpSecretError(secretRef)
+	}
+	return tokenserver.ParseServiceAccountKey([]byte(gcpSecret.Gcp.GetServiceAccountKey()))
+}
+
+func gcpAudiences(upstreams v1.UpstreamList) []string {
+	var audiences []string
+	for _, us := range upstreams {
+		gcpUpstream, ok := us.GetUpstreamType().(*v1.Upstream_Gcp)
+		if !ok {
+			continue
+		}
+		// invalid urls are reported when the upstream is processed
+		if serviceUrl, err := parseServiceUrl(gcpUpstream.Gcp.GetUrl()); err == nil {
+			audiences = append(audiences, getAudience(gcpUpstream.Gcp, serviceUrl))
+		}
+	}
+	return audiences
+}
@@ -0,0 +1,173 @@
+package tokenserver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/jwt"
+)
+
+const (
+	// IdentityPath is the path at which the server issues ID tokens, mirroring the identity endpoint of the metadata server.
+	IdentityPath = "/gcp/identity"
+
+	DefaultTokenUri = "https://oauth2.googleapis.com/token"
+
+	// AccessTokenParam is the query parameter with which clients authenticate to the server, see AccessToken.
+	AccessTokenParam = "access_token"
+
+	metadataFlavorHeader = "Metadata-Flavor"
+	metadataFlavorGoogle = "Google"
+	audienceParam        = "audience"
+
+	accessTokenContext = "gloo gcp token server access token"
+)
+
+var (
+	InvalidServiceAccountKeyError = func(err error) error {
+		return eris.Wrap(err, "invalid gcp service account key")
+	}
+	MissingServiceAccountKeyFieldError = func(field string) error {
+		return eris.Errorf("gcp service account key is missing %s", field)
+	}
+)
+
+// ServiceAccountKey holds the fields of the JSON key of a GCP service account which are needed to issue ID tokens.
+type ServiceAccountKey struct {
+	ClientEmail  string `json:"client_email"`
+	PrivateKey   string `json:"private_key"`
+	PrivateKeyId string `json:"private_key_id"`
+	TokenUri     string `json:"token_uri"`
+}
+
+// ParseServiceAccountKey parses the JSON key of a GCP service account.
+func ParseServiceAccountKey(data []byte) (*ServiceAccountKey, error) {
+	var key ServiceAccountKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, InvalidServiceAccountKeyError(err)
+	}
+	if key.ClientEmail == "" {
+		return nil, MissingServiceAccountKeyFieldError("client_email")
+	}
+	if key.PrivateKey == "" {
+		return nil, MissingServiceAccountKeyFieldError("private_key")
+	}
+	if key.TokenUri == "" {
+		key.TokenUri = DefaultTokenUri
+	}
+	return &key, nil
+}
+
+// AccessToken returns the token with which clients of the server authenticate for the key of a service account.
+// Envoy's GCP authentication filter cannot send credentials other than its token url, so the proxies are given the
+// token in the url through xDS. It is derived from the private key, so that every replica of Gloo accepts the same
+// token without sharing state, and it can not be guessed without the key.
+func AccessToken(key *ServiceAccountKey) string {
+	mac := hmac.New(sha256.New, []byte(key.PrivateKey))
+	mac.Write([]byte(accessTokenContext))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Server issues Google-signed ID tokens for the key of a service account, serving them like the identity endpoint
+// of the metadata server, so that Envoy's GCP authentication filter can fetch them from Gloo.
+// Requests must authenticate with the AccessToken of the key, as the server is reachable by anyone who can reach
+// Gloo. Tokens are only issued for the audiences of the GCP upstreams, so the server does not hand out tokens for
+// arbitrary services.
+type Server struct {
+	ctx context.Context
+
+	lock         sync.RWMutex
+	key          *ServiceAccountKey
+	accessToken  string
+	tokenSources map[string]oauth2.TokenSource
+}
+
+func NewServer(ctx context.Context) *Server {
+	return &Server{
+		ctx:          ctx,
+		tokenSources: make(map[string]oauth2.TokenSource),
+	}
+}
+
+// SetCredentials sets the key with which tokens are issued and the audiences for which they may be issued.
+// Cached tokens are kept as long as the key and their audience are unchanged.
+func (s *Server) SetCredentials(key *ServiceAccountKey, audiences []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.key == nil || key == nil || *s.key != *key {
+		s.tokenSources = make(map[string]oauth2.TokenSource)
+	}
+	s.key = key
+	if key == nil {
+		s.accessToken = ""
+		return
+	}
+	s.accessToken = AccessToken(key)
+
+	tokenSources := make(map[string]oauth2.TokenSource, len(audiences))
+	for _, audience := range audiences {
+		if tokenSource, ok := s.tokenSources[audience]; ok {
+			tokenSources[audience] = tokenSource
+			continue
+		}
+		config := &jwt.Config{
+			Email:        key.ClientEmail,
+			PrivateKey:   []byte(key.PrivateKey),
+			PrivateKeyID: key.PrivateKeyId,
+			TokenURL:     key.TokenUri,
+			PrivateClaims: map[string]interface{}{
+				"target_audience": audience,
+			},
+			UseIDToken: true,
+		}
+		tokenSources[audience] = config.TokenSource(s.ctx)
+	}
+	s.tokenSources = tokenSources
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// like the metadata server, require the header so that forwarded requests of a server-side request forgery are refused
+	if r.Header.Get(metadataFlavorHeader) != metadataFlavorGoogle {
+		http.Error(w, "missing Metadata-Flavor:Google header", http.StatusForbidden)
+		return
+	}
+
+	audience := r.URL.Query().Get(audienceParam)
+	s.lock.RLock()
+	hasKey := s.key != nil
+	accessToken := s.accessToken
+	tokenSource, ok := s.tokenSources[audience]
+	s.lock.RUnlock()
+	if !hasKey {
+		http.Error(w, "no gcp service account key is configured", http.StatusServiceUnavailable)
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(r.URL.Query().Get(AccessTokenParam)), []byte(accessToken)) != 1 {
+		http.Error(w, "invalid access token", http.StatusUnauthorized)
+		return
+	}
+	if !ok {
+		http.Error(w, "audience is not the audience of a gcp upstream", http.StatusNotFound)
+		return
+	}
+
+	token, err := tokenSource.Token()
+	if err != nil {
+		contextutils.LoggerFrom(s.ctx).Warnw("failed to fetch gcp id token", "audience", audience, "error", err)
+		http.Error(w, "failed to fetch id token", http.StatusBadGateway)
+		return
+	}
+	w.Header().Set(metadataFlavorHeader, metadataFlavorGoogle)
+	w.Header().Set("Content-Type", "application/text")
+	_, _ = w.Write([]byte(token.AccessToken))
+}
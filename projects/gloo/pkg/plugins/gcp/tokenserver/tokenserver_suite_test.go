@@ -0,0 +1,13 @@
+package tokenserver_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTokenServer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "GCP Token Server Suite")
+}
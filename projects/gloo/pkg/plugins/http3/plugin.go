@@ -0,0 +1,252 @@
+package http3
+
+import (
+	"fmt"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoyquic "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/http3"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var (
+	_ plugins.Plugin                  = new(plugin)
+	_ plugins.ListenerPlugin          = new(plugin)
+	_ plugins.ResourceGeneratorPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "http3"
+
+	// QuicListenerSuffix is appended to the name of a listener to name the QUIC listener paired with it
+	QuicListenerSuffix = "-quic"
+
+	// AltSvcHeader is the response header advertising HTTP/3 to the clients of the TCP listener
+	AltSvcHeader = "alt-svc"
+
+	defaultAltSvcMaxAge = 24 * time.Hour
+)
+
+var (
+	NoHttpsFilterChainError = func(listener string) error {
+		return eris.Errorf("http3 is enabled on listener %s, but the listener has no filter chain serving HTTP over TLS", listener)
+	}
+	QuicListenerError = func(err error, listener string) error {
+		return eris.Wrapf(err, "generating the QUIC listener of listener %s", listener)
+	}
+)
+
+type plugin struct {
+	// the http3 options of the listeners of the current translation, by name of the envoy listener
+	listeners map[string]*http3.Http3
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+	p.listeners = map[string]*http3.Http3{}
+}
+
+// ProcessListener records the listeners serving HTTP/3. Their QUIC listeners are generated once the route
+// configurations are known.
+func (p *plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *envoy_config_listener_v3.Listener) error {
+	opts := in.GetOptions().GetHttp3()
+	if !opts.GetEnabled().GetValue() {
+		return nil
+	}
+	if len(httpsFilterChains(out)) == 0 {
+		return NoHttpsFilterChainError(in.GetName())
+	}
+	p.listeners[out.GetName()] = opts
+	return nil
+}
+
+// GeneratedResources pairs each listener serving HTTP/3 with a QUIC listener, and adds the alt-svc header to the route
+// configurations of its TLS filter chains.
+func (p *plugin) GeneratedResources(params plugins.Params,
+	inClusters []*envoy_config_cluster_v3.Cluster,
+	inEndpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	inRouteConfigurations []*envoy_config_route_v3.RouteConfiguration,
+	inListeners []*envoy_config_listener_v3.Listener,
+) ([]*envoy_config_cluster_v3.Cluster, []*envoy_config_endpoint_v3.ClusterLoadAssignment, []*envoy_config_route_v3.RouteConfiguration, []*envoy_config_listener_v3.Listener, error) {
+	var (
+		generatedListeners []*envoy_config_listener_v3.Listener
+		multiErr           *multierror.Error
+	)
+	for _, listener := range inListeners {
+		opts, ok := p.listeners[listener.GetName()]
+		if !ok {
+			continue
+		}
+		quicListener, routeConfigNames, err := translateQuicListener(listener, opts)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, QuicListenerError(err, listener.GetName()))
+			continue
+		}
+		addAltSvcHeader(inRouteConfigurations, routeConfigNames, altSvc(listener, opts))
+		generatedListeners = append(generatedListeners, quicListener)
+	}
+	return nil, nil, nil, generatedListeners, multiErr.ErrorOrNil()
+}
+
+// translateQuicListener returns the QUIC listener serving the TLS filter chains of the listener, and the names of the
+// route configurations of these filter chains
+func translateQuicListener(in *envoy_config_listener_v3.Listener, opts *http3.Http3) (*envoy_config_listener_v3.Listener, []string, error) {
+	address := proto.Clone(in.GetAddress()).(*envoy_config_core_v3.Address)
+	if socketAddress := address.GetSocketAddress(); socketAddress != nil {
+		socketAddress.Protocol = envoy_config_core_v3.SocketAddress_UDP
+	}
+
+	out := &envoy_config_listener_v3.Listener{
+		Name:                          in.GetName() + QuicListenerSuffix,
+		Address:                       address,
+		PerConnectionBufferLimitBytes: in.GetPerConnectionBufferLimitBytes(),
+		UdpListenerConfig: &envoy_config_listener_v3.UdpListenerConfig{
+			QuicOptions: &envoy_config_listener_v3.QuicProtocolOptions{
+				QuicProtocolOptions: &envoy_config_core_v3.QuicProtocolOptions{
+					MaxConcurrentStreams: opts.GetMaxConcurrentStreams(),
+				},
+				IdleTimeout: opts.GetIdleTimeout(),
+			},
+		},
+	}
+
+	var routeConfigNames []string
+	for _, fc := range httpsFilterChains(in) {
+		tlsContext := &envoyauth.DownstreamTlsContext{}
+		if err := translator.ParseTypedConfig(fc.GetTransportSocket(), tlsContext); err != nil {
+			return nil, nil, err
+		}
+		if tlsContext.GetCommonTlsContext() == nil {
+			tlsContext.CommonTlsContext = &envoyauth.CommonTlsContext{}
+		}
+		tlsContext.GetCommonTlsContext().AlpnProtocols = []string{"h3"}
+		transportSocket, err := utils.MessageToAny(&envoyquic.QuicDownstreamTransport{
+			DownstreamTlsContext: tlsContext,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// QUIC listeners only support the HTTP connection manager as network filter
+		hcm := &envoyhttp.HttpConnectionManager{}
+		if err := translator.ParseTypedConfig(hcmFilter(fc), hcm); err != nil {
+			return nil, nil, err
+		}
+		hcm.CodecType = envoyhttp.HttpConnectionManager_HTTP3
+		hcm.Http3ProtocolOptions = &envoy_config_core_v3.Http3ProtocolOptions{}
+		hcmAny, err := utils.MessageToAny(hcm)
+		if err != nil {
+			return nil, nil, err
+		}
+		if routeConfigName := hcm.GetRds().GetRouteConfigName(); routeConfigName != "" {
+			routeConfigNames = append(routeConfigNames, routeConfigName)
+		}
+
+		var filterChainMatch *envoy_config_listener_v3.FilterChainMatch
+		if fc.GetFilterChainMatch() != nil {
+			filterChainMatch = proto.Clone(fc.GetFilterChainMatch()).(*envoy_config_listener_v3.FilterChainMatch)
+		}
+		out.FilterChains = append(out.GetFilterChains(), &envoy_config_listener_v3.FilterChain{
+			Name:             fc.GetName(),
+			FilterChainMatch: filterChainMatch,
+			Filters: []*envoy_config_listener_v3.Filter{{
+				Name:       wellknown.HTTPConnectionManager,
+				ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{TypedConfig: hcmAny},
+			}},
+			TransportSocket: &envoy_config_core_v3.TransportSocket{
+				Name:       wellknown.TransportSocketQuic,
+				ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: transportSocket},
+			},
+		})
+	}
+	return out, routeConfigNames, nil
+}
+
+// httpsFilterChains returns the filter chains of the listener that serve HTTP over TLS
+func httpsFilterChains(listener *envoy_config_listener_v3.Listener) []*envoy_config_listener_v3.FilterChain {
+	var filterChains []*envoy_config_listener_v3.FilterChain
+	for _, fc := range listener.GetFilterChains() {
+		if fc.GetTransportSocket().GetName() == wellknown.TransportSocketTls && hcmFilter(fc) != nil {
+			filterChains = append(filterChains, fc)
+		}
+	}
+	return filterChains
+}
+
+func hcmFilter(fc *envoy_config_listener_v3.FilterChain) *envoy_config_listener_v3.Filter {
+	for _, filter := range fc.GetFilters() {
+		if filter.GetName() == wellknown.HTTPConnectionManager {
+			return filter
+		}
+	}
+	return nil
+}
+
+// altSvc returns the value of the alt-svc header advertising the QUIC listener paired with the listener
+func altSvc(listener *envoy_config_listener_v3.Listener, opts *http3.Http3) string {
+	port := listener.GetAddress().GetSocketAddress().GetPortValue()
+	if opts.GetAdvertisedPort() != nil {
+		port = opts.GetAdvertisedPort().GetValue()
+	}
+	maxAge := defaultAltSvcMaxAge
+	if opts.GetAltSvcMaxAge() != nil {
+		maxAge = opts.GetAltSvcMaxAge().AsDuration()
+	}
+	return fmt.Sprintf(`h3=":%d"; ma=%d`, port, int64(maxAge.Seconds()))
+}
+
+// addAltSvcHeader adds the alt-svc header to the responses of the named route configurations. Responses that already
+// have an alt-svc header, for example set by the upstream, keep it.
+func addAltSvcHeader(routeConfigs []*envoy_config_route_v3.RouteConfiguration, names []string, value string) {
+	for _, routeConfig := range routeConfigs {
+		if !contains(names, routeConfig.GetName()) || hasHeader(routeConfig.GetResponseHeadersToAdd(), AltSvcHeader) {
+			continue
+		}
+		routeConfig.ResponseHeadersToAdd = append(routeConfig.GetResponseHeadersToAdd(), &envoy_config_core_v3.HeaderValueOption{
+			Header: &envoy_config_core_v3.HeaderValue{
+				Key:   AltSvcHeader,
+				Value: value,
+			},
+			AppendAction: envoy_config_core_v3.HeaderValueOption_ADD_IF_ABSENT,
+		})
+	}
+}
+
+func hasHeader(headers []*envoy_config_core_v3.HeaderValueOption, key string) bool {
+	for _, header := range headers {
+		if header.GetHeader().GetKey() == key {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
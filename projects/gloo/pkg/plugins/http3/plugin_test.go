@@ -0,0 +1,183 @@
+package http3_test
+
+import (
+	"context"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoyquic "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/http3"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/http3"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params plugins.Params
+		in     *v1.Listener
+	)
+
+	BeforeEach(func() {
+		params = plugins.Params{Ctx: context.Background()}
+		in = &v1.Listener{
+			Name:     "listener-::-8443",
+			BindPort: 8443,
+			Options: &v1.ListenerOptions{
+				Http3: &http3.Http3{
+					Enabled:        &wrappers.BoolValue{Value: true},
+					AdvertisedPort: &wrappers.UInt32Value{Value: 443},
+				},
+			},
+		}
+	})
+
+	filterChain := func(routeConfigName string, tls bool) *envoy_config_listener_v3.FilterChain {
+		hcm, err := utils.MessageToAny(&envoyhttp.HttpConnectionManager{
+			StatPrefix: "http",
+			RouteSpecifier: &envoyhttp.HttpConnectionManager_Rds{
+				Rds: &envoyhttp.Rds{RouteConfigName: routeConfigName},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		fc := &envoy_config_listener_v3.FilterChain{
+			Filters: []*envoy_config_listener_v3.Filter{{
+				Name:       wellknown.HTTPConnectionManager,
+				ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{TypedConfig: hcm},
+			}},
+		}
+		if tls {
+			tlsContext, err := utils.MessageToAny(&envoyauth.DownstreamTlsContext{
+				CommonTlsContext: &envoyauth.CommonTlsContext{AlpnProtocols: []string{"h2", "http/1.1"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			fc.FilterChainMatch = &envoy_config_listener_v3.FilterChainMatch{ServerNames: []string{"api.example.com"}}
+			fc.TransportSocket = &envoy_config_core_v3.TransportSocket{
+				Name:       wellknown.TransportSocketTls,
+				ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: tlsContext},
+			}
+		}
+		return fc
+	}
+
+	envoyListener := func(filterChains ...*envoy_config_listener_v3.FilterChain) *envoy_config_listener_v3.Listener {
+		return &envoy_config_listener_v3.Listener{
+			Name: "listener-::-8443",
+			Address: &envoy_config_core_v3.Address{
+				Address: &envoy_config_core_v3.Address_SocketAddress{
+					SocketAddress: &envoy_config_core_v3.SocketAddress{
+						Protocol:      envoy_config_core_v3.SocketAddress_TCP,
+						Address:       "::",
+						PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{PortValue: 8443},
+					},
+				},
+			},
+			ListenerFilters: []*envoy_config_listener_v3.ListenerFilter{{Name: wellknown.TlsInspector}},
+			FilterChains:    filterChains,
+		}
+	}
+
+	It("pairs the listener with a QUIC listener serving its TLS filter chains", func() {
+		out := envoyListener(filterChain("http-routes", false), filterChain("https-routes", true))
+		routeConfigs := []*envoy_config_route_v3.RouteConfiguration{{Name: "http-routes"}, {Name: "https-routes"}}
+
+		p := NewPlugin()
+		p.Init(plugins.InitParams{})
+		Expect(p.ProcessListener(params, in, out)).To(Succeed())
+		_, _, generatedRouteConfigs, generatedListeners, err := p.GeneratedResources(params, nil, nil, routeConfigs, []*envoy_config_listener_v3.Listener{out})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(generatedRouteConfigs).To(BeEmpty())
+		Expect(generatedListeners).To(HaveLen(1))
+
+		quic := generatedListeners[0]
+		Expect(quic.GetName()).To(Equal("listener-::-8443" + QuicListenerSuffix))
+		Expect(quic.GetAddress().GetSocketAddress().GetProtocol()).To(Equal(envoy_config_core_v3.SocketAddress_UDP))
+		Expect(quic.GetAddress().GetSocketAddress().GetPortValue()).To(Equal(uint32(8443)))
+		Expect(quic.GetUdpListenerConfig().GetQuicOptions()).NotTo(BeNil())
+		Expect(quic.GetListenerFilters()).To(BeEmpty())
+		Expect(quic.GetFilterChains()).To(HaveLen(1))
+		// the TCP listener is left as is
+		Expect(out.GetAddress().GetSocketAddress().GetProtocol()).To(Equal(envoy_config_core_v3.SocketAddress_TCP))
+
+		fc := quic.GetFilterChains()[0]
+		Expect(fc.GetFilterChainMatch().GetServerNames()).To(ConsistOf("api.example.com"))
+		Expect(fc.GetTransportSocket().GetName()).To(Equal(wellknown.TransportSocketQuic))
+		var quicTransport envoyquic.QuicDownstreamTransport
+		Expect(translator.ParseTypedConfig(fc.GetTransportSocket(), &quicTransport)).To(Succeed())
+		Expect(quicTransport.GetDownstreamTlsContext().GetCommonTlsContext().GetAlpnProtocols()).To(Equal([]string{"h3"}))
+
+		Expect(fc.GetFilters()).To(HaveLen(1))
+		var hcm envoyhttp.HttpConnectionManager
+		Expect(translator.ParseTypedConfig(fc.GetFilters()[0], &hcm)).To(Succeed())
+		Expect(hcm.GetCodecType()).To(Equal(envoyhttp.HttpConnectionManager_HTTP3))
+		Expect(hcm.GetRds().GetRouteConfigName()).To(Equal("https-routes"))
+
+		// only the route configuration of the TLS filter chain advertises HTTP/3
+		Expect(routeConfigs[0].GetResponseHeadersToAdd()).To(BeEmpty())
+		Expect(routeConfigs[1].GetResponseHeadersToAdd()).To(HaveLen(1))
+		header := routeConfigs[1].GetResponseHeadersToAdd()[0]
+		Expect(header.GetHeader().GetKey()).To(Equal(AltSvcHeader))
+		Expect(header.GetHeader().GetValue()).To(Equal(`h3=":443"; ma=86400`))
+		Expect(header.GetAppendAction()).To(Equal(envoy_config_core_v3.HeaderValueOption_ADD_IF_ABSENT))
+	})
+
+	It("configures the QUIC protocol and the advertisement", func() {
+		in.GetOptions().Http3 = &http3.Http3{
+			Enabled:              &wrappers.BoolValue{Value: true},
+			AltSvcMaxAge:         durationpb.New(time.Hour),
+			MaxConcurrentStreams: &wrappers.UInt32Value{Value: 50},
+			IdleTimeout:          durationpb.New(time.Minute),
+		}
+		out := envoyListener(filterChain("https-routes", true))
+		routeConfigs := []*envoy_config_route_v3.RouteConfiguration{{Name: "https-routes"}}
+
+		p := NewPlugin()
+		p.Init(plugins.InitParams{})
+		Expect(p.ProcessListener(params, in, out)).To(Succeed())
+		_, _, _, generatedListeners, err := p.GeneratedResources(params, nil, nil, routeConfigs, []*envoy_config_listener_v3.Listener{out})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(generatedListeners).To(HaveLen(1))
+
+		quicOptions := generatedListeners[0].GetUdpListenerConfig().GetQuicOptions()
+		Expect(quicOptions.GetQuicProtocolOptions().GetMaxConcurrentStreams().GetValue()).To(Equal(uint32(50)))
+		Expect(quicOptions.GetIdleTimeout().AsDuration()).To(Equal(time.Minute))
+		// the port defaults to the bind port of the listener
+		Expect(routeConfigs[0].GetResponseHeadersToAdd()[0].GetHeader().GetValue()).To(Equal(`h3=":8443"; ma=3600`))
+	})
+
+	It("reports listeners without a TLS filter chain", func() {
+		out := envoyListener(filterChain("http-routes", false))
+
+		p := NewPlugin()
+		p.Init(plugins.InitParams{})
+		err := p.ProcessListener(params, in, out)
+		Expect(err).To(MatchError(NoHttpsFilterChainError(in.GetName())))
+		_, _, _, generatedListeners, err := p.GeneratedResources(params, nil, nil, nil, []*envoy_config_listener_v3.Listener{out})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(generatedListeners).To(BeEmpty())
+	})
+
+	It("ignores listeners without http3", func() {
+		in.Options = nil
+		out := envoyListener(filterChain("https-routes", true))
+
+		p := NewPlugin()
+		p.Init(plugins.InitParams{})
+		Expect(p.ProcessListener(params, in, out)).To(Succeed())
+		_, _, _, generatedListeners, err := p.GeneratedResources(params, nil, nil, nil, []*envoy_config_listener_v3.Listener{out})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(generatedListeners).To(BeEmpty())
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
 	kubeinformers "k8s.io/client-go/informers"
 	kubelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -19,7 +20,12 @@ import (
 //go:generate mockgen -destination ./mocks/kubesharedfactory_mock.go github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes KubePluginSharedFactory
 
 type KubePluginSharedFactory interface {
+	// EndpointsLister returns nil when EndpointSlices are watched instead
 	EndpointsLister(ns string) kubelisters.EndpointsLister
+	// EndpointSliceLister returns nil unless EndpointSlices are watched
+	EndpointSliceLister(ns string) discoverylisters.EndpointSliceLister
+	// NodeLister returns nil unless nodes are watched
+	NodeLister() kubelisters.NodeLister
 	Subscribe() <-chan struct{}
 	Unsubscribe(<-chan struct{})
 }
@@ -27,17 +33,19 @@ type KubePluginSharedFactory interface {
 type KubePluginListers struct {
 	initError error
 
-	endpointsLister map[string]kubelisters.EndpointsLister
+	endpointsLister     map[string]kubelisters.EndpointsLister
+	endpointSliceLister map[string]discoverylisters.EndpointSliceLister
+	nodeLister          kubelisters.NodeLister
 
 	cacheUpdatedWatchers      []chan struct{}
 	cacheUpdatedWatchersMutex sync.Mutex
 }
 
-func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string, watchNodes, watchEndpointSlices bool) *KubePluginListers {
 	if len(watchNamespaces) == 0 {
 		watchNamespaces = []string{metav1.NamespaceAll}
 	}
-	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces)
+	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces, watchNodes, watchEndpointSlices)
 	if kubePluginSharedFactory.initError != nil {
 		// This is an unrecoverable error (no shared informer factory means all of kube EDS won't work, which is
 		// probably the most valuable / important role for gloo) and  users know immediately about e.g. any rbac errors
@@ -47,19 +55,32 @@ func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchN
 	return kubePluginSharedFactory
 }
 
-func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string, watchNodes, watchEndpointSlices bool) *KubePluginListers {
 	resyncDuration := 12 * time.Hour
 
 	var informers []cache.SharedIndexInformer
 	k := &KubePluginListers{
-		endpointsLister: map[string]kubelisters.EndpointsLister{},
+		endpointsLister:     map[string]kubelisters.EndpointsLister{},
+		endpointSliceLister: map[string]discoverylisters.EndpointSliceLister{},
 	}
 	for _, nsToWatch := range watchNamespaces {
 		kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(client, resyncDuration, kubeinformers.WithNamespace(nsToWatch))
+		if watchEndpointSlices {
+			endpointSliceInformer := kubeInformerFactory.Discovery().V1().EndpointSlices()
+			informers = append(informers, endpointSliceInformer.Informer())
+			k.endpointSliceLister[nsToWatch] = endpointSliceInformer.Lister()
+			continue
+		}
 		endpointInformer := kubeInformerFactory.Core().V1().Endpoints()
 		informers = append(informers, endpointInformer.Informer())
 		k.endpointsLister[nsToWatch] = endpointInformer.Lister()
 	}
+	if watchNodes {
+		// nodes are cluster scoped
+		nodeInformer := kubeinformers.NewSharedInformerFactory(client, resyncDuration).Core().V1().Nodes()
+		informers = append(informers, nodeInformer.Informer())
+		k.nodeLister = nodeInformer.Lister()
+	}
 
 	kubeController := controller.NewController("kube-plugin-controller",
 		controller.NewLockingSyncHandler(k.updatedOccurred),
@@ -90,6 +111,14 @@ func (k *KubePluginListers) EndpointsLister(ns string) kubelisters.EndpointsList
 	return k.endpointsLister[ns]
 }
 
+func (k *KubePluginListers) EndpointSliceLister(ns string) discoverylisters.EndpointSliceLister {
+	return k.endpointSliceLister[ns]
+}
+
+func (k *KubePluginListers) NodeLister() kubelisters.NodeLister {
+	return k.nodeLister
+}
+
 func (k *KubePluginListers) Subscribe() <-chan struct{} {
 	k.cacheUpdatedWatchersMutex.Lock()
 	defer k.cacheUpdatedWatchersMutex.Unlock()
@@ -11,6 +11,7 @@ import (
 
 	errors "github.com/rotisserie/eris"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
@@ -82,10 +83,67 @@ func (pm *podMap) GetLabelsForIp(ip string, podName, podNamespace string) (map[s
 	return nil, errors.Errorf("running pod not found with IP %v", ip)
 }
 
+func (pm *podMap) getPod(ip string, podName, podNamespace string) *corev1.Pod {
+	if podName != "" && podNamespace != "" {
+		if p, ok := pm.metaMap[podName+"/"+podNamespace]; ok {
+			return p
+		}
+	}
+	if ip != "" {
+		if p, ok := pm.ipMap[ip]; ok {
+			return p
+		}
+	}
+	return nil
+}
+
+// getNodeNameForIp returns the name of the node the pod is scheduled on, or "" if the pod is not known
+func (pm *podMap) getNodeNameForIp(ip string, podName, podNamespace string) string {
+	if p := pm.getPod(ip, podName, podNamespace); p != nil {
+		return p.Spec.NodeName
+	}
+	return ""
+}
+
+// isTerminating returns true if the pod is known and is being deleted
+func (pm *podMap) isTerminating(ip string, podName, podNamespace string) bool {
+	p := pm.getPod(ip, podName, podNamespace)
+	return p != nil && p.GetDeletionTimestamp() != nil
+}
+
+// generateNodeLocalities maps node names to the locality in their well-known topology labels.
+// Nodes without topology labels have no locality.
+func generateNodeLocalities(nodes []*corev1.Node) map[string]*v1.Locality {
+	localities := make(map[string]*v1.Locality, len(nodes))
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+		nodeLabels := node.GetLabels()
+		region := nodeLabels[corev1.LabelTopologyRegion]
+		if region == "" {
+			region = nodeLabels[corev1.LabelFailureDomainBetaRegion]
+		}
+		zone := nodeLabels[corev1.LabelTopologyZone]
+		if zone == "" {
+			zone = nodeLabels[corev1.LabelFailureDomainBetaZone]
+		}
+		if region == "" && zone == "" {
+			continue
+		}
+		localities[node.GetName()] = &v1.Locality{
+			Region: region,
+			Zone:   zone,
+		}
+	}
+	return localities
+}
+
 func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
 
 	kubeFactory := func(namespaces []string) KubePluginSharedFactory {
-		return getInformerFactory(opts.Ctx, p.kube, namespaces)
+		kubeSettings := p.settings.GetKubernetes()
+		return getInformerFactory(opts.Ctx, p.kube, namespaces, kubeSettings.GetEnableEndpointLocality(), kubeSettings.GetUseEndpointSlices())
 	}
 	watcher, err := newEndpointWatcherForUpstreams(kubeFactory, p.kubeCoreCache, upstreamsToTrack, opts, p.settings)
 	if err != nil {
@@ -121,7 +179,7 @@ func newEndpointWatcherForUpstreams(kubeFactoryFactory func(ns []string) KubePlu
 	}
 	opts = opts.WithDefaults()
 
-	return newEndpointsWatcher(kubeCoreCache, namespaces, kubeFactory, upstreamsToTrack), nil
+	return newEndpointsWatcher(kubeCoreCache, namespaces, kubeFactory, upstreamsToTrack, settings.GetKubernetes().GetUseEndpointSlices()), nil
 }
 
 type edsWatcher struct {
@@ -129,10 +187,11 @@ type edsWatcher struct {
 	kubeShareFactory  KubePluginSharedFactory
 	kubeCoreCache     corecache.KubeCoreCache
 	namespaces        []string
+	useEndpointSlices bool
 	lastEndpointsHash uint64
 }
 
-func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []string, kubeShareFactory KubePluginSharedFactory, upstreams v1.UpstreamList) *edsWatcher {
+func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []string, kubeShareFactory KubePluginSharedFactory, upstreams v1.UpstreamList, useEndpointSlices bool) *edsWatcher {
 	upstreamSpecs := make(map[*core.ResourceRef]*kubeplugin.UpstreamSpec)
 	for _, us := range upstreams {
 		kubeUpstream, ok := us.GetUpstreamType().(*v1.Upstream_Kube)
@@ -143,17 +202,20 @@ func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []str
 		upstreamSpecs[us.GetMetadata().Ref()] = kubeUpstream.Kube
 	}
 	return &edsWatcher{
-		upstreams:        upstreamSpecs,
-		kubeShareFactory: kubeShareFactory,
-		kubeCoreCache:    kubeCoreCache,
-		namespaces:       namespaces,
+		upstreams:         upstreamSpecs,
+		kubeShareFactory:  kubeShareFactory,
+		kubeCoreCache:     kubeCoreCache,
+		namespaces:        namespaces,
+		useEndpointSlices: useEndpointSlices,
 	}
 }
 
 func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.EndpointList, error) {
 	var endpointList []*corev1.Endpoints
+	var endpointSliceList []*discoveryv1.EndpointSlice
 	var serviceList []*corev1.Service
 	var podList []*corev1.Pod
+	var nodeList []*corev1.Node
 	ctx := contextutils.WithLogger(opts.Ctx, "kubernetes_eds")
 	var warnsToLog []string
 
@@ -174,6 +236,15 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		}
 		podList = append(podList, pods...)
 
+		if c.useEndpointSlices {
+			endpointSlices, err := c.kubeShareFactory.EndpointSliceLister(ns).List(labels.SelectorFromSet(opts.Selector))
+			if err != nil {
+				return nil, err
+			}
+			endpointSliceList = append(endpointSliceList, endpointSlices...)
+			continue
+		}
+
 		endpoints, err := c.kubeShareFactory.EndpointsLister(ns).List(labels.SelectorFromSet(opts.Selector))
 		if err != nil {
 			return nil, err
@@ -181,7 +252,22 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		endpointList = append(endpointList, endpoints...)
 	}
 
-	eps, warns, errsToLog := FilterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, c.upstreams)
+	// nodes are only watched when endpoint locality is enabled
+	if nodeLister := c.kubeShareFactory.NodeLister(); nodeLister != nil {
+		nodes, err := nodeLister.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		nodeList = nodes
+	}
+
+	var eps v1.EndpointList
+	var warns, errsToLog []string
+	if c.useEndpointSlices {
+		eps, warns, errsToLog = FilterEndpointSlices(ctx, writeNamespace, endpointSliceList, serviceList, podList, nodeList, c.upstreams)
+	} else {
+		eps, warns, errsToLog = FilterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, nodeList, c.upstreams)
+	}
 
 	warnsToLog = append(warnsToLog, warns...)
 
@@ -309,6 +395,7 @@ func getServiceFromUpstreamSpec(spec *kubeplugin.UpstreamSpec, services []*corev
 
 // FilterEndpoints computes the endpoints for Gloo from the given Kubernetes endpoints, services, and Gloo upstreams.
 // It is exported to provide an injection point into our existing EDS solution for the Gloo K8s Gateway integration.
+// The nodes are used to set the locality of the endpoints and may be empty.
 // It returns the endpoints, warnings, and errors.
 func FilterEndpoints(
 	_ context.Context, // do not use for logging! return logging messages as strings and log them after hashing (see https://github.com/solo-io/gloo/issues/3761)
@@ -316,30 +403,83 @@ func FilterEndpoints(
 	kubeEndpoints []*corev1.Endpoints,
 	services []*corev1.Service,
 	pods []*corev1.Pod,
+	nodes []*corev1.Node,
 	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
 ) (v1.EndpointList, []string, []string) {
 	podLabelSource := generatePodsMap(pods)
+	nodeLocalities := generateNodeLocalities(nodes)
 
 	return computeGlooEndpoints(
 		writeNamespace,
-		kubeEndpoints,
 		services,
 		podLabelSource,
+		func(addr Epkey) *v1.Locality {
+			return nodeLocalities[podLabelSource.getNodeNameForIp(addr.Address, addr.Name, addr.Namespace)]
+		},
 		upstreams,
+		func(usRef *core.ResourceRef, spec *kubeplugin.UpstreamSpec, kubeServicePort *corev1.ServicePort, singlePortService, isHeadlessSvc bool, endpointsMap map[Epkey][]*core.ResourceRef, drainingEndpoints map[Epkey]bool) []string {
+			var warnsToLog []string
+			// find each matching endpoint
+			for _, eps := range kubeEndpoints {
+				if eps.Namespace != spec.GetServiceNamespace() || eps.Name != spec.GetServiceName() {
+					continue
+				}
+				for _, subset := range eps.Subsets {
+					port := findFirstPortInEndpointSubsets(subset, singlePortService, kubeServicePort)
+					if port == 0 {
+						warnsToLog = append(warnsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v in endpoint %v", usRef.Key(), spec.GetServicePort(), spec.GetServiceName(), subset))
+						continue
+					}
+
+					warnings := processSubsetAddresses(subset.Addresses, spec, podLabelSource, usRef, port, endpointsMap, isHeadlessSvc, nil)
+					warnsToLog = append(warnsToLog, warnings...)
+
+					if spec.GetDrainTerminatingEndpoints() {
+						// terminating pods are not ready, but the endpoints api does not tell whether they are still serving
+						var terminatingAddresses []corev1.EndpointAddress
+						for _, addr := range subset.NotReadyAddresses {
+							var podName, podNamespace string
+							if addr.TargetRef != nil && addr.TargetRef.Kind == "Pod" {
+								podName, podNamespace = addr.TargetRef.Name, addr.TargetRef.Namespace
+							}
+							if podLabelSource.isTerminating(addr.IP, podName, podNamespace) {
+								terminatingAddresses = append(terminatingAddresses, addr)
+							}
+						}
+						warnings = processSubsetAddresses(terminatingAddresses, spec, podLabelSource, usRef, port, endpointsMap, isHeadlessSvc, drainingEndpoints)
+						warnsToLog = append(warnsToLog, warnings...)
+					}
+				}
+			}
+			return warnsToLog
+		},
 	)
 }
 
+// upstreamEndpointsFunc adds the addresses of the service of a kube upstream to the endpoints map and returns any warnings.
+// The addresses which should be drained are also added to drainingEndpoints.
+type upstreamEndpointsFunc func(
+	usRef *core.ResourceRef,
+	spec *kubeplugin.UpstreamSpec,
+	kubeServicePort *corev1.ServicePort,
+	singlePortService, isHeadlessSvc bool,
+	endpointsMap map[Epkey][]*core.ResourceRef,
+	drainingEndpoints map[Epkey]bool,
+) []string
+
 func computeGlooEndpoints(
 	writeNamespace string,
-	kubeEndpoints []*corev1.Endpoints,
 	services []*corev1.Service,
 	podLabelSource PodLabelSource,
+	localityForEndpoint func(addr Epkey) *v1.Locality,
 	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
+	upstreamEndpoints upstreamEndpointsFunc,
 ) (v1.EndpointList, []string, []string) {
 	var endpoints v1.EndpointList
 
 	var warnsToLog, errorsToLog []string
 	endpointsMap := make(map[Epkey][]*core.ResourceRef)
+	drainingEndpoints := make(map[Epkey]bool)
 
 	istioInjectionEnabled := isIstioInjectionEnabled()
 
@@ -371,32 +511,18 @@ func computeGlooEndpoints(
 			continue
 		}
 
-		// find each matching endpoint
-		for _, eps := range kubeEndpoints {
-			if eps.Namespace != spec.GetServiceNamespace() || eps.Name != spec.GetServiceName() {
-				continue
-			}
-			for _, subset := range eps.Subsets {
-				port := findFirstPortInEndpointSubsets(subset, singlePortService, kubeServicePort)
-				if port == 0 {
-					warnsToLog = append(warnsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v in endpoint %v", usRef.Key(), spec.GetServicePort(), spec.GetServiceName(), subset))
-					continue
-				}
-
-				warnings := processSubsetAddresses(subset, spec, podLabelSource, usRef, port, endpointsMap, isHeadlessSvc)
-				warnsToLog = append(warnsToLog, warnings...)
-			}
-		}
+		warnsToLog = append(warnsToLog, upstreamEndpoints(usRef, spec, kubeServicePort, singlePortService, isHeadlessSvc, endpointsMap, drainingEndpoints)...)
 	}
 
-	endpoints = generateFilteredEndpointList(endpointsMap, services, podLabelSource, writeNamespace, endpoints, istioInjectionEnabled)
+	endpoints = generateFilteredEndpointList(endpointsMap, drainingEndpoints, services, podLabelSource, localityForEndpoint, writeNamespace, endpoints, istioInjectionEnabled)
 
 	return endpoints, warnsToLog, errorsToLog
 }
 
-func processSubsetAddresses(subset corev1.EndpointSubset, spec *kubeplugin.UpstreamSpec, pods PodLabelSource, usRef *core.ResourceRef, port uint32, endpointsMap map[Epkey][]*core.ResourceRef, isHeadlessService bool) []string {
+// processSubsetAddresses adds the addresses to the endpoints map, and to drainingEndpoints if it is not nil
+func processSubsetAddresses(addresses []corev1.EndpointAddress, spec *kubeplugin.UpstreamSpec, pods PodLabelSource, usRef *core.ResourceRef, port uint32, endpointsMap map[Epkey][]*core.ResourceRef, isHeadlessService bool, drainingEndpoints map[Epkey]bool) []string {
 	var warnings []string
-	for _, addr := range subset.Addresses {
+	for _, addr := range addresses {
 		var podName, podNamespace string
 		targetRef := addr.TargetRef
 		if targetRef != nil {
@@ -424,6 +550,9 @@ func processSubsetAddresses(subset corev1.EndpointSubset, spec *kubeplugin.Upstr
 		key := Epkey{addr.IP, port, podName, podNamespace, usRef, isHeadlessService}
 		copyRef := *usRef
 		endpointsMap[key] = append(endpointsMap[key], &copyRef)
+		if drainingEndpoints != nil {
+			drainingEndpoints[key] = true
+		}
 	}
 	return warnings
 }
@@ -446,8 +575,10 @@ func findFirstPortInEndpointSubsets(subset corev1.EndpointSubset, singlePortServ
 
 func generateFilteredEndpointList(
 	endpointsMap map[Epkey][]*core.ResourceRef,
+	drainingEndpoints map[Epkey]bool,
 	services []*corev1.Service,
 	pods PodLabelSource,
+	localityForEndpoint func(addr Epkey) *v1.Locality,
 	writeNamespace string,
 	endpoints v1.EndpointList,
 	istioIntegrationEnabled bool) v1.EndpointList {
@@ -474,10 +605,15 @@ func generateFilteredEndpointList(
 		if istioIntegrationEnabled && !addr.IsHeadless {
 			// Istio integration requires assigning endpoints the Kub service VIP rather than pod address
 			service, _ := getServiceForHostname(addr.Address, addr.Name, addr.Namespace, services)
-			ep = createEndpoint(writeNamespace, endpointName, refs, service.Spec.ClusterIP, addr.Port, service.GetObjectMeta().GetLabels()) // TODO: labels may be nil
+			// the service VIP is not in any single locality
+			ep = createEndpoint(writeNamespace, endpointName, refs, service.Spec.ClusterIP, addr.Port, service.GetObjectMeta().GetLabels(), nil, v1.Endpoint_UNKNOWN) // TODO: labels may be nil
 		} else {
 			podLabels, _ := pods.GetLabelsForIp(addr.Address, addr.Name, addr.Namespace)
-			ep = createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, podLabels)
+			healthStatus := v1.Endpoint_UNKNOWN
+			if drainingEndpoints[addr] {
+				healthStatus = v1.Endpoint_DRAINING
+			}
+			ep = createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, podLabels, localityForEndpoint(addr), healthStatus)
 		}
 		endpoints = append(endpoints, ep)
 	}
@@ -489,17 +625,18 @@ func generateFilteredEndpointList(
 	return endpoints
 }
 
-func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, address string, port uint32, labels map[string]string) *v1.Endpoint {
+func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, address string, port uint32, labels map[string]string, locality *v1.Locality, healthStatus v1.Endpoint_HealthStatus) *v1.Endpoint {
 	return &v1.Endpoint{
 		Metadata: &core.Metadata{
 			Namespace: namespace,
 			Name:      name,
 			Labels:    labels,
 		},
-		Upstreams: upstreams,
-		Address:   address,
-		Port:      port,
-		// TODO: add locality info
+		Upstreams:    upstreams,
+		Address:      address,
+		Port:         port,
+		Locality:     locality,
+		HealthStatus: healthStatus,
 	}
 }
 
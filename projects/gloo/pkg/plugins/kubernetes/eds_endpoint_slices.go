@@ -0,0 +1,170 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+)
+
+// FilterEndpointSlices computes the endpoints for Gloo from the given Kubernetes EndpointSlices, services, and Gloo upstreams.
+// It behaves as FilterEndpoints, but also uses the zone and topology hints of the EndpointSlices as the locality of the
+// endpoints, the addresses of all the IP families of the service, and the serving condition of terminating endpoints.
+// It returns the endpoints, warnings, and errors.
+func FilterEndpointSlices(
+	_ context.Context, // do not use for logging! return logging messages as strings and log them after hashing (see https://github.com/solo-io/gloo/issues/3761)
+	writeNamespace string,
+	endpointSlices []*discoveryv1.EndpointSlice,
+	services []*corev1.Service,
+	pods []*corev1.Pod,
+	nodes []*corev1.Node,
+	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
+) (v1.EndpointList, []string, []string) {
+	podLabelSource := generatePodsMap(pods)
+	nodeLocalities := generateNodeLocalities(nodes)
+
+	// an address belongs to a single pod, so its slice endpoint is the same for every service of the pod
+	sliceEndpoints := make(map[string]*discoveryv1.Endpoint)
+	for _, slice := range endpointSlices {
+		for i, endpoint := range slice.Endpoints {
+			if len(endpoint.Addresses) > 0 {
+				sliceEndpoints[endpoint.Addresses[0]] = &slice.Endpoints[i]
+			}
+		}
+	}
+
+	return computeGlooEndpoints(
+		writeNamespace,
+		services,
+		podLabelSource,
+		func(addr Epkey) *v1.Locality {
+			sliceEndpoint := sliceEndpoints[addr.Address]
+			nodeName := podLabelSource.getNodeNameForIp(addr.Address, addr.Name, addr.Namespace)
+			if nodeName == "" && sliceEndpoint != nil && sliceEndpoint.NodeName != nil {
+				nodeName = *sliceEndpoint.NodeName
+			}
+			return sliceEndpointLocality(sliceEndpoint, nodeLocalities[nodeName])
+		},
+		upstreams,
+		func(usRef *core.ResourceRef, spec *kubeplugin.UpstreamSpec, kubeServicePort *corev1.ServicePort, singlePortService, isHeadlessSvc bool, endpointsMap map[Epkey][]*core.ResourceRef, drainingEndpoints map[Epkey]bool) []string {
+			var warnsToLog []string
+			ipFamilies := getServiceFromUpstreamSpec(spec, services).Spec.IPFamilies
+			for _, slice := range endpointSlices {
+				if slice.Namespace != spec.GetServiceNamespace() || slice.Labels[discoveryv1.LabelServiceName] != spec.GetServiceName() {
+					continue
+				}
+				if !isServiceAddressType(slice.AddressType, ipFamilies) {
+					if slice.AddressType == discoveryv1.AddressTypeFQDN {
+						warnsToLog = append(warnsToLog, fmt.Sprintf("upstream %v: ignoring endpoint slice %v of service %v with FQDN addresses", usRef.Key(), slice.Name, spec.GetServiceName()))
+					}
+					continue
+				}
+				port := findFirstPortInEndpointSlice(slice, singlePortService, kubeServicePort)
+				if port == 0 {
+					warnsToLog = append(warnsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v in endpoint slice %v", usRef.Key(), spec.GetServicePort(), spec.GetServiceName(), slice.Name))
+					continue
+				}
+
+				readyAddresses, drainingAddresses := endpointSliceAddresses(slice)
+				warnings := processSubsetAddresses(readyAddresses, spec, podLabelSource, usRef, port, endpointsMap, isHeadlessSvc, nil)
+				warnsToLog = append(warnsToLog, warnings...)
+				if spec.GetDrainTerminatingEndpoints() {
+					warnings = processSubsetAddresses(drainingAddresses, spec, podLabelSource, usRef, port, endpointsMap, isHeadlessSvc, drainingEndpoints)
+					warnsToLog = append(warnsToLog, warnings...)
+				}
+			}
+			return warnsToLog
+		},
+	)
+}
+
+// isServiceAddressType returns true if the addresses of the slice are in one of the IP families of the service.
+// Services without IP families (e.g. created before dual-stack support) accept both IP families.
+func isServiceAddressType(addressType discoveryv1.AddressType, ipFamilies []corev1.IPFamily) bool {
+	if addressType != discoveryv1.AddressTypeIPv4 && addressType != discoveryv1.AddressTypeIPv6 {
+		return false
+	}
+	if len(ipFamilies) == 0 {
+		return true
+	}
+	for _, ipFamily := range ipFamilies {
+		if string(ipFamily) == string(addressType) {
+			return true
+		}
+	}
+	return false
+}
+
+func findFirstPortInEndpointSlice(slice *discoveryv1.EndpointSlice, singlePortService bool, kubeServicePort *corev1.ServicePort) uint32 {
+	for _, p := range slice.Ports {
+		if p.Port == nil {
+			continue
+		}
+		// as with the endpoints api, an unnamed port implies that the service has a single unnamed port.
+		var name string
+		if p.Name != nil {
+			name = *p.Name
+		}
+		if singlePortService || name == kubeServicePort.Name {
+			return uint32(*p.Port)
+		}
+	}
+	return 0
+}
+
+// endpointSliceAddresses returns the addresses of the ready endpoints of a slice, and of its terminating endpoints
+// which are still serving. Terminating endpoints are never ready.
+func endpointSliceAddresses(slice *discoveryv1.EndpointSlice) (ready, draining []corev1.EndpointAddress) {
+	for _, endpoint := range slice.Endpoints {
+		if len(endpoint.Addresses) == 0 {
+			continue
+		}
+		// the addresses of an endpoint are fungible, so only the first one is used
+		addr := corev1.EndpointAddress{
+			IP:        endpoint.Addresses[0],
+			TargetRef: endpoint.TargetRef,
+		}
+		// nil conditions are unknown, which consumers should interpret as ready and serving
+		conditions := endpoint.Conditions
+		switch {
+		case conditions.Ready == nil || *conditions.Ready:
+			ready = append(ready, addr)
+		case conditions.Terminating != nil && *conditions.Terminating && (conditions.Serving == nil || *conditions.Serving):
+			draining = append(draining, addr)
+		}
+	}
+	return ready, draining
+}
+
+// sliceEndpointLocality returns the locality of the node of the endpoint, with the zone of the endpoint.
+// When Kubernetes topology aware routing hinted a single zone for the endpoint, that zone is used instead, so that
+// zone aware routing sends the endpoint the traffic of the zone Kubernetes allocated it to.
+func sliceEndpointLocality(endpoint *discoveryv1.Endpoint, nodeLocality *v1.Locality) *v1.Locality {
+	var zone string
+	if endpoint != nil {
+		zone = valueOrEmpty(endpoint.Zone)
+		if endpoint.Hints != nil && len(endpoint.Hints.ForZones) == 1 {
+			zone = endpoint.Hints.ForZones[0].Name
+		}
+	}
+	if zone == "" {
+		return nodeLocality
+	}
+	return &v1.Locality{
+		Region: nodeLocality.GetRegion(),
+		Zone:   zone,
+	}
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
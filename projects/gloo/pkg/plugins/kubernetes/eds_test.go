@@ -9,8 +9,12 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/gloo/projects/gloo/constants"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -54,6 +58,7 @@ var _ = Describe("Eds", func() {
 		upstreamsToTrack := v1.UpstreamList{up}
 
 		mockCache.EXPECT().NamespacedServiceLister("bar").Return(nil)
+		mockSharedFactory.EXPECT().NodeLister().Return(nil)
 
 		watcher, err := newEndpointWatcherForUpstreams(func([]string) KubePluginSharedFactory { return mockSharedFactory }, mockCache, upstreamsToTrack, clients.WatchOpts{Ctx: ctx}, nil)
 		Expect(err).NotTo(HaveOccurred())
@@ -157,6 +162,7 @@ var _ = Describe("Eds", func() {
 						Spec: corev1.PodSpec{},
 					},
 				},
+				nil,
 				map[*core.ResourceRef]*kubeplugin.UpstreamSpec{
 					up.Metadata.Ref(): up.GetKube(),
 				})
@@ -170,4 +176,392 @@ var _ = Describe("Eds", func() {
 		})
 	})
 
+	Context("Endpoint locality", func() {
+
+		var (
+			writeNamespace string
+			up             *v1.Upstream
+			kubeEndpoints  []*corev1.Endpoints
+			services       []*corev1.Service
+			pods           []*corev1.Pod
+		)
+
+		pod := func(name, ip, nodeName string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "foo",
+					Labels:    map[string]string{"app": "bar"},
+				},
+				Spec: corev1.PodSpec{NodeName: nodeName},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+					PodIP: ip,
+				},
+			}
+		}
+
+		address := func(podName, ip string) corev1.EndpointAddress {
+			return corev1.EndpointAddress{
+				IP: ip,
+				TargetRef: &corev1.ObjectReference{
+					Kind:      "Pod",
+					Name:      podName,
+					Namespace: "foo",
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			writeNamespace = "foo"
+			up = v1.NewUpstream(writeNamespace, "name")
+			up.UpstreamType = &v1.Upstream_Kube{
+				Kube: &kubev1.UpstreamSpec{
+					ServiceName:      "bar",
+					ServiceNamespace: "foo",
+					ServicePort:      9080,
+				},
+			}
+			kubeEndpoints = []*corev1.Endpoints{{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "foo"},
+				Subsets: []corev1.EndpointSubset{{
+					Ports: []corev1.EndpointPort{{Port: 9080, Name: "http", Protocol: "TCP"}},
+					Addresses: []corev1.EndpointAddress{
+						address("bar-a", "10.0.0.1"),
+						address("bar-b", "10.0.0.2"),
+						address("bar-c", "10.0.0.3"),
+					},
+				}},
+			}}
+			services = []*corev1.Service{{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "foo"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Name: "http", Port: 9080, Protocol: "TCP"}},
+				},
+			}}
+			pods = []*corev1.Pod{
+				pod("bar-a", "10.0.0.1", "node-a"),
+				pod("bar-b", "10.0.0.2", "node-b"),
+				pod("bar-c", "10.0.0.3", "node-c"),
+			}
+		})
+
+		filterEndpoints := func(nodes []*corev1.Node) map[string]*v1.Locality {
+			endpoints, warnsToLog, errorsToLog := FilterEndpoints(ctx, writeNamespace, kubeEndpoints, services, pods, nodes,
+				map[*core.ResourceRef]*kubeplugin.UpstreamSpec{
+					up.Metadata.Ref(): up.GetKube(),
+				})
+			Expect(warnsToLog).To(BeEmpty())
+			Expect(errorsToLog).To(BeEmpty())
+
+			localities := make(map[string]*v1.Locality)
+			for _, ep := range endpoints {
+				localities[ep.GetAddress()] = ep.GetLocality()
+			}
+			return localities
+		}
+
+		It("sets the locality from the topology labels of the pod's node", func() {
+			localities := filterEndpoints([]*corev1.Node{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-a",
+						Labels: map[string]string{
+							corev1.LabelTopologyRegion: "us-east-1",
+							corev1.LabelTopologyZone:   "us-east-1a",
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-b",
+						Labels: map[string]string{
+							corev1.LabelFailureDomainBetaRegion: "us-east-1",
+							corev1.LabelFailureDomainBetaZone:   "us-east-1b",
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-c"},
+				},
+			})
+
+			Expect(localities).To(HaveLen(3))
+			Expect(localities["10.0.0.1"]).To(Equal(&v1.Locality{Region: "us-east-1", Zone: "us-east-1a"}))
+			Expect(localities["10.0.0.2"]).To(Equal(&v1.Locality{Region: "us-east-1", Zone: "us-east-1b"}))
+			Expect(localities["10.0.0.3"]).To(BeNil())
+		})
+
+		It("does not set the locality when nodes are not watched", func() {
+			localities := filterEndpoints(nil)
+
+			Expect(localities).To(HaveLen(3))
+			for _, locality := range localities {
+				Expect(locality).To(BeNil())
+			}
+		})
+	})
+
+	Context("Terminating endpoints", func() {
+
+		var (
+			writeNamespace string
+			up             *v1.Upstream
+			kubeEndpoints  []*corev1.Endpoints
+			services       []*corev1.Service
+			pods           []*corev1.Pod
+		)
+
+		BeforeEach(func() {
+			writeNamespace = "foo"
+			up = v1.NewUpstream(writeNamespace, "name")
+			up.UpstreamType = &v1.Upstream_Kube{
+				Kube: &kubev1.UpstreamSpec{
+					ServiceName:      "bar",
+					ServiceNamespace: "foo",
+					ServicePort:      9080,
+				},
+			}
+			kubeEndpoints = []*corev1.Endpoints{{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "foo"},
+				Subsets: []corev1.EndpointSubset{{
+					Ports:             []corev1.EndpointPort{{Port: 9080, Name: "http", Protocol: "TCP"}},
+					Addresses:         []corev1.EndpointAddress{{IP: "10.0.0.1"}},
+					NotReadyAddresses: []corev1.EndpointAddress{{IP: "10.0.0.2"}, {IP: "10.0.0.3"}},
+				}},
+			}}
+			services = []*corev1.Service{{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "foo"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Name: "http", Port: 9080, Protocol: "TCP"}},
+				},
+			}}
+			deletionTimestamp := metav1.Now()
+			pods = []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "bar-a", Namespace: "foo"},
+					Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.1"},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "bar-b", Namespace: "foo", DeletionTimestamp: &deletionTimestamp},
+					Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.2"},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "bar-c", Namespace: "foo"},
+					Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.3"},
+				},
+			}
+		})
+
+		filterEndpoints := func() map[string]v1.Endpoint_HealthStatus {
+			endpoints, warnsToLog, errorsToLog := FilterEndpoints(ctx, writeNamespace, kubeEndpoints, services, pods, nil,
+				map[*core.ResourceRef]*kubeplugin.UpstreamSpec{
+					up.Metadata.Ref(): up.GetKube(),
+				})
+			Expect(warnsToLog).To(BeEmpty())
+			Expect(errorsToLog).To(BeEmpty())
+
+			healthStatuses := make(map[string]v1.Endpoint_HealthStatus)
+			for _, ep := range endpoints {
+				healthStatuses[ep.GetAddress()] = ep.GetHealthStatus()
+			}
+			return healthStatuses
+		}
+
+		It("drains the endpoints of terminating pods when enabled", func() {
+			up.GetKube().DrainTerminatingEndpoints = true
+
+			Expect(filterEndpoints()).To(Equal(map[string]v1.Endpoint_HealthStatus{
+				"10.0.0.1": v1.Endpoint_UNKNOWN,
+				"10.0.0.2": v1.Endpoint_DRAINING,
+			}))
+		})
+
+		It("does not create endpoints for terminating pods by default", func() {
+			Expect(filterEndpoints()).To(Equal(map[string]v1.Endpoint_HealthStatus{
+				"10.0.0.1": v1.Endpoint_UNKNOWN,
+			}))
+		})
+	})
+
+	Context("EndpointSlices", func() {
+
+		var (
+			writeNamespace string
+			up             *v1.Upstream
+			service        *corev1.Service
+			pods           []*corev1.Pod
+		)
+
+		sliceEndpoint := func(podName, address string, ready bool) discoveryv1.Endpoint {
+			return discoveryv1.Endpoint{
+				Addresses:  []string{address},
+				Conditions: discoveryv1.EndpointConditions{Ready: &ready},
+				TargetRef: &corev1.ObjectReference{
+					Kind:      "Pod",
+					Name:      podName,
+					Namespace: "foo",
+				},
+			}
+		}
+
+		endpointSlice := func(name string, addressType discoveryv1.AddressType, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+			portName := "http"
+			port := int32(9080)
+			return &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "foo",
+					Labels:    map[string]string{discoveryv1.LabelServiceName: "bar"},
+				},
+				AddressType: addressType,
+				Endpoints:   endpoints,
+				Ports:       []discoveryv1.EndpointPort{{Name: &portName, Port: &port}},
+			}
+		}
+
+		BeforeEach(func() {
+			writeNamespace = "foo"
+			up = v1.NewUpstream(writeNamespace, "name")
+			up.UpstreamType = &v1.Upstream_Kube{
+				Kube: &kubev1.UpstreamSpec{
+					ServiceName:      "bar",
+					ServiceNamespace: "foo",
+					ServicePort:      9080,
+				},
+			}
+			service = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "foo"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{Name: "http", Port: 9080, Protocol: "TCP"},
+						{Name: "grpc", Port: 9090, Protocol: "TCP"},
+					},
+				},
+			}
+			pods = nil
+		})
+
+		filterEndpointSlices := func(slices ...*discoveryv1.EndpointSlice) v1.EndpointList {
+			endpoints, warnsToLog, errorsToLog := FilterEndpointSlices(ctx, writeNamespace, slices, []*corev1.Service{service}, pods, nil,
+				map[*core.ResourceRef]*kubeplugin.UpstreamSpec{
+					up.Metadata.Ref(): up.GetKube(),
+				})
+			Expect(warnsToLog).To(BeEmpty())
+			Expect(errorsToLog).To(BeEmpty())
+			return endpoints
+		}
+
+		addresses := func(endpoints v1.EndpointList) []string {
+			var addresses []string
+			for _, ep := range endpoints {
+				Expect(ep.GetPort()).To(BeEquivalentTo(9080))
+				addresses = append(addresses, ep.GetAddress())
+			}
+			return addresses
+		}
+
+		It("creates endpoints for the ready endpoints of the slices of the service", func() {
+			terminating := sliceEndpoint("bar-c", "10.0.0.3", false)
+			terminating.Conditions.Terminating = &[]bool{true}[0]
+			otherService := endpointSlice("other", discoveryv1.AddressTypeIPv4, sliceEndpoint("other", "10.0.0.4", true))
+			otherService.Labels[discoveryv1.LabelServiceName] = "other"
+
+			endpoints := filterEndpointSlices(
+				endpointSlice("bar-1", discoveryv1.AddressTypeIPv4, sliceEndpoint("bar-a", "10.0.0.1", true), terminating),
+				endpointSlice("bar-2", discoveryv1.AddressTypeIPv4, sliceEndpoint("bar-b", "10.0.0.2", true)),
+				otherService,
+			)
+
+			Expect(addresses(endpoints)).To(ConsistOf("10.0.0.1", "10.0.0.2"))
+		})
+
+		It("creates endpoints for the IP families of dual-stack services", func() {
+			slices := []*discoveryv1.EndpointSlice{
+				endpointSlice("bar-v4", discoveryv1.AddressTypeIPv4, sliceEndpoint("bar-a", "10.0.0.1", true)),
+				endpointSlice("bar-v6", discoveryv1.AddressTypeIPv6, sliceEndpoint("bar-a", "fd00::1", true)),
+			}
+
+			service.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol}
+			Expect(addresses(filterEndpointSlices(slices...))).To(ConsistOf("10.0.0.1", "fd00::1"))
+
+			service.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv4Protocol}
+			Expect(addresses(filterEndpointSlices(slices...))).To(ConsistOf("10.0.0.1"))
+		})
+
+		It("sets the locality from the zone and topology hints of the endpoints", func() {
+			zoneA, zoneB := "us-east-1a", "us-east-1b"
+			inZone := sliceEndpoint("bar-a", "10.0.0.1", true)
+			inZone.Zone = &zoneA
+			hinted := sliceEndpoint("bar-b", "10.0.0.2", true)
+			hinted.Zone = &zoneA
+			hinted.Hints = &discoveryv1.EndpointHints{ForZones: []discoveryv1.ForZone{{Name: zoneB}}}
+
+			endpoints := filterEndpointSlices(
+				endpointSlice("bar-1", discoveryv1.AddressTypeIPv4, inZone, hinted, sliceEndpoint("bar-c", "10.0.0.3", true)),
+			)
+
+			localities := make(map[string]*v1.Locality)
+			for _, ep := range endpoints {
+				localities[ep.GetAddress()] = ep.GetLocality()
+			}
+			Expect(localities).To(HaveLen(3))
+			Expect(localities["10.0.0.1"]).To(Equal(&v1.Locality{Zone: zoneA}))
+			Expect(localities["10.0.0.2"]).To(Equal(&v1.Locality{Zone: zoneB}))
+			Expect(localities["10.0.0.3"]).To(BeNil())
+		})
+
+		It("drains the terminating endpoints which are still serving when enabled", func() {
+			up.GetKube().DrainTerminatingEndpoints = true
+			serving := sliceEndpoint("bar-b", "10.0.0.2", false)
+			serving.Conditions.Serving = &[]bool{true}[0]
+			serving.Conditions.Terminating = &[]bool{true}[0]
+			notServing := sliceEndpoint("bar-c", "10.0.0.3", false)
+			notServing.Conditions.Serving = &[]bool{false}[0]
+			notServing.Conditions.Terminating = &[]bool{true}[0]
+
+			endpoints := filterEndpointSlices(
+				endpointSlice("bar-1", discoveryv1.AddressTypeIPv4, sliceEndpoint("bar-a", "10.0.0.1", true), serving, notServing),
+			)
+
+			healthStatuses := make(map[string]v1.Endpoint_HealthStatus)
+			for _, ep := range endpoints {
+				healthStatuses[ep.GetAddress()] = ep.GetHealthStatus()
+			}
+			Expect(healthStatuses).To(Equal(map[string]v1.Endpoint_HealthStatus{
+				"10.0.0.1": v1.Endpoint_UNKNOWN,
+				"10.0.0.2": v1.Endpoint_DRAINING,
+			}))
+		})
+
+		It("lists EndpointSlices when configured to use them", func() {
+			newIndexer := func(objects ...interface{}) cache.Indexer {
+				indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+				for _, obj := range objects {
+					Expect(indexer.Add(obj)).NotTo(HaveOccurred())
+				}
+				return indexer
+			}
+			settings := &v1.Settings{
+				WatchNamespaces: []string{"foo"},
+				Kubernetes:      &v1.Settings_KubernetesConfiguration{UseEndpointSlices: true},
+			}
+			serviceLister := corelisters.NewServiceLister(newIndexer(service)).Services("foo")
+			podLister := corelisters.NewPodLister(newIndexer()).Pods("foo")
+			sliceLister := discoverylisters.NewEndpointSliceLister(newIndexer(
+				endpointSlice("bar-1", discoveryv1.AddressTypeIPv4, sliceEndpoint("bar-a", "10.0.0.1", true)),
+			))
+			mockCache.EXPECT().NamespacedServiceLister("foo").Return(serviceLister).Times(2)
+			mockCache.EXPECT().NamespacedPodLister("foo").Return(podLister)
+			mockSharedFactory.EXPECT().EndpointSliceLister("foo").Return(sliceLister)
+			mockSharedFactory.EXPECT().NodeLister().Return(nil)
+
+			watcher, err := newEndpointWatcherForUpstreams(func([]string) KubePluginSharedFactory { return mockSharedFactory }, mockCache, v1.UpstreamList{up}, clients.WatchOpts{Ctx: ctx}, settings)
+			Expect(err).NotTo(HaveOccurred())
+			endpoints, err := watcher.List(writeNamespace, clients.ListOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(addresses(endpoints)).To(ConsistOf("10.0.0.1"))
+		})
+	})
+
 })
@@ -71,6 +71,10 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 
 	// configure the cluster to use EDS:ADS and call it a day
 	xds.SetEdsOnCluster(out, p.settings)
+	// draining endpoints must be removed once they are removed from kubernetes, even if they still pass health checks
+	if kube.Kube.GetDrainTerminatingEndpoints() && in.GetIgnoreHealthOnHostRemoval() == nil {
+		out.IgnoreHealthOnHostRemoval = true
+	}
 	upstreamRef := in.GetMetadata().Ref()
 
 	// Lister functions obfuscate the typical (val, ok) pair returned values of maps, so we have to do a nil check instead.
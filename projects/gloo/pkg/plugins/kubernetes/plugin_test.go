@@ -9,6 +9,8 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -73,6 +75,22 @@ var _ = Describe("Plugin", func() {
 			Expect(strings.Contains(err.Error(), "invalid ServiceNamespace")).To(BeTrue())
 		})
 
+		It("should ignore health on host removal when draining terminating endpoints", func() {
+			_, err := kube.CoreV1().Services("ns").Create(context.Background(), &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "mySvc", Namespace: "ns"},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			kubeCoreCache, err := corecache.NewKubeCoreCache(context.Background(), kube)
+			Expect(err).NotTo(HaveOccurred())
+			plugin = NewPlugin(kube, kubeCoreCache)
+			plugin.Init(plugins.InitParams{})
+			upstream.GetKube().DrainTerminatingEndpoints = true
+
+			err = plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetIgnoreHealthOnHostRemoval()).To(BeTrue())
+		})
+
 	})
 
 })
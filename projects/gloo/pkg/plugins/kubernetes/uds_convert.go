@@ -110,6 +110,8 @@ func UpdateUpstream(original, desired *v1.Upstream) (didChange bool, err error)
 	desiredSpec.Kube.ServiceSpec = originalSpec.Kube.GetServiceSpec()
 	// copy labels; user may have written them over. cannot be auto-discovered
 	desiredSpec.Kube.Selector = originalSpec.Kube.GetSelector()
+	// copy endpoint draining; user may have enabled it. cannot be auto-discovered
+	desiredSpec.Kube.DrainTerminatingEndpoints = originalSpec.Kube.GetDrainTerminatingEndpoints()
 
 	utils.UpdateUpstream(original, desired)
 
@@ -60,4 +60,26 @@ var _ = Describe("Uds", func() {
 		Expect(desired.SslConfig).To(BeIdenticalTo(desiredSslConfig))
 	})
 
+	It("should preserve endpoint draining when updating upstreams", func() {
+		desired := &gloov1.Upstream{
+			UpstreamType: &gloov1.Upstream_Kube{
+				Kube: &gloov1kube.UpstreamSpec{
+					ServiceName: "test",
+				},
+			},
+		}
+		original := &gloov1.Upstream{
+			UpstreamType: &gloov1.Upstream_Kube{
+				Kube: &gloov1kube.UpstreamSpec{
+					ServiceName:               "test",
+					DrainTerminatingEndpoints: true,
+				},
+			},
+		}
+		updated, err := UpdateUpstream(original, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).To(BeFalse())
+		Expect(desired.GetKube().GetDrainTerminatingEndpoints()).To(BeTrue())
+	})
+
 })
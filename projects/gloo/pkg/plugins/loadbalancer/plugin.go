@@ -113,6 +113,10 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 				out.GetCommonLbConfig().LocalityConfigSpecifier = &envoy_config_cluster_v3.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
 					LocalityWeightedLbConfig: &envoy_config_cluster_v3.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
 				}
+			case *v1.LoadBalancerConfig_ZoneAwareLbConfig_:
+				out.GetCommonLbConfig().LocalityConfigSpecifier = &envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig_{
+					ZoneAwareLbConfig: translateZoneAwareLbConfig(cfg.GetZoneAwareLbConfig()),
+				}
 			}
 		}
 	}
@@ -145,6 +149,19 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	return nil
 }
 
+func translateZoneAwareLbConfig(in *v1.LoadBalancerConfig_ZoneAwareLbConfig) *envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig {
+	out := &envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+		MinClusterSize:     in.GetMinClusterSize(),
+		FailTrafficOnPanic: in.GetFailTrafficOnPanic(),
+	}
+	if in.GetRoutingEnabled() != nil {
+		out.RoutingEnabled = &envoy_type_v3.Percent{
+			Value: in.GetRoutingEnabled().GetValue(),
+		}
+	}
+	return out
+}
+
 func configureRoundRobinLb(out *envoy_config_cluster_v3.Cluster, cfg *v1.LoadBalancerConfig_RoundRobin_) {
 	out.LbPolicy = envoy_config_cluster_v3.Cluster_ROUND_ROBIN
 
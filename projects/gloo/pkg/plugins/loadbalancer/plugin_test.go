@@ -7,6 +7,7 @@ import (
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -351,6 +352,28 @@ status: {}
 			}))
 	})
 
+	It("should set locality config - zone aware lb config", func() {
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			LocalityConfig: &v1.LoadBalancerConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: &v1.LoadBalancerConfig_ZoneAwareLbConfig{
+					RoutingEnabled:     &wrappers.DoubleValue{Value: 50},
+					MinClusterSize:     &wrappers.UInt64Value{Value: 3},
+					FailTrafficOnPanic: true,
+				},
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.CommonLbConfig.LocalityConfigSpecifier).To(Equal(
+			&envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: &envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+					RoutingEnabled:     &envoy_type_v3.Percent{Value: 50},
+					MinClusterSize:     &wrappers.UInt64Value{Value: 3},
+					FailTrafficOnPanic: true,
+				},
+			}))
+	})
+
 	It("should not set locality config if no config", func() {
 		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
 			// We include this, so that the plugin generates a CommonLbConfig object
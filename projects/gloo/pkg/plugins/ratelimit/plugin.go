@@ -79,6 +79,13 @@ func (p *plugin) ProcessVirtualHost(
 		out.RateLimits, err = toEnvoyRateLimits(params.Ctx, newRateLimits, rateLimitStage)
 		return err
 	}
+	if refs := in.GetOptions().GetRateLimitConfigs().GetRefs(); len(refs) > 0 {
+		serverSettings := p.getServerSettingsForListener(params.HttpListener)
+		rateLimitStage := GetRateLimitStageForServerSettings(serverSettings)
+		var err error
+		out.RateLimits, err = toEnvoyRateLimitsForConfigs(params.Ctx, params.Snapshot.Ratelimitconfigs, refs, rateLimitStage)
+		return err
+	}
 	return nil
 }
 
@@ -96,6 +103,17 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 			return fmt.Errorf("cannot apply rate limits without a route action")
 		}
 	}
+	if refs := in.GetOptions().GetRateLimitConfigs().GetRefs(); len(refs) > 0 {
+		if ra := out.GetRoute(); ra != nil {
+			serverSettings := p.getServerSettingsForListener(params.HttpListener)
+			rateLimitStage := GetRateLimitStageForServerSettings(serverSettings)
+			var err error
+			ra.RateLimits, err = toEnvoyRateLimitsForConfigs(params.Ctx, params.Snapshot.Ratelimitconfigs, refs, rateLimitStage)
+			return err
+		} else {
+			return fmt.Errorf("cannot apply rate limits without a route action")
+		}
+	}
 	return nil
 }
 
@@ -7,12 +7,15 @@ import (
 
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	rlconfig "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoyratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	rlapi "github.com/solo-io/gloo/projects/gloo/api/external/solo/ratelimit"
+	rlexternal "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
 	ratelimitpb "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
@@ -21,9 +24,11 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/extauth"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
 	"github.com/solo-io/solo-kit/test/matchers"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("RateLimit Plugin", func() {
@@ -246,6 +251,136 @@ var _ = Describe("RateLimit Plugin", func() {
 		})
 	})
 
+	Context("rate limit configs", func() {
+
+		var (
+			rlc       *rlexternal.RateLimitConfig
+			vhParams  plugins.VirtualHostParams
+			configRef *ratelimitpb.RateLimitConfigRef
+		)
+
+		BeforeEach(func() {
+			rlc = &rlexternal.RateLimitConfig{
+				RateLimitConfig: rlapi.RateLimitConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "per-user",
+						Namespace: "ns",
+					},
+					Spec: solo_rl.RateLimitConfigSpec{
+						ConfigType: &solo_rl.RateLimitConfigSpec_Raw_{
+							Raw: &solo_rl.RateLimitConfigSpec_Raw{
+								Descriptors: []*solo_rl.Descriptor{{
+									Key: "user",
+									RateLimit: &solo_rl.RateLimit{
+										Unit:            solo_rl.RateLimit_MINUTE,
+										RequestsPerUnit: 10,
+									},
+								}},
+								SetDescriptors: []*solo_rl.SetDescriptor{{
+									SimpleDescriptors: []*solo_rl.SimpleDescriptor{{Key: "tier", Value: "free"}},
+									RateLimit: &solo_rl.RateLimit{
+										Unit:            solo_rl.RateLimit_SECOND,
+										RequestsPerUnit: 1,
+									},
+								}},
+								RateLimits: []*solo_rl.RateLimitActions{{
+									Actions: []*solo_rl.Action{{
+										ActionSpecifier: &solo_rl.Action_RequestHeaders_{
+											RequestHeaders: &solo_rl.Action_RequestHeaders{
+												HeaderName:    "x-user",
+												DescriptorKey: "user",
+											},
+										},
+									}},
+									SetActions: []*solo_rl.Action{{
+										ActionSpecifier: &solo_rl.Action_RequestHeaders_{
+											RequestHeaders: &solo_rl.Action_RequestHeaders{
+												HeaderName:    "x-tier",
+												DescriptorKey: "tier",
+											},
+										},
+									}},
+								}},
+							},
+						},
+					},
+				},
+			}
+			configRef = &ratelimitpb.RateLimitConfigRef{Name: "per-user", Namespace: "ns"}
+			params.Snapshot.Ratelimitconfigs = rlexternal.RateLimitConfigList{rlc}
+			vhParams = plugins.VirtualHostParams{
+				Params:       params,
+				HttpListener: &gloov1.HttpListener{},
+			}
+		})
+
+		genericKey := func(value string) *envoy_config_route_v3.RateLimit_Action {
+			return &envoy_config_route_v3.RateLimit_Action{
+				ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_GenericKey_{
+					GenericKey: &envoy_config_route_v3.RateLimit_Action_GenericKey{DescriptorValue: value},
+				},
+			}
+		}
+
+		It("scopes the actions of a referenced config by its generic key", func() {
+			out := &envoy_config_route_v3.VirtualHost{}
+			err := NewPlugin().ProcessVirtualHost(vhParams, &gloov1.VirtualHost{
+				Options: &gloov1.VirtualHostOptions{
+					RateLimitConfigType: &gloov1.VirtualHostOptions_RateLimitConfigs{
+						RateLimitConfigs: &ratelimitpb.RateLimitConfigRefs{
+							Refs: []*ratelimitpb.RateLimitConfigRef{configRef},
+						},
+					},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			configKey := ConfigKey("ns", "per-user")
+			Expect(out.GetRateLimits()).To(HaveLen(2))
+			Expect(out.GetRateLimits()[0].GetActions()).To(HaveLen(2))
+			Expect(out.GetRateLimits()[0].GetActions()[0]).To(matchers.MatchProto(genericKey(configKey)))
+			Expect(out.GetRateLimits()[0].GetActions()[1].GetRequestHeaders().GetSkipIfAbsent()).To(BeFalse())
+
+			setActions := out.GetRateLimits()[1].GetActions()
+			Expect(setActions).To(HaveLen(3))
+			Expect(setActions[0]).To(matchers.MatchProto(genericKey(SetDescriptorValue)))
+			Expect(setActions[1]).To(matchers.MatchProto(genericKey(configKey)))
+			Expect(setActions[2].GetRequestHeaders().GetSkipIfAbsent()).To(BeTrue())
+		})
+
+		It("errors when a referenced config does not exist", func() {
+			route := &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Route{Route: &envoy_config_route_v3.RouteAction{}},
+			}
+			err := NewPlugin().ProcessRoute(plugins.RouteParams{VirtualHostParams: vhParams}, &gloov1.Route{
+				Options: &gloov1.RouteOptions{
+					RateLimitConfigType: &gloov1.RouteOptions_RateLimitConfigs{
+						RateLimitConfigs: &ratelimitpb.RateLimitConfigRefs{
+							Refs: []*ratelimitpb.RateLimitConfigRef{{Name: "missing", Namespace: "ns"}},
+						},
+					},
+				},
+			}, route)
+			Expect(err).To(MatchError(ContainSubstring("could not find RateLimitConfig resource with name [missing] in namespace [ns]")))
+		})
+
+		It("nests config descriptors beneath the config's generic key", func() {
+			descriptors, setDescriptors, err := ConfigDescriptors(rlc)
+			Expect(err).NotTo(HaveOccurred())
+
+			configKey := ConfigKey("ns", "per-user")
+			Expect(descriptors).To(HaveLen(1))
+			Expect(descriptors[0].GetKey()).To(Equal(GenericKeyDescriptor))
+			Expect(descriptors[0].GetValue()).To(Equal(configKey))
+			Expect(descriptors[0].GetDescriptors()).To(Equal(rlc.Spec.GetRaw().GetDescriptors()))
+
+			Expect(setDescriptors).To(HaveLen(1))
+			Expect(setDescriptors[0].GetSimpleDescriptors()).To(HaveLen(2))
+			Expect(setDescriptors[0].GetSimpleDescriptors()[0].GetValue()).To(Equal(configKey))
+			Expect(setDescriptors[0].GetSimpleDescriptors()[1].GetKey()).To(Equal("tier"))
+		})
+	})
+
 })
 
 func getTypedConfig(f *envoyhttp.HttpFilter) *envoyratelimit.RateLimit {
@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	rlexternal "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+)
+
+// descriptors generated from a RateLimitConfig are scoped by a leading generic key,
+// so that configs in different namespaces cannot collide in the shared custom domain
+const GenericKeyDescriptor = "generic_key"
+
+var (
+	RateLimitConfigNotFound = func(ref *ratelimit.RateLimitConfigRef) error {
+		return eris.Errorf("could not find RateLimitConfig resource with name [%s] in namespace [%s]", ref.GetName(), ref.GetNamespace())
+	}
+
+	MissingRawConfigError = func(rlc *rlexternal.RateLimitConfig) error {
+		return eris.Errorf("RateLimitConfig %s.%s does not define a raw configuration", rlc.GetNamespace(), rlc.GetName())
+	}
+)
+
+// ConfigKey returns the generic key value that scopes the descriptors and actions of a RateLimitConfig.
+func ConfigKey(namespace, name string) string {
+	return fmt.Sprintf("ratelimitconfig.%s.%s", namespace, name)
+}
+
+// ConfigDescriptors converts a RateLimitConfig into the descriptors and set descriptors served to the rate
+// limit server, nesting them beneath the generic key produced by the config's actions.
+func ConfigDescriptors(rlc *rlexternal.RateLimitConfig) ([]*solo_rl.Descriptor, []*solo_rl.SetDescriptor, error) {
+	raw := rlc.Spec.GetRaw()
+	if raw == nil {
+		return nil, nil, MissingRawConfigError(rlc)
+	}
+	configKey := ConfigKey(rlc.GetNamespace(), rlc.GetName())
+
+	var descriptors []*solo_rl.Descriptor
+	if len(raw.GetDescriptors()) > 0 {
+		descriptors = append(descriptors, &solo_rl.Descriptor{
+			Key:         GenericKeyDescriptor,
+			Value:       configKey,
+			Descriptors: raw.GetDescriptors(),
+		})
+	}
+
+	var setDescriptors []*solo_rl.SetDescriptor
+	for _, setDescriptor := range raw.GetSetDescriptors() {
+		simpleDescriptors := append([]*solo_rl.SimpleDescriptor{{
+			Key:   GenericKeyDescriptor,
+			Value: configKey,
+		}}, setDescriptor.GetSimpleDescriptors()...)
+		setDescriptors = append(setDescriptors, &solo_rl.SetDescriptor{
+			SimpleDescriptors: simpleDescriptors,
+			RateLimit:         setDescriptor.GetRateLimit(),
+			AlwaysApply:       setDescriptor.GetAlwaysApply(),
+		})
+	}
+
+	return descriptors, setDescriptors, nil
+}
+
+func toEnvoyRateLimitsForConfigs(
+	ctx context.Context,
+	rateLimitConfigs rlexternal.RateLimitConfigList,
+	refs []*ratelimit.RateLimitConfigRef,
+	stage uint32,
+) ([]*envoy_config_route_v3.RateLimit, error) {
+	var ret []*envoy_config_route_v3.RateLimit
+	var allErrors error
+	for _, ref := range refs {
+		rlc, err := rateLimitConfigs.Find(ref.GetNamespace(), ref.GetName())
+		if err != nil {
+			allErrors = multierror.Append(allErrors, RateLimitConfigNotFound(ref))
+			continue
+		}
+		raw := rlc.Spec.GetRaw()
+		if raw == nil {
+			allErrors = multierror.Append(allErrors, MissingRawConfigError(rlc))
+			continue
+		}
+
+		configKeyAction := genericKeyAction(ConfigKey(rlc.GetNamespace(), rlc.GetName()))
+		var scopedActions []*solo_rl.RateLimitActions
+		for _, actions := range raw.GetRateLimits() {
+			scoped := &solo_rl.RateLimitActions{Limit: actions.GetLimit()}
+			if len(actions.GetActions()) != 0 {
+				scoped.Actions = append([]*solo_rl.Action{configKeyAction}, actions.GetActions()...)
+			}
+			if len(actions.GetSetActions()) != 0 {
+				scoped.SetActions = append([]*solo_rl.Action{configKeyAction}, actions.GetSetActions()...)
+			}
+			scopedActions = append(scopedActions, scoped)
+		}
+
+		rateLimits, err := toEnvoyRateLimits(ctx, scopedActions, stage)
+		if err != nil {
+			allErrors = multierror.Append(allErrors, err)
+		}
+		ret = append(ret, rateLimits...)
+	}
+	return ret, allErrors
+}
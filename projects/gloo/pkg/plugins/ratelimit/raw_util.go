@@ -44,10 +44,33 @@ func toEnvoyRateLimits(
 			}
 			ret = append(ret, rl)
 		}
+		if len(action.GetSetActions()) != 0 {
+			rl := &envoy_config_route_v3.RateLimit{
+				Stage: &wrappers.UInt32Value{Value: stage},
+			}
+			// the set-style descriptor is flagged to the rate limit server by a leading generic key
+			setActions := append([]*solo_rl.Action{genericKeyAction(SetDescriptorValue)}, action.GetSetActions()...)
+			var err error
+			rl.Actions, err = ConvertActions(ctx, setActions)
+			if err != nil {
+				allErrors = multierror.Append(allErrors, err)
+			}
+			ret = append(ret, rl)
+		}
 	}
 	return ret, allErrors
 }
 
+func genericKeyAction(descriptorValue string) *solo_rl.Action {
+	return &solo_rl.Action{
+		ActionSpecifier: &solo_rl.Action_GenericKey_{
+			GenericKey: &solo_rl.Action_GenericKey{
+				DescriptorValue: descriptorValue,
+			},
+		},
+	}
+}
+
 // ConvertActions generates Envoy RateLimit_Actions from the solo-apis API. It checks that all required fields are set.
 func ConvertActions(ctx context.Context, actions []*solo_rl.Action) ([]*envoy_config_route_v3.RateLimit_Action, error) {
 	var retActions []*envoy_config_route_v3.RateLimit_Action
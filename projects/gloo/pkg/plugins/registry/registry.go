@@ -12,6 +12,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/als"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws/ec2"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws_request_signing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/azure"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/buffer"
@@ -22,8 +23,10 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/deprecated_cipher_passthrough"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/dynamic_forward_proxy"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/enterprise_warning"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/eureka"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/extauth"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/faultinjection"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gcp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcjson"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcweb"
@@ -31,6 +34,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/hcm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/headers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/healthcheck"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/http3"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/istio_automtls"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/istio_integration"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
@@ -74,6 +78,7 @@ func Plugins(opts bootstrap.Opts) []plugins.Plugin {
 		loadbalancer.NewPlugin(),
 		upstreamconn.NewPlugin(),
 		azure.NewPlugin(),
+		gcp.NewPlugin(),
 		aws.NewPlugin(aws.GenerateAWSLambdaRouteConfig),
 		rest.NewPlugin(),
 		hcm.NewPlugin(),
@@ -108,9 +113,11 @@ func Plugins(opts bootstrap.Opts) []plugins.Plugin {
 		grpcjson.NewPlugin(),
 		metadata.NewPlugin(),
 		tunneling.NewPlugin(),
+		http3.NewPlugin(),
 		dynamic_forward_proxy.NewPlugin(),
 		deprecated_cipher_passthrough.NewPlugin(),
 		local_ratelimit.NewPlugin(),
+		aws_request_signing.NewPlugin(),
 		istio_automtls.NewPlugin(opts.GlooGateway.IstioValues.SDSEnabled, opts.GlooGateway.IstioValues.SidecarOnGatewayEnabled),
 	)
 
@@ -120,6 +127,14 @@ func Plugins(opts bootstrap.Opts) []plugins.Plugin {
 	if opts.Consul.ConsulWatcher != nil {
 		glooPlugins = append(glooPlugins, consul.NewPlugin(opts.Consul.ConsulWatcher, consul.NewConsulDnsResolver(opts.Consul.DnsServer), opts.Consul.DnsPollingInterval))
 	}
+	if eurekaSettings := opts.Settings.GetEureka(); eurekaSettings != nil {
+		eurekaClient, err := eureka.NewClient(eurekaSettings)
+		if err != nil {
+			contextutils.LoggerFrom(opts.WatchOpts.Ctx).Errorf("Failed to create eureka Plugin %+v", err)
+		} else {
+			glooPlugins = append(glooPlugins, eureka.NewPlugin(eurekaClient))
+		}
+	}
 	lookupResult, found := os.LookupEnv(constants.IstioInjectionEnabled)
 	istioEnabled := found && strings.ToLower(lookupResult) == "true"
 	if istioEnabled {
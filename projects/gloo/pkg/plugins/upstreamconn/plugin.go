@@ -5,6 +5,7 @@ import (
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/rotisserie/eris"
@@ -25,7 +26,15 @@ const (
 	ExtensionName = "upstream_conn"
 )
 
-type plugin struct{}
+var (
+	InvalidRetryBudgetPercentError = func(budgetPercent float64) error {
+		return eris.Errorf("retry budget percent must be between 0 and 100, it was: %v", budgetPercent)
+	}
+)
+
+type plugin struct {
+	settings *v1.Settings
+}
 
 func NewPlugin() *plugin {
 	return &plugin{}
@@ -35,10 +44,15 @@ func (p *plugin) Name() string {
 	return ExtensionName
 }
 
-func (p *plugin) Init(_ plugins.InitParams) {
+func (p *plugin) Init(params plugins.InitParams) {
+	p.settings = params.Settings
 }
 
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	if err := p.processRetryBudget(in, out); err != nil {
+		return err
+	}
+
 	cfg := in.GetConnectionConfig()
 	if cfg == nil {
 		return nil
@@ -83,6 +97,32 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	return nil
 }
 
+// processRetryBudget adds the retry budget of the circuit breaker of the upstream, or of the settings when the upstream
+// has none, to the circuit breaker thresholds of the cluster.
+func (p *plugin) processRetryBudget(in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	circuitBreakers := in.GetCircuitBreakers()
+	if circuitBreakers == nil {
+		circuitBreakers = p.settings.GetGloo().GetCircuitBreakers()
+	}
+	budget := circuitBreakers.GetRetryBudget()
+	if budget == nil || len(out.GetCircuitBreakers().GetThresholds()) == 0 {
+		return nil
+	}
+
+	// Envoy applies its own defaults to the unset fields
+	envoyBudget := &envoy_config_cluster_v3.CircuitBreakers_Thresholds_RetryBudget{
+		MinRetryConcurrency: budget.GetMinRetryConcurrency(),
+	}
+	if budgetPercent := budget.GetBudgetPercent(); budgetPercent != nil {
+		if budgetPercent.GetValue() < 0 || budgetPercent.GetValue() > 100 {
+			return InvalidRetryBudgetPercentError(budgetPercent.GetValue())
+		}
+		envoyBudget.BudgetPercent = &envoy_type_v3.Percent{Value: budgetPercent.GetValue()}
+	}
+	out.GetCircuitBreakers().GetThresholds()[0].RetryBudget = envoyBudget
+	return nil
+}
+
 func convertTcpKeepAlive(tcp *v1.ConnectionConfig_TcpKeepAlive) *envoy_config_core_v3.TcpKeepalive {
 	var probes *wrappers.UInt32Value
 	if tcp.GetKeepaliveProbes() > 0 {
@@ -5,6 +5,7 @@ import (
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/upstreamconn"
+	. "github.com/solo-io/solo-kit/test/matchers"
 )
 
 var _ = Describe("Plugin", func() {
@@ -178,4 +180,63 @@ var _ = Describe("Plugin", func() {
 		err := plugin.ProcessUpstream(params, upstream, out)
 		Expect(err).To(HaveOccurred())
 	})
+
+	Context("retry budgets", func() {
+
+		BeforeEach(func() {
+			// the translator converts the circuit breaker thresholds before the plugins run
+			out.CircuitBreakers = &envoy_config_cluster_v3.CircuitBreakers{
+				Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{{}},
+			}
+		})
+
+		It("should set the retry budget of the circuit breaker of the upstream", func() {
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				RetryBudget: &v1.RetryBudget{
+					BudgetPercent:       &wrappers.DoubleValue{Value: 25},
+					MinRetryConcurrency: &wrappers.UInt32Value{Value: 5},
+				},
+			}
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetCircuitBreakers().GetThresholds()[0].GetRetryBudget()).To(MatchProto(
+				&envoy_config_cluster_v3.CircuitBreakers_Thresholds_RetryBudget{
+					BudgetPercent:       &envoy_type_v3.Percent{Value: 25},
+					MinRetryConcurrency: &wrappers.UInt32Value{Value: 5},
+				},
+			))
+		})
+
+		It("should set the retry budget of the circuit breaker of the settings", func() {
+			plugin.Init(plugins.InitParams{Settings: &v1.Settings{
+				Gloo: &v1.GlooOptions{
+					CircuitBreakers: &v1.CircuitBreakerConfig{
+						RetryBudget: &v1.RetryBudget{
+							MinRetryConcurrency: &wrappers.UInt32Value{Value: 2},
+						},
+					},
+				},
+			}})
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetCircuitBreakers().GetThresholds()[0].GetRetryBudget()).To(MatchProto(
+				&envoy_config_cluster_v3.CircuitBreakers_Thresholds_RetryBudget{
+					MinRetryConcurrency: &wrappers.UInt32Value{Value: 2},
+				},
+			))
+		})
+
+		It("should error when the budget percent is out of range", func() {
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				RetryBudget: &v1.RetryBudget{
+					BudgetPercent: &wrappers.DoubleValue{Value: 120},
+				},
+			}
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(InvalidRetryBudgetPercentError(120)))
+		})
+	})
 })
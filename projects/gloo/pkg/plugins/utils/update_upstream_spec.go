@@ -93,4 +93,8 @@ func UpdateUpstream(original, desired *v1.Upstream) {
 	if desired.GetPreconnectPolicy() == nil {
 		desired.PreconnectPolicy = original.GetPreconnectPolicy()
 	}
+
+	if desired.GetAwsRequestSigning() == nil {
+		desired.AwsRequestSigning = original.GetAwsRequestSigning()
+	}
 }
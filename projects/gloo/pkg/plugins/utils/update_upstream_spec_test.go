@@ -9,6 +9,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/cluster"
 	envoycore_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws_request_signing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -35,6 +36,7 @@ var _ = Describe("UpdateUpstream", func() {
 			OverrideStreamErrorOnInvalidHttpMessage: &wrappers.BoolValue{Value: true},
 			RespectDnsTtl:                           &wrappers.BoolValue{Value: true},
 			DnsRefreshRate:                          &durationpb.Duration{Seconds: 10},
+			AwsRequestSigning:                       &aws_request_signing.AwsRequestSigning{ServiceName: "s3", Region: "us-east-1"},
 		}
 		utils.UpdateUpstream(original, desired)
 		Expect(desired.SslConfig).To(Equal(original.SslConfig))
@@ -50,6 +52,7 @@ var _ = Describe("UpdateUpstream", func() {
 		Expect(desired.OverrideStreamErrorOnInvalidHttpMessage).To(Equal(original.OverrideStreamErrorOnInvalidHttpMessage))
 		Expect(desired.RespectDnsTtl).To(Equal(original.RespectDnsTtl))
 		Expect(desired.DnsRefreshRate).To(Equal(original.DnsRefreshRate))
+		Expect(desired.AwsRequestSigning).To(Equal(original.AwsRequestSigning))
 	})
 
 	It("should update config when one is desired", func() {
@@ -123,7 +126,7 @@ var _ = Describe("UpdateUpstream", func() {
 		// This should happen very rarely, and should be used as an indication that the `UpdateUpstream` function
 		// most likely needs to change.
 		Expect(reflect.TypeOf(gloov1.Upstream{}).NumField()).To(
-			Equal(29),
+			Equal(30),
 			"wrong number of fields found",
 		)
 	})
@@ -0,0 +1,359 @@
+package extauth
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	// ConfigIdContextExtension is the ext_authz context extension that tells the open source extauth server
+	// which AuthConfig to apply to a request.
+	ConfigIdContextExtension = "config_id"
+
+	// prefix of htpasswd entries hashed with the Apache APR1 MD5 algorithm
+	aprPrefix = "$apr1$"
+)
+
+var (
+	UnsupportedAuthConfigError = func(name string) error {
+		return eris.Errorf("auth config %s is not supported by the open source extauth server, "+
+			"only basicAuth, apiKeyAuth and oauth2.oidcAuthorizationCode are available", name)
+	}
+
+	BasicAuthUserSourceError = eris.New("basic auth must define either apr, or encryption and a user source")
+
+	UnsupportedBasicAuthEncryptionError = eris.New("the open source extauth server only supports apr basic auth encryption")
+
+	InvalidHtpasswdEntryError = func(ref *core.ResourceRef, line int) error {
+		return eris.Errorf("htpasswd secret %s.%s has an invalid entry on line %d, only apr1 hashes are supported",
+			ref.GetNamespace(), ref.GetName(), line)
+	}
+
+	UnsupportedApiKeyStorageError = eris.New("the open source extauth server only supports API keys stored in secrets")
+
+	DuplicateApiKeyError = func(secret *gloov1.Secret) error {
+		return eris.Errorf("API key in secret %s.%s is also defined by another secret", secret.GetMetadata().GetNamespace(), secret.GetMetadata().GetName())
+	}
+
+	MissingApiKeyMetadataError = func(secret *gloov1.Secret, name string) error {
+		return eris.Errorf("API key secret %s.%s does not define the required metadata entry %s",
+			secret.GetMetadata().GetNamespace(), secret.GetMetadata().GetName(), name)
+	}
+
+	MissingOidcFieldError = func(field string) error {
+		return eris.Errorf("oidc authorization code config must define %s", field)
+	}
+
+	UnsupportedOidcError = func(feature string) error {
+		return eris.Errorf("oidc %s is not supported by the open source extauth server", feature)
+	}
+
+	SecretNotFoundError = func(ref *core.ResourceRef) error {
+		return eris.Errorf("could not find secret %s.%s", ref.GetNamespace(), ref.GetName())
+	}
+
+	UnexpectedSecretKindError = func(ref *core.ResourceRef, kind string) error {
+		return eris.Errorf("secret %s.%s is not a %s secret", ref.GetNamespace(), ref.GetName(), kind)
+	}
+)
+
+// ConfigId identifies the translated AuthConfig served to the extauth server.
+func ConfigId(namespace, name string) string {
+	return fmt.Sprintf("%s.%s", namespace, name)
+}
+
+// ConfigName returns the name of a config in an auth config chain, as referenced by the chain's boolean expression.
+func ConfigName(name *wrappers.StringValue, index int) string {
+	if name.GetValue() != "" {
+		return name.GetValue()
+	}
+	return fmt.Sprintf("config_%d", index)
+}
+
+// TranslateAuthConfig resolves the secrets referenced by an AuthConfig into the configuration served to the
+// open source extauth server. Only the subset of auth types implemented by that server is accepted.
+func TranslateAuthConfig(snap *gloov1snap.ApiSnapshot, authConfig *extauthv1.AuthConfig) (*extauthv1.ExtAuthConfig, error) {
+	out := &extauthv1.ExtAuthConfig{
+		AuthConfigRefName: ConfigId(authConfig.GetMetadata().GetNamespace(), authConfig.GetMetadata().GetName()),
+		BooleanExpr:       authConfig.GetBooleanExpr(),
+		FailOnRedirect:    authConfig.GetFailOnRedirect(),
+	}
+
+	var names []string
+	for i, config := range authConfig.GetConfigs() {
+		name := ConfigName(config.GetName(), i)
+		names = append(names, name)
+
+		translated := &extauthv1.ExtAuthConfig_Config{Name: &wrappers.StringValue{Value: name}}
+		var err error
+		switch cfg := config.GetAuthConfig().(type) {
+		case *extauthv1.AuthConfig_Config_BasicAuth:
+			translated.AuthConfig, err = translateBasicAuth(snap, cfg.BasicAuth)
+		case *extauthv1.AuthConfig_Config_ApiKeyAuth:
+			translated.AuthConfig, err = translateApiKeyAuth(snap, cfg.ApiKeyAuth)
+		case *extauthv1.AuthConfig_Config_Oauth2:
+			oidc := cfg.Oauth2.GetOidcAuthorizationCode()
+			if oidc == nil {
+				return nil, UnsupportedAuthConfigError(name)
+			}
+			translated.AuthConfig, err = translateOidc(snap, oidc)
+		default:
+			return nil, UnsupportedAuthConfigError(name)
+		}
+		if err != nil {
+			return nil, eris.Wrapf(err, "invalid auth config %s", name)
+		}
+		out.Configs = append(out.GetConfigs(), translated)
+	}
+
+	if expr := authConfig.GetBooleanExpr().GetValue(); expr != "" {
+		if _, err := ParseBooleanExpr(expr, names); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func translateBasicAuth(snap *gloov1snap.ApiSnapshot, basicAuth *extauthv1.BasicAuth) (*extauthv1.ExtAuthConfig_Config_BasicAuthInternal, error) {
+	if basicAuth.GetApr() != nil {
+		if basicAuth.GetEncryption() != nil || basicAuth.GetUserSource() != nil {
+			return nil, BasicAuthUserSourceError
+		}
+		users := make(map[string]*extauthv1.ExtAuthConfig_BasicAuthInternal_User, len(basicAuth.GetApr().GetUsers()))
+		for username, user := range basicAuth.GetApr().GetUsers() {
+			users[username] = &extauthv1.ExtAuthConfig_BasicAuthInternal_User{Salt: user.GetSalt(), HashedPassword: user.GetHashedPassword()}
+		}
+		return basicAuthInternal(basicAuth.GetRealm(), users), nil
+	}
+
+	switch basicAuth.GetEncryption().GetAlgorithm().(type) {
+	case *extauthv1.BasicAuth_EncryptionType_Apr_:
+	case nil:
+		// htpasswd entries carry their own algorithm, so encryption may be omitted
+		if basicAuth.GetHtpasswdSecretRef() == nil {
+			return nil, BasicAuthUserSourceError
+		}
+	default:
+		return nil, UnsupportedBasicAuthEncryptionError
+	}
+
+	switch source := basicAuth.GetUserSource().(type) {
+	case *extauthv1.BasicAuth_UserList_:
+		users := make(map[string]*extauthv1.ExtAuthConfig_BasicAuthInternal_User, len(source.UserList.GetUsers()))
+		for username, user := range source.UserList.GetUsers() {
+			users[username] = &extauthv1.ExtAuthConfig_BasicAuthInternal_User{Salt: user.GetSalt(), HashedPassword: user.GetHashedPassword()}
+		}
+		return basicAuthInternal(basicAuth.GetRealm(), users), nil
+	case *extauthv1.BasicAuth_HtpasswdSecretRef:
+		secret, err := findSecret(snap, source.HtpasswdSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		if secret.GetHtpasswd() == nil {
+			return nil, UnexpectedSecretKindError(source.HtpasswdSecretRef, "htpasswd")
+		}
+		users, err := parseHtpasswd(source.HtpasswdSecretRef, secret.GetHtpasswd().GetHtpasswd())
+		if err != nil {
+			return nil, err
+		}
+		return basicAuthInternal(basicAuth.GetRealm(), users), nil
+	}
+	return nil, BasicAuthUserSourceError
+}
+
+func basicAuthInternal(realm string, users map[string]*extauthv1.ExtAuthConfig_BasicAuthInternal_User) *extauthv1.ExtAuthConfig_Config_BasicAuthInternal {
+	return &extauthv1.ExtAuthConfig_Config_BasicAuthInternal{
+		BasicAuthInternal: &extauthv1.ExtAuthConfig_BasicAuthInternal{
+			Realm: realm,
+			Encryption: &extauthv1.ExtAuthConfig_BasicAuthInternal_EncryptionType{
+				Algorithm: &extauthv1.ExtAuthConfig_BasicAuthInternal_EncryptionType_Apr_{
+					Apr: &extauthv1.ExtAuthConfig_BasicAuthInternal_EncryptionType_Apr{},
+				},
+			},
+			UserSource: &extauthv1.ExtAuthConfig_BasicAuthInternal_UserList_{
+				UserList: &extauthv1.ExtAuthConfig_BasicAuthInternal_UserList{Users: users},
+			},
+		},
+	}
+}
+
+// parseHtpasswd reads `user:$apr1$salt$hash` entries, skipping blank lines and comments
+func parseHtpasswd(ref *core.ResourceRef, htpasswd string) (map[string]*extauthv1.ExtAuthConfig_BasicAuthInternal_User, error) {
+	users := make(map[string]*extauthv1.ExtAuthConfig_BasicAuthInternal_User)
+	for i, line := range strings.Split(htpasswd, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		username, hash, ok := strings.Cut(line, ":")
+		if !ok || username == "" || !strings.HasPrefix(hash, aprPrefix) {
+			return nil, InvalidHtpasswdEntryError(ref, i+1)
+		}
+		salt, hashedPassword, ok := strings.Cut(strings.TrimPrefix(hash, aprPrefix), "$")
+		if !ok || salt == "" || hashedPassword == "" {
+			return nil, InvalidHtpasswdEntryError(ref, i+1)
+		}
+		users[username] = &extauthv1.ExtAuthConfig_BasicAuthInternal_User{Salt: salt, HashedPassword: hashedPassword}
+	}
+	return users, nil
+}
+
+func translateApiKeyAuth(snap *gloov1snap.ApiSnapshot, apiKeyAuth *extauthv1.ApiKeyAuth) (*extauthv1.ExtAuthConfig_Config_ApiKeyAuth, error) {
+	if apiKeyAuth.GetAerospikeApikeyStorage() != nil {
+		return nil, UnsupportedApiKeyStorageError
+	}
+
+	// the storage backend takes precedence over the deprecated top level fields
+	labelSelector := apiKeyAuth.GetLabelSelector()
+	secretRefs := apiKeyAuth.GetApiKeySecretRefs()
+	if storage := apiKeyAuth.GetK8SSecretApikeyStorage(); storage != nil {
+		labelSelector = storage.GetLabelSelector()
+		secretRefs = storage.GetApiKeySecretRefs()
+	}
+
+	var secrets gloov1.SecretList
+	for _, ref := range secretRefs {
+		secret, err := findSecret(snap, ref)
+		if err != nil {
+			return nil, err
+		}
+		if secret.GetApiKey() == nil {
+			return nil, UnexpectedSecretKindError(ref, "API key")
+		}
+		secrets = append(secrets, secret)
+	}
+	if len(labelSelector) > 0 {
+		for _, secret := range snap.Secrets {
+			if secret.GetApiKey() != nil && matchesLabels(secret.GetMetadata().GetLabels(), labelSelector) && !containsSecret(secrets, secret) {
+				secrets = append(secrets, secret)
+			}
+		}
+	}
+
+	headersFromMetadata := make(map[string]string)
+	requiredMetadata := make(map[string]bool)
+	for header, entry := range apiKeyAuth.GetHeadersFromMetadata() {
+		headersFromMetadata[header] = entry.GetName()
+		requiredMetadata[entry.GetName()] = requiredMetadata[entry.GetName()] || entry.GetRequired()
+	}
+	for header, entry := range apiKeyAuth.GetHeadersFromMetadataEntry() {
+		headersFromMetadata[header] = entry.GetName()
+		requiredMetadata[entry.GetName()] = requiredMetadata[entry.GetName()] || entry.GetRequired()
+	}
+
+	validApiKeys := make(map[string]*extauthv1.ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata, len(secrets))
+	for _, secret := range secrets {
+		apiKey := secret.GetApiKey()
+		if _, ok := validApiKeys[apiKey.GetApiKey()]; ok {
+			return nil, DuplicateApiKeyError(secret)
+		}
+		for name, required := range requiredMetadata {
+			if _, ok := apiKey.GetMetadata()[name]; required && !ok {
+				return nil, MissingApiKeyMetadataError(secret, name)
+			}
+		}
+		validApiKeys[apiKey.GetApiKey()] = &extauthv1.ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata{
+			Username: secret.GetMetadata().GetName(),
+			Metadata: apiKey.GetMetadata(),
+		}
+	}
+
+	return &extauthv1.ExtAuthConfig_Config_ApiKeyAuth{
+		ApiKeyAuth: &extauthv1.ExtAuthConfig_ApiKeyAuthConfig{
+			ValidApiKeys:           validApiKeys,
+			HeaderName:             apiKeyAuth.GetHeaderName(),
+			HeadersFromKeyMetadata: headersFromMetadata,
+		},
+	}, nil
+}
+
+func translateOidc(snap *gloov1snap.ApiSnapshot, oidc *extauthv1.OidcAuthorizationCode) (*extauthv1.ExtAuthConfig_Config_Oauth2, error) {
+	switch {
+	case oidc.GetIssuerUrl() == "":
+		return nil, MissingOidcFieldError("issuerUrl")
+	case oidc.GetAppUrl() == "":
+		return nil, MissingOidcFieldError("appUrl")
+	case oidc.GetCallbackPath() == "":
+		return nil, MissingOidcFieldError("callbackPath")
+	case oidc.GetClientAuthentication().GetPrivateKeyJwt() != nil:
+		return nil, UnsupportedOidcError("private key JWT client authentication")
+	case oidc.GetSession().GetRedis() != nil:
+		return nil, UnsupportedOidcError("redis sessions")
+	}
+
+	clientSecretRef := oidc.GetClientSecretRef()
+	disableClientSecret := oidc.GetDisableClientSecret().GetValue()
+	if clientSecret := oidc.GetClientAuthentication().GetClientSecret(); clientSecret != nil {
+		clientSecretRef = clientSecret.GetClientSecretRef()
+		disableClientSecret = clientSecret.GetDisableClientSecret().GetValue()
+	}
+
+	var clientSecret string
+	if !disableClientSecret {
+		if clientSecretRef == nil {
+			return nil, MissingOidcFieldError("a client secret")
+		}
+		secret, err := findSecret(snap, clientSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		if secret.GetOauth() == nil {
+			return nil, UnexpectedSecretKindError(clientSecretRef, "oauth")
+		}
+		clientSecret = secret.GetOauth().GetClientSecret()
+	}
+
+	return &extauthv1.ExtAuthConfig_Config_Oauth2{
+		Oauth2: &extauthv1.ExtAuthConfig_OAuth2Config{
+			OauthType: &extauthv1.ExtAuthConfig_OAuth2Config_OidcAuthorizationCode{
+				OidcAuthorizationCode: &extauthv1.ExtAuthConfig_OidcAuthorizationCodeConfig{
+					ClientId:                 oidc.GetClientId(),
+					ClientSecret:             clientSecret,
+					IssuerUrl:                oidc.GetIssuerUrl(),
+					AuthEndpointQueryParams:  oidc.GetAuthEndpointQueryParams(),
+					TokenEndpointQueryParams: oidc.GetTokenEndpointQueryParams(),
+					AppUrl:                   oidc.GetAppUrl(),
+					CallbackPath:             oidc.GetCallbackPath(),
+					LogoutPath:               oidc.GetLogoutPath(),
+					AfterLogoutUrl:           oidc.GetAfterLogoutUrl(),
+					Scopes:                   oidc.GetScopes(),
+					Session:                  oidc.GetSession(),
+					Headers:                  oidc.GetHeaders(),
+				},
+			},
+		},
+	}, nil
+}
+
+func findSecret(snap *gloov1snap.ApiSnapshot, ref *core.ResourceRef) (*gloov1.Secret, error) {
+	secret, err := snap.Secrets.Find(ref.GetNamespace(), ref.GetName())
+	if err != nil {
+		return nil, SecretNotFoundError(ref)
+	}
+	return secret, nil
+}
+
+func matchesLabels(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func containsSecret(secrets gloov1.SecretList, secret *gloov1.Secret) bool {
+	for _, s := range secrets {
+		if s.GetMetadata().GetNamespace() == secret.GetMetadata().GetNamespace() && s.GetMetadata().GetName() == secret.GetMetadata().GetName() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,215 @@
+package extauth_test
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	skcore "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer/extauth"
+)
+
+var _ = Describe("TranslateAuthConfig", func() {
+
+	var (
+		snap *gloov1snap.ApiSnapshot
+	)
+
+	BeforeEach(func() {
+		snap = &gloov1snap.ApiSnapshot{}
+	})
+
+	newSecret := func(name string, labels map[string]string, kind interface{}) *gloov1.Secret {
+		secret := &gloov1.Secret{
+			Metadata: &skcore.Metadata{
+				Name:      name,
+				Namespace: defaults.GlooSystem,
+				Labels:    labels,
+			},
+		}
+		switch k := kind.(type) {
+		case *extauth.ApiKey:
+			secret.Kind = &gloov1.Secret_ApiKey{ApiKey: k}
+		case *extauth.OauthSecret:
+			secret.Kind = &gloov1.Secret_Oauth{Oauth: k}
+		case *gloov1.HtpasswdSecret:
+			secret.Kind = &gloov1.Secret_Htpasswd{Htpasswd: k}
+		}
+		snap.Secrets = append(snap.Secrets, secret)
+		return secret
+	}
+
+	translate := func(configs ...*extauth.AuthConfig_Config) (*extauth.ExtAuthConfig, error) {
+		return TranslateAuthConfig(snap, &extauth.AuthConfig{
+			Metadata: &skcore.Metadata{Name: "auth", Namespace: defaults.GlooSystem},
+			Configs:  configs,
+		})
+	}
+
+	Context("basic auth", func() {
+
+		basicAuth := func(basicAuth *extauth.BasicAuth) *extauth.AuthConfig_Config {
+			return &extauth.AuthConfig_Config{AuthConfig: &extauth.AuthConfig_Config_BasicAuth{BasicAuth: basicAuth}}
+		}
+
+		It("translates the legacy apr users", func() {
+			config, err := translate(basicAuth(&extauth.BasicAuth{
+				Realm: "gloo",
+				Apr: &extauth.BasicAuth_Apr{
+					Users: map[string]*extauth.BasicAuth_Apr_SaltedHashedPassword{
+						"user": {Salt: "TYiryv0/", HashedPassword: "8BvzLUO9IfGPGGsPnAgSu1"},
+					},
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.GetAuthConfigRefName()).To(Equal("gloo-system.auth"))
+			Expect(config.GetConfigs()[0].GetName().GetValue()).To(Equal("config_0"))
+			internal := config.GetConfigs()[0].GetBasicAuthInternal()
+			Expect(internal.GetRealm()).To(Equal("gloo"))
+			Expect(internal.GetEncryption().GetApr()).NotTo(BeNil())
+			Expect(internal.GetUserList().GetUsers()["user"].GetHashedPassword()).To(Equal("8BvzLUO9IfGPGGsPnAgSu1"))
+		})
+
+		It("rejects htpasswd entries that are not apr1 hashes", func() {
+			secret := newSecret("htpasswd", nil, &gloov1.HtpasswdSecret{
+				Htpasswd: "# users\nuser:$apr1$TYiryv0/$8BvzLUO9IfGPGGsPnAgSu1\nbcrypt:$2y$05$abcdefghijklmnopqrstuv\n",
+			})
+			_, err := translate(basicAuth(&extauth.BasicAuth{
+				UserSource: &extauth.BasicAuth_HtpasswdSecretRef{HtpasswdSecretRef: secret.GetMetadata().Ref()},
+			}))
+			Expect(err).To(MatchError(ContainSubstring(InvalidHtpasswdEntryError(secret.GetMetadata().Ref(), 3).Error())))
+		})
+
+		It("rejects sha1 encryption", func() {
+			_, err := translate(basicAuth(&extauth.BasicAuth{
+				Encryption: &extauth.BasicAuth_EncryptionType{
+					Algorithm: &extauth.BasicAuth_EncryptionType_Sha1_{Sha1: &extauth.BasicAuth_EncryptionType_Sha1{}},
+				},
+				UserSource: &extauth.BasicAuth_UserList_{UserList: &extauth.BasicAuth_UserList{}},
+			}))
+			Expect(err).To(MatchError(ContainSubstring(UnsupportedBasicAuthEncryptionError.Error())))
+		})
+	})
+
+	Context("api keys", func() {
+
+		apiKeyAuth := func(apiKeyAuth *extauth.ApiKeyAuth) *extauth.AuthConfig_Config {
+			return &extauth.AuthConfig_Config{AuthConfig: &extauth.AuthConfig_Config_ApiKeyAuth{ApiKeyAuth: apiKeyAuth}}
+		}
+
+		BeforeEach(func() {
+			newSecret("alice", map[string]string{"team": "infra"}, &extauth.ApiKey{ApiKey: "key-1", Metadata: map[string]string{"email": "alice@example.com"}})
+			newSecret("bob", map[string]string{"team": "infra"}, &extauth.ApiKey{ApiKey: "key-2"})
+			newSecret("carol", map[string]string{"team": "web"}, &extauth.ApiKey{ApiKey: "key-3"})
+		})
+
+		It("resolves the secrets matching the label selector", func() {
+			config, err := translate(apiKeyAuth(&extauth.ApiKeyAuth{
+				HeaderName: "x-api-key",
+				StorageBackend: &extauth.ApiKeyAuth_K8SSecretApikeyStorage{
+					K8SSecretApikeyStorage: &extauth.K8SSecretApiKeyStorage{LabelSelector: map[string]string{"team": "infra"}},
+				},
+				HeadersFromMetadataEntry: map[string]*extauth.ApiKeyAuth_MetadataEntry{
+					"x-user-email": {Name: "email"},
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			apiKeyConfig := config.GetConfigs()[0].GetApiKeyAuth()
+			Expect(apiKeyConfig.GetHeaderName()).To(Equal("x-api-key"))
+			Expect(apiKeyConfig.GetValidApiKeys()).To(HaveLen(2))
+			Expect(apiKeyConfig.GetValidApiKeys()["key-1"].GetUsername()).To(Equal("alice"))
+			Expect(apiKeyConfig.GetValidApiKeys()["key-1"].GetMetadata()).To(HaveKeyWithValue("email", "alice@example.com"))
+			Expect(apiKeyConfig.GetHeadersFromKeyMetadata()).To(Equal(map[string]string{"x-user-email": "email"}))
+		})
+
+		It("rejects secrets missing required metadata", func() {
+			_, err := translate(apiKeyAuth(&extauth.ApiKeyAuth{
+				StorageBackend: &extauth.ApiKeyAuth_K8SSecretApikeyStorage{
+					K8SSecretApikeyStorage: &extauth.K8SSecretApiKeyStorage{LabelSelector: map[string]string{"team": "infra"}},
+				},
+				HeadersFromMetadataEntry: map[string]*extauth.ApiKeyAuth_MetadataEntry{
+					"x-user-email": {Name: "email", Required: true},
+				},
+			}))
+			Expect(err).To(MatchError(ContainSubstring("does not define the required metadata entry email")))
+		})
+
+		It("rejects references to missing secrets", func() {
+			ref := &skcore.ResourceRef{Name: "missing", Namespace: defaults.GlooSystem}
+			_, err := translate(apiKeyAuth(&extauth.ApiKeyAuth{
+				StorageBackend: &extauth.ApiKeyAuth_K8SSecretApikeyStorage{
+					K8SSecretApikeyStorage: &extauth.K8SSecretApiKeyStorage{ApiKeySecretRefs: []*skcore.ResourceRef{ref}},
+				},
+			}))
+			Expect(err).To(MatchError(ContainSubstring(SecretNotFoundError(ref).Error())))
+		})
+	})
+
+	Context("oidc", func() {
+
+		var oidc *extauth.OidcAuthorizationCode
+
+		oidcConfig := func() *extauth.AuthConfig_Config {
+			return &extauth.AuthConfig_Config{
+				AuthConfig: &extauth.AuthConfig_Config_Oauth2{
+					Oauth2: &extauth.OAuth2{
+						OauthType: &extauth.OAuth2_OidcAuthorizationCode{OidcAuthorizationCode: oidc},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			secret := newSecret("oauth", nil, &extauth.OauthSecret{ClientSecret: "client-secret"})
+			oidc = &extauth.OidcAuthorizationCode{
+				ClientId:     "client",
+				IssuerUrl:    "http://idp.example.com/",
+				AppUrl:       "http://app.example.com",
+				CallbackPath: "/callback",
+				ClientAuthentication: &extauth.OidcAuthorizationCode_ClientAuthentication{
+					ClientAuthenticationConfig: &extauth.OidcAuthorizationCode_ClientAuthentication_ClientSecret_{
+						ClientSecret: &extauth.OidcAuthorizationCode_ClientAuthentication_ClientSecret{
+							ClientSecretRef: secret.GetMetadata().Ref(),
+						},
+					},
+				},
+			}
+		})
+
+		It("resolves the client secret", func() {
+			config, err := translate(oidcConfig())
+			Expect(err).NotTo(HaveOccurred())
+			translated := config.GetConfigs()[0].GetOauth2().GetOidcAuthorizationCode()
+			Expect(translated.GetClientSecret()).To(Equal("client-secret"))
+			Expect(translated.GetIssuerUrl()).To(Equal("http://idp.example.com/"))
+			Expect(translated.GetCallbackPath()).To(Equal("/callback"))
+		})
+
+		It("rejects redis sessions", func() {
+			oidc.Session = &extauth.UserSession{
+				Session: &extauth.UserSession_Redis{Redis: &extauth.UserSession_RedisSession{}},
+			}
+			_, err := translate(oidcConfig())
+			Expect(err).To(MatchError(ContainSubstring(UnsupportedOidcError("redis sessions").Error())))
+		})
+	})
+
+	It("validates the boolean expression against the config names", func() {
+		basic := &extauth.AuthConfig_Config{
+			Name: &wrappers.StringValue{Value: "basic"},
+			AuthConfig: &extauth.AuthConfig_Config_BasicAuth{BasicAuth: &extauth.BasicAuth{
+				Apr: &extauth.BasicAuth_Apr{},
+			}},
+		}
+		_, err := TranslateAuthConfig(snap, &extauth.AuthConfig{
+			Metadata:    &skcore.Metadata{Name: "auth", Namespace: defaults.GlooSystem},
+			Configs:     []*extauth.AuthConfig_Config{basic},
+			BooleanExpr: &wrappers.StringValue{Value: "basic || apikey"},
+		})
+		Expect(err).To(MatchError(UnknownConfigNameError("basic || apikey", "apikey")))
+	})
+})
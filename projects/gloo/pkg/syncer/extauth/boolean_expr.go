@@ -0,0 +1,182 @@
+package extauth
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/rotisserie/eris"
+)
+
+var (
+	InvalidBooleanExprError = func(expr string, reason string) error {
+		return eris.Errorf("invalid boolean expression [%s]: %s", expr, reason)
+	}
+
+	UnknownConfigNameError = func(expr string, name string) error {
+		return InvalidBooleanExprError(expr, "no auth config named "+name)
+	}
+)
+
+// BooleanExpr combines the results of the named configs in an auth config chain.
+type BooleanExpr interface {
+	// Eval evaluates the expression left to right, calling check only for the configs
+	// whose result is needed to decide the outcome.
+	Eval(check func(name string) bool) bool
+}
+
+type (
+	nameExpr string
+	notExpr  struct{ expr BooleanExpr }
+	andExpr  struct{ left, right BooleanExpr }
+	orExpr   struct{ left, right BooleanExpr }
+)
+
+func (e nameExpr) Eval(check func(string) bool) bool { return check(string(e)) }
+func (e notExpr) Eval(check func(string) bool) bool  { return !e.expr.Eval(check) }
+func (e andExpr) Eval(check func(string) bool) bool {
+	return e.left.Eval(check) && e.right.Eval(check)
+}
+func (e orExpr) Eval(check func(string) bool) bool {
+	return e.left.Eval(check) || e.right.Eval(check)
+}
+
+// AllOf is the expression used when an auth config does not define one: every config must succeed.
+func AllOf(names []string) BooleanExpr {
+	if len(names) == 0 {
+		return nil
+	}
+	var expr BooleanExpr = nameExpr(names[0])
+	for _, name := range names[1:] {
+		expr = andExpr{left: expr, right: nameExpr(name)}
+	}
+	return expr
+}
+
+// ParseBooleanExpr parses an expression such as `basic1 || (oidc1 && !apikey1)`, in which every
+// identifier must be one of the given config names.
+func ParseBooleanExpr(expr string, names []string) (BooleanExpr, error) {
+	known := make(map[string]bool, len(names))
+	for _, name := range names {
+		known[name] = true
+	}
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{expr: expr, tokens: tokens, known: known}
+	result, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, InvalidBooleanExprError(expr, "unexpected "+p.tokens[p.pos])
+	}
+	return result, nil
+}
+
+func tokenize(expr string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(expr); {
+		c := rune(expr[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(' || c == ')' || c == '!':
+			tokens = append(tokens, string(c))
+			i++
+		case strings.HasPrefix(expr[i:], "&&") || strings.HasPrefix(expr[i:], "||"):
+			tokens = append(tokens, expr[i:i+2])
+			i += 2
+		case isNameChar(c):
+			start := i
+			for i < len(expr) && isNameChar(rune(expr[i])) {
+				i++
+			}
+			tokens = append(tokens, expr[start:i])
+		default:
+			return nil, InvalidBooleanExprError(expr, "unexpected character "+string(c))
+		}
+	}
+	return tokens, nil
+}
+
+func isNameChar(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '-' || c == '.'
+}
+
+type exprParser struct {
+	expr   string
+	tokens []string
+	pos    int
+	known  map[string]bool
+}
+
+func (p *exprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *exprParser) parseOr() (BooleanExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "||" {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (BooleanExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "&&" {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (BooleanExpr, error) {
+	token := p.peek()
+	p.pos++
+	switch token {
+	case "":
+		return nil, InvalidBooleanExprError(p.expr, "unexpected end of expression")
+	case "!":
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: expr}, nil
+	case "(":
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, InvalidBooleanExprError(p.expr, "missing closing parenthesis")
+		}
+		p.pos++
+		return expr, nil
+	case ")", "&&", "||":
+		return nil, InvalidBooleanExprError(p.expr, "unexpected "+token)
+	}
+	if !p.known[token] {
+		return nil, UnknownConfigNameError(p.expr, token)
+	}
+	return nameExpr(token), nil
+}
@@ -0,0 +1,68 @@
+package extauth_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer/extauth"
+)
+
+var _ = Describe("BooleanExpr", func() {
+
+	names := []string{"basic", "apikey", "oidc-1", "oidc-2"}
+
+	eval := func(expr string, results map[string]bool) (bool, []string) {
+		parsed, err := ParseBooleanExpr(expr, names)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		var checked []string
+		return parsed.Eval(func(name string) bool {
+			checked = append(checked, name)
+			return results[name]
+		}), checked
+	}
+
+	DescribeTable("evaluates expressions",
+		func(expr string, results map[string]bool, expected bool) {
+			result, _ := eval(expr, results)
+			Expect(result).To(Equal(expected))
+		},
+		Entry("or", "basic || apikey", map[string]bool{"apikey": true}, true),
+		Entry("and", "basic && apikey", map[string]bool{"apikey": true}, false),
+		Entry("not", "!basic", map[string]bool{}, true),
+		Entry("and binds tighter than or", "basic || apikey && oidc-1", map[string]bool{"basic": true}, true),
+		Entry("parenthesis", "(basic || apikey) && oidc-1", map[string]bool{"basic": true}, false),
+		Entry("nested", "( basic || (oidc-1 && !oidc-2) )", map[string]bool{"oidc-1": true}, true),
+	)
+
+	It("short circuits", func() {
+		result, checked := eval("basic || apikey", map[string]bool{"basic": true})
+		Expect(result).To(BeTrue())
+		Expect(checked).To(Equal([]string{"basic"}))
+
+		result, checked = eval("basic && apikey", map[string]bool{})
+		Expect(result).To(BeFalse())
+		Expect(checked).To(Equal([]string{"basic"}))
+	})
+
+	It("requires every config when no expression is defined", func() {
+		var checked []string
+		result := AllOf(names).Eval(func(name string) bool {
+			checked = append(checked, name)
+			return true
+		})
+		Expect(result).To(BeTrue())
+		Expect(checked).To(Equal(names))
+	})
+
+	DescribeTable("rejects invalid expressions",
+		func(expr string, reason string) {
+			_, err := ParseBooleanExpr(expr, names)
+			Expect(err).To(MatchError(InvalidBooleanExprError(expr, reason)))
+		},
+		Entry("unknown config", "basic || ldap", "no auth config named ldap"),
+		Entry("missing operand", "basic &&", "unexpected end of expression"),
+		Entry("unbalanced parenthesis", "(basic || apikey", "missing closing parenthesis"),
+		Entry("trailing tokens", "basic apikey", "unexpected apikey"),
+		Entry("invalid character", "basic | apikey", "unexpected character |"),
+	)
+})
@@ -2,16 +2,21 @@ package extauth
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 
 	"github.com/rotisserie/eris"
 
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
 	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/go-utils/contextutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"go.uber.org/zap"
 )
 
 // Compile-time assertion
@@ -27,15 +32,21 @@ var (
 	ErrEnterpriseOnly = eris.New("The Gloo Advanced Extauth API is an enterprise-only feature, please upgrade or use the Envoy Extauth API instead")
 )
 
-// translatorSyncerExtension is the Open Source variant of the Enterprise translatorSyncerExtension for ExtAuth
-// TODO (sam-heilbron)
-//
-//	This placeholder is solely used to detect Enterprise features being used in an Open Source installation
-//	Once https://github.com/solo-io/gloo/issues/6495 is implemented, we should be able to remove this placeholder altogether
-type translatorSyncerExtension struct{}
+// translatorSyncerExtension is the Open Source variant of the Enterprise translatorSyncerExtension for ExtAuth.
+// It serves the subset of AuthConfig features implemented by the open source extauth server, and reports
+// errors for the features that remain enterprise-only.
+type translatorSyncerExtension struct {
+	hasher func(resources []envoycache.Resource) (uint64, error)
+}
 
-func NewTranslatorSyncerExtension(_ context.Context, _ syncer.TranslatorSyncerExtensionParams) syncer.TranslatorSyncerExtension {
-	return &translatorSyncerExtension{}
+func NewTranslatorSyncerExtension(_ context.Context, params syncer.TranslatorSyncerExtensionParams) syncer.TranslatorSyncerExtension {
+	hasher := params.Hasher
+	if hasher == nil {
+		hasher = translator.EnvoyCacheResourcesListToFnvHash
+	}
+	return &translatorSyncerExtension{
+		hasher: hasher,
+	}
 }
 
 func (s *translatorSyncerExtension) ID() string {
@@ -46,7 +57,7 @@ func (s *translatorSyncerExtension) Sync(
 	ctx context.Context,
 	snap *gloov1snap.ApiSnapshot,
 	settings *gloov1.Settings,
-	_ syncer.SnapshotSetter,
+	snapshotSetter syncer.SnapshotSetter,
 	reports reporter.ResourceReports,
 ) {
 	ctx = contextutils.WithLogger(ctx, "extAuthTranslatorSyncer")
@@ -63,8 +74,15 @@ func (s *translatorSyncerExtension) Sync(
 
 	reports.Accept(snap.Proxies.AsInputResources()...)
 
+	var resources []envoycache.Resource
 	for _, ac := range snap.AuthConfigs {
-		reports.AddError(ac, getEnterpriseOnlyErr())
+		config, err := TranslateAuthConfig(snap, ac)
+		if err != nil {
+			reports.AddError(ac, err)
+			continue
+		}
+		reports.Accept(ac)
+		resources = append(resources, extauthv1.NewExtAuthConfigXdsResourceWrapper(config))
 	}
 
 	for _, proxy := range snap.Proxies {
@@ -72,28 +90,17 @@ func (s *translatorSyncerExtension) Sync(
 			virtualHosts := utils.GetVirtualHostsForListener(listener)
 
 			for _, virtualHost := range virtualHosts {
-				if virtualHost.GetOptions().GetExtauth().GetConfigRef() != nil {
-					reports.AddError(proxy, getEnterpriseOnlyErr())
-				}
-
+				// named custom auth servers are configured through Settings.NamedExtauth, which is enterprise-only
 				if virtualHost.GetOptions().GetExtauth().GetCustomAuth().GetName() != "" {
 					reports.AddError(proxy, getEnterpriseOnlyErr())
 				}
 
 				for _, route := range virtualHost.GetRoutes() {
-					if route.GetOptions().GetExtauth().GetConfigRef() != nil {
-						reports.AddError(proxy, getEnterpriseOnlyErr())
-					}
-
 					if route.GetOptions().GetExtauth().GetCustomAuth().GetName() != "" {
 						reports.AddError(proxy, getEnterpriseOnlyErr())
 					}
 
 					for _, weightedDestination := range route.GetRouteAction().GetMulti().GetDestinations() {
-						if weightedDestination.GetOptions().GetExtauth().GetConfigRef() != nil {
-							reports.AddError(proxy, getEnterpriseOnlyErr())
-						}
-
 						if weightedDestination.GetOptions().GetExtauth().GetCustomAuth().GetName() != "" {
 							reports.AddError(proxy, getEnterpriseOnlyErr())
 						}
@@ -103,4 +110,11 @@ func (s *translatorSyncerExtension) Sync(
 			}
 		}
 	}
+
+	h, err := s.hasher(resources)
+	if err != nil {
+		logger.DPanicw("error trying to hash extauth configs", zap.Error(err))
+		return
+	}
+	snapshotSetter.SetSnapshot(ServerRole, envoycache.NewEasyGenericSnapshot(fmt.Sprintf("%d", h), resources))
 }
@@ -59,89 +59,83 @@ var _ = Describe("ExtauthTranslatorSyncer", func() {
 		ExpectWithOffset(1, err).ToNot(HaveOccurred())
 	}
 
-	Context("Listener contains ExtAuthExtension.ConfigRef", func() {
+	Context("AuthConfigs", func() {
 
 		var (
-			authConfig       *extauth.AuthConfig
-			extAuthExtension *extauth.ExtAuthExtension
+			authConfig *extauth.AuthConfig
+			xdsCache   *syncer.MockXdsCache
 		)
 
 		BeforeEach(func() {
+			secret := &gloov1.Secret{
+				Metadata: &skcore.Metadata{
+					Name:      "htpasswd",
+					Namespace: defaults.GlooSystem,
+				},
+				Kind: &gloov1.Secret_Htpasswd{
+					Htpasswd: &gloov1.HtpasswdSecret{Htpasswd: "user:$apr1$TYiryv0/$8BvzLUO9IfGPGGsPnAgSu1\n"},
+				},
+			}
 			authConfig = &extauth.AuthConfig{
 				Metadata: &skcore.Metadata{
 					Name:      "auth",
 					Namespace: defaults.GlooSystem,
 				},
 				Configs: []*extauth.AuthConfig_Config{{
-					AuthConfig: &extauth.AuthConfig_Config_Oauth{},
+					AuthConfig: &extauth.AuthConfig_Config_BasicAuth{
+						BasicAuth: &extauth.BasicAuth{
+							Realm: "gloo",
+							UserSource: &extauth.BasicAuth_HtpasswdSecretRef{
+								HtpasswdSecretRef: secret.GetMetadata().Ref(),
+							},
+						},
+					},
 				}},
 			}
+			xdsCache = &syncer.MockXdsCache{}
 
-			extAuthExtension = &extauth.ExtAuthExtension{
+			proxy := getProxyWithVirtualHostExtAuthExtension(&extauth.ExtAuthExtension{
 				Spec: &extauth.ExtAuthExtension_ConfigRef{
-					ConfigRef: authConfig.Metadata.Ref(),
+					ConfigRef: authConfig.GetMetadata().Ref(),
 				},
-			}
-		})
-
-		When("defined on VirtualHost", func() {
-
-			BeforeEach(func() {
-				proxy := getProxyWithVirtualHostExtAuthExtension(extAuthExtension)
-				apiSnapshot = &gloov1snap.ApiSnapshot{
-					Proxies:     gloov1.ProxyList{proxy},
-					AuthConfigs: extauth.AuthConfigList{authConfig},
-				}
-			})
-
-			It("should error", func() {
-				ExpectSyncGeneratesEnterpriseOnlyError()
-			})
-		})
-
-		When("defined on VirtualHost in HybridListener", func() {
-
-			BeforeEach(func() {
-				proxy := getProxyWithHybridListenerVirtualHostExtAuthExtension(extAuthExtension)
-				apiSnapshot = &gloov1snap.ApiSnapshot{
-					Proxies:     gloov1.ProxyList{proxy},
-					AuthConfigs: extauth.AuthConfigList{authConfig},
-				}
-			})
-
-			It("should error", func() {
-				ExpectSyncGeneratesEnterpriseOnlyError()
 			})
+			apiSnapshot = &gloov1snap.ApiSnapshot{
+				Proxies:     gloov1.ProxyList{proxy},
+				AuthConfigs: extauth.AuthConfigList{authConfig},
+				Secrets:     gloov1.SecretList{secret},
+			}
 		})
 
-		When("defined on Route", func() {
-
-			BeforeEach(func() {
-				proxy := getProxyWithRouteExtAuthExtension(extAuthExtension)
-				apiSnapshot = &gloov1snap.ApiSnapshot{
-					Proxies:     gloov1.ProxyList{proxy},
-					AuthConfigs: extauth.AuthConfigList{authConfig},
-				}
-			})
-
-			It("should error", func() {
-				ExpectSyncGeneratesEnterpriseOnlyError()
-			})
+		sync := func() reporter.ResourceReports {
+			reports := make(reporter.ResourceReports)
+			translator.Sync(ctx, apiSnapshot, settings, xdsCache, reports)
+			ExpectWithOffset(1, xdsCache.Called).To(BeTrue())
+			return reports
+		}
+
+		It("serves supported AuthConfigs to the extauth server", func() {
+			reports := sync()
+			Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+			resources := xdsCache.SetSnap.GetResources(extauth.ExtAuthConfigType)
+			configId := ConfigId(defaults.GlooSystem, "auth")
+			Expect(resources.Items).To(HaveKey(configId))
+			config := resources.Items[configId].ResourceProto().(*extauth.ExtAuthConfig)
+			user := config.GetConfigs()[0].GetBasicAuthInternal().GetUserList().GetUsers()["user"]
+			Expect(user.GetSalt()).To(Equal("TYiryv0/"))
+			Expect(user.GetHashedPassword()).To(Equal("8BvzLUO9IfGPGGsPnAgSu1"))
 		})
 
-		When("defined on WeightedDestination", func() {
-
-			BeforeEach(func() {
-				proxy := getProxyWithWeightedDestinationAuthExtension(extAuthExtension)
-				apiSnapshot = &gloov1snap.ApiSnapshot{
-					Proxies:     gloov1.ProxyList{proxy},
-					AuthConfigs: extauth.AuthConfigList{authConfig},
-				}
-			})
+		It("rejects AuthConfigs using auth types the extauth server does not support", func() {
+			authConfig.Configs = []*extauth.AuthConfig_Config{{
+				AuthConfig: &extauth.AuthConfig_Config_OpaAuth{OpaAuth: &extauth.OpaAuth{}},
+			}}
+			reports := sync()
 
-			It("should error", func() {
-				ExpectSyncGeneratesEnterpriseOnlyError()
-			})
+			err := reports.ValidateStrict()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(UnsupportedAuthConfigError("config_0").Error()))
+			Expect(xdsCache.SetSnap.GetResources(extauth.ExtAuthConfigType).Items).To(BeEmpty())
 		})
 
 	})
@@ -176,6 +170,20 @@ var _ = Describe("ExtauthTranslatorSyncer", func() {
 			})
 		})
 
+		When("defined on VirtualHost in HybridListener", func() {
+
+			BeforeEach(func() {
+				proxy := getProxyWithHybridListenerVirtualHostExtAuthExtension(extAuthExtension)
+				apiSnapshot = &gloov1snap.ApiSnapshot{
+					Proxies: gloov1.ProxyList{proxy},
+				}
+			})
+
+			It("should error", func() {
+				ExpectSyncGeneratesEnterpriseOnlyError()
+			})
+		})
+
 		When("defined on Route", func() {
 
 			BeforeEach(func() {
@@ -11,9 +11,16 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	rlplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/go-utils/contextutils"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"go.uber.org/zap"
 )
 
 // Compile-time assertion
@@ -25,15 +32,33 @@ const (
 	ServerRole = "ratelimit"
 )
 
-// translatorSyncerExtension is the Open Source variant of the Enterprise translatorSyncerExtension for RateLimit
-// TODO (sam-heilbron)
-//
-//	This placeholder is solely used to detect Enterprise features being used in an Open Source installation
-//	Once https://github.com/solo-io/gloo/issues/6495 is implemented, we should be able to remove this placeholder altogether
-type translatorSyncerExtension struct{}
+var (
+	MissingDescriptorKeyError = eris.New("rate limit descriptors must define a key")
+
+	MissingSetDescriptorRateLimitError = eris.New("rate limit set descriptors must define a rate limit with a unit")
+
+	InvalidRateLimitUnitError = func(key string) error {
+		return eris.Errorf("rate limit for descriptor %s must define a unit", key)
+	}
+)
 
-func NewTranslatorSyncerExtension(_ context.Context, _ syncer.TranslatorSyncerExtensionParams) syncer.TranslatorSyncerExtension {
-	return &translatorSyncerExtension{}
+// translatorSyncerExtension is the Open Source variant of the Enterprise translatorSyncerExtension for RateLimit.
+// It serves the descriptors defined in Settings and in RateLimitConfig resources to the open source
+// rate limit server, and reports errors for the advanced features that remain enterprise-only.
+type translatorSyncerExtension struct {
+	hasher          func(resources []envoycache.Resource) (uint64, error)
+	serviceSettings *ratelimit.ServiceSettings
+}
+
+func NewTranslatorSyncerExtension(_ context.Context, params syncer.TranslatorSyncerExtensionParams) syncer.TranslatorSyncerExtension {
+	hasher := params.Hasher
+	if hasher == nil {
+		hasher = translator.EnvoyCacheResourcesListToFnvHash
+	}
+	return &translatorSyncerExtension{
+		hasher:          hasher,
+		serviceSettings: params.RateLimitServiceSettings,
+	}
 }
 
 func (s *translatorSyncerExtension) ID() string {
@@ -43,8 +68,8 @@ func (s *translatorSyncerExtension) ID() string {
 func (s *translatorSyncerExtension) Sync(
 	ctx context.Context,
 	snap *gloov1snap.ApiSnapshot,
-	_ *gloov1.Settings,
-	_ syncer.SnapshotSetter,
+	settings *gloov1.Settings,
+	snapshotSetter syncer.SnapshotSetter,
 	reports reporter.ResourceReports,
 ) {
 	ctx = contextutils.WithLogger(ctx, "rateLimitTranslatorSyncer")
@@ -58,8 +83,28 @@ func (s *translatorSyncerExtension) Sync(
 
 	reports.Accept(snap.Proxies.AsInputResources()...)
 
+	serviceSettings := settings.GetRatelimit()
+	if serviceSettings == nil {
+		serviceSettings = s.serviceSettings
+	}
+	config := &enterprise.RateLimitConfig{
+		Domain:         rlplugin.CustomDomain,
+		Descriptors:    serviceSettings.GetDescriptors(),
+		SetDescriptors: serviceSettings.GetSetDescriptors(),
+	}
+
 	for _, rlc := range snap.Ratelimitconfigs {
-		reports.AddError(rlc, enterpriseOnlyError("RateLimitConfig"))
+		descriptors, setDescriptors, err := rlplugin.ConfigDescriptors(rlc)
+		if err == nil {
+			err = validateConfig(descriptors, setDescriptors)
+		}
+		if err != nil {
+			reports.AddError(rlc, err)
+			continue
+		}
+		reports.Accept(rlc)
+		config.Descriptors = append(config.GetDescriptors(), descriptors...)
+		config.SetDescriptors = append(config.GetSetDescriptors(), setDescriptors...)
 	}
 
 	for _, proxy := range snap.Proxies {
@@ -68,24 +113,11 @@ func (s *translatorSyncerExtension) Sync(
 
 			for _, virtualHost := range virtualHosts {
 
-				// RateLimitConfigs is an enterprise feature https://docs.solo.io/gloo-edge/latest/guides/security/rate_limiting/crds/
-				if virtualHost.GetOptions().GetRateLimitConfigs() != nil {
-					reports.AddError(proxy, enterpriseOnlyError("RateLimitConfig"))
-				}
-
 				// ratelimitBasic is an enterprise feature https://docs.solo.io/gloo-edge/latest/guides/security/rate_limiting/simple/
 				if virtualHost.GetOptions().GetRatelimitBasic() != nil {
 					reports.AddError(proxy, enterpriseOnlyError("ratelimitBasic"))
 				}
 
-				// check setActions on vhost
-				rlactionsVhost := virtualHost.GetOptions().GetRatelimit().GetRateLimits()
-				for _, rlaction := range rlactionsVhost {
-					if rlaction.GetSetActions() != nil {
-						reports.AddError(proxy, enterpriseOnlyError("setActions"))
-					}
-				}
-
 				// Staged RateLimiting is an enterprise feature
 				if virtualHost.GetOptions().GetRateLimitEarlyConfigType() != nil {
 					reports.AddError(proxy, enterpriseOnlyError("RateLimitEarly"))
@@ -95,22 +127,10 @@ func (s *translatorSyncerExtension) Sync(
 				}
 
 				for _, route := range virtualHost.GetRoutes() {
-					if route.GetOptions().GetRateLimitConfigs() != nil {
-						reports.AddError(proxy, enterpriseOnlyError("RateLimitConfig"))
-					}
-
 					if route.GetOptions().GetRatelimitBasic() != nil {
 						reports.AddError(proxy, enterpriseOnlyError("ratelimitBasic"))
 					}
 
-					// check setActions on route
-					rlactionsRoute := route.GetOptions().GetRatelimit().GetRateLimits()
-					for _, rlaction := range rlactionsRoute {
-						if rlaction.GetSetActions() != nil {
-							reports.AddError(proxy, enterpriseOnlyError("setActions"))
-						}
-					}
-
 					// Staged RateLimiting is an enterprise feature
 					if route.GetOptions().GetRateLimitEarlyConfigType() != nil {
 						reports.AddError(proxy, enterpriseOnlyError("RateLimitEarly"))
@@ -122,6 +142,48 @@ func (s *translatorSyncerExtension) Sync(
 			}
 		}
 	}
+
+	resources := []envoycache.Resource{enterprise.NewRateLimitConfigXdsResourceWrapper(config)}
+	h, err := s.hasher(resources)
+	if err != nil {
+		logger.DPanicw("error trying to hash rate limit config", zap.Error(err))
+		return
+	}
+	snapshotSetter.SetSnapshot(ServerRole, envoycache.NewEasyGenericSnapshot(fmt.Sprintf("%d", h), resources))
+}
+
+// validateConfig checks the fields the rate limit server relies on, so that a bad RateLimitConfig
+// is rejected rather than silently ignored by the server
+func validateConfig(descriptors []*solo_rl.Descriptor, setDescriptors []*solo_rl.SetDescriptor) error {
+	var validate func(descriptors []*solo_rl.Descriptor) error
+	validate = func(descriptors []*solo_rl.Descriptor) error {
+		for _, descriptor := range descriptors {
+			if descriptor.GetKey() == "" {
+				return MissingDescriptorKeyError
+			}
+			if rl := descriptor.GetRateLimit(); rl != nil && rl.GetUnit() == solo_rl.RateLimit_UNKNOWN {
+				return InvalidRateLimitUnitError(descriptor.GetKey())
+			}
+			if err := validate(descriptor.GetDescriptors()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := validate(descriptors); err != nil {
+		return err
+	}
+	for _, setDescriptor := range setDescriptors {
+		for _, simpleDescriptor := range setDescriptor.GetSimpleDescriptors() {
+			if simpleDescriptor.GetKey() == "" {
+				return MissingDescriptorKeyError
+			}
+		}
+		if rl := setDescriptor.GetRateLimit(); rl == nil || rl.GetUnit() == solo_rl.RateLimit_UNKNOWN {
+			return MissingSetDescriptorRateLimitError
+		}
+	}
+	return nil
 }
 
 func createErrorMsg(feature string) string {
@@ -8,15 +8,20 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/rotisserie/eris"
+	rlapi "github.com/solo-io/gloo/projects/gloo/api/external/solo/ratelimit"
+	rlexternal "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	rlplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/testutils"
 	"github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	skcore "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer/ratelimit"
 )
@@ -178,85 +183,90 @@ var _ = Describe("RatelimitTranslatorSyncer", func() {
 
 		Context("config RateLimitConfig", func() {
 
-			BeforeEach(func() {
-				config := &ratelimit.RateLimitConfigRef{
-					Name:      "foo",
-					Namespace: "gloo-system",
-				}
+			var (
+				rlc      *rlexternal.RateLimitConfig
+				xdsCache *syncer.MockXdsCache
+			)
 
-				route := &gloov1.Route{
-					Options: &gloov1.RouteOptions{
-						RateLimitConfigType: &gloov1.RouteOptions_RateLimitConfigs{
-							RateLimitConfigs: &ratelimit.RateLimitConfigRefs{
-								Refs: []*ratelimit.RateLimitConfigRef{
-									config,
+			BeforeEach(func() {
+				rlc = &rlexternal.RateLimitConfig{
+					RateLimitConfig: rlapi.RateLimitConfig{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "foo",
+							Namespace: "gloo-system",
+						},
+						Spec: v1alpha1.RateLimitConfigSpec{
+							ConfigType: &v1alpha1.RateLimitConfigSpec_Raw_{
+								Raw: &v1alpha1.RateLimitConfigSpec_Raw{
+									Descriptors: []*v1alpha1.Descriptor{{
+										Key:   "generic_key",
+										Value: "counter",
+										RateLimit: &v1alpha1.RateLimit{
+											Unit:            v1alpha1.RateLimit_MINUTE,
+											RequestsPerUnit: 1,
+										},
+									}},
 								},
 							},
 						},
 					},
 				}
+				xdsCache = &syncer.MockXdsCache{}
 
 				proxy = &gloov1.Proxy{
 					Metadata: &skcore.Metadata{
 						Name:      "proxy",
 						Namespace: "gloo-system",
 					},
-					Listeners: []*gloov1.Listener{{
-						Name: "listener-::-8080",
-						ListenerType: &gloov1.Listener_HttpListener{
-							HttpListener: &gloov1.HttpListener{
-								VirtualHosts: []*gloov1.VirtualHost{
-									{
-										Routes: []*gloov1.Route{route},
-									},
-								},
-							},
-						},
-					}},
 				}
 			})
 
-			It("should error when enterprise RateLimitConfig config is set", func() {
-				ExpectSyncGeneratesEnterpriseOnlyError("RateLimitConfig")
-			})
-		})
+			sync := func(settings *gloov1.Settings) reporter.ResourceReports {
+				apiSnapshot := &gloov1snap.ApiSnapshot{
+					Proxies:          []*gloov1.Proxy{proxy},
+					Ratelimitconfigs: rlexternal.RateLimitConfigList{rlc},
+				}
+				reports := make(reporter.ResourceReports)
+				translator.Sync(ctx, apiSnapshot, settings, xdsCache, reports)
+				return reports
+			}
 
-		Context("config setActions", func() {
+			servedConfig := func() *enterprise.RateLimitConfig {
+				ExpectWithOffset(1, xdsCache.Called).To(BeTrue())
+				resources := xdsCache.SetSnap.GetResources(enterprise.RateLimitConfigType)
+				ExpectWithOffset(1, resources.Items).To(HaveKey(rlplugin.CustomDomain))
+				return resources.Items[rlplugin.CustomDomain].ResourceProto().(*enterprise.RateLimitConfig)
+			}
 
-			BeforeEach(func() {
-				proxy = &gloov1.Proxy{
-					Metadata: &skcore.Metadata{
-						Name:      "proxy",
-						Namespace: "gloo-system",
+			It("serves the config descriptors alongside the settings descriptors", func() {
+				settingsDescriptor := &v1alpha1.Descriptor{
+					Key: "remote_address",
+					RateLimit: &v1alpha1.RateLimit{
+						Unit:            v1alpha1.RateLimit_SECOND,
+						RequestsPerUnit: 5,
 					},
-					Listeners: []*gloov1.Listener{{
-						Name: "listener-::-8080",
-						ListenerType: &gloov1.Listener_HttpListener{
-							HttpListener: &gloov1.HttpListener{
-								VirtualHosts: []*gloov1.VirtualHost{
-									{
-										Name: "gloo-system.default",
-										Options: &gloov1.VirtualHostOptions{
-											RateLimitConfigType: &gloov1.VirtualHostOptions_Ratelimit{
-												Ratelimit: &ratelimit.RateLimitVhostExtension{
-													RateLimits: []*v1alpha1.RateLimitActions{
-														{
-															SetActions: []*v1alpha1.Action{},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					}},
 				}
+				reports := sync(&gloov1.Settings{
+					Ratelimit: &ratelimit.ServiceSettings{
+						Descriptors: []*v1alpha1.Descriptor{settingsDescriptor},
+					},
+				})
+				Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+				config := servedConfig()
+				Expect(config.GetDescriptors()).To(HaveLen(2))
+				Expect(config.GetDescriptors()[0]).To(Equal(settingsDescriptor))
+				Expect(config.GetDescriptors()[1].GetValue()).To(Equal(rlplugin.ConfigKey("gloo-system", "foo")))
 			})
 
-			It("should error when enterprise setActions config is set", func() {
-				ExpectSyncGeneratesEnterpriseOnlyError("setActions")
+			It("rejects a config with an invalid rate limit", func() {
+				rlc.Spec.GetRaw().GetDescriptors()[0].GetRateLimit().Unit = v1alpha1.RateLimit_UNKNOWN
+				reports := sync(&gloov1.Settings{})
+
+				err := reports.ValidateStrict()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("rate limit for descriptor generic_key must define a unit"))
+				Expect(servedConfig().GetDescriptors()).To(BeEmpty())
 			})
 		})
 
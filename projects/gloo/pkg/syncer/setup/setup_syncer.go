@@ -47,6 +47,8 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gcp"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gcp/tokenserver"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer"
 	extauthExt "github.com/solo-io/gloo/projects/gloo/pkg/syncer/extauth"
@@ -423,6 +425,8 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 	opts.ControlPlane = s.controlPlane
 	opts.ValidationServer = s.validationServer
 	opts.ProxyDebugServer = s.proxyDebugServer
+	// served on the REST xDS server, from which Envoy fetches the ID tokens for GCP upstreams
+	opts.GcpTokenServer = tokenserver.NewServer(ctx)
 	// if nil, kube plugin disabled
 	opts.KubeClient = clientset
 	opts.DevMode = settings.GetDevMode()
@@ -903,6 +907,9 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	if opts.GatewayControllerEnabled {
 		syncers = append(syncers, gwValidationSyncer)
 	}
+	if opts.GcpTokenServer != nil {
+		syncers = append(syncers, gcp.NewTokenServerSyncer(opts.GcpTokenServer, opts.Settings))
+	}
 	apiEventLoop := v1snap.NewApiEventLoop(apiCache, syncers)
 	apiEventLoopErrs, err := apiEventLoop.Run(opts.WatchNamespaces, watchOpts)
 	if err != nil {
@@ -930,6 +937,14 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 		startFuncs["k8s-gateway-controller"] = K8sGatewayControllerStartFunc(proxyClient, authConfigClient)
 	}
 
+	if opts.GatewayControllerEnabled && opts.Settings.GetGateway().GetCanary().GetEnabled().GetValue() {
+		startFuncs["canary-controller"] = CanaryControllerStartFunc(upstreamGroupClient)
+	}
+
+	if opts.Settings.GetConsulDiscovery().GetConnect() != nil && opts.Consul.ConsulWatcher != nil {
+		startFuncs["consul-connect-certificates"] = ConsulConnectCertificatesStartFunc(secretClient)
+	}
+
 	validationMustStart := os.Getenv("VALIDATION_MUST_START")
 	// only starting validation server if the env var is true or empty (previously, it always started, so this avoids causing unwanted changes for users)
 	if validationMustStart == "true" || validationMustStart == "" {
@@ -1046,9 +1061,14 @@ func startRestXdsServer(opts bootstrap.Opts) {
 	if restXdsAddr == "" {
 		restXdsAddr = DefaultRestXdsBindAddr
 	}
+	mux := http.NewServeMux()
+	mux.Handle("/", restClient)
+	if opts.GcpTokenServer != nil {
+		mux.Handle(tokenserver.IdentityPath, opts.GcpTokenServer)
+	}
 	srv := &http.Server{
 		Addr:    restXdsAddr,
-		Handler: restClient,
+		Handler: mux,
 	}
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -1208,6 +1228,11 @@ func constructOpts(ctx context.Context, params constructOptsParams) (bootstrap.O
 		return bootstrap.Opts{}, err
 	}
 
+	canaryFactory, err := bootstrap_clients.ConfigFactoryForSettings(factoryParams, gateway.CanaryCrd)
+	if err != nil {
+		return bootstrap.Opts{}, err
+	}
+
 	var validation *gwtranslator.ValidationOpts
 	validationCfg := params.settings.GetGateway().GetValidation()
 
@@ -1286,6 +1311,7 @@ func constructOpts(ctx context.Context, params constructOptsParams) (bootstrap.O
 		Gateways:                     gatewayFactory,
 		MatchableHttpGateways:        matchableHttpGatewayFactory,
 		MatchableTcpGateways:         matchableTcpGatewayFactory,
+		Canaries:                     canaryFactory,
 		KubeCoreCache:                kubeCoreCache,
 		ValidationOpts:               validation,
 		ReadGatwaysFromAllNamespaces: readGatewaysFromAllNamespaces,
@@ -2,6 +2,8 @@ package setup
 
 import (
 	"context"
+	"net/http"
+	"time"
 
 	api "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
 
@@ -12,10 +14,12 @@ import (
 
 	"github.com/solo-io/gloo/pkg/utils/statusutils"
 	gateway "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/canary"
 	"github.com/solo-io/gloo/projects/gateway2/controller"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/debug"
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 )
 
 // StartFunc represents a function that will be called with the initialized bootstrap.Opts
@@ -83,3 +87,40 @@ func K8sGatewayControllerStartFunc(proxyClient v1.ProxyClient, authConfigClient
 		})
 	}
 }
+
+// ConsulConnectCertificatesStartFunc returns a StartFunc to keep Gloo's Consul Connect certificates up to date
+func ConsulConnectCertificatesStartFunc(secretClient v1.SecretClient) StartFunc {
+	return func(ctx context.Context, opts bootstrap.Opts, extensions Extensions) error {
+		return consulplugin.SyncConnectCertificates(ctx, opts.Consul.ConsulWatcher, secretClient, opts.Settings)
+	}
+}
+
+// CanaryControllerStartFunc returns a StartFunc to run the controller shifting UpstreamGroup weights for Canary resources
+func CanaryControllerStartFunc(upstreamGroupClient v1.UpstreamGroupClient) StartFunc {
+	return func(ctx context.Context, opts bootstrap.Opts, extensions Extensions) error {
+		canaryClient, err := gateway.NewCanaryClient(ctx, opts.Canaries)
+		if err != nil {
+			return err
+		}
+		if err := canaryClient.Register(); err != nil {
+			return err
+		}
+
+		canaryOpts := opts.Settings.GetGateway().GetCanary()
+		events := canary.NewLoggingEventRecorder()
+		if opts.KubeClient != nil {
+			events = canary.NewKubeEventRecorder(opts.KubeClient, "canary-controller")
+		}
+		controller := canary.NewController(canary.Opts{
+			WatchNamespaces:         opts.WatchNamespaces,
+			StatusReporterNamespace: opts.StatusReporterNamespace,
+			SyncPeriod:              canaryOpts.GetSyncPeriod().AsDuration(),
+			Canaries:                canaryClient,
+			UpstreamGroups:          upstreamGroupClient,
+			Metrics:                 canary.NewMetricsProvider(canaryOpts, &http.Client{Timeout: 30 * time.Second}),
+			Events:                  events,
+			Identity:                opts.Identity,
+		})
+		return controller.Run(ctx)
+	}
+}
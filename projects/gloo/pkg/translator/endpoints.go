@@ -1,6 +1,8 @@
 package translator
 
 import (
+	"sort"
+
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	structpb "github.com/golang/protobuf/ptypes/struct"
@@ -54,6 +56,7 @@ func loadAssignmentForUpstream(
 ) *envoy_config_endpoint_v3.ClusterLoadAssignment {
 	clusterName := UpstreamToClusterName(upstream.GetMetadata().Ref())
 	var endpoints []*envoy_config_endpoint_v3.LbEndpoint
+	var localities []*v1.Locality
 	for _, addr := range clusterEndpoints {
 		// Get the metadata labels and filter metadata for the envoy load balancer based on the upstream
 		metadata := getLbMetadata(upstream, addr.GetMetadata().GetLabels(), "")
@@ -69,6 +72,8 @@ func loadAssignmentForUpstream(
 		}
 		lbEndpoint := envoy_config_endpoint_v3.LbEndpoint{
 			Metadata: metadata,
+			// the gloo health statuses have the same values as the envoy ones
+			HealthStatus: envoy_config_core_v3.HealthStatus(addr.GetHealthStatus()),
 			HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
 				Endpoint: &envoy_config_endpoint_v3.Endpoint{
 					Address: &envoy_config_core_v3.Address{
@@ -88,14 +93,95 @@ func loadAssignmentForUpstream(
 			},
 		}
 		endpoints = append(endpoints, &lbEndpoint)
+		localities = append(localities, addr.GetLocality())
 	}
 
 	return &envoy_config_endpoint_v3.ClusterLoadAssignment{
 		ClusterName: clusterName,
-		Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
-			LbEndpoints: endpoints,
-		}},
+		Endpoints:   groupEndpointsByLocality(endpoints, localities, upstream.GetLoadBalancerConfig().GetLocalityFailover()),
+	}
+}
+
+// groupEndpointsByLocality groups the endpoints by their locality, sorted by locality for idempotency.
+// With locality failover, each group is given a priority by its distance to the failover locality.
+func groupEndpointsByLocality(
+	endpoints []*envoy_config_endpoint_v3.LbEndpoint,
+	localities []*v1.Locality,
+	failover *v1.LoadBalancerConfig_LocalityFailover,
+) []*envoy_config_endpoint_v3.LocalityLbEndpoints {
+	var groups []*envoy_config_endpoint_v3.LocalityLbEndpoints
+	groupsByLocality := make(map[string]*envoy_config_endpoint_v3.LocalityLbEndpoints)
+	for i, endpoint := range endpoints {
+		locality := localities[i]
+		key := localityKey(locality)
+		group, ok := groupsByLocality[key]
+		if !ok {
+			group = &envoy_config_endpoint_v3.LocalityLbEndpoints{}
+			if locality != nil {
+				group.Locality = &envoy_config_core_v3.Locality{
+					Region:  locality.GetRegion(),
+					Zone:    locality.GetZone(),
+					SubZone: locality.GetSubZone(),
+				}
+			}
+			groupsByLocality[key] = group
+			groups = append(groups, group)
+		}
+		group.LbEndpoints = append(group.GetLbEndpoints(), endpoint)
+	}
+
+	// keep the single group without locality of endpoints which have none
+	if len(groups) == 1 && groups[0].GetLocality() == nil {
+		return groups
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return localityKey(groups[i].GetLocality()) < localityKey(groups[j].GetLocality())
+	})
+
+	if failover.GetLocality() != nil {
+		setFailoverPriorities(groups, failover.GetLocality())
 	}
+	return groups
+}
+
+// setFailoverPriorities prefers the endpoints in the zone of the given locality, then the endpoints
+// in its region, then all others. Envoy requires the priorities to be contiguous, so unused ones are skipped.
+func setFailoverPriorities(groups []*envoy_config_endpoint_v3.LocalityLbEndpoints, locality *v1.Locality) {
+	distances := make([]uint32, len(groups))
+	used := make(map[uint32]bool)
+	for i, group := range groups {
+		switch {
+		case group.GetLocality().GetRegion() == locality.GetRegion() && group.GetLocality().GetZone() == locality.GetZone():
+			distances[i] = 0
+		case group.GetLocality().GetRegion() == locality.GetRegion():
+			distances[i] = 1
+		default:
+			distances[i] = 2
+		}
+		used[distances[i]] = true
+	}
+
+	for i, group := range groups {
+		var priority uint32
+		for distance := uint32(0); distance < distances[i]; distance++ {
+			if used[distance] {
+				priority++
+			}
+		}
+		group.Priority = priority
+	}
+}
+
+type localityGetter interface {
+	GetRegion() string
+	GetZone() string
+	GetSubZone() string
+}
+
+// localityKey identifies a locality; endpoints without locality share the key of the empty locality
+func localityKey(locality localityGetter) string {
+	return locality.GetRegion() + "/" + locality.GetZone() + "/" + locality.GetSubZone()
 }
 
 func createUpstreamToEndpointsMap(upstreams []*v1.Upstream, endpoints []*v1.Endpoint) map[string][]*v1.Endpoint {
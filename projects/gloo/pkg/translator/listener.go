@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"reflect"
 
+	xds_core_v3 "github.com/cncf/xds/go/xds/core/v3"
+	xds_matcher_v3 "github.com/cncf/xds/go/xds/type/matcher/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyudp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
 	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/go-utils/contextutils"
 )
@@ -21,6 +26,10 @@ type ListenerTranslator interface {
 
 var _ ListenerTranslator = new(emptyListenerTranslator)
 var _ ListenerTranslator = new(listenerTranslatorInstance)
+var _ ListenerTranslator = new(udpListenerTranslator)
+
+// UdpProxyFilterName is the name of the Envoy listener filter proxying UDP datagrams
+const UdpProxyFilterName = "envoy.filters.udp_listener.udp_proxy"
 
 type emptyListenerTranslator struct {
 }
@@ -58,7 +67,7 @@ func (l *listenerTranslatorInstance) ComputeListener(params plugins.Params) *env
 	// This is upstream envoy definition we cannot mutate this struct
 	out := &envoy_config_listener_v3.Listener{
 		Name:         l.listener.GetName(),
-		Address:      l.computeListenerAddress(),
+		Address:      computeListenerAddress(l.listener, l.report, envoy_config_core_v3.SocketAddress_TCP),
 		FilterChains: filterChains,
 	}
 
@@ -89,11 +98,110 @@ func (l *listenerTranslatorInstance) ComputeListener(params plugins.Params) *env
 	return out
 }
 
+// udpListenerTranslator produces the listener of a UdpListener. It has no filter chain: the udp proxy is a listener
+// filter. The listener plugins are not run, as the listener options apply to TCP connections.
+type udpListenerTranslator struct {
+	listener *v1.Listener
+	report   *validationapi.ListenerReport
+}
+
+func (u *udpListenerTranslator) ComputeListener(params plugins.Params) *envoy_config_listener_v3.Listener {
+	udpListener := u.listener.GetUdpListener()
+	udpListenerReport := u.report.GetUdpListenerReport()
+
+	upstreamRef := udpListener.GetUpstream()
+	if upstreamRef == nil {
+		validation.AppendUDPListenerError(udpListenerReport,
+			validationapi.UdpListenerReport_Error_ProcessingError,
+			"no upstream is configured")
+		return nil
+	}
+	if _, err := params.Snapshot.Upstreams.Find(upstreamRef.GetNamespace(), upstreamRef.GetName()); err != nil {
+		// like for tcp hosts, a missing destination is only a warning, the listener is not served until it exists
+		validation.AppendUdpListenerWarning(udpListenerReport,
+			validationapi.UdpListenerReport_Warning_InvalidDestinationWarning,
+			pluginutils.NewUpstreamNotFoundErr(*upstreamRef).Error())
+		return nil
+	}
+
+	listenerFilter, err := udpProxyListenerFilter(u.listener)
+	if err != nil {
+		validation.AppendUDPListenerError(udpListenerReport,
+			validationapi.UdpListenerReport_Error_ProcessingError,
+			err.Error())
+		return nil
+	}
+
+	return &envoy_config_listener_v3.Listener{
+		Name:              u.listener.GetName(),
+		Address:           computeListenerAddress(u.listener, u.report, envoy_config_core_v3.SocketAddress_UDP),
+		UdpListenerConfig: &envoy_config_listener_v3.UdpListenerConfig{},
+		ListenerFilters:   []*envoy_config_listener_v3.ListenerFilter{listenerFilter},
+	}
+}
+
+// udpProxyListenerFilter returns the udp proxy filter forwarding the datagrams of the listener to its upstream
+func udpProxyListenerFilter(listener *v1.Listener) (*envoy_config_listener_v3.ListenerFilter, error) {
+	udpListener := listener.GetUdpListener()
+
+	route, err := utils.MessageToAny(&envoyudp.Route{
+		Cluster: UpstreamToClusterName(udpListener.GetUpstream()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statPrefix := udpListener.GetStatPrefix()
+	if statPrefix == "" {
+		statPrefix = listener.GetName()
+	}
+
+	udpProxy := &envoyudp.UdpProxyConfig{
+		StatPrefix:  statPrefix,
+		IdleTimeout: udpListener.GetIdleTimeout(),
+		// every datagram is routed to the upstream
+		RouteSpecifier: &envoyudp.UdpProxyConfig_Matcher{
+			Matcher: &xds_matcher_v3.Matcher{
+				OnNoMatch: &xds_matcher_v3.Matcher_OnMatch{
+					OnMatch: &xds_matcher_v3.Matcher_OnMatch_Action{
+						Action: &xds_core_v3.TypedExtensionConfig{
+							Name:        "route",
+							TypedConfig: route,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	switch policy := udpListener.GetHashPolicy().GetPolicySpecifier().(type) {
+	case *v1.UdpListener_HashPolicy_SourceIp:
+		udpProxy.HashPolicies = []*envoyudp.UdpProxyConfig_HashPolicy{{
+			PolicySpecifier: &envoyudp.UdpProxyConfig_HashPolicy_SourceIp{SourceIp: policy.SourceIp},
+		}}
+	case *v1.UdpListener_HashPolicy_Key:
+		udpProxy.HashPolicies = []*envoyudp.UdpProxyConfig_HashPolicy{{
+			PolicySpecifier: &envoyudp.UdpProxyConfig_HashPolicy_Key{Key: policy.Key},
+		}}
+	}
+
+	udpProxyAny, err := utils.MessageToAny(udpProxy)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_listener_v3.ListenerFilter{
+		Name: UdpProxyFilterName,
+		ConfigType: &envoy_config_listener_v3.ListenerFilter_TypedConfig{
+			TypedConfig: udpProxyAny,
+		},
+	}, nil
+}
+
 // computeListenerAddress returns the Address that this listener will listen for traffic
-func (l *listenerTranslatorInstance) computeListenerAddress() *envoy_config_core_v3.Address {
-	_, isIpv4Address, err := IsIpv4Address(l.listener.GetBindAddress())
+func computeListenerAddress(listener *v1.Listener, report *validationapi.ListenerReport, protocol envoy_config_core_v3.SocketAddress_Protocol) *envoy_config_core_v3.Address {
+	_, isIpv4Address, err := IsIpv4Address(listener.GetBindAddress())
 	if err != nil {
-		validation.AppendListenerError(l.report,
+		validation.AppendListenerError(report,
 			validationapi.ListenerReport_Error_ProcessingError,
 			err.Error(),
 		)
@@ -102,10 +210,10 @@ func (l *listenerTranslatorInstance) computeListenerAddress() *envoy_config_core
 	return &envoy_config_core_v3.Address{
 		Address: &envoy_config_core_v3.Address_SocketAddress{
 			SocketAddress: &envoy_config_core_v3.SocketAddress{
-				Protocol: envoy_config_core_v3.SocketAddress_TCP,
-				Address:  l.listener.GetBindAddress(),
+				Protocol: protocol,
+				Address:  listener.GetBindAddress(),
 				PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{
-					PortValue: l.listener.GetBindPort(),
+					PortValue: listener.GetBindPort(),
 				},
 				// As of Envoy 1.22: https://www.envoyproxy.io/docs/envoy/latest/version_history/v1.22/v1.22.0.html
 				// the Ipv4Compat flag can only be set on Ipv6 address and Ipv4-mapped Ipv6 address.
@@ -117,11 +225,22 @@ func (l *listenerTranslatorInstance) computeListenerAddress() *envoy_config_core
 }
 
 func validateListenerPorts(proxy *v1.Proxy, listenerReport *validationapi.ListenerReport) {
-	listenersByPort := make(map[uint32][]int)
+	// a UDP listener may share its port with a TCP listener
+	type listenerPort struct {
+		port uint32
+		udp  bool
+	}
+	listenersByPort := make(map[listenerPort][]int)
 	for i, listener := range proxy.GetListeners() {
-		listenersByPort[listener.GetBindPort()] = append(listenersByPort[listener.GetBindPort()], i)
+		key := listenerPort{port: listener.GetBindPort(), udp: listener.GetUdpListener() != nil}
+		listenersByPort[key] = append(listenersByPort[key], i)
+		if listener.GetOptions().GetHttp3().GetEnabled().GetValue() {
+			// the QUIC listener generated by the http3 plugin serves HTTP/3 on the UDP port of the listener
+			quicKey := listenerPort{port: listener.GetBindPort(), udp: true}
+			listenersByPort[quicKey] = append(listenersByPort[quicKey], i)
+		}
 	}
-	for port, listeners := range listenersByPort {
+	for key, listeners := range listenersByPort {
 		if len(listeners) == 1 {
 			continue
 		}
@@ -129,9 +248,13 @@ func validateListenerPorts(proxy *v1.Proxy, listenerReport *validationapi.Listen
 		for _, idx := range listeners {
 			listenerNames = append(listenerNames, proxy.GetListeners()[idx].GetName())
 		}
+		protocol := "tcp"
+		if key.udp {
+			protocol = "udp"
+		}
 		validation.AppendListenerError(listenerReport,
 			validationapi.ListenerReport_Error_BindPortNotUniqueError,
-			fmt.Sprintf("port %v is shared by listeners %v", port, listeners),
+			fmt.Sprintf("%s port %v is shared by listeners %v", protocol, key.port, listenerNames),
 		)
 	}
 }
@@ -17,7 +17,7 @@ import (
 // Gloo sends resources to Envoy via xDS. The components of the Listener subsystem that Gloo configures are:
 // 1. Listeners
 // 2. RouteConfiguration
-// Given that Gloo exposes a variety of ListenerTypes (HttpListener, TcpListener, HybridListener, AggregateListener), and each of these types
+// Given that Gloo exposes a variety of ListenerTypes (HttpListener, TcpListener, HybridListener, AggregateListener, UdpListener), and each of these types
 // affect how resources are generated, we abstract those implementation details behind abstract translators.
 // The ListenerSubsystemTranslatorFactory returns a ListenerTranslator and RouteConfigurationTranslator for a given Gloo Listener
 type ListenerSubsystemTranslatorFactory struct {
@@ -51,6 +51,9 @@ func (l *ListenerSubsystemTranslatorFactory) GetTranslators(ctx context.Context,
 
 	case *v1.Listener_AggregateListener:
 		return l.GetAggregateListenerTranslators(ctx, proxy, listener, listenerReport)
+
+	case *v1.Listener_UdpListener:
+		return l.GetUdpListenerTranslators(ctx, listener, listenerReport)
 	default:
 		// This case should never occur
 		return &emptyListenerTranslator{}, &emptyRouteConfigurationTranslator{}
@@ -159,6 +162,26 @@ func (l *ListenerSubsystemTranslatorFactory) GetTcpListenerTranslators(ctx conte
 	return listenerTranslator, routeConfigurationTranslator
 }
 
+func (l *ListenerSubsystemTranslatorFactory) GetUdpListenerTranslators(ctx context.Context, listener *v1.Listener, listenerReport *validationapi.ListenerReport) (
+	ListenerTranslator,
+	RouteConfigurationTranslator,
+) {
+	if listenerReport.GetUdpListenerReport() == nil {
+		contextutils.LoggerFrom(ctx).DPanic("internal error: listener report was not udp type")
+	}
+
+	// This translator produces a single Listener, without FilterChains
+	listenerTranslator := &udpListenerTranslator{
+		listener: listener,
+		report:   listenerReport,
+	}
+
+	// A UdpListener does not produce any RouteConfiguration
+	routeConfigurationTranslator := &emptyRouteConfigurationTranslator{}
+
+	return listenerTranslator, routeConfigurationTranslator
+}
+
 func (l *ListenerSubsystemTranslatorFactory) GetHybridListenerTranslators(ctx context.Context, proxy *v1.Proxy, listener *v1.Listener, listenerReport *validationapi.ListenerReport) (
 	ListenerTranslator,
 	RouteConfigurationTranslator,
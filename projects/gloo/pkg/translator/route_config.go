@@ -252,7 +252,7 @@ func validateEnvoyRoute(r *envoy_config_route_v3.Route, routeReport *validationa
 // utility function to transform gloo matcher to envoy route matcher
 func (h *httpRouteConfigurationTranslator) glooMatcherToEnvoyMatcher(ctx context.Context, matcher *matchers.Matcher) envoy_config_route_v3.RouteMatch {
 	match := envoy_config_route_v3.RouteMatch{
-		Headers:         envoyHeaderMatcher(ctx, matcher.GetHeaders()),
+		Headers:         pluginutils.EnvoyHeaderMatchers(ctx, matcher.GetHeaders()),
 		QueryParameters: envoyQueryMatcher(ctx, matcher.GetQueryParameters()),
 	}
 	if len(matcher.GetMethods()) > 0 {
@@ -686,38 +686,6 @@ func (h *httpRouteConfigurationTranslator) setEnvoyPathMatcher(ctx context.Conte
 	}
 }
 
-func envoyHeaderMatcher(ctx context.Context, in []*matchers.HeaderMatcher) []*envoy_config_route_v3.HeaderMatcher {
-	var out []*envoy_config_route_v3.HeaderMatcher
-	for _, matcher := range in {
-
-		envoyMatch := &envoy_config_route_v3.HeaderMatcher{
-			Name: matcher.GetName(),
-		}
-		if matcher.GetValue() == "" {
-			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PresentMatch{
-				PresentMatch: true,
-			}
-		} else {
-			if matcher.GetRegex() {
-				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
-					SafeRegexMatch: regexutils.NewRegex(ctx, matcher.GetValue()),
-				}
-			} else {
-				envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_ExactMatch{
-					ExactMatch: matcher.GetValue(),
-				}
-			}
-		}
-
-		if matcher.GetInvertMatch() {
-			envoyMatch.InvertMatch = true
-		}
-
-		out = append(out, envoyMatch)
-	}
-	return out
-}
-
 func envoyQueryMatcher(ctx context.Context, in []*matchers.QueryParameterMatcher) []*envoy_config_route_v3.QueryParameterMatcher {
 	var out []*envoy_config_route_v3.QueryParameterMatcher
 	for _, matcher := range in {
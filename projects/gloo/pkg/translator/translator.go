@@ -280,7 +280,8 @@ func (t *translatorInstance) generateXDSSnapshot(
 	}
 	for _, listener := range listeners {
 		// don't add empty listeners, envoy will complain
-		if len(listener.GetFilterChains()) < 1 {
+		// UDP listeners have no filter chain, the udp proxy is a listener filter
+		if len(listener.GetFilterChains()) < 1 && listener.GetUdpListenerConfig() == nil {
 			continue
 		}
 		listenersProto = append(listenersProto, resource.NewEnvoyResource(listener))
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -13,6 +14,7 @@ import (
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	envoyudp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
@@ -62,6 +64,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/faultinjection"
 	v1grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/http3"
 	v1kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
@@ -1865,6 +1868,87 @@ var _ = Describe("Translator", func() {
 			Expect(filterMetadata[SoloAnnotations].Fields).To(HaveKey("testkey"))
 			Expect(filterMetadata[SoloAnnotations].Fields["testkey"].GetStringValue()).To(Equal("testvalue"))
 		})
+
+		Context("with localities", func() {
+
+			BeforeEach(func() {
+				ref := upstream.Metadata.Ref()
+				endpoint := func(name, address string, locality *v1.Locality) *v1.Endpoint {
+					return &v1.Endpoint{
+						Metadata:  &core.Metadata{Name: name, Namespace: "gloo-system"},
+						Upstreams: []*core.ResourceRef{ref},
+						Address:   address,
+						Port:      1234,
+						Locality:  locality,
+					}
+				}
+				params.Snapshot.Endpoints = v1.EndpointList{
+					endpoint("b-1", "1.2.3.4", &v1.Locality{Region: "us-east-1", Zone: "us-east-1b"}),
+					endpoint("a-1", "1.2.3.5", &v1.Locality{Region: "us-east-1", Zone: "us-east-1a"}),
+					endpoint("w-1", "1.2.3.6", &v1.Locality{Region: "us-west-1", Zone: "us-west-1a"}),
+					endpoint("a-2", "1.2.3.7", &v1.Locality{Region: "us-east-1", Zone: "us-east-1a"}),
+				}
+			})
+
+			getLoadAssignment := func() *envoy_config_endpoint_v3.ClusterLoadAssignment {
+				translate()
+				clusterName := getEndpointClusterName(upstream)
+				endpoints := snapshot.GetResources(types.EndpointTypeV3)
+				Expect(endpoints.Items).To(HaveKey(clusterName))
+				return endpoints.Items[clusterName].ResourceProto().(*envoy_config_endpoint_v3.ClusterLoadAssignment)
+			}
+
+			It("groups the endpoints by locality", func() {
+				cla := getLoadAssignment()
+				Expect(cla.GetEndpoints()).To(HaveLen(3))
+
+				Expect(cla.GetEndpoints()[0].GetLocality()).To(MatchProto(&envoy_config_core_v3.Locality{Region: "us-east-1", Zone: "us-east-1a"}))
+				Expect(cla.GetEndpoints()[0].GetLbEndpoints()).To(HaveLen(2))
+				Expect(cla.GetEndpoints()[1].GetLocality()).To(MatchProto(&envoy_config_core_v3.Locality{Region: "us-east-1", Zone: "us-east-1b"}))
+				Expect(cla.GetEndpoints()[1].GetLbEndpoints()).To(HaveLen(1))
+				Expect(cla.GetEndpoints()[2].GetLocality()).To(MatchProto(&envoy_config_core_v3.Locality{Region: "us-west-1", Zone: "us-west-1a"}))
+				Expect(cla.GetEndpoints()[2].GetLbEndpoints()).To(HaveLen(1))
+				for _, localityEndpoints := range cla.GetEndpoints() {
+					Expect(localityEndpoints.GetPriority()).To(BeZero())
+				}
+			})
+
+			It("prioritizes the zone, then the region, of the failover locality", func() {
+				upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+					LocalityFailover: &v1.LoadBalancerConfig_LocalityFailover{
+						Locality: &v1.Locality{Region: "us-east-1", Zone: "us-east-1b"},
+					},
+				}
+
+				cla := getLoadAssignment()
+				Expect(cla.GetEndpoints()).To(HaveLen(3))
+				Expect(cla.GetEndpoints()[0].GetPriority()).To(BeEquivalentTo(1))
+				Expect(cla.GetEndpoints()[1].GetPriority()).To(BeEquivalentTo(0))
+				Expect(cla.GetEndpoints()[2].GetPriority()).To(BeEquivalentTo(2))
+			})
+
+			It("keeps the priorities contiguous", func() {
+				upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+					LocalityFailover: &v1.LoadBalancerConfig_LocalityFailover{
+						Locality: &v1.Locality{Region: "us-west-1", Zone: "us-west-1b"},
+					},
+				}
+
+				cla := getLoadAssignment()
+				Expect(cla.GetEndpoints()).To(HaveLen(3))
+				Expect(cla.GetEndpoints()[0].GetPriority()).To(BeEquivalentTo(1))
+				Expect(cla.GetEndpoints()[1].GetPriority()).To(BeEquivalentTo(1))
+				Expect(cla.GetEndpoints()[2].GetPriority()).To(BeEquivalentTo(0))
+			})
+
+			It("sets the health status of the endpoints", func() {
+				params.Snapshot.Endpoints[0].HealthStatus = v1.Endpoint_DRAINING
+
+				cla := getLoadAssignment()
+				Expect(cla.GetEndpoints()[1].GetLbEndpoints()[0].GetHealthStatus()).To(Equal(envoy_config_core_v3.HealthStatus_DRAINING))
+				Expect(cla.GetEndpoints()[0].GetLbEndpoints()[0].GetHealthStatus()).To(Equal(envoy_config_core_v3.HealthStatus_UNKNOWN))
+			})
+		})
 	})
 
 	Context("when handling subsets", func() {
@@ -2803,6 +2887,96 @@ var _ = Describe("Translator", func() {
 		})
 	})
 
+	Context("UDP", func() {
+
+		var udpListener *v1.Listener
+
+		BeforeEach(func() {
+			udpListener = &v1.Listener{
+				Name:        "udp-listener",
+				BindAddress: "127.0.0.1",
+				BindPort:    8080,
+				ListenerType: &v1.Listener_UdpListener{
+					UdpListener: &v1.UdpListener{
+						Upstream: &core.ResourceRef{
+							Name:      "test",
+							Namespace: "gloo-system",
+						},
+						IdleTimeout: &duration.Duration{Seconds: 30},
+						HashPolicy: &v1.UdpListener_HashPolicy{
+							PolicySpecifier: &v1.UdpListener_HashPolicy_SourceIp{SourceIp: true},
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			proxy.Listeners = append(proxy.GetListeners(), udpListener)
+		})
+
+		It("can properly create a udp listener sharing its port with a tcp listener", func() {
+			translate()
+			val, found := snapshot.GetResources(types.ListenerTypeV3).Items["udp-listener"]
+			Expect(found).To(BeTrue())
+			listener, ok := val.ResourceProto().(*envoy_config_listener_v3.Listener)
+			Expect(ok).To(BeTrue())
+			Expect(listener.GetFilterChains()).To(BeEmpty())
+			Expect(listener.GetUdpListenerConfig()).NotTo(BeNil())
+			Expect(listener.GetAddress().GetSocketAddress().GetProtocol()).To(Equal(envoy_config_core_v3.SocketAddress_UDP))
+			Expect(listener.GetAddress().GetSocketAddress().GetPortValue()).To(BeEquivalentTo(8080))
+
+			Expect(listener.GetListenerFilters()).To(HaveLen(1))
+			Expect(listener.GetListenerFilters()[0].GetName()).To(Equal(UdpProxyFilterName))
+			var udpProxy envoyudp.UdpProxyConfig
+			Expect(listener.GetListenerFilters()[0].GetTypedConfig().UnmarshalTo(&udpProxy)).NotTo(HaveOccurred())
+			Expect(udpProxy.GetStatPrefix()).To(Equal("udp-listener"))
+			Expect(udpProxy.GetIdleTimeout().AsDuration()).To(Equal(30 * time.Second))
+			Expect(udpProxy.GetHashPolicies()).To(HaveLen(1))
+			Expect(udpProxy.GetHashPolicies()[0].GetSourceIp()).To(BeTrue())
+
+			var route envoyudp.Route
+			action := udpProxy.GetMatcher().GetOnNoMatch().GetAction()
+			Expect(action.GetTypedConfig().UnmarshalTo(&route)).NotTo(HaveOccurred())
+			Expect(route.GetCluster()).To(Equal("test_gloo-system"))
+		})
+
+		It("skips the listener and reports a warning when the upstream does not exist", func() {
+			udpListener.GetUdpListener().Upstream = &core.ResourceRef{Name: "missing", Namespace: "gloo-system"}
+
+			snap, errs, report := translator.Translate(params, proxy)
+			Expect(errs.Validate()).NotTo(HaveOccurred())
+			Expect(errs.ValidateStrict()).To(HaveOccurred())
+			Expect(snap.GetResources(types.ListenerTypeV3).Items).NotTo(HaveKey("udp-listener"))
+
+			udpReport := report.GetListenerReports()[len(proxy.GetListeners())-1].GetUdpListenerReport()
+			Expect(udpReport.GetWarnings()).To(HaveLen(1))
+			Expect(udpReport.GetWarnings()[0].GetType()).To(Equal(validation.UdpListenerReport_Warning_InvalidDestinationWarning))
+		})
+
+		It("reports an error when the port is used by the QUIC listener of an http3 listener", func() {
+			httpListener := proxy.GetListeners()[0]
+			httpListener.Options = &v1.ListenerOptions{
+				Http3: &http3.Http3{Enabled: &wrappers.BoolValue{Value: true}},
+			}
+			udpListener.BindPort = httpListener.GetBindPort()
+
+			report := translateWithError()
+			udpReport := report.GetListenerReports()[len(proxy.GetListeners())-1]
+			Expect(udpReport.GetErrors()).To(ContainElement(HaveField("Type", validation.ListenerReport_Error_BindPortNotUniqueError)))
+			Expect(udpReport.GetErrors()).To(ContainElement(HaveField("Reason", "udp port 80 is shared by listeners [http-listener udp-listener]")))
+		})
+
+		It("reports an error when no upstream is configured", func() {
+			udpListener.GetUdpListener().Upstream = nil
+
+			report := translateWithError()
+			udpReport := report.GetListenerReports()[len(proxy.GetListeners())-1].GetUdpListenerReport()
+			Expect(udpReport.GetErrors()).To(HaveLen(1))
+			Expect(udpReport.GetErrors()[0].GetReason()).To(ContainSubstring("no upstream is configured"))
+		})
+	})
+
 	Context("Hybrid", func() {
 
 		// The number of HttpFilters that we expect to be generated on the HttpConnectionManager by default
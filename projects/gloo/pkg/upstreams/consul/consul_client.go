@@ -25,6 +25,10 @@ type ClientWrapper interface {
 	Service(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error)
 	// Connect is used to query catalog entries for a given Connect-enabled service
 	Connect(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error)
+	// ConnectCARoots is used to query the local agent for the Connect CA roots
+	ConnectCARoots(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error)
+	// ConnectCALeaf is used to query the local agent for a Connect leaf certificate for the given service
+	ConnectCALeaf(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error)
 }
 
 type clientWrapper struct {
@@ -52,6 +56,14 @@ func (c *clientWrapper) Connect(service, tag string, q *consulapi.QueryOptions)
 	return c.api.Catalog().Connect(service, tag, q)
 }
 
+func (c *clientWrapper) ConnectCARoots(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+	return c.api.Agent().ConnectCARoots(q)
+}
+
+func (c *clientWrapper) ConnectCALeaf(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+	return c.api.Agent().ConnectCALeaf(service, q)
+}
+
 // NewFilteredConsulClient is used to create a new client for filtered consul requests.
 // We have a wrapper around the consul api client *consulapi.Client - so that we can filter requests
 func NewFilteredConsulClient(client ClientWrapper, dataCenters []string, serviceTagsAllowlist []string) (ClientWrapper, error) {
@@ -111,6 +123,15 @@ func (c *consul) Connect(service, tag string, q *consulapi.QueryOptions) ([]*con
 	return c.api.Connect(service, tag, q)
 }
 
+// the Connect CA endpoints are served by the local agent and are not subject to data center filtering
+func (c *consul) ConnectCARoots(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+	return c.api.ConnectCARoots(q)
+}
+
+func (c *consul) ConnectCALeaf(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+	return c.api.ConnectCALeaf(service, q)
+}
+
 // Filters out the data centers not listed in the config
 func (c *consul) filterDataCenters(dataCenters []string) []string {
 
@@ -15,6 +15,9 @@ import (
 
 const UpstreamNamePrefix = "consul-svc:"
 
+// Consul registers the sidecar proxy of a Connect service as a service named after it
+const connectSidecarProxySuffix = "-sidecar-proxy"
+
 func IsConsulUpstream(upstreamName string) bool {
 	return strings.HasPrefix(upstreamName, UpstreamNamePrefix)
 }
@@ -30,8 +33,14 @@ func fakeUpstreamName(consulSvcName string) string {
 	return UpstreamNamePrefix + consulSvcName
 }
 
-// Creates an upstream for each service in the map
+// Creates an upstream for each service in the map.
+// When Connect is configured, the upstreams of the services that have a Connect sidecar proxy are connect-enabled.
 func toUpstreamList(forNamespace string, services []*ServiceMeta, consulConfig *v1.Settings_ConsulUpstreamDiscoveryConfiguration) v1.UpstreamList {
+	serviceNames := make(map[string]bool)
+	for _, svc := range services {
+		serviceNames[svc.Name] = true
+	}
+
 	var results v1.UpstreamList
 	for _, svc := range services {
 		upstreams := CreateUpstreamsFromService(svc, consulConfig)
@@ -39,6 +48,9 @@ func toUpstreamList(forNamespace string, services []*ServiceMeta, consulConfig *
 			if forNamespace != "" && upstream.GetMetadata().GetNamespace() != forNamespace {
 				continue
 			}
+			if consulConfig.GetConnect() != nil && serviceNames[svc.Name+connectSidecarProxySuffix] {
+				upstream.GetConsul().ConnectEnabled = true
+			}
 			results = append(results, upstream)
 		}
 	}
@@ -48,6 +48,26 @@ var _ = Describe("Conversions", func() {
 		Expect(usList[1].GetConsul().DataCenters).To(ConsistOf("dc1", "dc3", "dc4"))
 	})
 
+	It("enables connect for services with a connect sidecar proxy when connect is configured", func() {
+		services := []*ServiceMeta{
+			{Name: "svc-1", DataCenters: []string{"dc1"}},
+			{Name: "svc-1-sidecar-proxy", DataCenters: []string{"dc1"}},
+			{Name: "svc-2", DataCenters: []string{"dc1"}},
+		}
+
+		usList := toUpstreamList(defaults.GlooSystem, services, &v1.Settings_ConsulUpstreamDiscoveryConfiguration{
+			Connect: &v1.Settings_ConsulUpstreamDiscoveryConfiguration_ConnectConfiguration{},
+		})
+		Expect(usList).To(HaveLen(3))
+		Expect(usList[0].GetConsul().GetServiceName()).To(Equal("svc-1"))
+		Expect(usList[0].GetConsul().GetConnectEnabled()).To(BeTrue())
+		Expect(usList[1].GetConsul().GetConnectEnabled()).To(BeFalse())
+		Expect(usList[2].GetConsul().GetConnectEnabled()).To(BeFalse())
+
+		usList = toUpstreamList(defaults.GlooSystem, services, &v1.Settings_ConsulUpstreamDiscoveryConfiguration{})
+		Expect(usList[0].GetConsul().GetConnectEnabled()).To(BeFalse())
+	})
+
 	It("adds TLS to upstreams that have the TLS tag", func() {
 		servicesWithDataCenters := []*ServiceMeta{
 			{Name: "svc-1", DataCenters: []string{"dc1", "dc2"}, Tags: []string{"glooUseTls"}},
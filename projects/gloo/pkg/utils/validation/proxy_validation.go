@@ -120,6 +120,12 @@ func MakeReport(proxy *v1.Proxy) *validation.ProxyReport {
 					},
 				},
 			}
+		case *v1.Listener_UdpListener:
+			listenerReports[i] = &validation.ListenerReport{
+				ListenerTypeReport: &validation.ListenerReport_UdpListenerReport{
+					UdpListenerReport: &validation.UdpListenerReport{},
+				},
+			}
 		case *v1.Listener_AggregateListener:
 			httpListenerReports := make(map[string]*validation.HttpListenerReport)
 			tcpListenerReports := make(map[string]*validation.TcpListenerReport)
@@ -266,6 +272,24 @@ func GetTcpHostWarning(tcpHost *validation.TcpHostReport) []string {
 	return warnings
 }
 
+func GetUdpListenerErr(udpListener *validation.UdpListenerReport) []error {
+	var errs []error
+	for _, errReport := range udpListener.GetErrors() {
+		errs = append(errs, mkErr("UdpListener", errReport.GetType().String(), errReport.GetReason()))
+	}
+	return errs
+}
+
+// Extract, format and return all warnings on this UdpListener instance as a list
+// of strings
+func GetUdpListenerWarning(udpListener *validation.UdpListenerReport) []string {
+	var warnings []string
+	for _, warning := range udpListener.GetWarnings() {
+		warnings = append(warnings, fmt.Sprintf("UdpListener Warning: %v. Reason: %v", warning.GetType().String(), warning.GetReason()))
+	}
+	return warnings
+}
+
 func GetListenerError(listener *validation.ListenerReport) []error {
 	var errs []error
 
@@ -279,6 +303,9 @@ func GetListenerError(listener *validation.ListenerReport) []error {
 	case *validation.ListenerReport_TcpListenerReport:
 		errs = append(errs, getTcpListenerReportErrs(listenerType.TcpListenerReport)...)
 
+	case *validation.ListenerReport_UdpListenerReport:
+		errs = append(errs, GetUdpListenerErr(listenerType.UdpListenerReport)...)
+
 	case *validation.ListenerReport_HybridListenerReport:
 		for _, mr := range listenerType.HybridListenerReport.GetMatchedListenerReports() {
 			switch lrt := mr.GetListenerReportType().(type) {
@@ -369,6 +396,7 @@ func GetProxyWarning(proxyRpt *validation.ProxyReport) []string {
 		for _, tcpHostReport := range utils.GetTcpHostReportsFromListenerReport(listenerReport) {
 			warnings = append(warnings, GetTcpHostWarning(tcpHostReport)...)
 		}
+		warnings = append(warnings, GetUdpListenerWarning(listenerReport.GetUdpListenerReport())...)
 
 	}
 
@@ -403,6 +431,13 @@ func AppendTCPListenerError(tcpListenerReport *validation.TcpListenerReport, err
 	})
 }
 
+func AppendUDPListenerError(udpListenerReport *validation.UdpListenerReport, errType validation.UdpListenerReport_Error_Type, reason string) {
+	udpListenerReport.Errors = append(udpListenerReport.GetErrors(), &validation.UdpListenerReport_Error{
+		Type:   errType,
+		Reason: reason,
+	})
+}
+
 func AppendRouteError(routeReport *validation.RouteReport, errType validation.RouteReport_Error_Type, reason string, routeName string) {
 	routeReport.Errors = append(routeReport.GetErrors(), &validation.RouteReport_Error{
 		Type:   errType,
@@ -431,3 +466,10 @@ func AppendTcpHostWarning(tcpHostReport *validation.TcpHostReport, errType valid
 		Reason: reason,
 	})
 }
+
+func AppendUdpListenerWarning(udpListenerReport *validation.UdpListenerReport, errType validation.UdpListenerReport_Warning_Type, reason string) {
+	udpListenerReport.Warnings = append(udpListenerReport.GetWarnings(), &validation.UdpListenerReport_Warning{
+		Type:   errType,
+		Reason: reason,
+	})
+}
@@ -0,0 +1,74 @@
+package backend
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Counter stores the hits counted against each rate limit window.
+type Counter interface {
+	// Increment adds hits to the counter stored at key and returns the new total.
+	// The counter is discarded once expiry has elapsed.
+	Increment(ctx context.Context, key string, hits uint64, expiry time.Duration) (uint64, error)
+}
+
+var _ Counter = new(memoryCounter)
+
+type memoryEntry struct {
+	count     uint64
+	expiresAt time.Time
+}
+
+// memoryCounter keeps counters in process memory, limits are therefore enforced per replica
+type memoryCounter struct {
+	lock    sync.Mutex
+	now     func() time.Time
+	entries map[string]*memoryEntry
+	// sweep expired entries once the map has grown past this size
+	sweepAt int
+}
+
+const minSweepSize = 1024
+
+func NewMemoryCounter() Counter {
+	return newMemoryCounter(time.Now)
+}
+
+func newMemoryCounter(now func() time.Time) *memoryCounter {
+	return &memoryCounter{
+		now:     now,
+		entries: make(map[string]*memoryEntry),
+		sweepAt: minSweepSize,
+	}
+}
+
+func (m *memoryCounter) Increment(_ context.Context, key string, hits uint64, expiry time.Duration) (uint64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := m.now()
+	entry, ok := m.entries[key]
+	if !ok || !now.Before(entry.expiresAt) {
+		entry = &memoryEntry{expiresAt: now.Add(expiry)}
+		m.entries[key] = entry
+	}
+	entry.count += hits
+
+	if len(m.entries) >= m.sweepAt {
+		m.sweep(now)
+	}
+	return entry.count, nil
+}
+
+func (m *memoryCounter) sweep(now time.Time) {
+	for key, entry := range m.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(m.entries, key)
+		}
+	}
+	m.sweepAt = 2 * len(m.entries)
+	if m.sweepAt < minSweepSize {
+		m.sweepAt = minSweepSize
+	}
+}
@@ -0,0 +1,259 @@
+package backend_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/ratelimit/pkg/backend"
+)
+
+var _ = Describe("Counters", func() {
+
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Context("memory", func() {
+
+		It("counts hits until the key expires", func() {
+			counter := NewMemoryCounter()
+			count, err := counter.Increment(ctx, "key", 1, 50*time.Millisecond)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeEquivalentTo(1))
+
+			count, err = counter.Increment(ctx, "key", 2, 50*time.Millisecond)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeEquivalentTo(3))
+
+			Eventually(func() uint64 {
+				count, _ := counter.Increment(ctx, "key", 1, 50*time.Millisecond)
+				return count
+			}, time.Second, 10*time.Millisecond).Should(BeEquivalentTo(1))
+		})
+	})
+
+	Context("redis", func() {
+
+		var server *fakeRedis
+
+		BeforeEach(func() {
+			server = newFakeRedis("secret")
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("increments counters over the redis protocol", func() {
+			counter := NewRedisCounter(RedisOptions{Address: server.Addr(), Password: "secret", DB: 2})
+
+			count, err := counter.Increment(ctx, "key", 1, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeEquivalentTo(1))
+
+			count, err = counter.Increment(ctx, "key", 4, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeEquivalentTo(5))
+
+			Expect(server.Commands()).To(ContainElements("AUTH secret", "SELECT 2", "PEXPIRE key 60000"))
+		})
+
+		It("surfaces redis errors", func() {
+			counter := NewRedisCounter(RedisOptions{Address: server.Addr(), Password: "wrong"})
+
+			_, err := counter.Increment(ctx, "key", 1, time.Minute)
+			Expect(err).To(MatchError(ContainSubstring("WRONGPASS")))
+		})
+
+		It("reconnects after the connection is lost", func() {
+			counter := NewRedisCounter(RedisOptions{Address: server.Addr(), Password: "secret"})
+
+			_, err := counter.Increment(ctx, "key", 1, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			server.DropConnections()
+			_, err = counter.Increment(ctx, "key", 1, time.Minute)
+			Expect(err).To(HaveOccurred())
+
+			// the hit sent on the dropped connection is lost
+			count, err := counter.Increment(ctx, "key", 1, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeEquivalentTo(2))
+		})
+
+		It("does not serialize concurrent increments on one connection", func() {
+			server.SetReplyDelay(100 * time.Millisecond)
+			counter := NewRedisCounter(RedisOptions{Address: server.Addr(), Password: "secret", PoolSize: 5})
+
+			var wg sync.WaitGroup
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					_, err := counter.Increment(ctx, "key", 1, time.Minute)
+					Expect(err).NotTo(HaveOccurred())
+				}()
+			}
+			wg.Wait()
+
+			Expect(server.Connections()).To(Equal(5))
+			count, err := counter.Increment(ctx, "key", 1, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeEquivalentTo(6))
+		})
+	})
+})
+
+// fakeRedis is a minimal stand-in for a Redis server, speaking just enough of the protocol for the counter
+type fakeRedis struct {
+	password string
+	listener net.Listener
+
+	lock       sync.Mutex
+	values     map[string]int64
+	commands   []string
+	conns      []net.Conn
+	accepted   int
+	replyDelay time.Duration
+}
+
+func newFakeRedis(password string) *fakeRedis {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	f := &fakeRedis{
+		password: password,
+		listener: listener,
+		values:   map[string]int64{},
+	}
+	go f.serve()
+	return f
+}
+
+func (f *fakeRedis) Addr() string {
+	return f.listener.Addr().String()
+}
+
+func (f *fakeRedis) Close() {
+	_ = f.listener.Close()
+	f.DropConnections()
+}
+
+func (f *fakeRedis) DropConnections() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for _, conn := range f.conns {
+		_ = conn.Close()
+	}
+	f.conns = nil
+}
+
+// SetReplyDelay delays every reply, as a busy or distant server would
+func (f *fakeRedis) SetReplyDelay(delay time.Duration) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.replyDelay = delay
+}
+
+// Connections returns the number of connections accepted so far
+func (f *fakeRedis) Connections() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.accepted
+}
+
+func (f *fakeRedis) Commands() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]string{}, f.commands...)
+}
+
+func (f *fakeRedis) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+		f.lock.Lock()
+		f.conns = append(f.conns, conn)
+		f.accepted++
+		f.lock.Unlock()
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeRedis) handle(conn net.Conn) {
+	defer GinkgoRecover()
+	reader := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+		reply, delay := f.execute(args)
+		time.Sleep(delay)
+		if _, err := io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+func (f *fakeRedis) execute(args []string) (string, time.Duration) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.commands = append(f.commands, strings.Join(args, " "))
+	return f.reply(args), f.replyDelay
+}
+
+func (f *fakeRedis) reply(args []string) string {
+	switch strings.ToUpper(args[0]) {
+	case "AUTH":
+		if args[1] != f.password {
+			return "-WRONGPASS invalid username-password pair\r\n"
+		}
+		return "+OK\r\n"
+	case "SELECT":
+		return "+OK\r\n"
+	case "INCRBY":
+		by, _ := strconv.ParseInt(args[2], 10, 64)
+		f.values[args[1]] += by
+		return fmt.Sprintf(":%d\r\n", f.values[args[1]])
+	case "PEXPIRE":
+		return ":1\r\n"
+	}
+	return "-ERR unknown command\r\n"
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		if _, err := reader.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		arg, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, strings.TrimSuffix(arg, "\r\n"))
+	}
+	return args, nil
+}
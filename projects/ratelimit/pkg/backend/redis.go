@@ -0,0 +1,83 @@
+package backend
+
+import (
+	"context"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/rotisserie/eris"
+)
+
+var _ Counter = new(redisCounter)
+
+const DefaultRedisPoolSize = 10
+
+// RedisOptions configures the connections to a server speaking the Redis protocol (RESP).
+type RedisOptions struct {
+	Address     string
+	Password    string
+	DB          int
+	DialTimeout time.Duration
+	// PoolSize is the maximum number of connections, and so of requests in flight, to the server.
+	PoolSize int
+}
+
+// redisCounter stores counters in any server speaking the Redis protocol, so that all replicas of
+// the rate limit server share their limits. Concurrent increments use separate connections from a pool;
+// connections which fail are discarded and replaced on demand.
+type redisCounter struct {
+	pool *redis.Pool
+}
+
+func NewRedisCounter(opts RedisOptions) Counter {
+	if opts.DialTimeout == 0 {
+		opts.DialTimeout = 5 * time.Second
+	}
+	if opts.PoolSize == 0 {
+		opts.PoolSize = DefaultRedisPoolSize
+	}
+	return &redisCounter{
+		pool: &redis.Pool{
+			DialContext: func(ctx context.Context) (redis.Conn, error) {
+				conn, err := redis.DialContext(ctx, "tcp", opts.Address,
+					redis.DialConnectTimeout(opts.DialTimeout),
+					redis.DialPassword(opts.Password),
+					redis.DialDatabase(opts.DB),
+				)
+				if err != nil {
+					return nil, eris.Wrapf(err, "connecting to redis at %s", opts.Address)
+				}
+				return conn, nil
+			},
+			MaxIdle:     opts.PoolSize,
+			MaxActive:   opts.PoolSize,
+			IdleTimeout: 5 * time.Minute,
+			Wait:        true,
+		},
+	}
+}
+
+func (r *redisCounter) Increment(ctx context.Context, key string, hits uint64, expiry time.Duration) (uint64, error) {
+	conn, err := r.pool.GetContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	// counters are keyed by window, so refreshing the expiry on every hit only serves to clean them up
+	if err := conn.Send("INCRBY", key, hits); err != nil {
+		return 0, err
+	}
+	if err := conn.Send("PEXPIRE", key, expiry.Milliseconds()); err != nil {
+		return 0, err
+	}
+	var timeout time.Duration
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	replies, err := redis.Values(redis.DoWithTimeout(conn, timeout, ""))
+	if err != nil {
+		return 0, err
+	}
+	return redis.Uint64(replies[0], nil)
+}
@@ -0,0 +1,184 @@
+package config
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise"
+	rlplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+)
+
+// Entry is a single key/value pair of a descriptor sent by Envoy.
+type Entry struct {
+	Key   string
+	Value string
+}
+
+// Rule is a rate limit that a request descriptor matched.
+type Rule struct {
+	// Key uniquely identifies the counter for this rule and the descriptor values that matched it
+	Key         string
+	Limit       *solo_rl.RateLimit
+	Weight      uint32
+	AlwaysApply bool
+}
+
+// Config holds the rate limit configuration of every domain served to the rate limit server.
+type Config struct {
+	domains map[string]*domain
+}
+
+type domain struct {
+	descriptors    []*solo_rl.Descriptor
+	setDescriptors []*solo_rl.SetDescriptor
+}
+
+// NewConfig indexes the configs received from Gloo by domain.
+func NewConfig(configs []*enterprise.RateLimitConfig) *Config {
+	domains := make(map[string]*domain, len(configs))
+	for _, cfg := range configs {
+		d, ok := domains[cfg.GetDomain()]
+		if !ok {
+			d = &domain{}
+			domains[cfg.GetDomain()] = d
+		}
+		d.descriptors = append(d.descriptors, cfg.GetDescriptors()...)
+		d.setDescriptors = append(d.setDescriptors, cfg.GetSetDescriptors()...)
+	}
+	return &Config{domains: domains}
+}
+
+// HasDomain reports whether any configuration was received for the domain.
+func (c *Config) HasDomain(name string) bool {
+	_, ok := c.domains[name]
+	return ok
+}
+
+// Match returns the rules that apply to a single request descriptor.
+// Set-style descriptors (flagged by a leading generic key) may match several rules; tree-style
+// descriptors match at most one, the node reached by the last entry.
+func (c *Config) Match(domainName string, entries []Entry) []*Rule {
+	d, ok := c.domains[domainName]
+	if !ok || len(entries) == 0 {
+		return nil
+	}
+	if entries[0].Key == rlplugin.GenericKeyDescriptor && entries[0].Value == rlplugin.SetDescriptorValue {
+		return d.matchSet(entries[1:])
+	}
+	if rule := d.matchTree(entries); rule != nil {
+		return []*Rule{rule}
+	}
+	return nil
+}
+
+func (d *domain) matchTree(entries []Entry) *Rule {
+	var (
+		node        *solo_rl.Descriptor
+		keyParts    []string
+		weight      uint32
+		alwaysApply bool
+	)
+	children := d.descriptors
+	for _, entry := range entries {
+		node = findDescriptor(children, entry)
+		if node == nil {
+			return nil
+		}
+		keyParts = append(keyParts, entry.Key+"="+entry.Value)
+		if node.GetWeight() > weight {
+			weight = node.GetWeight()
+		}
+		alwaysApply = alwaysApply || node.GetAlwaysApply()
+		children = node.GetDescriptors()
+	}
+	if node.GetRateLimit() == nil {
+		return nil
+	}
+	return &Rule{
+		Key:         "tree|" + strings.Join(keyParts, "|"),
+		Limit:       node.GetRateLimit(),
+		Weight:      weight,
+		AlwaysApply: alwaysApply,
+	}
+}
+
+// findDescriptor prefers a descriptor matching both key and value, falling back to one that only
+// defines the key, in which case every distinct value gets its own counter.
+func findDescriptor(descriptors []*solo_rl.Descriptor, entry Entry) *solo_rl.Descriptor {
+	var wildcard *solo_rl.Descriptor
+	for _, descriptor := range descriptors {
+		if descriptor.GetKey() != entry.Key {
+			continue
+		}
+		if descriptor.GetValue() == entry.Value {
+			return descriptor
+		}
+		if descriptor.GetValue() == "" && wildcard == nil {
+			wildcard = descriptor
+		}
+	}
+	return wildcard
+}
+
+// matchSet applies the first set descriptor whose simple descriptors are all present in the request,
+// along with every later match that is marked alwaysApply.
+func (d *domain) matchSet(entries []Entry) []*Rule {
+	var rules []*Rule
+	matchedFirst := false
+	for _, setDescriptor := range d.setDescriptors {
+		if matchedFirst && !setDescriptor.GetAlwaysApply() {
+			continue
+		}
+		keyParts, ok := matchSimpleDescriptors(setDescriptor.GetSimpleDescriptors(), entries)
+		if !ok {
+			continue
+		}
+		matchedFirst = true
+		rules = append(rules, &Rule{
+			Key:         "set|" + setDescriptorId(setDescriptor) + "|" + strings.Join(keyParts, "|"),
+			Limit:       setDescriptor.GetRateLimit(),
+			AlwaysApply: setDescriptor.GetAlwaysApply(),
+		})
+	}
+	return rules
+}
+
+// setDescriptorId identifies a set descriptor by its simple descriptors rather than its position, so that its counters
+// are kept when the set descriptors are reordered.
+func setDescriptorId(setDescriptor *solo_rl.SetDescriptor) string {
+	var parts []string
+	for _, simpleDescriptor := range setDescriptor.GetSimpleDescriptors() {
+		parts = append(parts, simpleDescriptor.GetKey()+"="+simpleDescriptor.GetValue())
+	}
+	sort.Strings(parts)
+	hash := fnv.New64a()
+	hash.Write([]byte(strings.Join(parts, "\x00")))
+	return strconv.FormatUint(hash.Sum64(), 16)
+}
+
+// matchSimpleDescriptors returns the matched entries, sorted so that the key does not depend on the order of the simple
+// descriptors.
+func matchSimpleDescriptors(simpleDescriptors []*solo_rl.SimpleDescriptor, entries []Entry) ([]string, bool) {
+	var keyParts []string
+	for _, simpleDescriptor := range simpleDescriptors {
+		found := false
+		for _, entry := range entries {
+			if entry.Key != simpleDescriptor.GetKey() {
+				continue
+			}
+			if simpleDescriptor.GetValue() == "" || simpleDescriptor.GetValue() == entry.Value {
+				keyParts = append(keyParts, entry.Key+"="+entry.Value)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	sort.Strings(keyParts)
+	return keyParts, true
+}
@@ -0,0 +1,130 @@
+package config_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise"
+	rlplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/config"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+)
+
+var _ = Describe("Config", func() {
+
+	var (
+		cfg       *config.Config
+		perMinute = func(n uint32) *solo_rl.RateLimit {
+			return &solo_rl.RateLimit{Unit: solo_rl.RateLimit_MINUTE, RequestsPerUnit: n}
+		}
+		setEntry = config.Entry{Key: rlplugin.GenericKeyDescriptor, Value: rlplugin.SetDescriptorValue}
+	)
+
+	BeforeEach(func() {
+		cfg = config.NewConfig([]*enterprise.RateLimitConfig{{
+			Domain: "custom",
+			Descriptors: []*solo_rl.Descriptor{
+				{
+					Key:       "remote_address",
+					RateLimit: perMinute(10),
+					Descriptors: []*solo_rl.Descriptor{{
+						Key:       "path",
+						Value:     "/admin",
+						RateLimit: perMinute(1),
+						Weight:    1,
+					}},
+				},
+				{
+					Key:       "remote_address",
+					Value:     "10.0.0.1",
+					RateLimit: perMinute(100),
+				},
+			},
+			SetDescriptors: []*solo_rl.SetDescriptor{
+				{
+					SimpleDescriptors: []*solo_rl.SimpleDescriptor{{Key: "tier", Value: "free"}},
+					RateLimit:         perMinute(2),
+				},
+				{
+					SimpleDescriptors: []*solo_rl.SimpleDescriptor{{Key: "tier"}},
+					RateLimit:         perMinute(20),
+				},
+				{
+					SimpleDescriptors: []*solo_rl.SimpleDescriptor{{Key: "user"}},
+					RateLimit:         perMinute(5),
+					AlwaysApply:       true,
+				},
+			},
+		}})
+	})
+
+	It("ignores unknown domains", func() {
+		Expect(cfg.HasDomain("other")).To(BeFalse())
+		Expect(cfg.Match("other", []config.Entry{{Key: "remote_address", Value: "1.2.3.4"}})).To(BeEmpty())
+	})
+
+	It("prefers an exact value over a key-only descriptor", func() {
+		rules := cfg.Match("custom", []config.Entry{{Key: "remote_address", Value: "10.0.0.1"}})
+		Expect(rules).To(HaveLen(1))
+		Expect(rules[0].Limit.GetRequestsPerUnit()).To(BeEquivalentTo(100))
+	})
+
+	It("counts each value of a key-only descriptor separately", func() {
+		first := cfg.Match("custom", []config.Entry{{Key: "remote_address", Value: "1.2.3.4"}})
+		second := cfg.Match("custom", []config.Entry{{Key: "remote_address", Value: "5.6.7.8"}})
+		Expect(first).To(HaveLen(1))
+		Expect(second).To(HaveLen(1))
+		Expect(first[0].Limit.GetRequestsPerUnit()).To(BeEquivalentTo(10))
+		Expect(first[0].Key).NotTo(Equal(second[0].Key))
+	})
+
+	It("uses the limit of the descriptor reached by the last entry", func() {
+		rules := cfg.Match("custom", []config.Entry{{Key: "remote_address", Value: "1.2.3.4"}, {Key: "path", Value: "/admin"}})
+		Expect(rules).To(HaveLen(1))
+		Expect(rules[0].Limit.GetRequestsPerUnit()).To(BeEquivalentTo(1))
+		Expect(rules[0].Weight).To(BeEquivalentTo(1))
+
+		Expect(cfg.Match("custom", []config.Entry{{Key: "remote_address", Value: "1.2.3.4"}, {Key: "path", Value: "/"}})).To(BeEmpty())
+	})
+
+	It("applies the first matching set descriptor and those that always apply", func() {
+		rules := cfg.Match("custom", []config.Entry{setEntry, {Key: "tier", Value: "free"}, {Key: "user", Value: "alice"}})
+		Expect(rules).To(HaveLen(2))
+		Expect(rules[0].Limit.GetRequestsPerUnit()).To(BeEquivalentTo(2))
+		Expect(rules[1].Limit.GetRequestsPerUnit()).To(BeEquivalentTo(5))
+		Expect(rules[1].AlwaysApply).To(BeTrue())
+
+		rules = cfg.Match("custom", []config.Entry{setEntry, {Key: "tier", Value: "paid"}})
+		Expect(rules).To(HaveLen(1))
+		Expect(rules[0].Limit.GetRequestsPerUnit()).To(BeEquivalentTo(20))
+	})
+
+	It("keeps the counters of set descriptors when they are reordered", func() {
+		entries := []config.Entry{setEntry, {Key: "tier", Value: "free"}, {Key: "user", Value: "alice"}}
+		before := cfg.Match("custom", entries)
+
+		reordered := config.NewConfig([]*enterprise.RateLimitConfig{{
+			Domain: "custom",
+			SetDescriptors: []*solo_rl.SetDescriptor{
+				{
+					SimpleDescriptors: []*solo_rl.SimpleDescriptor{{Key: "plan"}},
+					RateLimit:         perMinute(1),
+				},
+				{
+					SimpleDescriptors: []*solo_rl.SimpleDescriptor{{Key: "tier", Value: "free"}},
+					RateLimit:         perMinute(2),
+				},
+				{
+					SimpleDescriptors: []*solo_rl.SimpleDescriptor{{Key: "user"}},
+					RateLimit:         perMinute(5),
+					AlwaysApply:       true,
+				},
+			},
+		}})
+		after := reordered.Match("custom", entries)
+
+		Expect(after).To(HaveLen(2))
+		Expect(after[0].Key).To(Equal(before[0].Key))
+		Expect(after[1].Key).To(Equal(before[1].Key))
+		Expect(before[0].Key).NotTo(Equal(before[1].Key))
+	})
+})
@@ -0,0 +1,151 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise"
+	rlsyncer "github.com/solo-io/gloo/projects/gloo/pkg/syncer/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/backend"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/service"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/healthchecker"
+	"github.com/solo-io/go-utils/stats"
+	envoy_api_v2_core "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
+	"go.opencensus.io/plugin/ocgrpc"
+	"go.opencensus.io/stats/view"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// time to wait before reconnecting to Gloo after the xDS stream fails
+const xdsRetryInterval = 5 * time.Second
+
+func init() {
+	view.Register(ocgrpc.DefaultServerViews...)
+}
+
+func Run() {
+	settings := NewSettings()
+	ctx := contextutils.WithLogger(context.Background(), "ratelimit")
+
+	if settings.DebugPort != 0 {
+		// TODO(yuval-k): we need to start the stats server before calling contextutils
+		// need to think of a better way to express this dependency, or preferably, fix it.
+		stats.StartStatsServerWithPort(stats.StartupOptions{Port: settings.DebugPort})
+	}
+
+	err := RunWithSettings(ctx, settings)
+
+	if err != nil {
+		if ctx.Err() == nil {
+			// not a context error - panic
+			panic(err)
+		}
+	}
+}
+
+func RunWithSettings(ctx context.Context, settings Settings) error {
+	server := service.NewServer(newCounter(ctx, settings))
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		return StartRateLimitServer(ctx, settings, server)
+	})
+	eg.Go(func() error {
+		return WatchConfig(ctx, settings, server)
+	})
+	err := eg.Wait()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func newCounter(ctx context.Context, settings Settings) backend.Counter {
+	logger := contextutils.LoggerFrom(ctx)
+	if settings.RedisUrl == "" {
+		logger.Infow("storing rate limit counters in memory, limits are enforced per replica")
+		return backend.NewMemoryCounter()
+	}
+	logger.Infow("storing rate limit counters in redis", zap.String("address", settings.RedisUrl), zap.Int("db", settings.RedisDb))
+	return backend.NewRedisCounter(backend.RedisOptions{
+		Address:  settings.RedisUrl,
+		Password: settings.RedisPassword,
+		DB:       settings.RedisDb,
+		PoolSize: settings.RedisPoolSize,
+	})
+}
+
+// WatchConfig streams the rate limit configuration from Gloo into the server, reconnecting until ctx is done.
+func WatchConfig(ctx context.Context, settings Settings, server *service.Server) error {
+	logger := contextutils.LoggerFrom(ctx)
+
+	nodeInfo := &envoy_api_v2_core.Node{
+		Id:      settings.PodName,
+		Cluster: settings.ServiceName,
+		Metadata: &_struct.Struct{
+			Fields: map[string]*_struct.Value{
+				xds.RoleKey: {Kind: &_struct.Value_StringValue{StringValue: rlsyncer.ServerRole}},
+			},
+		},
+	}
+	client := enterprise.NewRateLimitConfigClient(nodeInfo, func(version string, configs []*enterprise.RateLimitConfig) error {
+		logger.Infow("received rate limit configuration", zap.String("version", version), zap.Int("domains", len(configs)))
+		server.SetConfig(configs)
+		return nil
+	})
+
+	cc, err := grpc.DialContext(ctx, settings.GlooAddress, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	for {
+		err := client.Start(ctx, cc)
+		if ctx.Err() != nil {
+			return nil
+		}
+		logger.Warnw("rate limit configuration stream ended, reconnecting", zap.String("gloo_address", settings.GlooAddress), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(xdsRetryInterval):
+		}
+	}
+}
+
+func StartRateLimitServer(ctx context.Context, settings Settings, server *service.Server) error {
+	srv := grpc.NewServer(grpc.StatsHandler(&ocgrpc.ServerHandler{}))
+
+	pb.RegisterRateLimitServiceServer(srv, server)
+	hc := healthchecker.NewGrpc(settings.ServiceName, health.NewServer(), false, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(srv, hc.GetServer())
+	reflection.Register(srv)
+
+	logger := contextutils.LoggerFrom(ctx)
+	addr := fmt.Sprintf(":%d", settings.ServerPort)
+	logger.Infof("rate limit server listening at [%s]", addr)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		logger.Errorw("Failed to announce on network", zap.Any("address", addr), zap.Any("error", err))
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		srv.Stop()
+		_ = lis.Close()
+	}()
+
+	return srv.Serve(lis)
+}
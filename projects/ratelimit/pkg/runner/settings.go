@@ -0,0 +1,34 @@
+package runner
+
+import (
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Settings struct {
+	DebugPort   int    `envconfig:"DEBUG_PORT" default:"9091"`
+	ServerPort  int    `envconfig:"SERVER_PORT" default:"18081"`
+	ServiceName string `envconfig:"SERVICE_NAME" default:"ratelimit"`
+	PodName     string `envconfig:"POD_NAME" default:"ratelimit"`
+
+	// GlooAddress is the address of the Gloo xDS server the rate limit configuration is read from
+	GlooAddress string `envconfig:"GLOO_ADDRESS" default:"gloo:9977"`
+
+	// RedisUrl is the address of a server speaking the Redis protocol. When empty, counters are kept in memory
+	// and limits are enforced by each replica independently.
+	RedisUrl      string `envconfig:"REDIS_URL"`
+	RedisPassword string `envconfig:"REDIS_PASSWORD"`
+	RedisDb       int    `envconfig:"REDIS_DB" default:"0"`
+	// RedisPoolSize is the maximum number of connections to the Redis server
+	RedisPoolSize int `envconfig:"REDIS_POOL_SIZE" default:"10"`
+}
+
+func NewSettings() Settings {
+	var s Settings
+
+	err := envconfig.Process("", &s)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
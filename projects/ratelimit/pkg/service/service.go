@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	envoy_extensions_common_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/backend"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/config"
+	"github.com/solo-io/go-utils/contextutils"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+	"go.uber.org/zap"
+)
+
+var (
+	EmptyDomainError = eris.New("rate limit domain must not be empty")
+
+	CounterError = func(err error) error {
+		return eris.Wrap(err, "updating rate limit counter")
+	}
+)
+
+var _ pb.RateLimitServiceServer = new(Server)
+
+// Server implements the Envoy rate limit service using the configuration received from Gloo.
+type Server struct {
+	counter backend.Counter
+	config  atomic.Pointer[config.Config]
+	now     func() time.Time
+}
+
+func NewServer(counter backend.Counter) *Server {
+	s := &Server{
+		counter: counter,
+		now:     time.Now,
+	}
+	s.config.Store(config.NewConfig(nil))
+	return s
+}
+
+// SetConfig replaces the configuration used to evaluate requests.
+func (s *Server) SetConfig(configs []*enterprise.RateLimitConfig) {
+	s.config.Store(config.NewConfig(configs))
+}
+
+type appliedRule struct {
+	rule       *config.Rule
+	descriptor int
+}
+
+func (s *Server) ShouldRateLimit(ctx context.Context, req *pb.RateLimitRequest) (*pb.RateLimitResponse, error) {
+	if req.GetDomain() == "" {
+		return nil, EmptyDomainError
+	}
+	cfg := s.config.Load()
+	if !cfg.HasDomain(req.GetDomain()) {
+		contextutils.LoggerFrom(ctx).Debugw("no rate limit configuration for domain", zap.String("domain", req.GetDomain()))
+	}
+
+	hits := uint64(req.GetHitsAddend())
+	if hits == 0 {
+		hits = 1
+	}
+
+	// collect the rules for every descriptor, then keep the highest weighted ones and any that always apply
+	var candidates []appliedRule
+	var maxWeight uint32
+	for i, descriptor := range req.GetDescriptors() {
+		for _, rule := range cfg.Match(req.GetDomain(), toEntries(descriptor)) {
+			if override := limitOverride(descriptor.GetLimit()); override != nil {
+				rule.Limit = override
+			}
+			if rule.Weight > maxWeight {
+				maxWeight = rule.Weight
+			}
+			candidates = append(candidates, appliedRule{rule: rule, descriptor: i})
+		}
+	}
+
+	now := s.now()
+	response := &pb.RateLimitResponse{
+		OverallCode: pb.RateLimitResponse_OK,
+		Statuses:    make([]*pb.RateLimitResponse_DescriptorStatus, len(req.GetDescriptors())),
+	}
+	for i := range response.GetStatuses() {
+		response.Statuses[i] = &pb.RateLimitResponse_DescriptorStatus{Code: pb.RateLimitResponse_OK}
+	}
+
+	for _, candidate := range candidates {
+		if candidate.rule.Weight < maxWeight && !candidate.rule.AlwaysApply {
+			continue
+		}
+		status, err := s.apply(ctx, req.GetDomain(), candidate.rule, hits, now)
+		if err != nil {
+			return nil, CounterError(err)
+		}
+		// a descriptor matching several rules reports the most restrictive one
+		if moreRestrictive(status, response.GetStatuses()[candidate.descriptor]) {
+			response.Statuses[candidate.descriptor] = status
+		}
+		if status.GetCode() == pb.RateLimitResponse_OVER_LIMIT {
+			response.OverallCode = pb.RateLimitResponse_OVER_LIMIT
+		}
+	}
+	return response, nil
+}
+
+func (s *Server) apply(ctx context.Context, domain string, rule *config.Rule, hits uint64, now time.Time) (*pb.RateLimitResponse_DescriptorStatus, error) {
+	window := unitDuration(rule.Limit.GetUnit())
+	if window == 0 {
+		return &pb.RateLimitResponse_DescriptorStatus{Code: pb.RateLimitResponse_OK}, nil
+	}
+	windowStart := now.Truncate(window)
+	key := domain + "|" + rule.Key + "|" + windowStart.Format(time.RFC3339)
+
+	count, err := s.counter.Increment(ctx, key, hits, window)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := uint64(rule.Limit.GetRequestsPerUnit())
+	status := &pb.RateLimitResponse_DescriptorStatus{
+		Code: pb.RateLimitResponse_OK,
+		CurrentLimit: &pb.RateLimitResponse_RateLimit{
+			RequestsPerUnit: rule.Limit.GetRequestsPerUnit(),
+			// both enums number their units identically
+			Unit: pb.RateLimitResponse_RateLimit_Unit(rule.Limit.GetUnit()),
+		},
+		DurationUntilReset: prototime.DurationToProto(windowStart.Add(window).Sub(now)),
+	}
+	if count > limit {
+		status.Code = pb.RateLimitResponse_OVER_LIMIT
+	} else {
+		status.LimitRemaining = uint32(limit - count)
+	}
+	return status, nil
+}
+
+func moreRestrictive(status, current *pb.RateLimitResponse_DescriptorStatus) bool {
+	if current.GetCurrentLimit() == nil {
+		return true
+	}
+	if status.GetCode() != current.GetCode() {
+		return status.GetCode() == pb.RateLimitResponse_OVER_LIMIT
+	}
+	return status.GetLimitRemaining() < current.GetLimitRemaining()
+}
+
+func toEntries(descriptor *envoy_extensions_common_ratelimit_v3.RateLimitDescriptor) []config.Entry {
+	entries := make([]config.Entry, 0, len(descriptor.GetEntries()))
+	for _, entry := range descriptor.GetEntries() {
+		entries = append(entries, config.Entry{Key: entry.GetKey(), Value: entry.GetValue()})
+	}
+	return entries
+}
+
+// limitOverride converts the limit Envoy attaches to a descriptor, which takes precedence over the configured one
+func limitOverride(override *envoy_extensions_common_ratelimit_v3.RateLimitDescriptor_RateLimitOverride) *solo_rl.RateLimit {
+	if override == nil {
+		return nil
+	}
+	var unit solo_rl.RateLimit_Unit
+	switch override.GetUnit() {
+	case envoy_type_v3.RateLimitUnit_SECOND:
+		unit = solo_rl.RateLimit_SECOND
+	case envoy_type_v3.RateLimitUnit_MINUTE:
+		unit = solo_rl.RateLimit_MINUTE
+	case envoy_type_v3.RateLimitUnit_HOUR:
+		unit = solo_rl.RateLimit_HOUR
+	case envoy_type_v3.RateLimitUnit_DAY:
+		unit = solo_rl.RateLimit_DAY
+	default:
+		return nil
+	}
+	return &solo_rl.RateLimit{Unit: unit, RequestsPerUnit: override.GetRequestsPerUnit()}
+}
+
+func unitDuration(unit solo_rl.RateLimit_Unit) time.Duration {
+	switch unit {
+	case solo_rl.RateLimit_SECOND:
+		return time.Second
+	case solo_rl.RateLimit_MINUTE:
+		return time.Minute
+	case solo_rl.RateLimit_HOUR:
+		return time.Hour
+	case solo_rl.RateLimit_DAY:
+		return 24 * time.Hour
+	}
+	return 0
+}
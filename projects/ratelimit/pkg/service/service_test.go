@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	envoy_extensions_common_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise"
+	"github.com/solo-io/gloo/projects/ratelimit/pkg/backend"
+	. "github.com/solo-io/gloo/projects/ratelimit/pkg/service"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+)
+
+type failingCounter struct{}
+
+func (failingCounter) Increment(context.Context, string, uint64, time.Duration) (uint64, error) {
+	return 0, eris.New("connection refused")
+}
+
+var _ = Describe("Server", func() {
+
+	var (
+		ctx    context.Context
+		server *Server
+	)
+
+	perHour := func(n uint32) *solo_rl.RateLimit {
+		return &solo_rl.RateLimit{Unit: solo_rl.RateLimit_HOUR, RequestsPerUnit: n}
+	}
+
+	descriptor := func(kvs ...string) *envoy_extensions_common_ratelimit_v3.RateLimitDescriptor {
+		d := &envoy_extensions_common_ratelimit_v3.RateLimitDescriptor{}
+		for i := 0; i < len(kvs); i += 2 {
+			d.Entries = append(d.Entries, &envoy_extensions_common_ratelimit_v3.RateLimitDescriptor_Entry{Key: kvs[i], Value: kvs[i+1]})
+		}
+		return d
+	}
+
+	request := func(descriptors ...*envoy_extensions_common_ratelimit_v3.RateLimitDescriptor) *pb.RateLimitRequest {
+		return &pb.RateLimitRequest{Domain: "custom", Descriptors: descriptors}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = NewServer(backend.NewMemoryCounter())
+		server.SetConfig([]*enterprise.RateLimitConfig{{
+			Domain: "custom",
+			Descriptors: []*solo_rl.Descriptor{
+				{Key: "user", RateLimit: perHour(2)},
+				{Key: "path", Value: "/expensive", RateLimit: perHour(1), Weight: 1},
+				{Key: "path", Value: "/login", RateLimit: perHour(1), Weight: 1},
+				{Key: "generic_key", Value: "global", RateLimit: perHour(1), AlwaysApply: true},
+			},
+		}})
+	})
+
+	It("allows requests until the limit is exceeded", func() {
+		for i := 0; i < 2; i++ {
+			resp, err := server.ShouldRateLimit(ctx, request(descriptor("user", "alice")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OK))
+			Expect(resp.GetStatuses()[0].GetLimitRemaining()).To(BeEquivalentTo(1 - i))
+			Expect(resp.GetStatuses()[0].GetCurrentLimit().GetUnit()).To(Equal(pb.RateLimitResponse_RateLimit_HOUR))
+			Expect(resp.GetStatuses()[0].GetDurationUntilReset().AsDuration()).To(BeNumerically("<=", time.Hour))
+		}
+
+		resp, err := server.ShouldRateLimit(ctx, request(descriptor("user", "alice")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OVER_LIMIT))
+		Expect(resp.GetStatuses()[0].GetCode()).To(Equal(pb.RateLimitResponse_OVER_LIMIT))
+
+		resp, err = server.ShouldRateLimit(ctx, request(descriptor("user", "bob")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OK))
+	})
+
+	It("adds hits_addend to the counter", func() {
+		req := request(descriptor("user", "alice"))
+		req.HitsAddend = 3
+		resp, err := server.ShouldRateLimit(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OVER_LIMIT))
+	})
+
+	It("only applies the highest weighted rules and those that always apply", func() {
+		resp, err := server.ShouldRateLimit(ctx, request(descriptor("user", "alice"), descriptor("path", "/expensive")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OK))
+		// the user rule has a lower weight than the path rule, so it is not counted
+		Expect(resp.GetStatuses()[0].GetCurrentLimit()).To(BeNil())
+		Expect(resp.GetStatuses()[1].GetCurrentLimit().GetRequestsPerUnit()).To(BeEquivalentTo(1))
+
+		resp, err = server.ShouldRateLimit(ctx, request(descriptor("path", "/login"), descriptor("generic_key", "global")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetStatuses()[1].GetCurrentLimit().GetRequestsPerUnit()).To(BeEquivalentTo(1))
+
+		resp, err = server.ShouldRateLimit(ctx, request(descriptor("generic_key", "global")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OVER_LIMIT))
+	})
+
+	It("allows requests that match no rule", func() {
+		resp, err := server.ShouldRateLimit(ctx, &pb.RateLimitRequest{Domain: "unknown", Descriptors: []*envoy_extensions_common_ratelimit_v3.RateLimitDescriptor{descriptor("user", "alice")}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetOverallCode()).To(Equal(pb.RateLimitResponse_OK))
+		Expect(resp.GetStatuses()).To(HaveLen(1))
+	})
+
+	It("errors when the counter cannot be updated", func() {
+		server = NewServer(failingCounter{})
+		server.SetConfig([]*enterprise.RateLimitConfig{{
+			Domain:      "custom",
+			Descriptors: []*solo_rl.Descriptor{{Key: "user", RateLimit: perHour(2)}},
+		}})
+		_, err := server.ShouldRateLimit(ctx, request(descriptor("user", "alice")))
+		Expect(err).To(MatchError(ContainSubstring("connection refused")))
+	})
+
+	It("errors when the domain is empty", func() {
+		_, err := server.ShouldRateLimit(ctx, &pb.RateLimitRequest{})
+		Expect(err).To(MatchError(EmptyDomainError))
+	})
+})
@@ -26,6 +26,7 @@ import (
 
 	ratelimit2 "github.com/solo-io/gloo/projects/gloo/api/external/solo/ratelimit"
 	v1alpha1skv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
+	rlsyncer "github.com/solo-io/gloo/projects/gloo/pkg/syncer/ratelimit"
 	"github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	rlv1alpha1 "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	gloo_matchers "github.com/solo-io/solo-kit/test/matchers"
@@ -2064,13 +2065,12 @@ spec:
         value: foo
         rateLimit:
           requestsPerUnit: 1
-          unit: MINUTE
     rateLimits:
       - actions:
         - genericKey:
             descriptorValue: bar
 `,
-						errorMatcher: ContainSubstring("The Gloo Advanced Rate limit API feature 'RateLimitConfig' is enterprise-only"),
+						errorMatcher: ContainSubstring(rlsyncer.InvalidRateLimitUnitError("foo").Error()),
 					},
 				}
 
@@ -2835,12 +2835,11 @@ spec:
 				})
 
 				It("correctly sets a status to a RateLimitConfig", func() {
-					// demand that a created ratelimit config _has_ a rejected status.
+					// demand that a created ratelimit config _has_ an accepted status.
 					Eventually(func(g Gomega) error {
 						rlc, err := resourceClientset.RateLimitConfigClient().Read(rateLimitConfig.GetMetadata().GetNamespace(), rateLimitConfig.GetMetadata().GetName(), clients.ReadOpts{Ctx: ctx})
 						g.Expect(err).NotTo(HaveOccurred())
-						g.Expect(rlc.Status.State).To(Equal(v1alpha1.RateLimitConfigStatus_REJECTED))
-						g.Expect(rlc.Status.Message).Should(ContainSubstring("enterprise-only"))
+						g.Expect(rlc.Status.State).To(Equal(v1alpha1.RateLimitConfigStatus_ACCEPTED))
 						return nil
 					}, "15s", "0.5s").ShouldNot(HaveOccurred())
 				})